@@ -0,0 +1,6631 @@
+// Package client provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Defines values for AuditAction.
+const (
+	AdminBalanceAdjustment AuditAction = "admin.balance_adjustment"
+	AdminUserStatusChange  AuditAction = "admin.user_status_change"
+	BalanceUpdate          AuditAction = "balance.update"
+	UserLogin              AuditAction = "user.login"
+)
+
+// Defines values for Currency.
+const (
+	IDR Currency = "IDR"
+	SGD Currency = "SGD"
+	USD Currency = "USD"
+)
+
+// Defines values for DisbursementCallbackStatus.
+const (
+	DisbursementCallbackStatusFailed     DisbursementCallbackStatus = "Failed"
+	DisbursementCallbackStatusSuccessful DisbursementCallbackStatus = "Successful"
+)
+
+// Defines values for QRType.
+const (
+	Dynamic QRType = "Dynamic"
+	Static  QRType = "Static"
+)
+
+// Defines values for SharedWalletRole.
+const (
+	Owner   SharedWalletRole = "Owner"
+	Spender SharedWalletRole = "Spender"
+	Viewer  SharedWalletRole = "Viewer"
+)
+
+// Defines values for TopUpIntentStatus.
+const (
+	Expired TopUpIntentStatus = "Expired"
+	Paid    TopUpIntentStatus = "Paid"
+	Pending TopUpIntentStatus = "Pending"
+)
+
+// Defines values for TransactionStatus.
+const (
+	TransactionStatusFailed     TransactionStatus = "Failed"
+	TransactionStatusPending    TransactionStatus = "Pending"
+	TransactionStatusSuccessful TransactionStatus = "Successful"
+)
+
+// Defines values for TransactionType.
+const (
+	AdjustmentCredit    TransactionType = "AdjustmentCredit"
+	AdjustmentDebit     TransactionType = "AdjustmentDebit"
+	Convert             TransactionType = "Convert"
+	Payment             TransactionType = "Payment"
+	PocketTransfer      TransactionType = "PocketTransfer"
+	SharedWalletDeposit TransactionType = "SharedWalletDeposit"
+	TopUp               TransactionType = "TopUp"
+	TransferOut         TransactionType = "TransferOut"
+	Withdrawal          TransactionType = "Withdrawal"
+)
+
+// Defines values for UserStatus.
+const (
+	Active UserStatus = "Active"
+	Closed UserStatus = "Closed"
+	Frozen UserStatus = "Frozen"
+)
+
+// AuditAction defines model for AuditAction.
+type AuditAction string
+
+// AuditLogEntry defines model for AuditLogEntry.
+type AuditLogEntry struct {
+	Action      AuditAction       `json:"action"`
+	ActorId     *int64            `json:"actor_id,omitempty"`
+	CreatedTime time.Time         `json:"created_time"`
+	Details     map[string]string `json:"details"`
+	Hash        string            `json:"hash"`
+	Id          int64             `json:"id"`
+	Ip          *string           `json:"ip,omitempty"`
+	PrevHash    string            `json:"prev_hash"`
+	UserAgent   *string           `json:"user_agent,omitempty"`
+	UserId      *int64            `json:"user_id,omitempty"`
+}
+
+// AuditLogResponse defines model for AuditLogResponse.
+type AuditLogResponse struct {
+	Entries []AuditLogEntry `json:"entries"`
+	Header  ResponseHeader  `json:"header"`
+}
+
+// Balance defines model for Balance.
+type Balance struct {
+	Amount   *float32  `json:"amount,omitempty"`
+	Currency *Currency `json:"currency,omitempty"`
+}
+
+// BalanceAdjustmentRequest defines model for BalanceAdjustmentRequest.
+type BalanceAdjustmentRequest struct {
+	// Amount Credited if positive, debited if negative, never zero.
+	Amount   float32   `json:"amount"`
+	Currency *Currency `json:"currency,omitempty"`
+
+	// Reason Why the balance is adjusted, kept as the transaction description.
+	Reason string `json:"reason"`
+}
+
+// BankAccount defines model for BankAccount.
+type BankAccount struct {
+	// AccountName Name of the bank account holder, without leading or trailing spaces.
+	AccountName   *string `json:"account_name,omitempty"`
+	AccountNumber *string `json:"account_number,omitempty"`
+
+	// BankCode Bank code, i.e. 014 for BCA.
+	BankCode *string `json:"bank_code,omitempty"`
+	Id       *int64  `json:"id,omitempty"`
+}
+
+// BankAccountResponse defines model for BankAccountResponse.
+type BankAccountResponse struct {
+	BankAccount BankAccount    `json:"bank_account"`
+	Header      ResponseHeader `json:"header"`
+}
+
+// BankAccountsResponse defines model for BankAccountsResponse.
+type BankAccountsResponse struct {
+	BankAccounts []BankAccount  `json:"bank_accounts"`
+	Header       ResponseHeader `json:"header"`
+}
+
+// CallbackResponse defines model for CallbackResponse.
+type CallbackResponse struct {
+	Header ResponseHeader `json:"header"`
+}
+
+// Contact defines model for Contact.
+type Contact struct {
+	CreatedTime *time.Time `json:"created_time,omitempty"`
+	Id          *int64     `json:"id,omitempty"`
+	Nickname    *string    `json:"nickname,omitempty"`
+
+	// RecipientClosed The account of the recipient is closed, it can not receive transfers anymore.
+	RecipientClosed *bool  `json:"recipient_closed,omitempty"`
+	RecipientId     *int64 `json:"recipient_id,omitempty"`
+
+	// RecipientName Current masked full name of the recipient, i.e. Jo** Do*.
+	RecipientName *string `json:"recipient_name,omitempty"`
+}
+
+// ContactRequest defines model for ContactRequest.
+type ContactRequest struct {
+	Nickname string `json:"nickname"`
+
+	// RecipientId Only a recipient the user has already transferred to.
+	RecipientId *int64 `json:"recipient_id,omitempty"`
+
+	// RecipientPhoneNumber Phone number of the recipient, counts as a recipient lookup, see LookupRecipient.
+	RecipientPhoneNumber *string `json:"recipient_phone_number,omitempty"`
+
+	// RecipientToken Token of a recipient lookup.
+	RecipientToken *string `json:"recipient_token,omitempty"`
+}
+
+// ContactResponse defines model for ContactResponse.
+type ContactResponse struct {
+	Contact Contact        `json:"contact"`
+	Header  ResponseHeader `json:"header"`
+}
+
+// ContactSuggestion defines model for ContactSuggestion.
+type ContactSuggestion struct {
+	LastTransferTime *time.Time `json:"last_transfer_time,omitempty"`
+
+	// RecipientId Saved as a contact with recipient_id of AddContact.
+	RecipientId *int64 `json:"recipient_id,omitempty"`
+
+	// RecipientName Masked full name of the recipient, i.e. Jo** Do*.
+	RecipientName *string `json:"recipient_name,omitempty"`
+
+	// TransferCount Successful transfers to the recipient over the last 90 days.
+	TransferCount *int `json:"transfer_count,omitempty"`
+}
+
+// ContactSuggestionsResponse defines model for ContactSuggestionsResponse.
+type ContactSuggestionsResponse struct {
+	Header      ResponseHeader      `json:"header"`
+	Suggestions []ContactSuggestion `json:"suggestions"`
+}
+
+// ContactsResponse defines model for ContactsResponse.
+type ContactsResponse struct {
+	Contacts []Contact      `json:"contacts"`
+	Header   ResponseHeader `json:"header"`
+}
+
+// Currency defines model for Currency.
+type Currency string
+
+// DeleteContactResponse defines model for DeleteContactResponse.
+type DeleteContactResponse struct {
+	Header ResponseHeader `json:"header"`
+}
+
+// DeletePocketResponse defines model for DeletePocketResponse.
+type DeletePocketResponse struct {
+	Header ResponseHeader `json:"header"`
+}
+
+// DeleteSharedWalletMemberResponse defines model for DeleteSharedWalletMemberResponse.
+type DeleteSharedWalletMemberResponse struct {
+	Header ResponseHeader `json:"header"`
+}
+
+// DisbursementCallback defines model for DisbursementCallback.
+type DisbursementCallback struct {
+	FailureReason *string `json:"failure_reason,omitempty"`
+
+	// Reference Disbursement reference assigned by the gateway.
+	Reference *string                    `json:"reference,omitempty"`
+	Status    DisbursementCallbackStatus `json:"status"`
+
+	// TransactionId ID of the Withdrawal transaction sent as idempotency key in the disbursement request.
+	TransactionId string `json:"transaction_id"`
+}
+
+// DisbursementCallbackStatus defines model for DisbursementCallback.Status.
+type DisbursementCallbackStatus string
+
+// FXQuote defines model for FXQuote.
+type FXQuote struct {
+	ExpiryTime *time.Time `json:"expiry_time,omitempty"`
+
+	// Fee Fee in source currency, already deducted from source_amount before conversion.
+	Fee            *float32  `json:"fee,omitempty"`
+	Id             *string   `json:"id,omitempty"`
+	Rate           *float64  `json:"rate,omitempty"`
+	SourceAmount   *float32  `json:"source_amount,omitempty"`
+	SourceCurrency *Currency `json:"source_currency,omitempty"`
+	TargetAmount   *float32  `json:"target_amount,omitempty"`
+	TargetCurrency *Currency `json:"target_currency,omitempty"`
+}
+
+// FXQuoteRequest defines model for FXQuoteRequest.
+type FXQuoteRequest struct {
+	SourceAmount   float32  `json:"source_amount"`
+	SourceCurrency Currency `json:"source_currency"`
+	TargetCurrency Currency `json:"target_currency"`
+}
+
+// FXQuoteResponse defines model for FXQuoteResponse.
+type FXQuoteResponse struct {
+	Header ResponseHeader `json:"header"`
+	Quote  FXQuote        `json:"quote"`
+}
+
+// FeePreview defines model for FeePreview.
+type FeePreview struct {
+	Amount   *float32  `json:"amount,omitempty"`
+	Currency *Currency `json:"currency,omitempty"`
+	Fee      *float32  `json:"fee,omitempty"`
+
+	// TotalAmount Amount and fee deducted from the balance.
+	TotalAmount *float32 `json:"total_amount,omitempty"`
+	Type        *string  `json:"type,omitempty"`
+}
+
+// FeePreviewRequest defines model for FeePreviewRequest.
+type FeePreviewRequest struct {
+	Amount   float32   `json:"amount"`
+	Currency *Currency `json:"currency,omitempty"`
+
+	// Type Transaction type, one of TransferOut, Payment or Withdrawal.
+	Type string `json:"type"`
+}
+
+// FeePreviewResponse defines model for FeePreviewResponse.
+type FeePreviewResponse struct {
+	Fee    FeePreview     `json:"fee"`
+	Header ResponseHeader `json:"header"`
+}
+
+// GetUserResponse defines model for GetUserResponse.
+type GetUserResponse struct {
+	Header ResponseHeader `json:"header"`
+	User   User           `json:"user"`
+}
+
+// HealthResponse defines model for HealthResponse.
+type HealthResponse struct {
+	// Checks Result of each readiness check, "ok" or the reason it failed.
+	Checks *map[string]string `json:"checks,omitempty"`
+	Header ResponseHeader     `json:"header"`
+}
+
+// PhoneNumberChange Phone number change waiting for the OTP sent to the new phone number.
+type PhoneNumberChange struct {
+	ExpiryTime  *time.Time `json:"expiry_time,omitempty"`
+	PhoneNumber *string    `json:"phone_number,omitempty"`
+}
+
+// Pocket defines model for Pocket.
+type Pocket struct {
+	// Balance Balance in IDR.
+	Balance     *float32   `json:"balance,omitempty"`
+	CreatedTime *time.Time `json:"created_time,omitempty"`
+	Id          *int64     `json:"id,omitempty"`
+	Name        *string    `json:"name,omitempty"`
+
+	// TargetAmount Amount the user is saving for, not set if zero.
+	TargetAmount *float32 `json:"target_amount,omitempty"`
+
+	// TargetDate Date the user is saving for.
+	TargetDate *openapi_types.Date `json:"target_date,omitempty"`
+}
+
+// PocketRequest defines model for PocketRequest.
+type PocketRequest struct {
+	Name         string              `json:"name"`
+	TargetAmount *float32            `json:"target_amount,omitempty"`
+	TargetDate   *openapi_types.Date `json:"target_date,omitempty"`
+}
+
+// PocketResponse defines model for PocketResponse.
+type PocketResponse struct {
+	Header ResponseHeader `json:"header"`
+	Pocket Pocket         `json:"pocket"`
+}
+
+// QR defines model for QR.
+type QR struct {
+	Amount *float32 `json:"amount,omitempty"`
+
+	// Payload EMVCo merchant-presented QR payload, to be rendered as QR code by the client.
+	Payload *string `json:"payload,omitempty"`
+	Type    *QRType `json:"type,omitempty"`
+}
+
+// QRType defines model for QR.Type.
+type QRType string
+
+// QRPaymentRequest defines model for QRPaymentRequest.
+type QRPaymentRequest struct {
+	// Amount Amount to be paid. Required for static QR, must match the QR amount for dynamic QR.
+	Amount      *float32 `json:"amount,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	Password    *string  `json:"password,omitempty"`
+
+	// Payload Scanned EMVCo merchant-presented QR payload.
+	Payload string `json:"payload"`
+}
+
+// QRRequest defines model for QRRequest.
+type QRRequest struct {
+	// Amount Amount to be paid. Generates a dynamic QR if set, otherwise a static QR.
+	Amount *float32 `json:"amount,omitempty"`
+
+	// Description Purpose of transaction, only used for dynamic QR.
+	Description *string `json:"description,omitempty"`
+
+	// MerchantCategoryCode ISO 18245 merchant category code. Defaults to the category of a verified merchant, or 4829 (money transfer) for personal wallets, which can not use any other.
+	MerchantCategoryCode *string `json:"merchant_category_code,omitempty"`
+
+	// MerchantName Name shown to the payer. Defaults to User's full name.
+	MerchantName *string `json:"merchant_name,omitempty"`
+}
+
+// QRResponse defines model for QRResponse.
+type QRResponse struct {
+	Header ResponseHeader `json:"header"`
+	Qr     QR             `json:"qr"`
+}
+
+// Recipient defines model for Recipient.
+type Recipient struct {
+	ExpiryTime *time.Time `json:"expiry_time,omitempty"`
+
+	// Name Masked full name of the recipient for the sender to confirm, i.e. Jo** Do*.
+	Name *string `json:"name,omitempty"`
+
+	// Token Transfers to the recipient as recipient_token until expiry_time.
+	Token *string `json:"token,omitempty"`
+}
+
+// RecipientLookupRequest defines model for RecipientLookupRequest.
+type RecipientLookupRequest struct {
+	// PhoneNumber Phone number of the recipient, in the formats accepted for User's phone_number.
+	PhoneNumber string `json:"phone_number"`
+}
+
+// RecipientLookupResponse defines model for RecipientLookupResponse.
+type RecipientLookupResponse struct {
+	Header    ResponseHeader `json:"header"`
+	Recipient Recipient      `json:"recipient"`
+}
+
+// RegisterBankAccountRequest defines model for RegisterBankAccountRequest.
+type RegisterBankAccountRequest struct {
+	// AccountName Name of the bank account holder, without leading or trailing spaces.
+	AccountName   string `json:"account_name"`
+	AccountNumber string `json:"account_number"`
+
+	// BankCode Bank code, i.e. 014 for BCA.
+	BankCode string `json:"bank_code"`
+	Id       *int64 `json:"id,omitempty"`
+}
+
+// RegisterUserRequest defines model for RegisterUserRequest.
+type RegisterUserRequest struct {
+	// Balance Balance in IDR available to spend, money in pockets excluded.
+	Balance *float32 `json:"balance,omitempty"`
+
+	// Balances Balance in every currency the user holds.
+	Balances    *[]Balance `json:"balances,omitempty"`
+	CreatedTime *time.Time `json:"created_time,omitempty"`
+
+	// FullName User's full name, without leading or trailing spaces.
+	FullName string `json:"full_name"`
+	Id       *int64 `json:"id,omitempty"`
+
+	// Password User's password, containing a capital letter, a number and a special character.
+	Password string `json:"password"`
+
+	// PhoneNumber User's mobile phone number, returned in E.164 format, i.e. +628123456789. Local numbers of the default country, i.e. 0812-3456-789, are accepted, see validation in config.example.yml for the allowed countries.
+	PhoneNumber string `json:"phone_number"`
+
+	// Pockets Money in IDR the user has set aside, see CreatePocket.
+	Pockets *[]Pocket `json:"pockets,omitempty"`
+
+	// Status Frozen accounts can log in and read but not send, Closed accounts can not log in.
+	Status *UserStatus `json:"status,omitempty"`
+}
+
+// RegisterUserResponse defines model for RegisterUserResponse.
+type RegisterUserResponse struct {
+	Header ResponseHeader `json:"header"`
+	User   User           `json:"user"`
+}
+
+// ResponseHeader defines model for ResponseHeader.
+type ResponseHeader struct {
+	// Messages Array of error message(s).
+	Messages []string `json:"messages"`
+
+	// Success Boolean to denote whether response is OK or not.
+	Success bool `json:"success"`
+}
+
+// SharedWallet defines model for SharedWallet.
+type SharedWallet struct {
+	// Balance Balance in IDR.
+	Balance     *float32   `json:"balance,omitempty"`
+	CreatedTime *time.Time `json:"created_time,omitempty"`
+	Id          *int64     `json:"id,omitempty"`
+
+	// MonthlyLimit Maximum amount the user transfers out of the shared wallet in a calendar month, not set if no limit.
+	MonthlyLimit *float32 `json:"monthly_limit,omitempty"`
+
+	// MonthlySpent Amount the user transferred out of the shared wallet this month, fees excluded.
+	MonthlySpent *float32 `json:"monthly_spent,omitempty"`
+	Name         *string  `json:"name,omitempty"`
+	OwnerId      *int64   `json:"owner_id,omitempty"`
+
+	// Role Owner created the shared wallet, manages its members and is not limited. Spender transfers out of it within its limits. Viewer only views it and its activity. Every member can deposit into it.
+	Role *SharedWalletRole `json:"role,omitempty"`
+
+	// TransactionLimit Maximum amount of a transfer of the user out of the shared wallet, not set if no limit.
+	TransactionLimit *float32 `json:"transaction_limit,omitempty"`
+}
+
+// SharedWalletMember defines model for SharedWalletMember.
+type SharedWalletMember struct {
+	// Closed The account of the member is closed, it can not act on the shared wallet anymore.
+	Closed      *bool      `json:"closed,omitempty"`
+	CreatedTime *time.Time `json:"created_time,omitempty"`
+
+	// MonthlyLimit Maximum amount transferred out of the shared wallet in a calendar month, not set if no limit.
+	MonthlyLimit *float32 `json:"monthly_limit,omitempty"`
+
+	// MonthlySpent Amount transferred out of the shared wallet this month, fees excluded.
+	MonthlySpent *float32 `json:"monthly_spent,omitempty"`
+
+	// Name Masked full name of the member, i.e. Jo** Do*.
+	Name *string `json:"name,omitempty"`
+
+	// Role Owner created the shared wallet, manages its members and is not limited. Spender transfers out of it within its limits. Viewer only views it and its activity. Every member can deposit into it.
+	Role *SharedWalletRole `json:"role,omitempty"`
+
+	// TransactionLimit Maximum amount of a transfer out of the shared wallet, not set if no limit.
+	TransactionLimit *float32 `json:"transaction_limit,omitempty"`
+	UserId           *int64   `json:"user_id,omitempty"`
+}
+
+// SharedWalletMemberRequest defines model for SharedWalletMemberRequest.
+type SharedWalletMemberRequest struct {
+	// MonthlyLimit No limit if not set or zero.
+	MonthlyLimit *float32 `json:"monthly_limit,omitempty"`
+
+	// PhoneNumber Phone number of the member, counts as a recipient lookup, see LookupRecipient.
+	PhoneNumber *string `json:"phone_number,omitempty"`
+
+	// Role Owner created the shared wallet, manages its members and is not limited. Spender transfers out of it within its limits. Viewer only views it and its activity. Every member can deposit into it.
+	Role SharedWalletRole `json:"role"`
+
+	// Token Token of a recipient lookup of the member.
+	Token *string `json:"token,omitempty"`
+
+	// TransactionLimit No limit if not set or zero.
+	TransactionLimit *float32 `json:"transaction_limit,omitempty"`
+}
+
+// SharedWalletMemberResponse defines model for SharedWalletMemberResponse.
+type SharedWalletMemberResponse struct {
+	Header ResponseHeader     `json:"header"`
+	Member SharedWalletMember `json:"member"`
+}
+
+// SharedWalletMembersResponse defines model for SharedWalletMembersResponse.
+type SharedWalletMembersResponse struct {
+	Header  ResponseHeader       `json:"header"`
+	Members []SharedWalletMember `json:"members"`
+}
+
+// SharedWalletRequest defines model for SharedWalletRequest.
+type SharedWalletRequest struct {
+	Name string `json:"name"`
+}
+
+// SharedWalletResponse defines model for SharedWalletResponse.
+type SharedWalletResponse struct {
+	Header       ResponseHeader `json:"header"`
+	SharedWallet SharedWallet   `json:"shared_wallet"`
+}
+
+// SharedWalletRole Owner created the shared wallet, manages its members and is not limited. Spender transfers out of it within its limits. Viewer only views it and its activity. Every member can deposit into it.
+type SharedWalletRole string
+
+// SharedWalletsResponse defines model for SharedWalletsResponse.
+type SharedWalletsResponse struct {
+	Header        ResponseHeader `json:"header"`
+	SharedWallets []SharedWallet `json:"shared_wallets"`
+}
+
+// TopUpCallback defines model for TopUpCallback.
+type TopUpCallback struct {
+	Amount   float32    `json:"amount"`
+	PaidTime *time.Time `json:"paid_time,omitempty"`
+
+	// PaymentReference Unique reference of the payment assigned by the gateway.
+	PaymentReference     string `json:"payment_reference"`
+	VirtualAccountNumber string `json:"virtual_account_number"`
+}
+
+// TopUpIntent defines model for TopUpIntent.
+type TopUpIntent struct {
+	Amount               *float32           `json:"amount,omitempty"`
+	BankCode             *string            `json:"bank_code,omitempty"`
+	ExpiryTime           *time.Time         `json:"expiry_time,omitempty"`
+	Id                   *string            `json:"id,omitempty"`
+	Status               *TopUpIntentStatus `json:"status,omitempty"`
+	TransactionId        *string            `json:"transaction_id,omitempty"`
+	VirtualAccountNumber *string            `json:"virtual_account_number,omitempty"`
+}
+
+// TopUpIntentStatus defines model for TopUpIntent.Status.
+type TopUpIntentStatus string
+
+// TopUpRequest defines model for TopUpRequest.
+type TopUpRequest struct {
+	Amount float32 `json:"amount"`
+}
+
+// TopUpResponse defines model for TopUpResponse.
+type TopUpResponse struct {
+	Header ResponseHeader `json:"header"`
+	Topup  TopUpIntent    `json:"topup"`
+}
+
+// Transaction defines model for Transaction.
+type Transaction struct {
+	// ActorId Admin who made the transaction on the user's behalf, i.e. a balance adjustment.
+	ActorId *int64   `json:"actor_id,omitempty"`
+	Amount  *float32 `json:"amount,omitempty"`
+
+	// BankAccountId Destination bank account, required for Withdrawal.
+	BankAccountId     *int64     `json:"bank_account_id,omitempty"`
+	ConvertedAmount   *float32   `json:"converted_amount,omitempty"`
+	ConvertedCurrency *Currency  `json:"converted_currency,omitempty"`
+	CreatedTime       *time.Time `json:"created_time,omitempty"`
+	Currency          *Currency  `json:"currency,omitempty"`
+	Description       *string    `json:"description,omitempty"`
+
+	// Fee Fee charged on top of amount, in the same currency.
+	Fee *float32 `json:"fee,omitempty"`
+
+	// FxQuoteId Locked quote to use, required for Convert.
+	FxQuoteId *string `json:"fx_quote_id,omitempty"`
+	Id        *string `json:"id,omitempty"`
+	Password  *string `json:"password,omitempty"`
+
+	// PocketId Pocket the money of a PocketTransfer is moved into, the balance if not set.
+	PocketId    *int64 `json:"pocket_id,omitempty"`
+	RecipientId *int64 `json:"recipient_id,omitempty"`
+
+	// RecipientPhoneNumber Phone number of the recipient, alternative to recipient_id for TransferOut. Counts as a recipient lookup, see LookupRecipient.
+	RecipientPhoneNumber *string `json:"recipient_phone_number,omitempty"`
+
+	// RecipientToken Token of a recipient lookup, alternative to recipient_id for TransferOut once the recipient name is confirmed.
+	RecipientToken *string `json:"recipient_token,omitempty"`
+
+	// SharedWalletId Shared wallet a TransferOut is made out of, or a SharedWalletDeposit is made into, the balance if not set. user_id is then the member acting on the shared wallet.
+	SharedWalletId *int64 `json:"shared_wallet_id,omitempty"`
+
+	// SourcePocketId Pocket the money of a PocketTransfer is moved out of, the balance if not set.
+	SourcePocketId *int64             `json:"source_pocket_id,omitempty"`
+	Status         *TransactionStatus `json:"status,omitempty"`
+
+	// Type AdjustmentCredit and AdjustmentDebit are only made by admins, see AdminAdjustUserBalance. PocketTransfer moves money between the balance and the pockets of the user, it is free and does not count towards the transfer limits. SharedWalletDeposit moves money from the balance into the shared wallet of shared_wallet_id.
+	Type   *TransactionType `json:"type,omitempty"`
+	UserId *int64           `json:"user_id,omitempty"`
+}
+
+// TransactionRequest defines model for TransactionRequest.
+type TransactionRequest struct {
+	// ActorId Admin who made the transaction on the user's behalf, i.e. a balance adjustment.
+	ActorId *int64  `json:"actor_id,omitempty"`
+	Amount  float32 `json:"amount"`
+
+	// BankAccountId Destination bank account, required for Withdrawal.
+	BankAccountId     *int64     `json:"bank_account_id,omitempty"`
+	ConvertedAmount   *float32   `json:"converted_amount,omitempty"`
+	ConvertedCurrency *Currency  `json:"converted_currency,omitempty"`
+	CreatedTime       *time.Time `json:"created_time,omitempty"`
+	Currency          *Currency  `json:"currency,omitempty"`
+	Description       *string    `json:"description,omitempty"`
+
+	// Fee Fee charged on top of amount, in the same currency.
+	Fee *float32 `json:"fee,omitempty"`
+
+	// FxQuoteId Locked quote to use, required for Convert.
+	FxQuoteId *string `json:"fx_quote_id,omitempty"`
+	Id        *string `json:"id,omitempty"`
+	Password  *string `json:"password,omitempty"`
+
+	// PocketId Pocket the money of a PocketTransfer is moved into, the balance if not set.
+	PocketId    *int64 `json:"pocket_id,omitempty"`
+	RecipientId *int64 `json:"recipient_id,omitempty"`
+
+	// RecipientPhoneNumber Phone number of the recipient, alternative to recipient_id for TransferOut. Counts as a recipient lookup, see LookupRecipient.
+	RecipientPhoneNumber *string `json:"recipient_phone_number,omitempty"`
+
+	// RecipientToken Token of a recipient lookup, alternative to recipient_id for TransferOut once the recipient name is confirmed.
+	RecipientToken *string `json:"recipient_token,omitempty"`
+
+	// SharedWalletId Shared wallet a TransferOut is made out of, or a SharedWalletDeposit is made into, the balance if not set. user_id is then the member acting on the shared wallet.
+	SharedWalletId *int64 `json:"shared_wallet_id,omitempty"`
+
+	// SourcePocketId Pocket the money of a PocketTransfer is moved out of, the balance if not set.
+	SourcePocketId *int64             `json:"source_pocket_id,omitempty"`
+	Status         *TransactionStatus `json:"status,omitempty"`
+
+	// Type AdjustmentCredit and AdjustmentDebit are only made by admins, see AdminAdjustUserBalance. PocketTransfer moves money between the balance and the pockets of the user, it is free and does not count towards the transfer limits. SharedWalletDeposit moves money from the balance into the shared wallet of shared_wallet_id.
+	Type   TransactionType `json:"type"`
+	UserId *int64          `json:"user_id,omitempty"`
+}
+
+// TransactionResponse defines model for TransactionResponse.
+type TransactionResponse struct {
+	Header      ResponseHeader `json:"header"`
+	Transaction Transaction    `json:"transaction"`
+}
+
+// TransactionStatus defines model for TransactionStatus.
+type TransactionStatus string
+
+// TransactionType AdjustmentCredit and AdjustmentDebit are only made by admins, see AdminAdjustUserBalance. PocketTransfer moves money between the balance and the pockets of the user, it is free and does not count towards the transfer limits. SharedWalletDeposit moves money from the balance into the shared wallet of shared_wallet_id.
+type TransactionType string
+
+// TransactionsResponse defines model for TransactionsResponse.
+type TransactionsResponse struct {
+	Header       ResponseHeader `json:"header"`
+	Transactions []Transaction  `json:"transactions"`
+}
+
+// UpdateContactRequest defines model for UpdateContactRequest.
+type UpdateContactRequest struct {
+	Nickname string `json:"nickname"`
+}
+
+// UpdateSharedWalletMemberRequest defines model for UpdateSharedWalletMemberRequest.
+type UpdateSharedWalletMemberRequest struct {
+	// MonthlyLimit No limit if not set or zero.
+	MonthlyLimit *float32 `json:"monthly_limit,omitempty"`
+
+	// Role Owner created the shared wallet, manages its members and is not limited. Spender transfers out of it within its limits. Viewer only views it and its activity. Every member can deposit into it.
+	Role SharedWalletRole `json:"role"`
+
+	// TransactionLimit No limit if not set or zero.
+	TransactionLimit *float32 `json:"transaction_limit,omitempty"`
+}
+
+// UpdateUserRequest defines model for UpdateUserRequest.
+type UpdateUserRequest struct {
+	// Balance Balance in IDR available to spend, money in pockets excluded.
+	Balance *float32 `json:"balance,omitempty"`
+
+	// Balances Balance in every currency the user holds.
+	Balances    *[]Balance `json:"balances,omitempty"`
+	CreatedTime *time.Time `json:"created_time,omitempty"`
+
+	// FullName User's full name, without leading or trailing spaces.
+	FullName *string `json:"full_name,omitempty"`
+	Id       *int64  `json:"id,omitempty"`
+
+	// Password User's password, containing a capital letter, a number and a special character.
+	Password *string `json:"password,omitempty"`
+
+	// PhoneNumber User's mobile phone number, returned in E.164 format, i.e. +628123456789. Local numbers of the default country, i.e. 0812-3456-789, are accepted, see validation in config.example.yml for the allowed countries.
+	PhoneNumber *string `json:"phone_number,omitempty"`
+
+	// Pockets Money in IDR the user has set aside, see CreatePocket.
+	Pockets *[]Pocket `json:"pockets,omitempty"`
+
+	// Status Frozen accounts can log in and read but not send, Closed accounts can not log in.
+	Status *UserStatus `json:"status,omitempty"`
+}
+
+// UpdateUserResponse defines model for UpdateUserResponse.
+type UpdateUserResponse struct {
+	Header ResponseHeader `json:"header"`
+
+	// PhoneNumberChange Phone number change waiting for the OTP sent to the new phone number.
+	PhoneNumberChange *PhoneNumberChange `json:"phone_number_change,omitempty"`
+	User              User               `json:"user"`
+}
+
+// User defines model for User.
+type User struct {
+	// Balance Balance in IDR available to spend, money in pockets excluded.
+	Balance *float32 `json:"balance,omitempty"`
+
+	// Balances Balance in every currency the user holds.
+	Balances    *[]Balance `json:"balances,omitempty"`
+	CreatedTime *time.Time `json:"created_time,omitempty"`
+
+	// FullName User's full name, without leading or trailing spaces.
+	FullName *string `json:"full_name,omitempty"`
+	Id       *int64  `json:"id,omitempty"`
+
+	// Password User's password, containing a capital letter, a number and a special character.
+	Password *string `json:"password,omitempty"`
+
+	// PhoneNumber User's mobile phone number, returned in E.164 format, i.e. +628123456789. Local numbers of the default country, i.e. 0812-3456-789, are accepted, see validation in config.example.yml for the allowed countries.
+	PhoneNumber *string `json:"phone_number,omitempty"`
+
+	// Pockets Money in IDR the user has set aside, see CreatePocket.
+	Pockets *[]Pocket `json:"pockets,omitempty"`
+
+	// Status Frozen accounts can log in and read but not send, Closed accounts can not log in.
+	Status *UserStatus `json:"status,omitempty"`
+}
+
+// UserLoginRequest defines model for UserLoginRequest.
+type UserLoginRequest struct {
+	// Balance Balance in IDR available to spend, money in pockets excluded.
+	Balance *float32 `json:"balance,omitempty"`
+
+	// Balances Balance in every currency the user holds.
+	Balances    *[]Balance `json:"balances,omitempty"`
+	CreatedTime *time.Time `json:"created_time,omitempty"`
+
+	// FullName User's full name, without leading or trailing spaces.
+	FullName *string `json:"full_name,omitempty"`
+	Id       *int64  `json:"id,omitempty"`
+
+	// Password User's password, containing a capital letter, a number and a special character.
+	Password string `json:"password"`
+
+	// PhoneNumber User's mobile phone number, returned in E.164 format, i.e. +628123456789. Local numbers of the default country, i.e. 0812-3456-789, are accepted, see validation in config.example.yml for the allowed countries.
+	PhoneNumber string `json:"phone_number"`
+
+	// Pockets Money in IDR the user has set aside, see CreatePocket.
+	Pockets *[]Pocket `json:"pockets,omitempty"`
+
+	// Status Frozen accounts can log in and read but not send, Closed accounts can not log in.
+	Status *UserStatus `json:"status,omitempty"`
+}
+
+// UserLoginResponse defines model for UserLoginResponse.
+type UserLoginResponse struct {
+	Header ResponseHeader `json:"header"`
+	User   User           `json:"user"`
+}
+
+// UserStatus Frozen accounts can log in and read but not send, Closed accounts can not log in.
+type UserStatus string
+
+// UserStatusChange defines model for UserStatusChange.
+type UserStatusChange struct {
+	ActorId *int64 `json:"actor_id,omitempty"`
+
+	// FromStatus Frozen accounts can log in and read but not send, Closed accounts can not log in.
+	FromStatus *UserStatus `json:"from_status,omitempty"`
+	Id         *int64      `json:"id,omitempty"`
+	Reason     *string     `json:"reason,omitempty"`
+
+	// Status Frozen accounts can log in and read but not send, Closed accounts can not log in.
+	Status *UserStatus `json:"status,omitempty"`
+	UserId *int64      `json:"user_id,omitempty"`
+}
+
+// UserStatusChangeResponse defines model for UserStatusChangeResponse.
+type UserStatusChangeResponse struct {
+	Header       ResponseHeader   `json:"header"`
+	StatusChange UserStatusChange `json:"status_change"`
+}
+
+// UserStatusRequest defines model for UserStatusRequest.
+type UserStatusRequest struct {
+	// Reason Why the status is changed, kept in the audit trail.
+	Reason string `json:"reason"`
+
+	// Status Frozen accounts can log in and read but not send, Closed accounts can not log in.
+	Status UserStatus `json:"status"`
+}
+
+// UsersResponse defines model for UsersResponse.
+type UsersResponse struct {
+	Header ResponseHeader `json:"header"`
+	Users  []User         `json:"users"`
+}
+
+// VerifyPhoneNumberRequest defines model for VerifyPhoneNumberRequest.
+type VerifyPhoneNumberRequest struct {
+	// Otp OTP sent to the new phone number.
+	Otp string `json:"otp"`
+}
+
+// Limit defines model for Limit.
+type Limit = int
+
+// Offset defines model for Offset.
+type Offset = int
+
+// AdminGetAuditLogParams defines parameters for AdminGetAuditLog.
+type AdminGetAuditLogParams struct {
+	// UserId Only entries about this user.
+	UserId *int64 `form:"user_id,omitempty" json:"user_id,omitempty"`
+
+	// ActorId Only entries of actions performed by this user.
+	ActorId *int64       `form:"actor_id,omitempty" json:"actor_id,omitempty"`
+	Action  *AuditAction `form:"action,omitempty" json:"action,omitempty"`
+
+	// CreatedFrom Only entries recorded at or after this time.
+	CreatedFrom *time.Time `form:"created_from,omitempty" json:"created_from,omitempty"`
+
+	// CreatedTo Only entries recorded before this time.
+	CreatedTo *time.Time `form:"created_to,omitempty" json:"created_to,omitempty"`
+
+	// Limit Maximum number of results, 50 if not set.
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of results to skip.
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// AdminSearchUsersParams defines parameters for AdminSearchUsers.
+type AdminSearchUsersParams struct {
+	// PhoneNumber Normalized to E.164 like User's phone_number, i.e. 0812-3456-789 finds +628123456789.
+	PhoneNumber *string `form:"phone_number,omitempty" json:"phone_number,omitempty"`
+
+	// FullName Case-insensitive part of the full name.
+	FullName *string `form:"full_name,omitempty" json:"full_name,omitempty"`
+
+	// CreatedFrom Only users registered at or after this time.
+	CreatedFrom *time.Time `form:"created_from,omitempty" json:"created_from,omitempty"`
+
+	// CreatedTo Only users registered before this time.
+	CreatedTo *time.Time `form:"created_to,omitempty" json:"created_to,omitempty"`
+
+	// Limit Maximum number of results, 50 if not set.
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of results to skip.
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// AdminGetUserTransactionsParams defines parameters for AdminGetUserTransactions.
+type AdminGetUserTransactionsParams struct {
+	Type   *TransactionType   `form:"type,omitempty" json:"type,omitempty"`
+	Status *TransactionStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Limit Maximum number of results, 50 if not set.
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of results to skip.
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// DisbursementCallbackParams defines parameters for DisbursementCallback.
+type DisbursementCallbackParams struct {
+	XCallbackToken string `json:"X-Callback-Token"`
+}
+
+// TopUpCallbackParams defines parameters for TopUpCallback.
+type TopUpCallbackParams struct {
+	// XSignature Hex encoded HMAC-SHA256 of the raw request body using the shared callback secret.
+	XSignature string `json:"X-Signature"`
+}
+
+// GetContactSuggestionsParams defines parameters for GetContactSuggestions.
+type GetContactSuggestionsParams struct {
+	// Limit Maximum number of suggestions, 5 if not set.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetSharedWalletTransactionsParams defines parameters for GetSharedWalletTransactions.
+type GetSharedWalletTransactionsParams struct {
+	// Limit Maximum number of results, 50 if not set.
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of results to skip.
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// AdminAdjustUserBalanceJSONRequestBody defines body for AdminAdjustUserBalance for application/json ContentType.
+type AdminAdjustUserBalanceJSONRequestBody = BalanceAdjustmentRequest
+
+// AdminChangeUserStatusJSONRequestBody defines body for AdminChangeUserStatus for application/json ContentType.
+type AdminChangeUserStatusJSONRequestBody = UserStatusRequest
+
+// DisbursementCallbackJSONRequestBody defines body for DisbursementCallback for application/json ContentType.
+type DisbursementCallbackJSONRequestBody = DisbursementCallback
+
+// TopUpCallbackJSONRequestBody defines body for TopUpCallback for application/json ContentType.
+type TopUpCallbackJSONRequestBody = TopUpCallback
+
+// UpdateUserJSONRequestBody defines body for UpdateUser for application/json ContentType.
+type UpdateUserJSONRequestBody = UpdateUserRequest
+
+// RegisterUserJSONRequestBody defines body for RegisterUser for application/json ContentType.
+type RegisterUserJSONRequestBody = RegisterUserRequest
+
+// UserLoginJSONRequestBody defines body for UserLogin for application/json ContentType.
+type UserLoginJSONRequestBody = UserLoginRequest
+
+// VerifyUserPhoneNumberJSONRequestBody defines body for VerifyUserPhoneNumber for application/json ContentType.
+type VerifyUserPhoneNumberJSONRequestBody = VerifyPhoneNumberRequest
+
+// RegisterUserBankAccountJSONRequestBody defines body for RegisterUserBankAccount for application/json ContentType.
+type RegisterUserBankAccountJSONRequestBody = RegisterBankAccountRequest
+
+// AddContactJSONRequestBody defines body for AddContact for application/json ContentType.
+type AddContactJSONRequestBody = ContactRequest
+
+// UpdateContactJSONRequestBody defines body for UpdateContact for application/json ContentType.
+type UpdateContactJSONRequestBody = UpdateContactRequest
+
+// CreateUserFXQuoteJSONRequestBody defines body for CreateUserFXQuote for application/json ContentType.
+type CreateUserFXQuoteJSONRequestBody = FXQuoteRequest
+
+// CreatePocketJSONRequestBody defines body for CreatePocket for application/json ContentType.
+type CreatePocketJSONRequestBody = PocketRequest
+
+// UpdatePocketJSONRequestBody defines body for UpdatePocket for application/json ContentType.
+type UpdatePocketJSONRequestBody = PocketRequest
+
+// GenerateUserQRJSONRequestBody defines body for GenerateUserQR for application/json ContentType.
+type GenerateUserQRJSONRequestBody = QRRequest
+
+// CreateUserQRPaymentJSONRequestBody defines body for CreateUserQRPayment for application/json ContentType.
+type CreateUserQRPaymentJSONRequestBody = QRPaymentRequest
+
+// LookupRecipientJSONRequestBody defines body for LookupRecipient for application/json ContentType.
+type LookupRecipientJSONRequestBody = RecipientLookupRequest
+
+// CreateSharedWalletJSONRequestBody defines body for CreateSharedWallet for application/json ContentType.
+type CreateSharedWalletJSONRequestBody = SharedWalletRequest
+
+// AddSharedWalletMemberJSONRequestBody defines body for AddSharedWalletMember for application/json ContentType.
+type AddSharedWalletMemberJSONRequestBody = SharedWalletMemberRequest
+
+// UpdateSharedWalletMemberJSONRequestBody defines body for UpdateSharedWalletMember for application/json ContentType.
+type UpdateSharedWalletMemberJSONRequestBody = UpdateSharedWalletMemberRequest
+
+// CreateUserTopUpJSONRequestBody defines body for CreateUserTopUp for application/json ContentType.
+type CreateUserTopUpJSONRequestBody = TopUpRequest
+
+// CreateUserTransactionJSONRequestBody defines body for CreateUserTransaction for application/json ContentType.
+type CreateUserTransactionJSONRequestBody = TransactionRequest
+
+// PreviewUserTransactionFeeJSONRequestBody defines body for PreviewUserTransactionFee for application/json ContentType.
+type PreviewUserTransactionFeeJSONRequestBody = FeePreviewRequest
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// AdminGetAuditLog request
+	AdminGetAuditLog(ctx context.Context, params *AdminGetAuditLogParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminSearchUsers request
+	AdminSearchUsers(ctx context.Context, params *AdminSearchUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminAdjustUserBalanceWithBody request with any body
+	AdminAdjustUserBalanceWithBody(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminAdjustUserBalance(ctx context.Context, userId int, body AdminAdjustUserBalanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminChangeUserStatusWithBody request with any body
+	AdminChangeUserStatusWithBody(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminChangeUserStatus(ctx context.Context, userId int, body AdminChangeUserStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminGetUserTransactions request
+	AdminGetUserTransactions(ctx context.Context, userId int, params *AdminGetUserTransactionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Healthz request
+	Healthz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Readyz request
+	Readyz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DisbursementCallbackWithBody request with any body
+	DisbursementCallbackWithBody(ctx context.Context, params *DisbursementCallbackParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DisbursementCallback(ctx context.Context, params *DisbursementCallbackParams, body DisbursementCallbackJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TopUpCallbackWithBody request with any body
+	TopUpCallbackWithBody(ctx context.Context, params *TopUpCallbackParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TopUpCallback(ctx context.Context, params *TopUpCallbackParams, body TopUpCallbackJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUser request
+	GetUser(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateUserWithBody request with any body
+	UpdateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateUser(ctx context.Context, body UpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RegisterUserWithBody request with any body
+	RegisterUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RegisterUser(ctx context.Context, body RegisterUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserLoginWithBody request with any body
+	UserLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UserLogin(ctx context.Context, body UserLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// VerifyUserPhoneNumberWithBody request with any body
+	VerifyUserPhoneNumberWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	VerifyUserPhoneNumber(ctx context.Context, body VerifyUserPhoneNumberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUserBankAccounts request
+	GetUserBankAccounts(ctx context.Context, userId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RegisterUserBankAccountWithBody request with any body
+	RegisterUserBankAccountWithBody(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RegisterUserBankAccount(ctx context.Context, userId int, body RegisterUserBankAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUserContacts request
+	GetUserContacts(ctx context.Context, userId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddContactWithBody request with any body
+	AddContactWithBody(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddContact(ctx context.Context, userId int, body AddContactJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetContactSuggestions request
+	GetContactSuggestions(ctx context.Context, userId int, params *GetContactSuggestionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteContact request
+	DeleteContact(ctx context.Context, userId int, contactId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateContactWithBody request with any body
+	UpdateContactWithBody(ctx context.Context, userId int, contactId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateContact(ctx context.Context, userId int, contactId int, body UpdateContactJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateUserFXQuoteWithBody request with any body
+	CreateUserFXQuoteWithBody(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateUserFXQuote(ctx context.Context, userId int, body CreateUserFXQuoteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreatePocketWithBody request with any body
+	CreatePocketWithBody(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreatePocket(ctx context.Context, userId int, body CreatePocketJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeletePocket request
+	DeletePocket(ctx context.Context, userId int, pocketId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdatePocketWithBody request with any body
+	UpdatePocketWithBody(ctx context.Context, userId int, pocketId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdatePocket(ctx context.Context, userId int, pocketId int, body UpdatePocketJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GenerateUserQRWithBody request with any body
+	GenerateUserQRWithBody(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	GenerateUserQR(ctx context.Context, userId int, body GenerateUserQRJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateUserQRPaymentWithBody request with any body
+	CreateUserQRPaymentWithBody(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateUserQRPayment(ctx context.Context, userId int, body CreateUserQRPaymentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LookupRecipientWithBody request with any body
+	LookupRecipientWithBody(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LookupRecipient(ctx context.Context, userId int, body LookupRecipientJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUserSharedWallets request
+	GetUserSharedWallets(ctx context.Context, userId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateSharedWalletWithBody request with any body
+	CreateSharedWalletWithBody(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateSharedWallet(ctx context.Context, userId int, body CreateSharedWalletJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSharedWalletMembers request
+	GetSharedWalletMembers(ctx context.Context, userId int, sharedWalletId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddSharedWalletMemberWithBody request with any body
+	AddSharedWalletMemberWithBody(ctx context.Context, userId int, sharedWalletId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddSharedWalletMember(ctx context.Context, userId int, sharedWalletId int, body AddSharedWalletMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RemoveSharedWalletMember request
+	RemoveSharedWalletMember(ctx context.Context, userId int, sharedWalletId int, memberId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateSharedWalletMemberWithBody request with any body
+	UpdateSharedWalletMemberWithBody(ctx context.Context, userId int, sharedWalletId int, memberId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateSharedWalletMember(ctx context.Context, userId int, sharedWalletId int, memberId int, body UpdateSharedWalletMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSharedWalletTransactions request
+	GetSharedWalletTransactions(ctx context.Context, userId int, sharedWalletId int, params *GetSharedWalletTransactionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateUserTopUpWithBody request with any body
+	CreateUserTopUpWithBody(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateUserTopUp(ctx context.Context, userId int, body CreateUserTopUpJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUserTopUp request
+	GetUserTopUp(ctx context.Context, userId int, topupId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateUserTransactionWithBody request with any body
+	CreateUserTransactionWithBody(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateUserTransaction(ctx context.Context, userId int, body CreateUserTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PreviewUserTransactionFeeWithBody request with any body
+	PreviewUserTransactionFeeWithBody(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PreviewUserTransactionFee(ctx context.Context, userId int, body PreviewUserTransactionFeeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) AdminGetAuditLog(ctx context.Context, params *AdminGetAuditLogParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminGetAuditLogRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminSearchUsers(ctx context.Context, params *AdminSearchUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminSearchUsersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminAdjustUserBalanceWithBody(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminAdjustUserBalanceRequestWithBody(c.Server, userId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminAdjustUserBalance(ctx context.Context, userId int, body AdminAdjustUserBalanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminAdjustUserBalanceRequest(c.Server, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminChangeUserStatusWithBody(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminChangeUserStatusRequestWithBody(c.Server, userId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminChangeUserStatus(ctx context.Context, userId int, body AdminChangeUserStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminChangeUserStatusRequest(c.Server, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminGetUserTransactions(ctx context.Context, userId int, params *AdminGetUserTransactionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminGetUserTransactionsRequest(c.Server, userId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Healthz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHealthzRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Readyz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReadyzRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DisbursementCallbackWithBody(ctx context.Context, params *DisbursementCallbackParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDisbursementCallbackRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DisbursementCallback(ctx context.Context, params *DisbursementCallbackParams, body DisbursementCallbackJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDisbursementCallbackRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TopUpCallbackWithBody(ctx context.Context, params *TopUpCallbackParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTopUpCallbackRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TopUpCallback(ctx context.Context, params *TopUpCallbackParams, body TopUpCallbackJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTopUpCallbackRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUser(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateUserRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateUser(ctx context.Context, body UpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateUserRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RegisterUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegisterUserRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RegisterUser(ctx context.Context, body RegisterUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegisterUserRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserLoginRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UserLogin(ctx context.Context, body UserLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserLoginRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) VerifyUserPhoneNumberWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyUserPhoneNumberRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) VerifyUserPhoneNumber(ctx context.Context, body VerifyUserPhoneNumberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyUserPhoneNumberRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUserBankAccounts(ctx context.Context, userId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserBankAccountsRequest(c.Server, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RegisterUserBankAccountWithBody(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegisterUserBankAccountRequestWithBody(c.Server, userId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RegisterUserBankAccount(ctx context.Context, userId int, body RegisterUserBankAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegisterUserBankAccountRequest(c.Server, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUserContacts(ctx context.Context, userId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserContactsRequest(c.Server, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddContactWithBody(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddContactRequestWithBody(c.Server, userId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddContact(ctx context.Context, userId int, body AddContactJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddContactRequest(c.Server, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetContactSuggestions(ctx context.Context, userId int, params *GetContactSuggestionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetContactSuggestionsRequest(c.Server, userId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteContact(ctx context.Context, userId int, contactId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteContactRequest(c.Server, userId, contactId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateContactWithBody(ctx context.Context, userId int, contactId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateContactRequestWithBody(c.Server, userId, contactId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateContact(ctx context.Context, userId int, contactId int, body UpdateContactJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateContactRequest(c.Server, userId, contactId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateUserFXQuoteWithBody(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserFXQuoteRequestWithBody(c.Server, userId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateUserFXQuote(ctx context.Context, userId int, body CreateUserFXQuoteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserFXQuoteRequest(c.Server, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePocketWithBody(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePocketRequestWithBody(c.Server, userId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePocket(ctx context.Context, userId int, body CreatePocketJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePocketRequest(c.Server, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeletePocket(ctx context.Context, userId int, pocketId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePocketRequest(c.Server, userId, pocketId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdatePocketWithBody(ctx context.Context, userId int, pocketId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePocketRequestWithBody(c.Server, userId, pocketId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdatePocket(ctx context.Context, userId int, pocketId int, body UpdatePocketJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePocketRequest(c.Server, userId, pocketId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GenerateUserQRWithBody(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGenerateUserQRRequestWithBody(c.Server, userId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GenerateUserQR(ctx context.Context, userId int, body GenerateUserQRJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGenerateUserQRRequest(c.Server, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateUserQRPaymentWithBody(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserQRPaymentRequestWithBody(c.Server, userId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateUserQRPayment(ctx context.Context, userId int, body CreateUserQRPaymentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserQRPaymentRequest(c.Server, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LookupRecipientWithBody(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLookupRecipientRequestWithBody(c.Server, userId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LookupRecipient(ctx context.Context, userId int, body LookupRecipientJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLookupRecipientRequest(c.Server, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUserSharedWallets(ctx context.Context, userId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserSharedWalletsRequest(c.Server, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateSharedWalletWithBody(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSharedWalletRequestWithBody(c.Server, userId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateSharedWallet(ctx context.Context, userId int, body CreateSharedWalletJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSharedWalletRequest(c.Server, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSharedWalletMembers(ctx context.Context, userId int, sharedWalletId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSharedWalletMembersRequest(c.Server, userId, sharedWalletId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddSharedWalletMemberWithBody(ctx context.Context, userId int, sharedWalletId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddSharedWalletMemberRequestWithBody(c.Server, userId, sharedWalletId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddSharedWalletMember(ctx context.Context, userId int, sharedWalletId int, body AddSharedWalletMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddSharedWalletMemberRequest(c.Server, userId, sharedWalletId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RemoveSharedWalletMember(ctx context.Context, userId int, sharedWalletId int, memberId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveSharedWalletMemberRequest(c.Server, userId, sharedWalletId, memberId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateSharedWalletMemberWithBody(ctx context.Context, userId int, sharedWalletId int, memberId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSharedWalletMemberRequestWithBody(c.Server, userId, sharedWalletId, memberId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateSharedWalletMember(ctx context.Context, userId int, sharedWalletId int, memberId int, body UpdateSharedWalletMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSharedWalletMemberRequest(c.Server, userId, sharedWalletId, memberId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSharedWalletTransactions(ctx context.Context, userId int, sharedWalletId int, params *GetSharedWalletTransactionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSharedWalletTransactionsRequest(c.Server, userId, sharedWalletId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateUserTopUpWithBody(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserTopUpRequestWithBody(c.Server, userId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateUserTopUp(ctx context.Context, userId int, body CreateUserTopUpJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserTopUpRequest(c.Server, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUserTopUp(ctx context.Context, userId int, topupId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserTopUpRequest(c.Server, userId, topupId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateUserTransactionWithBody(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserTransactionRequestWithBody(c.Server, userId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateUserTransaction(ctx context.Context, userId int, body CreateUserTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserTransactionRequest(c.Server, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PreviewUserTransactionFeeWithBody(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPreviewUserTransactionFeeRequestWithBody(c.Server, userId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PreviewUserTransactionFee(ctx context.Context, userId int, body PreviewUserTransactionFeeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPreviewUserTransactionFeeRequest(c.Server, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewAdminGetAuditLogRequest generates requests for AdminGetAuditLog
+func NewAdminGetAuditLogRequest(server string, params *AdminGetAuditLogParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/audit-log")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.UserId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ActorId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "actor_id", runtime.ParamLocationQuery, *params.ActorId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Action != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "action", runtime.ParamLocationQuery, *params.Action); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedFrom != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "created_from", runtime.ParamLocationQuery, *params.CreatedFrom); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedTo != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "created_to", runtime.ParamLocationQuery, *params.CreatedTo); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminSearchUsersRequest generates requests for AdminSearchUsers
+func NewAdminSearchUsersRequest(server string, params *AdminSearchUsersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PhoneNumber != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "phone_number", runtime.ParamLocationQuery, *params.PhoneNumber); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.FullName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "full_name", runtime.ParamLocationQuery, *params.FullName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedFrom != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "created_from", runtime.ParamLocationQuery, *params.CreatedFrom); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedTo != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "created_to", runtime.ParamLocationQuery, *params.CreatedTo); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminAdjustUserBalanceRequest calls the generic AdminAdjustUserBalance builder with application/json body
+func NewAdminAdjustUserBalanceRequest(server string, userId int, body AdminAdjustUserBalanceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminAdjustUserBalanceRequestWithBody(server, userId, "application/json", bodyReader)
+}
+
+// NewAdminAdjustUserBalanceRequestWithBody generates requests for AdminAdjustUserBalance with any type of body
+func NewAdminAdjustUserBalanceRequestWithBody(server string, userId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/users/%s/balance-adjustments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAdminChangeUserStatusRequest calls the generic AdminChangeUserStatus builder with application/json body
+func NewAdminChangeUserStatusRequest(server string, userId int, body AdminChangeUserStatusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminChangeUserStatusRequestWithBody(server, userId, "application/json", bodyReader)
+}
+
+// NewAdminChangeUserStatusRequestWithBody generates requests for AdminChangeUserStatus with any type of body
+func NewAdminChangeUserStatusRequestWithBody(server string, userId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/users/%s/status", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAdminGetUserTransactionsRequest generates requests for AdminGetUserTransactions
+func NewAdminGetUserTransactionsRequest(server string, userId int, params *AdminGetUserTransactionsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/users/%s/transactions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Type != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, *params.Type); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewHealthzRequest generates requests for Healthz
+func NewHealthzRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/healthz")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReadyzRequest generates requests for Readyz
+func NewReadyzRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/readyz")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDisbursementCallbackRequest calls the generic DisbursementCallback builder with application/json body
+func NewDisbursementCallbackRequest(server string, params *DisbursementCallbackParams, body DisbursementCallbackJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDisbursementCallbackRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewDisbursementCallbackRequestWithBody generates requests for DisbursementCallback with any type of body
+func NewDisbursementCallbackRequestWithBody(server string, params *DisbursementCallbackParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/disbursements/callback")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Callback-Token", runtime.ParamLocationHeader, params.XCallbackToken)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Callback-Token", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewTopUpCallbackRequest calls the generic TopUpCallback builder with application/json body
+func NewTopUpCallbackRequest(server string, params *TopUpCallbackParams, body TopUpCallbackJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTopUpCallbackRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewTopUpCallbackRequestWithBody generates requests for TopUpCallback with any type of body
+func NewTopUpCallbackRequestWithBody(server string, params *TopUpCallbackParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/topups/callback")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Signature", runtime.ParamLocationHeader, params.XSignature)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Signature", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetUserRequest generates requests for GetUser
+func NewGetUserRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateUserRequest calls the generic UpdateUser builder with application/json body
+func NewUpdateUserRequest(server string, body UpdateUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateUserRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpdateUserRequestWithBody generates requests for UpdateUser with any type of body
+func NewUpdateUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRegisterUserRequest calls the generic RegisterUser builder with application/json body
+func NewRegisterUserRequest(server string, body RegisterUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRegisterUserRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRegisterUserRequestWithBody generates requests for RegisterUser with any type of body
+func NewRegisterUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserLoginRequest calls the generic UserLogin builder with application/json body
+func NewUserLoginRequest(server string, body UserLoginJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserLoginRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUserLoginRequestWithBody generates requests for UserLogin with any type of body
+func NewUserLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewVerifyUserPhoneNumberRequest calls the generic VerifyUserPhoneNumber builder with application/json body
+func NewVerifyUserPhoneNumberRequest(server string, body VerifyUserPhoneNumberJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewVerifyUserPhoneNumberRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewVerifyUserPhoneNumberRequestWithBody generates requests for VerifyUserPhoneNumber with any type of body
+func NewVerifyUserPhoneNumberRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/phone-number/verify")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetUserBankAccountsRequest generates requests for GetUserBankAccounts
+func NewGetUserBankAccountsRequest(server string, userId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/%s/bank-accounts", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRegisterUserBankAccountRequest calls the generic RegisterUserBankAccount builder with application/json body
+func NewRegisterUserBankAccountRequest(server string, userId int, body RegisterUserBankAccountJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRegisterUserBankAccountRequestWithBody(server, userId, "application/json", bodyReader)
+}
+
+// NewRegisterUserBankAccountRequestWithBody generates requests for RegisterUserBankAccount with any type of body
+func NewRegisterUserBankAccountRequestWithBody(server string, userId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/%s/bank-accounts", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetUserContactsRequest generates requests for GetUserContacts
+func NewGetUserContactsRequest(server string, userId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/%s/contacts", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddContactRequest calls the generic AddContact builder with application/json body
+func NewAddContactRequest(server string, userId int, body AddContactJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddContactRequestWithBody(server, userId, "application/json", bodyReader)
+}
+
+// NewAddContactRequestWithBody generates requests for AddContact with any type of body
+func NewAddContactRequestWithBody(server string, userId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/%s/contacts", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetContactSuggestionsRequest generates requests for GetContactSuggestions
+func NewGetContactSuggestionsRequest(server string, userId int, params *GetContactSuggestionsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/%s/contacts/suggestions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteContactRequest generates requests for DeleteContact
+func NewDeleteContactRequest(server string, userId int, contactId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "contact_id", runtime.ParamLocationPath, contactId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/%s/contacts/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateContactRequest calls the generic UpdateContact builder with application/json body
+func NewUpdateContactRequest(server string, userId int, contactId int, body UpdateContactJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateContactRequestWithBody(server, userId, contactId, "application/json", bodyReader)
+}
+
+// NewUpdateContactRequestWithBody generates requests for UpdateContact with any type of body
+func NewUpdateContactRequestWithBody(server string, userId int, contactId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "contact_id", runtime.ParamLocationPath, contactId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/%s/contacts/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateUserFXQuoteRequest calls the generic CreateUserFXQuote builder with application/json body
+func NewCreateUserFXQuoteRequest(server string, userId int, body CreateUserFXQuoteJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateUserFXQuoteRequestWithBody(server, userId, "application/json", bodyReader)
+}
+
+// NewCreateUserFXQuoteRequestWithBody generates requests for CreateUserFXQuote with any type of body
+func NewCreateUserFXQuoteRequestWithBody(server string, userId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/%s/fx-quotes", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreatePocketRequest calls the generic CreatePocket builder with application/json body
+func NewCreatePocketRequest(server string, userId int, body CreatePocketJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePocketRequestWithBody(server, userId, "application/json", bodyReader)
+}
+
+// NewCreatePocketRequestWithBody generates requests for CreatePocket with any type of body
+func NewCreatePocketRequestWithBody(server string, userId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/%s/pockets", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeletePocketRequest generates requests for DeletePocket
+func NewDeletePocketRequest(server string, userId int, pocketId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "pocket_id", runtime.ParamLocationPath, pocketId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/%s/pockets/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdatePocketRequest calls the generic UpdatePocket builder with application/json body
+func NewUpdatePocketRequest(server string, userId int, pocketId int, body UpdatePocketJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdatePocketRequestWithBody(server, userId, pocketId, "application/json", bodyReader)
+}
+
+// NewUpdatePocketRequestWithBody generates requests for UpdatePocket with any type of body
+func NewUpdatePocketRequestWithBody(server string, userId int, pocketId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "pocket_id", runtime.ParamLocationPath, pocketId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/%s/pockets/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGenerateUserQRRequest calls the generic GenerateUserQR builder with application/json body
+func NewGenerateUserQRRequest(server string, userId int, body GenerateUserQRJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewGenerateUserQRRequestWithBody(server, userId, "application/json", bodyReader)
+}
+
+// NewGenerateUserQRRequestWithBody generates requests for GenerateUserQR with any type of body
+func NewGenerateUserQRRequestWithBody(server string, userId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/%s/qr", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateUserQRPaymentRequest calls the generic CreateUserQRPayment builder with application/json body
+func NewCreateUserQRPaymentRequest(server string, userId int, body CreateUserQRPaymentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateUserQRPaymentRequestWithBody(server, userId, "application/json", bodyReader)
+}
+
+// NewCreateUserQRPaymentRequestWithBody generates requests for CreateUserQRPayment with any type of body
+func NewCreateUserQRPaymentRequestWithBody(server string, userId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/%s/qr/payments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLookupRecipientRequest calls the generic LookupRecipient builder with application/json body
+func NewLookupRecipientRequest(server string, userId int, body LookupRecipientJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLookupRecipientRequestWithBody(server, userId, "application/json", bodyReader)
+}
+
+// NewLookupRecipientRequestWithBody generates requests for LookupRecipient with any type of body
+func NewLookupRecipientRequestWithBody(server string, userId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/%s/recipient-lookups", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetUserSharedWalletsRequest generates requests for GetUserSharedWallets
+func NewGetUserSharedWalletsRequest(server string, userId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/%s/shared-wallets", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateSharedWalletRequest calls the generic CreateSharedWallet builder with application/json body
+func NewCreateSharedWalletRequest(server string, userId int, body CreateSharedWalletJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSharedWalletRequestWithBody(server, userId, "application/json", bodyReader)
+}
+
+// NewCreateSharedWalletRequestWithBody generates requests for CreateSharedWallet with any type of body
+func NewCreateSharedWalletRequestWithBody(server string, userId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/%s/shared-wallets", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetSharedWalletMembersRequest generates requests for GetSharedWalletMembers
+func NewGetSharedWalletMembersRequest(server string, userId int, sharedWalletId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "shared_wallet_id", runtime.ParamLocationPath, sharedWalletId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/%s/shared-wallets/%s/members", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddSharedWalletMemberRequest calls the generic AddSharedWalletMember builder with application/json body
+func NewAddSharedWalletMemberRequest(server string, userId int, sharedWalletId int, body AddSharedWalletMemberJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddSharedWalletMemberRequestWithBody(server, userId, sharedWalletId, "application/json", bodyReader)
+}
+
+// NewAddSharedWalletMemberRequestWithBody generates requests for AddSharedWalletMember with any type of body
+func NewAddSharedWalletMemberRequestWithBody(server string, userId int, sharedWalletId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "shared_wallet_id", runtime.ParamLocationPath, sharedWalletId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/%s/shared-wallets/%s/members", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRemoveSharedWalletMemberRequest generates requests for RemoveSharedWalletMember
+func NewRemoveSharedWalletMemberRequest(server string, userId int, sharedWalletId int, memberId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "shared_wallet_id", runtime.ParamLocationPath, sharedWalletId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "member_id", runtime.ParamLocationPath, memberId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/%s/shared-wallets/%s/members/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateSharedWalletMemberRequest calls the generic UpdateSharedWalletMember builder with application/json body
+func NewUpdateSharedWalletMemberRequest(server string, userId int, sharedWalletId int, memberId int, body UpdateSharedWalletMemberJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateSharedWalletMemberRequestWithBody(server, userId, sharedWalletId, memberId, "application/json", bodyReader)
+}
+
+// NewUpdateSharedWalletMemberRequestWithBody generates requests for UpdateSharedWalletMember with any type of body
+func NewUpdateSharedWalletMemberRequestWithBody(server string, userId int, sharedWalletId int, memberId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "shared_wallet_id", runtime.ParamLocationPath, sharedWalletId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "member_id", runtime.ParamLocationPath, memberId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/%s/shared-wallets/%s/members/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetSharedWalletTransactionsRequest generates requests for GetSharedWalletTransactions
+func NewGetSharedWalletTransactionsRequest(server string, userId int, sharedWalletId int, params *GetSharedWalletTransactionsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "shared_wallet_id", runtime.ParamLocationPath, sharedWalletId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/%s/shared-wallets/%s/transactions", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateUserTopUpRequest calls the generic CreateUserTopUp builder with application/json body
+func NewCreateUserTopUpRequest(server string, userId int, body CreateUserTopUpJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateUserTopUpRequestWithBody(server, userId, "application/json", bodyReader)
+}
+
+// NewCreateUserTopUpRequestWithBody generates requests for CreateUserTopUp with any type of body
+func NewCreateUserTopUpRequestWithBody(server string, userId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/%s/topups", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetUserTopUpRequest generates requests for GetUserTopUp
+func NewGetUserTopUpRequest(server string, userId int, topupId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "topup_id", runtime.ParamLocationPath, topupId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/%s/topups/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateUserTransactionRequest calls the generic CreateUserTransaction builder with application/json body
+func NewCreateUserTransactionRequest(server string, userId int, body CreateUserTransactionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateUserTransactionRequestWithBody(server, userId, "application/json", bodyReader)
+}
+
+// NewCreateUserTransactionRequestWithBody generates requests for CreateUserTransaction with any type of body
+func NewCreateUserTransactionRequestWithBody(server string, userId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/%s/transactions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPreviewUserTransactionFeeRequest calls the generic PreviewUserTransactionFee builder with application/json body
+func NewPreviewUserTransactionFeeRequest(server string, userId int, body PreviewUserTransactionFeeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPreviewUserTransactionFeeRequestWithBody(server, userId, "application/json", bodyReader)
+}
+
+// NewPreviewUserTransactionFeeRequestWithBody generates requests for PreviewUserTransactionFee with any type of body
+func NewPreviewUserTransactionFeeRequestWithBody(server string, userId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/user/%s/transactions/fee", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// AdminGetAuditLogWithResponse request
+	AdminGetAuditLogWithResponse(ctx context.Context, params *AdminGetAuditLogParams, reqEditors ...RequestEditorFn) (*AdminGetAuditLogResult, error)
+
+	// AdminSearchUsersWithResponse request
+	AdminSearchUsersWithResponse(ctx context.Context, params *AdminSearchUsersParams, reqEditors ...RequestEditorFn) (*AdminSearchUsersResult, error)
+
+	// AdminAdjustUserBalanceWithBodyWithResponse request with any body
+	AdminAdjustUserBalanceWithBodyWithResponse(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminAdjustUserBalanceResult, error)
+
+	AdminAdjustUserBalanceWithResponse(ctx context.Context, userId int, body AdminAdjustUserBalanceJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminAdjustUserBalanceResult, error)
+
+	// AdminChangeUserStatusWithBodyWithResponse request with any body
+	AdminChangeUserStatusWithBodyWithResponse(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminChangeUserStatusResult, error)
+
+	AdminChangeUserStatusWithResponse(ctx context.Context, userId int, body AdminChangeUserStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminChangeUserStatusResult, error)
+
+	// AdminGetUserTransactionsWithResponse request
+	AdminGetUserTransactionsWithResponse(ctx context.Context, userId int, params *AdminGetUserTransactionsParams, reqEditors ...RequestEditorFn) (*AdminGetUserTransactionsResult, error)
+
+	// HealthzWithResponse request
+	HealthzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthzResult, error)
+
+	// ReadyzWithResponse request
+	ReadyzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReadyzResult, error)
+
+	// DisbursementCallbackWithBodyWithResponse request with any body
+	DisbursementCallbackWithBodyWithResponse(ctx context.Context, params *DisbursementCallbackParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DisbursementCallbackResult, error)
+
+	DisbursementCallbackWithResponse(ctx context.Context, params *DisbursementCallbackParams, body DisbursementCallbackJSONRequestBody, reqEditors ...RequestEditorFn) (*DisbursementCallbackResult, error)
+
+	// TopUpCallbackWithBodyWithResponse request with any body
+	TopUpCallbackWithBodyWithResponse(ctx context.Context, params *TopUpCallbackParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TopUpCallbackResult, error)
+
+	TopUpCallbackWithResponse(ctx context.Context, params *TopUpCallbackParams, body TopUpCallbackJSONRequestBody, reqEditors ...RequestEditorFn) (*TopUpCallbackResult, error)
+
+	// GetUserWithResponse request
+	GetUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserResult, error)
+
+	// UpdateUserWithBodyWithResponse request with any body
+	UpdateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserResult, error)
+
+	UpdateUserWithResponse(ctx context.Context, body UpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserResult, error)
+
+	// RegisterUserWithBodyWithResponse request with any body
+	RegisterUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterUserResult, error)
+
+	RegisterUserWithResponse(ctx context.Context, body RegisterUserJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterUserResult, error)
+
+	// UserLoginWithBodyWithResponse request with any body
+	UserLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserLoginResult, error)
+
+	UserLoginWithResponse(ctx context.Context, body UserLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*UserLoginResult, error)
+
+	// VerifyUserPhoneNumberWithBodyWithResponse request with any body
+	VerifyUserPhoneNumberWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyUserPhoneNumberResult, error)
+
+	VerifyUserPhoneNumberWithResponse(ctx context.Context, body VerifyUserPhoneNumberJSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyUserPhoneNumberResult, error)
+
+	// GetUserBankAccountsWithResponse request
+	GetUserBankAccountsWithResponse(ctx context.Context, userId int, reqEditors ...RequestEditorFn) (*GetUserBankAccountsResult, error)
+
+	// RegisterUserBankAccountWithBodyWithResponse request with any body
+	RegisterUserBankAccountWithBodyWithResponse(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterUserBankAccountResult, error)
+
+	RegisterUserBankAccountWithResponse(ctx context.Context, userId int, body RegisterUserBankAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterUserBankAccountResult, error)
+
+	// GetUserContactsWithResponse request
+	GetUserContactsWithResponse(ctx context.Context, userId int, reqEditors ...RequestEditorFn) (*GetUserContactsResult, error)
+
+	// AddContactWithBodyWithResponse request with any body
+	AddContactWithBodyWithResponse(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddContactResult, error)
+
+	AddContactWithResponse(ctx context.Context, userId int, body AddContactJSONRequestBody, reqEditors ...RequestEditorFn) (*AddContactResult, error)
+
+	// GetContactSuggestionsWithResponse request
+	GetContactSuggestionsWithResponse(ctx context.Context, userId int, params *GetContactSuggestionsParams, reqEditors ...RequestEditorFn) (*GetContactSuggestionsResult, error)
+
+	// DeleteContactWithResponse request
+	DeleteContactWithResponse(ctx context.Context, userId int, contactId int, reqEditors ...RequestEditorFn) (*DeleteContactResult, error)
+
+	// UpdateContactWithBodyWithResponse request with any body
+	UpdateContactWithBodyWithResponse(ctx context.Context, userId int, contactId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateContactResult, error)
+
+	UpdateContactWithResponse(ctx context.Context, userId int, contactId int, body UpdateContactJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateContactResult, error)
+
+	// CreateUserFXQuoteWithBodyWithResponse request with any body
+	CreateUserFXQuoteWithBodyWithResponse(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserFXQuoteResult, error)
+
+	CreateUserFXQuoteWithResponse(ctx context.Context, userId int, body CreateUserFXQuoteJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserFXQuoteResult, error)
+
+	// CreatePocketWithBodyWithResponse request with any body
+	CreatePocketWithBodyWithResponse(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePocketResult, error)
+
+	CreatePocketWithResponse(ctx context.Context, userId int, body CreatePocketJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePocketResult, error)
+
+	// DeletePocketWithResponse request
+	DeletePocketWithResponse(ctx context.Context, userId int, pocketId int, reqEditors ...RequestEditorFn) (*DeletePocketResult, error)
+
+	// UpdatePocketWithBodyWithResponse request with any body
+	UpdatePocketWithBodyWithResponse(ctx context.Context, userId int, pocketId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePocketResult, error)
+
+	UpdatePocketWithResponse(ctx context.Context, userId int, pocketId int, body UpdatePocketJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePocketResult, error)
+
+	// GenerateUserQRWithBodyWithResponse request with any body
+	GenerateUserQRWithBodyWithResponse(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GenerateUserQRResult, error)
+
+	GenerateUserQRWithResponse(ctx context.Context, userId int, body GenerateUserQRJSONRequestBody, reqEditors ...RequestEditorFn) (*GenerateUserQRResult, error)
+
+	// CreateUserQRPaymentWithBodyWithResponse request with any body
+	CreateUserQRPaymentWithBodyWithResponse(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserQRPaymentResult, error)
+
+	CreateUserQRPaymentWithResponse(ctx context.Context, userId int, body CreateUserQRPaymentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserQRPaymentResult, error)
+
+	// LookupRecipientWithBodyWithResponse request with any body
+	LookupRecipientWithBodyWithResponse(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LookupRecipientResult, error)
+
+	LookupRecipientWithResponse(ctx context.Context, userId int, body LookupRecipientJSONRequestBody, reqEditors ...RequestEditorFn) (*LookupRecipientResult, error)
+
+	// GetUserSharedWalletsWithResponse request
+	GetUserSharedWalletsWithResponse(ctx context.Context, userId int, reqEditors ...RequestEditorFn) (*GetUserSharedWalletsResult, error)
+
+	// CreateSharedWalletWithBodyWithResponse request with any body
+	CreateSharedWalletWithBodyWithResponse(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSharedWalletResult, error)
+
+	CreateSharedWalletWithResponse(ctx context.Context, userId int, body CreateSharedWalletJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSharedWalletResult, error)
+
+	// GetSharedWalletMembersWithResponse request
+	GetSharedWalletMembersWithResponse(ctx context.Context, userId int, sharedWalletId int, reqEditors ...RequestEditorFn) (*GetSharedWalletMembersResult, error)
+
+	// AddSharedWalletMemberWithBodyWithResponse request with any body
+	AddSharedWalletMemberWithBodyWithResponse(ctx context.Context, userId int, sharedWalletId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddSharedWalletMemberResult, error)
+
+	AddSharedWalletMemberWithResponse(ctx context.Context, userId int, sharedWalletId int, body AddSharedWalletMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*AddSharedWalletMemberResult, error)
+
+	// RemoveSharedWalletMemberWithResponse request
+	RemoveSharedWalletMemberWithResponse(ctx context.Context, userId int, sharedWalletId int, memberId int, reqEditors ...RequestEditorFn) (*RemoveSharedWalletMemberResult, error)
+
+	// UpdateSharedWalletMemberWithBodyWithResponse request with any body
+	UpdateSharedWalletMemberWithBodyWithResponse(ctx context.Context, userId int, sharedWalletId int, memberId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSharedWalletMemberResult, error)
+
+	UpdateSharedWalletMemberWithResponse(ctx context.Context, userId int, sharedWalletId int, memberId int, body UpdateSharedWalletMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSharedWalletMemberResult, error)
+
+	// GetSharedWalletTransactionsWithResponse request
+	GetSharedWalletTransactionsWithResponse(ctx context.Context, userId int, sharedWalletId int, params *GetSharedWalletTransactionsParams, reqEditors ...RequestEditorFn) (*GetSharedWalletTransactionsResult, error)
+
+	// CreateUserTopUpWithBodyWithResponse request with any body
+	CreateUserTopUpWithBodyWithResponse(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserTopUpResult, error)
+
+	CreateUserTopUpWithResponse(ctx context.Context, userId int, body CreateUserTopUpJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserTopUpResult, error)
+
+	// GetUserTopUpWithResponse request
+	GetUserTopUpWithResponse(ctx context.Context, userId int, topupId string, reqEditors ...RequestEditorFn) (*GetUserTopUpResult, error)
+
+	// CreateUserTransactionWithBodyWithResponse request with any body
+	CreateUserTransactionWithBodyWithResponse(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserTransactionResult, error)
+
+	CreateUserTransactionWithResponse(ctx context.Context, userId int, body CreateUserTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserTransactionResult, error)
+
+	// PreviewUserTransactionFeeWithBodyWithResponse request with any body
+	PreviewUserTransactionFeeWithBodyWithResponse(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PreviewUserTransactionFeeResult, error)
+
+	PreviewUserTransactionFeeWithResponse(ctx context.Context, userId int, body PreviewUserTransactionFeeJSONRequestBody, reqEditors ...RequestEditorFn) (*PreviewUserTransactionFeeResult, error)
+}
+
+type AdminGetAuditLogResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuditLogResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminGetAuditLogResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminGetAuditLogResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminSearchUsersResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UsersResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminSearchUsersResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminSearchUsersResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminAdjustUserBalanceResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TransactionResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminAdjustUserBalanceResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminAdjustUserBalanceResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminChangeUserStatusResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserStatusChangeResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminChangeUserStatusResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminChangeUserStatusResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminGetUserTransactionsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TransactionsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminGetUserTransactionsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminGetUserTransactionsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HealthzResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HealthResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r HealthzResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HealthzResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReadyzResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HealthResponse
+	JSON503      *HealthResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ReadyzResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReadyzResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DisbursementCallbackResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CallbackResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DisbursementCallbackResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DisbursementCallbackResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TopUpCallbackResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CallbackResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r TopUpCallbackResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TopUpCallbackResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetUserResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateUserResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UpdateUserResponse
+	JSON202      *UpdateUserResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateUserResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateUserResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RegisterUserResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *RegisterUserResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RegisterUserResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RegisterUserResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserLoginResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserLoginResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UserLoginResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserLoginResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VerifyUserPhoneNumberResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UpdateUserResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r VerifyUserPhoneNumberResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VerifyUserPhoneNumberResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserBankAccountsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BankAccountsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserBankAccountsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserBankAccountsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RegisterUserBankAccountResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *BankAccountResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RegisterUserBankAccountResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RegisterUserBankAccountResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserContactsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ContactsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserContactsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserContactsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddContactResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ContactResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AddContactResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddContactResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetContactSuggestionsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ContactSuggestionsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetContactSuggestionsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetContactSuggestionsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteContactResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeleteContactResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteContactResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteContactResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateContactResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ContactResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateContactResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateContactResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateUserFXQuoteResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *FXQuoteResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateUserFXQuoteResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateUserFXQuoteResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePocketResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *PocketResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePocketResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePocketResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeletePocketResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeletePocketResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeletePocketResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeletePocketResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdatePocketResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PocketResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdatePocketResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdatePocketResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GenerateUserQRResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *QRResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GenerateUserQRResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GenerateUserQRResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateUserQRPaymentResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TransactionResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateUserQRPaymentResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateUserQRPaymentResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LookupRecipientResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RecipientLookupResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r LookupRecipientResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LookupRecipientResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserSharedWalletsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SharedWalletsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserSharedWalletsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserSharedWalletsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateSharedWalletResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *SharedWalletResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSharedWalletResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSharedWalletResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSharedWalletMembersResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SharedWalletMembersResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSharedWalletMembersResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSharedWalletMembersResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddSharedWalletMemberResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *SharedWalletMemberResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AddSharedWalletMemberResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddSharedWalletMemberResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveSharedWalletMemberResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeleteSharedWalletMemberResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveSharedWalletMemberResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveSharedWalletMemberResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateSharedWalletMemberResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SharedWalletMemberResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateSharedWalletMemberResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateSharedWalletMemberResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSharedWalletTransactionsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TransactionsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSharedWalletTransactionsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSharedWalletTransactionsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateUserTopUpResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TopUpResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateUserTopUpResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateUserTopUpResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserTopUpResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TopUpResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserTopUpResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserTopUpResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateUserTransactionResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TransactionResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateUserTransactionResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateUserTransactionResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PreviewUserTransactionFeeResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FeePreviewResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PreviewUserTransactionFeeResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PreviewUserTransactionFeeResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// AdminGetAuditLogWithResponse request returning *AdminGetAuditLogResult
+func (c *ClientWithResponses) AdminGetAuditLogWithResponse(ctx context.Context, params *AdminGetAuditLogParams, reqEditors ...RequestEditorFn) (*AdminGetAuditLogResult, error) {
+	rsp, err := c.AdminGetAuditLog(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminGetAuditLogResult(rsp)
+}
+
+// AdminSearchUsersWithResponse request returning *AdminSearchUsersResult
+func (c *ClientWithResponses) AdminSearchUsersWithResponse(ctx context.Context, params *AdminSearchUsersParams, reqEditors ...RequestEditorFn) (*AdminSearchUsersResult, error) {
+	rsp, err := c.AdminSearchUsers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminSearchUsersResult(rsp)
+}
+
+// AdminAdjustUserBalanceWithBodyWithResponse request with arbitrary body returning *AdminAdjustUserBalanceResult
+func (c *ClientWithResponses) AdminAdjustUserBalanceWithBodyWithResponse(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminAdjustUserBalanceResult, error) {
+	rsp, err := c.AdminAdjustUserBalanceWithBody(ctx, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminAdjustUserBalanceResult(rsp)
+}
+
+func (c *ClientWithResponses) AdminAdjustUserBalanceWithResponse(ctx context.Context, userId int, body AdminAdjustUserBalanceJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminAdjustUserBalanceResult, error) {
+	rsp, err := c.AdminAdjustUserBalance(ctx, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminAdjustUserBalanceResult(rsp)
+}
+
+// AdminChangeUserStatusWithBodyWithResponse request with arbitrary body returning *AdminChangeUserStatusResult
+func (c *ClientWithResponses) AdminChangeUserStatusWithBodyWithResponse(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminChangeUserStatusResult, error) {
+	rsp, err := c.AdminChangeUserStatusWithBody(ctx, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminChangeUserStatusResult(rsp)
+}
+
+func (c *ClientWithResponses) AdminChangeUserStatusWithResponse(ctx context.Context, userId int, body AdminChangeUserStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminChangeUserStatusResult, error) {
+	rsp, err := c.AdminChangeUserStatus(ctx, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminChangeUserStatusResult(rsp)
+}
+
+// AdminGetUserTransactionsWithResponse request returning *AdminGetUserTransactionsResult
+func (c *ClientWithResponses) AdminGetUserTransactionsWithResponse(ctx context.Context, userId int, params *AdminGetUserTransactionsParams, reqEditors ...RequestEditorFn) (*AdminGetUserTransactionsResult, error) {
+	rsp, err := c.AdminGetUserTransactions(ctx, userId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminGetUserTransactionsResult(rsp)
+}
+
+// HealthzWithResponse request returning *HealthzResult
+func (c *ClientWithResponses) HealthzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthzResult, error) {
+	rsp, err := c.Healthz(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHealthzResult(rsp)
+}
+
+// ReadyzWithResponse request returning *ReadyzResult
+func (c *ClientWithResponses) ReadyzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReadyzResult, error) {
+	rsp, err := c.Readyz(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReadyzResult(rsp)
+}
+
+// DisbursementCallbackWithBodyWithResponse request with arbitrary body returning *DisbursementCallbackResult
+func (c *ClientWithResponses) DisbursementCallbackWithBodyWithResponse(ctx context.Context, params *DisbursementCallbackParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DisbursementCallbackResult, error) {
+	rsp, err := c.DisbursementCallbackWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDisbursementCallbackResult(rsp)
+}
+
+func (c *ClientWithResponses) DisbursementCallbackWithResponse(ctx context.Context, params *DisbursementCallbackParams, body DisbursementCallbackJSONRequestBody, reqEditors ...RequestEditorFn) (*DisbursementCallbackResult, error) {
+	rsp, err := c.DisbursementCallback(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDisbursementCallbackResult(rsp)
+}
+
+// TopUpCallbackWithBodyWithResponse request with arbitrary body returning *TopUpCallbackResult
+func (c *ClientWithResponses) TopUpCallbackWithBodyWithResponse(ctx context.Context, params *TopUpCallbackParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TopUpCallbackResult, error) {
+	rsp, err := c.TopUpCallbackWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTopUpCallbackResult(rsp)
+}
+
+func (c *ClientWithResponses) TopUpCallbackWithResponse(ctx context.Context, params *TopUpCallbackParams, body TopUpCallbackJSONRequestBody, reqEditors ...RequestEditorFn) (*TopUpCallbackResult, error) {
+	rsp, err := c.TopUpCallback(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTopUpCallbackResult(rsp)
+}
+
+// GetUserWithResponse request returning *GetUserResult
+func (c *ClientWithResponses) GetUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserResult, error) {
+	rsp, err := c.GetUser(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserResult(rsp)
+}
+
+// UpdateUserWithBodyWithResponse request with arbitrary body returning *UpdateUserResult
+func (c *ClientWithResponses) UpdateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserResult, error) {
+	rsp, err := c.UpdateUserWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateUserResult(rsp)
+}
+
+func (c *ClientWithResponses) UpdateUserWithResponse(ctx context.Context, body UpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserResult, error) {
+	rsp, err := c.UpdateUser(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateUserResult(rsp)
+}
+
+// RegisterUserWithBodyWithResponse request with arbitrary body returning *RegisterUserResult
+func (c *ClientWithResponses) RegisterUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterUserResult, error) {
+	rsp, err := c.RegisterUserWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegisterUserResult(rsp)
+}
+
+func (c *ClientWithResponses) RegisterUserWithResponse(ctx context.Context, body RegisterUserJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterUserResult, error) {
+	rsp, err := c.RegisterUser(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegisterUserResult(rsp)
+}
+
+// UserLoginWithBodyWithResponse request with arbitrary body returning *UserLoginResult
+func (c *ClientWithResponses) UserLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserLoginResult, error) {
+	rsp, err := c.UserLoginWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserLoginResult(rsp)
+}
+
+func (c *ClientWithResponses) UserLoginWithResponse(ctx context.Context, body UserLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*UserLoginResult, error) {
+	rsp, err := c.UserLogin(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserLoginResult(rsp)
+}
+
+// VerifyUserPhoneNumberWithBodyWithResponse request with arbitrary body returning *VerifyUserPhoneNumberResult
+func (c *ClientWithResponses) VerifyUserPhoneNumberWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyUserPhoneNumberResult, error) {
+	rsp, err := c.VerifyUserPhoneNumberWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyUserPhoneNumberResult(rsp)
+}
+
+func (c *ClientWithResponses) VerifyUserPhoneNumberWithResponse(ctx context.Context, body VerifyUserPhoneNumberJSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyUserPhoneNumberResult, error) {
+	rsp, err := c.VerifyUserPhoneNumber(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyUserPhoneNumberResult(rsp)
+}
+
+// GetUserBankAccountsWithResponse request returning *GetUserBankAccountsResult
+func (c *ClientWithResponses) GetUserBankAccountsWithResponse(ctx context.Context, userId int, reqEditors ...RequestEditorFn) (*GetUserBankAccountsResult, error) {
+	rsp, err := c.GetUserBankAccounts(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserBankAccountsResult(rsp)
+}
+
+// RegisterUserBankAccountWithBodyWithResponse request with arbitrary body returning *RegisterUserBankAccountResult
+func (c *ClientWithResponses) RegisterUserBankAccountWithBodyWithResponse(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterUserBankAccountResult, error) {
+	rsp, err := c.RegisterUserBankAccountWithBody(ctx, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegisterUserBankAccountResult(rsp)
+}
+
+func (c *ClientWithResponses) RegisterUserBankAccountWithResponse(ctx context.Context, userId int, body RegisterUserBankAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterUserBankAccountResult, error) {
+	rsp, err := c.RegisterUserBankAccount(ctx, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegisterUserBankAccountResult(rsp)
+}
+
+// GetUserContactsWithResponse request returning *GetUserContactsResult
+func (c *ClientWithResponses) GetUserContactsWithResponse(ctx context.Context, userId int, reqEditors ...RequestEditorFn) (*GetUserContactsResult, error) {
+	rsp, err := c.GetUserContacts(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserContactsResult(rsp)
+}
+
+// AddContactWithBodyWithResponse request with arbitrary body returning *AddContactResult
+func (c *ClientWithResponses) AddContactWithBodyWithResponse(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddContactResult, error) {
+	rsp, err := c.AddContactWithBody(ctx, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddContactResult(rsp)
+}
+
+func (c *ClientWithResponses) AddContactWithResponse(ctx context.Context, userId int, body AddContactJSONRequestBody, reqEditors ...RequestEditorFn) (*AddContactResult, error) {
+	rsp, err := c.AddContact(ctx, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddContactResult(rsp)
+}
+
+// GetContactSuggestionsWithResponse request returning *GetContactSuggestionsResult
+func (c *ClientWithResponses) GetContactSuggestionsWithResponse(ctx context.Context, userId int, params *GetContactSuggestionsParams, reqEditors ...RequestEditorFn) (*GetContactSuggestionsResult, error) {
+	rsp, err := c.GetContactSuggestions(ctx, userId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetContactSuggestionsResult(rsp)
+}
+
+// DeleteContactWithResponse request returning *DeleteContactResult
+func (c *ClientWithResponses) DeleteContactWithResponse(ctx context.Context, userId int, contactId int, reqEditors ...RequestEditorFn) (*DeleteContactResult, error) {
+	rsp, err := c.DeleteContact(ctx, userId, contactId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteContactResult(rsp)
+}
+
+// UpdateContactWithBodyWithResponse request with arbitrary body returning *UpdateContactResult
+func (c *ClientWithResponses) UpdateContactWithBodyWithResponse(ctx context.Context, userId int, contactId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateContactResult, error) {
+	rsp, err := c.UpdateContactWithBody(ctx, userId, contactId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateContactResult(rsp)
+}
+
+func (c *ClientWithResponses) UpdateContactWithResponse(ctx context.Context, userId int, contactId int, body UpdateContactJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateContactResult, error) {
+	rsp, err := c.UpdateContact(ctx, userId, contactId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateContactResult(rsp)
+}
+
+// CreateUserFXQuoteWithBodyWithResponse request with arbitrary body returning *CreateUserFXQuoteResult
+func (c *ClientWithResponses) CreateUserFXQuoteWithBodyWithResponse(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserFXQuoteResult, error) {
+	rsp, err := c.CreateUserFXQuoteWithBody(ctx, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserFXQuoteResult(rsp)
+}
+
+func (c *ClientWithResponses) CreateUserFXQuoteWithResponse(ctx context.Context, userId int, body CreateUserFXQuoteJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserFXQuoteResult, error) {
+	rsp, err := c.CreateUserFXQuote(ctx, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserFXQuoteResult(rsp)
+}
+
+// CreatePocketWithBodyWithResponse request with arbitrary body returning *CreatePocketResult
+func (c *ClientWithResponses) CreatePocketWithBodyWithResponse(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePocketResult, error) {
+	rsp, err := c.CreatePocketWithBody(ctx, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePocketResult(rsp)
+}
+
+func (c *ClientWithResponses) CreatePocketWithResponse(ctx context.Context, userId int, body CreatePocketJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePocketResult, error) {
+	rsp, err := c.CreatePocket(ctx, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePocketResult(rsp)
+}
+
+// DeletePocketWithResponse request returning *DeletePocketResult
+func (c *ClientWithResponses) DeletePocketWithResponse(ctx context.Context, userId int, pocketId int, reqEditors ...RequestEditorFn) (*DeletePocketResult, error) {
+	rsp, err := c.DeletePocket(ctx, userId, pocketId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeletePocketResult(rsp)
+}
+
+// UpdatePocketWithBodyWithResponse request with arbitrary body returning *UpdatePocketResult
+func (c *ClientWithResponses) UpdatePocketWithBodyWithResponse(ctx context.Context, userId int, pocketId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePocketResult, error) {
+	rsp, err := c.UpdatePocketWithBody(ctx, userId, pocketId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePocketResult(rsp)
+}
+
+func (c *ClientWithResponses) UpdatePocketWithResponse(ctx context.Context, userId int, pocketId int, body UpdatePocketJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePocketResult, error) {
+	rsp, err := c.UpdatePocket(ctx, userId, pocketId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePocketResult(rsp)
+}
+
+// GenerateUserQRWithBodyWithResponse request with arbitrary body returning *GenerateUserQRResult
+func (c *ClientWithResponses) GenerateUserQRWithBodyWithResponse(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GenerateUserQRResult, error) {
+	rsp, err := c.GenerateUserQRWithBody(ctx, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGenerateUserQRResult(rsp)
+}
+
+func (c *ClientWithResponses) GenerateUserQRWithResponse(ctx context.Context, userId int, body GenerateUserQRJSONRequestBody, reqEditors ...RequestEditorFn) (*GenerateUserQRResult, error) {
+	rsp, err := c.GenerateUserQR(ctx, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGenerateUserQRResult(rsp)
+}
+
+// CreateUserQRPaymentWithBodyWithResponse request with arbitrary body returning *CreateUserQRPaymentResult
+func (c *ClientWithResponses) CreateUserQRPaymentWithBodyWithResponse(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserQRPaymentResult, error) {
+	rsp, err := c.CreateUserQRPaymentWithBody(ctx, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserQRPaymentResult(rsp)
+}
+
+func (c *ClientWithResponses) CreateUserQRPaymentWithResponse(ctx context.Context, userId int, body CreateUserQRPaymentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserQRPaymentResult, error) {
+	rsp, err := c.CreateUserQRPayment(ctx, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserQRPaymentResult(rsp)
+}
+
+// LookupRecipientWithBodyWithResponse request with arbitrary body returning *LookupRecipientResult
+func (c *ClientWithResponses) LookupRecipientWithBodyWithResponse(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LookupRecipientResult, error) {
+	rsp, err := c.LookupRecipientWithBody(ctx, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLookupRecipientResult(rsp)
+}
+
+func (c *ClientWithResponses) LookupRecipientWithResponse(ctx context.Context, userId int, body LookupRecipientJSONRequestBody, reqEditors ...RequestEditorFn) (*LookupRecipientResult, error) {
+	rsp, err := c.LookupRecipient(ctx, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLookupRecipientResult(rsp)
+}
+
+// GetUserSharedWalletsWithResponse request returning *GetUserSharedWalletsResult
+func (c *ClientWithResponses) GetUserSharedWalletsWithResponse(ctx context.Context, userId int, reqEditors ...RequestEditorFn) (*GetUserSharedWalletsResult, error) {
+	rsp, err := c.GetUserSharedWallets(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserSharedWalletsResult(rsp)
+}
+
+// CreateSharedWalletWithBodyWithResponse request with arbitrary body returning *CreateSharedWalletResult
+func (c *ClientWithResponses) CreateSharedWalletWithBodyWithResponse(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSharedWalletResult, error) {
+	rsp, err := c.CreateSharedWalletWithBody(ctx, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSharedWalletResult(rsp)
+}
+
+func (c *ClientWithResponses) CreateSharedWalletWithResponse(ctx context.Context, userId int, body CreateSharedWalletJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSharedWalletResult, error) {
+	rsp, err := c.CreateSharedWallet(ctx, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSharedWalletResult(rsp)
+}
+
+// GetSharedWalletMembersWithResponse request returning *GetSharedWalletMembersResult
+func (c *ClientWithResponses) GetSharedWalletMembersWithResponse(ctx context.Context, userId int, sharedWalletId int, reqEditors ...RequestEditorFn) (*GetSharedWalletMembersResult, error) {
+	rsp, err := c.GetSharedWalletMembers(ctx, userId, sharedWalletId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSharedWalletMembersResult(rsp)
+}
+
+// AddSharedWalletMemberWithBodyWithResponse request with arbitrary body returning *AddSharedWalletMemberResult
+func (c *ClientWithResponses) AddSharedWalletMemberWithBodyWithResponse(ctx context.Context, userId int, sharedWalletId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddSharedWalletMemberResult, error) {
+	rsp, err := c.AddSharedWalletMemberWithBody(ctx, userId, sharedWalletId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddSharedWalletMemberResult(rsp)
+}
+
+func (c *ClientWithResponses) AddSharedWalletMemberWithResponse(ctx context.Context, userId int, sharedWalletId int, body AddSharedWalletMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*AddSharedWalletMemberResult, error) {
+	rsp, err := c.AddSharedWalletMember(ctx, userId, sharedWalletId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddSharedWalletMemberResult(rsp)
+}
+
+// RemoveSharedWalletMemberWithResponse request returning *RemoveSharedWalletMemberResult
+func (c *ClientWithResponses) RemoveSharedWalletMemberWithResponse(ctx context.Context, userId int, sharedWalletId int, memberId int, reqEditors ...RequestEditorFn) (*RemoveSharedWalletMemberResult, error) {
+	rsp, err := c.RemoveSharedWalletMember(ctx, userId, sharedWalletId, memberId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveSharedWalletMemberResult(rsp)
+}
+
+// UpdateSharedWalletMemberWithBodyWithResponse request with arbitrary body returning *UpdateSharedWalletMemberResult
+func (c *ClientWithResponses) UpdateSharedWalletMemberWithBodyWithResponse(ctx context.Context, userId int, sharedWalletId int, memberId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSharedWalletMemberResult, error) {
+	rsp, err := c.UpdateSharedWalletMemberWithBody(ctx, userId, sharedWalletId, memberId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSharedWalletMemberResult(rsp)
+}
+
+func (c *ClientWithResponses) UpdateSharedWalletMemberWithResponse(ctx context.Context, userId int, sharedWalletId int, memberId int, body UpdateSharedWalletMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSharedWalletMemberResult, error) {
+	rsp, err := c.UpdateSharedWalletMember(ctx, userId, sharedWalletId, memberId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSharedWalletMemberResult(rsp)
+}
+
+// GetSharedWalletTransactionsWithResponse request returning *GetSharedWalletTransactionsResult
+func (c *ClientWithResponses) GetSharedWalletTransactionsWithResponse(ctx context.Context, userId int, sharedWalletId int, params *GetSharedWalletTransactionsParams, reqEditors ...RequestEditorFn) (*GetSharedWalletTransactionsResult, error) {
+	rsp, err := c.GetSharedWalletTransactions(ctx, userId, sharedWalletId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSharedWalletTransactionsResult(rsp)
+}
+
+// CreateUserTopUpWithBodyWithResponse request with arbitrary body returning *CreateUserTopUpResult
+func (c *ClientWithResponses) CreateUserTopUpWithBodyWithResponse(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserTopUpResult, error) {
+	rsp, err := c.CreateUserTopUpWithBody(ctx, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserTopUpResult(rsp)
+}
+
+func (c *ClientWithResponses) CreateUserTopUpWithResponse(ctx context.Context, userId int, body CreateUserTopUpJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserTopUpResult, error) {
+	rsp, err := c.CreateUserTopUp(ctx, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserTopUpResult(rsp)
+}
+
+// GetUserTopUpWithResponse request returning *GetUserTopUpResult
+func (c *ClientWithResponses) GetUserTopUpWithResponse(ctx context.Context, userId int, topupId string, reqEditors ...RequestEditorFn) (*GetUserTopUpResult, error) {
+	rsp, err := c.GetUserTopUp(ctx, userId, topupId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserTopUpResult(rsp)
+}
+
+// CreateUserTransactionWithBodyWithResponse request with arbitrary body returning *CreateUserTransactionResult
+func (c *ClientWithResponses) CreateUserTransactionWithBodyWithResponse(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserTransactionResult, error) {
+	rsp, err := c.CreateUserTransactionWithBody(ctx, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserTransactionResult(rsp)
+}
+
+func (c *ClientWithResponses) CreateUserTransactionWithResponse(ctx context.Context, userId int, body CreateUserTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserTransactionResult, error) {
+	rsp, err := c.CreateUserTransaction(ctx, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserTransactionResult(rsp)
+}
+
+// PreviewUserTransactionFeeWithBodyWithResponse request with arbitrary body returning *PreviewUserTransactionFeeResult
+func (c *ClientWithResponses) PreviewUserTransactionFeeWithBodyWithResponse(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PreviewUserTransactionFeeResult, error) {
+	rsp, err := c.PreviewUserTransactionFeeWithBody(ctx, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePreviewUserTransactionFeeResult(rsp)
+}
+
+func (c *ClientWithResponses) PreviewUserTransactionFeeWithResponse(ctx context.Context, userId int, body PreviewUserTransactionFeeJSONRequestBody, reqEditors ...RequestEditorFn) (*PreviewUserTransactionFeeResult, error) {
+	rsp, err := c.PreviewUserTransactionFee(ctx, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePreviewUserTransactionFeeResult(rsp)
+}
+
+// ParseAdminGetAuditLogResult parses an HTTP response from a AdminGetAuditLogWithResponse call
+func ParseAdminGetAuditLogResult(rsp *http.Response) (*AdminGetAuditLogResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminGetAuditLogResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuditLogResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminSearchUsersResult parses an HTTP response from a AdminSearchUsersWithResponse call
+func ParseAdminSearchUsersResult(rsp *http.Response) (*AdminSearchUsersResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminSearchUsersResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UsersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminAdjustUserBalanceResult parses an HTTP response from a AdminAdjustUserBalanceWithResponse call
+func ParseAdminAdjustUserBalanceResult(rsp *http.Response) (*AdminAdjustUserBalanceResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminAdjustUserBalanceResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TransactionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminChangeUserStatusResult parses an HTTP response from a AdminChangeUserStatusWithResponse call
+func ParseAdminChangeUserStatusResult(rsp *http.Response) (*AdminChangeUserStatusResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminChangeUserStatusResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserStatusChangeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminGetUserTransactionsResult parses an HTTP response from a AdminGetUserTransactionsWithResponse call
+func ParseAdminGetUserTransactionsResult(rsp *http.Response) (*AdminGetUserTransactionsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminGetUserTransactionsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TransactionsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHealthzResult parses an HTTP response from a HealthzWithResponse call
+func ParseHealthzResult(rsp *http.Response) (*HealthzResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HealthzResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HealthResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReadyzResult parses an HTTP response from a ReadyzWithResponse call
+func ParseReadyzResult(rsp *http.Response) (*ReadyzResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReadyzResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HealthResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest HealthResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDisbursementCallbackResult parses an HTTP response from a DisbursementCallbackWithResponse call
+func ParseDisbursementCallbackResult(rsp *http.Response) (*DisbursementCallbackResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DisbursementCallbackResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CallbackResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTopUpCallbackResult parses an HTTP response from a TopUpCallbackWithResponse call
+func ParseTopUpCallbackResult(rsp *http.Response) (*TopUpCallbackResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TopUpCallbackResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CallbackResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUserResult parses an HTTP response from a GetUserWithResponse call
+func ParseGetUserResult(rsp *http.Response) (*GetUserResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUserResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetUserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateUserResult parses an HTTP response from a UpdateUserWithResponse call
+func ParseUpdateUserResult(rsp *http.Response) (*UpdateUserResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateUserResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UpdateUserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest UpdateUserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRegisterUserResult parses an HTTP response from a RegisterUserWithResponse call
+func ParseRegisterUserResult(rsp *http.Response) (*RegisterUserResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RegisterUserResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest RegisterUserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserLoginResult parses an HTTP response from a UserLoginWithResponse call
+func ParseUserLoginResult(rsp *http.Response) (*UserLoginResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserLoginResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserLoginResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseVerifyUserPhoneNumberResult parses an HTTP response from a VerifyUserPhoneNumberWithResponse call
+func ParseVerifyUserPhoneNumberResult(rsp *http.Response) (*VerifyUserPhoneNumberResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VerifyUserPhoneNumberResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UpdateUserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUserBankAccountsResult parses an HTTP response from a GetUserBankAccountsWithResponse call
+func ParseGetUserBankAccountsResult(rsp *http.Response) (*GetUserBankAccountsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUserBankAccountsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BankAccountsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRegisterUserBankAccountResult parses an HTTP response from a RegisterUserBankAccountWithResponse call
+func ParseRegisterUserBankAccountResult(rsp *http.Response) (*RegisterUserBankAccountResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RegisterUserBankAccountResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest BankAccountResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUserContactsResult parses an HTTP response from a GetUserContactsWithResponse call
+func ParseGetUserContactsResult(rsp *http.Response) (*GetUserContactsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUserContactsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ContactsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddContactResult parses an HTTP response from a AddContactWithResponse call
+func ParseAddContactResult(rsp *http.Response) (*AddContactResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddContactResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ContactResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetContactSuggestionsResult parses an HTTP response from a GetContactSuggestionsWithResponse call
+func ParseGetContactSuggestionsResult(rsp *http.Response) (*GetContactSuggestionsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetContactSuggestionsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ContactSuggestionsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteContactResult parses an HTTP response from a DeleteContactWithResponse call
+func ParseDeleteContactResult(rsp *http.Response) (*DeleteContactResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteContactResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeleteContactResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateContactResult parses an HTTP response from a UpdateContactWithResponse call
+func ParseUpdateContactResult(rsp *http.Response) (*UpdateContactResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateContactResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ContactResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateUserFXQuoteResult parses an HTTP response from a CreateUserFXQuoteWithResponse call
+func ParseCreateUserFXQuoteResult(rsp *http.Response) (*CreateUserFXQuoteResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateUserFXQuoteResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest FXQuoteResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreatePocketResult parses an HTTP response from a CreatePocketWithResponse call
+func ParseCreatePocketResult(rsp *http.Response) (*CreatePocketResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreatePocketResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest PocketResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeletePocketResult parses an HTTP response from a DeletePocketWithResponse call
+func ParseDeletePocketResult(rsp *http.Response) (*DeletePocketResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeletePocketResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeletePocketResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdatePocketResult parses an HTTP response from a UpdatePocketWithResponse call
+func ParseUpdatePocketResult(rsp *http.Response) (*UpdatePocketResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdatePocketResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PocketResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGenerateUserQRResult parses an HTTP response from a GenerateUserQRWithResponse call
+func ParseGenerateUserQRResult(rsp *http.Response) (*GenerateUserQRResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GenerateUserQRResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest QRResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateUserQRPaymentResult parses an HTTP response from a CreateUserQRPaymentWithResponse call
+func ParseCreateUserQRPaymentResult(rsp *http.Response) (*CreateUserQRPaymentResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateUserQRPaymentResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TransactionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLookupRecipientResult parses an HTTP response from a LookupRecipientWithResponse call
+func ParseLookupRecipientResult(rsp *http.Response) (*LookupRecipientResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LookupRecipientResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecipientLookupResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUserSharedWalletsResult parses an HTTP response from a GetUserSharedWalletsWithResponse call
+func ParseGetUserSharedWalletsResult(rsp *http.Response) (*GetUserSharedWalletsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUserSharedWalletsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SharedWalletsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateSharedWalletResult parses an HTTP response from a CreateSharedWalletWithResponse call
+func ParseCreateSharedWalletResult(rsp *http.Response) (*CreateSharedWalletResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateSharedWalletResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest SharedWalletResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSharedWalletMembersResult parses an HTTP response from a GetSharedWalletMembersWithResponse call
+func ParseGetSharedWalletMembersResult(rsp *http.Response) (*GetSharedWalletMembersResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSharedWalletMembersResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SharedWalletMembersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddSharedWalletMemberResult parses an HTTP response from a AddSharedWalletMemberWithResponse call
+func ParseAddSharedWalletMemberResult(rsp *http.Response) (*AddSharedWalletMemberResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddSharedWalletMemberResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest SharedWalletMemberResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRemoveSharedWalletMemberResult parses an HTTP response from a RemoveSharedWalletMemberWithResponse call
+func ParseRemoveSharedWalletMemberResult(rsp *http.Response) (*RemoveSharedWalletMemberResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RemoveSharedWalletMemberResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeleteSharedWalletMemberResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateSharedWalletMemberResult parses an HTTP response from a UpdateSharedWalletMemberWithResponse call
+func ParseUpdateSharedWalletMemberResult(rsp *http.Response) (*UpdateSharedWalletMemberResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateSharedWalletMemberResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SharedWalletMemberResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSharedWalletTransactionsResult parses an HTTP response from a GetSharedWalletTransactionsWithResponse call
+func ParseGetSharedWalletTransactionsResult(rsp *http.Response) (*GetSharedWalletTransactionsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSharedWalletTransactionsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TransactionsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateUserTopUpResult parses an HTTP response from a CreateUserTopUpWithResponse call
+func ParseCreateUserTopUpResult(rsp *http.Response) (*CreateUserTopUpResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateUserTopUpResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TopUpResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUserTopUpResult parses an HTTP response from a GetUserTopUpWithResponse call
+func ParseGetUserTopUpResult(rsp *http.Response) (*GetUserTopUpResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUserTopUpResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TopUpResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateUserTransactionResult parses an HTTP response from a CreateUserTransactionWithResponse call
+func ParseCreateUserTransactionResult(rsp *http.Response) (*CreateUserTransactionResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateUserTransactionResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TransactionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePreviewUserTransactionFeeResult parses an HTTP response from a PreviewUserTransactionFeeWithResponse call
+func ParsePreviewUserTransactionFeeResult(rsp *http.Response) (*PreviewUserTransactionFeeResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PreviewUserTransactionFeeResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FeePreviewResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
@@ -0,0 +1,133 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Errors by response status, match them with errors.Is
+var (
+	ErrBadRequest      = errors.New("bad request")
+	ErrUnauthorized    = errors.New("unauthorized")
+	ErrForbidden       = errors.New("forbidden")
+	ErrNotFound        = errors.New("not found")
+	ErrConflict        = errors.New("conflict")
+	ErrTooManyRequests = errors.New("too many requests")
+	ErrTimeout         = errors.New("timeout")
+	ErrServer          = errors.New("server error")
+)
+
+// Errors by ResponseHeader.Messages, their text is the message the wallet responds with
+var (
+	ErrBalanceNotEnough      = errors.New("balance not enough")
+	ErrInvalidPassword       = errors.New("invalid password")
+	ErrUserNotFound          = errors.New("user does not exist")
+	ErrFXQuoteExpired        = errors.New("fx quote is expired")
+	ErrFXQuoteUsed           = errors.New("fx quote is already used")
+	ErrTopUpNotFound         = errors.New("top-up not found")
+	ErrTopUpExpired          = errors.New("top-up is expired")
+	ErrRecipientNotFound     = errors.New("recipient not found")
+	ErrRecipientTokenExpired = errors.New("recipient token has expired, look up the recipient again")
+	ErrContactNotFound       = errors.New("contact not found")
+	ErrPocketNotFound        = errors.New("pocket not found")
+	ErrPocketNotEmpty        = errors.New("pocket balance must be zero to delete it")
+
+	ErrSharedWalletNotFound                 = errors.New("shared wallet not found")
+	ErrSharedWalletMemberNotFound           = errors.New("shared wallet member not found")
+	ErrSharedWalletRoleNotAllowed           = errors.New("shared wallet role does not allow this")
+	ErrSharedWalletTransactionLimitExceeded = errors.New("amount exceeds the transaction limit of the shared wallet member")
+	ErrSharedWalletMonthlyLimitExceeded     = errors.New("amount exceeds the monthly limit of the shared wallet member")
+)
+
+var messageErrors = []error{
+	ErrBalanceNotEnough,
+	ErrInvalidPassword,
+	ErrUserNotFound,
+	ErrFXQuoteExpired,
+	ErrFXQuoteUsed,
+	ErrTopUpNotFound,
+	ErrTopUpExpired,
+	ErrRecipientNotFound,
+	ErrRecipientTokenExpired,
+	ErrContactNotFound,
+	ErrPocketNotFound,
+	ErrPocketNotEmpty,
+	ErrSharedWalletNotFound,
+	ErrSharedWalletMemberNotFound,
+	ErrSharedWalletRoleNotAllowed,
+	ErrSharedWalletTransactionLimitExceeded,
+	ErrSharedWalletMonthlyLimitExceeded,
+}
+
+// APIError is returned when the wallet responds with a non 2xx status.
+// Messages are the ResponseHeader.Messages of the response, i.e. every violation of api.yml for a 400.
+type APIError struct {
+	StatusCode int
+	Messages   []string
+}
+
+func (e *APIError) Error() string {
+	if len(e.Messages) == 0 {
+		return fmt.Sprintf("wallet responded %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	}
+	return fmt.Sprintf("wallet responded %d: %s", e.StatusCode, strings.Join(e.Messages, ", "))
+}
+
+// Is matches the error of the response status, and the errors of the response messages
+func (e *APIError) Is(target error) bool {
+	if target == statusError(e.StatusCode) {
+		return true
+	}
+
+	for _, messageError := range messageErrors {
+		if target != messageError {
+			continue
+		}
+		for _, message := range e.Messages {
+			if message == messageError.Error() {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func statusError(statusCode int) error {
+	switch {
+	case statusCode == http.StatusUnauthorized:
+		return ErrUnauthorized
+	case statusCode == http.StatusForbidden:
+		return ErrForbidden
+	case statusCode == http.StatusNotFound:
+		return ErrNotFound
+	case statusCode == http.StatusConflict:
+		return ErrConflict
+	case statusCode == http.StatusTooManyRequests:
+		return ErrTooManyRequests
+	case statusCode == http.StatusGatewayTimeout:
+		return ErrTimeout
+	case statusCode >= 500:
+		return ErrServer
+	case statusCode >= 400:
+		return ErrBadRequest
+	default:
+		return nil
+	}
+}
+
+// newAPIError decodes the ResponseHeader of an error response, the body may not be JSON, i.e. from a proxy
+func newAPIError(statusCode int, body []byte) *APIError {
+	response := struct {
+		Header ResponseHeader `json:"header"`
+	}{}
+	_ = json.Unmarshal(body, &response)
+
+	return &APIError{
+		StatusCode: statusCode,
+		Messages:   response.Header.Messages,
+	}
+}
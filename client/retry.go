@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// RetryPolicy retries idempotent requests, i.e. GET, failing with a network error or a 429, 502, 503 or 504
+type RetryPolicy struct {
+	MaxAttempts    int           // Including the first attempt, 1 disables retries
+	InitialBackoff time.Duration // Doubled after every attempt
+	MaxBackoff     time.Duration
+}
+
+// DefaultRetryPolicy is used when no RetryPolicy is configured
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: 100 * time.Millisecond,
+	MaxBackoff:     2 * time.Second,
+}
+
+// retryDoer sends requests with doer, retrying them as configured by policy
+type retryDoer struct {
+	doer   HttpRequestDoer
+	policy RetryPolicy
+}
+
+func (d *retryDoer) Do(request *http.Request) (*http.Response, error) {
+	if !isIdempotent(request.Method) {
+		return d.doer.Do(request)
+	}
+
+	backoff := d.policy.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		response, err := d.doer.Do(request)
+		if attempt >= d.policy.MaxAttempts || !isRetryable(request.Context(), response, err) {
+			return response, err
+		}
+
+		if response != nil {
+			response.Body.Close()
+		}
+
+		select {
+		case <-request.Context().Done():
+			return nil, request.Context().Err()
+		case <-time.After(backoff):
+		}
+
+		backoff = min(2*backoff, d.policy.MaxBackoff)
+	}
+}
+
+// isIdempotent reports whether sending a request twice has the same effect as once, every idempotent operation of api.yml is a GET
+func isIdempotent(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead
+}
+
+func isRetryable(ctx context.Context, response *http.Response, err error) bool {
+	if err != nil {
+		// The caller gave up, not the wallet
+		return ctx.Err() == nil && !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+
+	switch response.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,463 @@
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultTokenExpiry is the JWT expiry of the wallet, only used if the JWT has no exp claim
+	DefaultTokenExpiry = 5 * time.Minute
+
+	// DefaultRefreshBefore is how long before the JWT expires Wallet logs in again
+	DefaultRefreshBefore = 30 * time.Second
+)
+
+// Wallet calls the wallet service as a User.
+// It logs in on the first call needing a JWT, then reuses the JWT until it is about to expire.
+// It is safe for concurrent use.
+type Wallet struct {
+	api           *Client
+	phoneNumber   string
+	password      string
+	refreshBefore time.Duration
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+	userID    int64
+}
+
+type NewWalletOptions struct {
+	PhoneNumber string
+	Password    string
+
+	HTTPClient    HttpRequestDoer // http.DefaultClient if nil
+	Retry         *RetryPolicy    // DefaultRetryPolicy if nil
+	RefreshBefore time.Duration   // DefaultRefreshBefore if zero
+}
+
+// NewWallet returns a Wallet calling the wallet service at server, i.e. "http://localhost:1323"
+func NewWallet(server string, opts NewWalletOptions) (*Wallet, error) {
+	var doer HttpRequestDoer = http.DefaultClient
+	if opts.HTTPClient != nil {
+		doer = opts.HTTPClient
+	}
+
+	policy := DefaultRetryPolicy
+	if opts.Retry != nil {
+		policy = *opts.Retry
+	}
+
+	api, err := NewClient(server, WithHTTPClient(&retryDoer{doer: doer, policy: policy}))
+	if err != nil {
+		return nil, err
+	}
+
+	refreshBefore := DefaultRefreshBefore
+	if opts.RefreshBefore > 0 {
+		refreshBefore = opts.RefreshBefore
+	}
+
+	return &Wallet{
+		api:           api,
+		phoneNumber:   opts.PhoneNumber,
+		password:      opts.Password,
+		refreshBefore: refreshBefore,
+	}, nil
+}
+
+// RegisterUser registers a new User, it does not need a JWT
+func (w *Wallet) RegisterUser(ctx context.Context, request RegisterUserRequest) (User, error) {
+	response, err := decode[RegisterUserResponse](w.api.RegisterUser(ctx, request))
+	return response.User, err
+}
+
+// Login logs in with the configured phone number and password, and caches the JWT for the following calls.
+// Calling it is optional, Wallet logs in when it needs a JWT.
+func (w *Wallet) Login(ctx context.Context) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.login(ctx)
+}
+
+// login must be called with mu held
+func (w *Wallet) login(ctx context.Context) error {
+	httpResponse, err := w.api.UserLogin(ctx, UserLoginRequest{
+		PhoneNumber: w.phoneNumber,
+		Password:    w.password,
+	})
+	// Read the header before decode closes the response
+	authorization := ""
+	if httpResponse != nil {
+		authorization = httpResponse.Header.Get("Authorization")
+	}
+
+	response, err := decode[UserLoginResponse](httpResponse, err)
+	if err != nil {
+		return err
+	}
+
+	token, ok := strings.CutPrefix(authorization, "Bearer ")
+	if !ok || token == "" || response.User.Id == nil {
+		return errors.New("login response has no JWT")
+	}
+
+	w.token = token
+	w.expiresAt = tokenExpiry(token)
+	w.userID = *response.User.Id
+	return nil
+}
+
+// session returns the cached JWT, logging in if there is none or it is about to expire
+func (w *Wallet) session(ctx context.Context) (token string, userID int, err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.token == "" || !time.Now().Add(w.refreshBefore).Before(w.expiresAt) {
+		if err := w.login(ctx); err != nil {
+			return "", 0, err
+		}
+	}
+
+	return w.token, int(w.userID), nil
+}
+
+// invalidate drops token if it is still the cached JWT, so the next session logs in again
+func (w *Wallet) invalidate(token string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.token == token {
+		w.token = ""
+	}
+}
+
+// authorized calls call with the JWT and the ID of the User.
+// If the wallet rejects the JWT, i.e. its keys were rotated, it logs in again and calls call once more.
+// The request was rejected before reaching any handler, so calling again is safe even if call is not idempotent.
+func (w *Wallet) authorized(ctx context.Context, call func(userID int, editor RequestEditorFn) (*http.Response, error)) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		token, userID, err := w.session(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		response, err := call(userID, func(ctx context.Context, request *http.Request) error {
+			request.Header.Set("Authorization", "Bearer "+token)
+			return nil
+		})
+		if err != nil || response.StatusCode != http.StatusUnauthorized || attempt > 1 {
+			return response, err
+		}
+
+		response.Body.Close()
+		w.invalidate(token)
+	}
+}
+
+// GetUser retrieves the User, i.e. wallet balance
+func (w *Wallet) GetUser(ctx context.Context) (User, error) {
+	response, err := decode[GetUserResponse](w.authorized(ctx, func(_ int, editor RequestEditorFn) (*http.Response, error) {
+		return w.api.GetUser(ctx, editor)
+	}))
+	return response.User, err
+}
+
+// UpdateUser updates the profile of the User.
+// A new phone number requires the current password in the request, it is returned as the pending PhoneNumberChange and changed once the OTP
+// sent to it is verified with VerifyPhoneNumber.
+func (w *Wallet) UpdateUser(ctx context.Context, request UpdateUserRequest) (User, *PhoneNumberChange, error) {
+	response, err := decode[UpdateUserResponse](w.authorized(ctx, func(_ int, editor RequestEditorFn) (*http.Response, error) {
+		return w.api.UpdateUser(ctx, request, editor)
+	}))
+	return response.User, response.PhoneNumberChange, err
+}
+
+// VerifyPhoneNumber changes the phone number of the User with the OTP sent to it, Wallet logs in with the new phone number afterwards
+func (w *Wallet) VerifyPhoneNumber(ctx context.Context, otp string) (User, error) {
+	response, err := decode[UpdateUserResponse](w.authorized(ctx, func(_ int, editor RequestEditorFn) (*http.Response, error) {
+		return w.api.VerifyUserPhoneNumber(ctx, VerifyPhoneNumberRequest{Otp: otp}, editor)
+	}))
+	if err == nil && response.User.PhoneNumber != nil {
+		w.mu.Lock()
+		w.phoneNumber = *response.User.PhoneNumber
+		w.mu.Unlock()
+	}
+	return response.User, err
+}
+
+// CreateTransaction performs a Transaction, it is not retried as it is not idempotent
+func (w *Wallet) CreateTransaction(ctx context.Context, request TransactionRequest) (Transaction, error) {
+	response, err := decode[TransactionResponse](w.authorized(ctx, func(userID int, editor RequestEditorFn) (*http.Response, error) {
+		return w.api.CreateUserTransaction(ctx, userID, request, editor)
+	}))
+	return response.Transaction, err
+}
+
+// LookupRecipient returns the masked name of the User with the phone number, to be confirmed before transferring with the
+// token of the returned Recipient as RecipientToken. Lookups are rate-limited, failing with ErrTooManyRequests.
+func (w *Wallet) LookupRecipient(ctx context.Context, phoneNumber string) (Recipient, error) {
+	response, err := decode[RecipientLookupResponse](w.authorized(ctx, func(userID int, editor RequestEditorFn) (*http.Response, error) {
+		return w.api.LookupRecipient(ctx, userID, RecipientLookupRequest{PhoneNumber: phoneNumber}, editor)
+	}))
+	return response.Recipient, err
+}
+
+// GetContacts lists the Contacts of the User by nickname, RecipientClosed tells a Contact can not be transferred to anymore
+func (w *Wallet) GetContacts(ctx context.Context) ([]Contact, error) {
+	response, err := decode[ContactsResponse](w.authorized(ctx, func(userID int, editor RequestEditorFn) (*http.Response, error) {
+		return w.api.GetUserContacts(ctx, userID, editor)
+	}))
+	return response.Contacts, err
+}
+
+// AddContact saves a Recipient as a Contact, the Recipient is requested like the Recipient of CreateTransaction.
+// Fails with ErrConflict if the Recipient is already a Contact.
+func (w *Wallet) AddContact(ctx context.Context, request ContactRequest) (Contact, error) {
+	response, err := decode[ContactResponse](w.authorized(ctx, func(userID int, editor RequestEditorFn) (*http.Response, error) {
+		return w.api.AddContact(ctx, userID, request, editor)
+	}))
+	return response.Contact, err
+}
+
+// RenameContact changes the nickname of a Contact
+func (w *Wallet) RenameContact(ctx context.Context, contactID int64, nickname string) (Contact, error) {
+	response, err := decode[ContactResponse](w.authorized(ctx, func(userID int, editor RequestEditorFn) (*http.Response, error) {
+		return w.api.UpdateContact(ctx, userID, int(contactID), UpdateContactRequest{Nickname: nickname}, editor)
+	}))
+	return response.Contact, err
+}
+
+// DeleteContact deletes a Contact, failing with ErrContactNotFound if there is no such Contact
+func (w *Wallet) DeleteContact(ctx context.Context, contactID int64) error {
+	_, err := decode[DeleteContactResponse](w.authorized(ctx, func(userID int, editor RequestEditorFn) (*http.Response, error) {
+		return w.api.DeleteContact(ctx, userID, int(contactID), editor)
+	}))
+	return err
+}
+
+// GetContactSuggestions returns Recipients of recent transfers who are not Contacts yet, to be saved with their RecipientId.
+// limit is set by the wallet if zero.
+func (w *Wallet) GetContactSuggestions(ctx context.Context, limit int) ([]ContactSuggestion, error) {
+	params := &GetContactSuggestionsParams{}
+	if limit > 0 {
+		params.Limit = &limit
+	}
+
+	response, err := decode[ContactSuggestionsResponse](w.authorized(ctx, func(userID int, editor RequestEditorFn) (*http.Response, error) {
+		return w.api.GetContactSuggestions(ctx, userID, params, editor)
+	}))
+	return response.Suggestions, err
+}
+
+// CreatePocket creates an empty Pocket to set money aside in, money is moved with PocketTransfer Transactions of CreateTransaction.
+// The Pockets of the User are returned by GetUser. Fails with ErrConflict if the name is already used.
+func (w *Wallet) CreatePocket(ctx context.Context, request PocketRequest) (Pocket, error) {
+	response, err := decode[PocketResponse](w.authorized(ctx, func(userID int, editor RequestEditorFn) (*http.Response, error) {
+		return w.api.CreatePocket(ctx, userID, request, editor)
+	}))
+	return response.Pocket, err
+}
+
+// UpdatePocket renames a Pocket or changes its target
+func (w *Wallet) UpdatePocket(ctx context.Context, pocketID int64, request PocketRequest) (Pocket, error) {
+	response, err := decode[PocketResponse](w.authorized(ctx, func(userID int, editor RequestEditorFn) (*http.Response, error) {
+		return w.api.UpdatePocket(ctx, userID, int(pocketID), request, editor)
+	}))
+	return response.Pocket, err
+}
+
+// DeletePocket deletes a Pocket, failing with ErrPocketNotEmpty until its money is moved out
+func (w *Wallet) DeletePocket(ctx context.Context, pocketID int64) error {
+	_, err := decode[DeletePocketResponse](w.authorized(ctx, func(userID int, editor RequestEditorFn) (*http.Response, error) {
+		return w.api.DeletePocket(ctx, userID, int(pocketID), editor)
+	}))
+	return err
+}
+
+// CreateSharedWallet creates an empty SharedWallet owned by the User. Members deposit into it with SharedWalletDeposit Transactions
+// and spend from it with TransferOut Transactions of CreateTransaction setting SharedWalletId.
+func (w *Wallet) CreateSharedWallet(ctx context.Context, name string) (SharedWallet, error) {
+	response, err := decode[SharedWalletResponse](w.authorized(ctx, func(userID int, editor RequestEditorFn) (*http.Response, error) {
+		return w.api.CreateSharedWallet(ctx, userID, SharedWalletRequest{Name: name}, editor)
+	}))
+	return response.SharedWallet, err
+}
+
+// GetSharedWallets lists the SharedWallets the User is a member of, with the role and limits of the User in each
+func (w *Wallet) GetSharedWallets(ctx context.Context) ([]SharedWallet, error) {
+	response, err := decode[SharedWalletsResponse](w.authorized(ctx, func(userID int, editor RequestEditorFn) (*http.Response, error) {
+		return w.api.GetUserSharedWallets(ctx, userID, editor)
+	}))
+	return response.SharedWallets, err
+}
+
+// GetSharedWalletMembers lists the members of a SharedWallet the User owns, failing with ErrSharedWalletRoleNotAllowed otherwise
+func (w *Wallet) GetSharedWalletMembers(ctx context.Context, sharedWalletID int64) ([]SharedWalletMember, error) {
+	response, err := decode[SharedWalletMembersResponse](w.authorized(ctx, func(userID int, editor RequestEditorFn) (*http.Response, error) {
+		return w.api.GetSharedWalletMembers(ctx, userID, int(sharedWalletID), editor)
+	}))
+	return response.Members, err
+}
+
+// AddSharedWalletMember adds a member to a SharedWallet the User owns, the member is requested by phone number or the token of
+// LookupRecipient. Fails with ErrConflict if the member was already added.
+func (w *Wallet) AddSharedWalletMember(ctx context.Context, sharedWalletID int64, request SharedWalletMemberRequest) (SharedWalletMember, error) {
+	response, err := decode[SharedWalletMemberResponse](w.authorized(ctx, func(userID int, editor RequestEditorFn) (*http.Response, error) {
+		return w.api.AddSharedWalletMember(ctx, userID, int(sharedWalletID), request, editor)
+	}))
+	return response.Member, err
+}
+
+// UpdateSharedWalletMember changes the role or limits of a member of a SharedWallet the User owns
+func (w *Wallet) UpdateSharedWalletMember(ctx context.Context, sharedWalletID, memberID int64, request UpdateSharedWalletMemberRequest) (SharedWalletMember, error) {
+	response, err := decode[SharedWalletMemberResponse](w.authorized(ctx, func(userID int, editor RequestEditorFn) (*http.Response, error) {
+		return w.api.UpdateSharedWalletMember(ctx, userID, int(sharedWalletID), int(memberID), request, editor)
+	}))
+	return response.Member, err
+}
+
+// RemoveSharedWalletMember removes a member from a SharedWallet the User owns, its Transactions are kept
+func (w *Wallet) RemoveSharedWalletMember(ctx context.Context, sharedWalletID, memberID int64) error {
+	_, err := decode[DeleteSharedWalletMemberResponse](w.authorized(ctx, func(userID int, editor RequestEditorFn) (*http.Response, error) {
+		return w.api.RemoveSharedWalletMember(ctx, userID, int(sharedWalletID), int(memberID), editor)
+	}))
+	return err
+}
+
+// GetSharedWalletTransactions lists the Transactions of a SharedWallet, most recent first. Spenders only get their own Transactions.
+// limit is set by the wallet if zero.
+func (w *Wallet) GetSharedWalletTransactions(ctx context.Context, sharedWalletID int64, limit, offset int) ([]Transaction, error) {
+	params := &GetSharedWalletTransactionsParams{}
+	if limit > 0 {
+		params.Limit = &limit
+	}
+	if offset > 0 {
+		params.Offset = &offset
+	}
+
+	response, err := decode[TransactionsResponse](w.authorized(ctx, func(userID int, editor RequestEditorFn) (*http.Response, error) {
+		return w.api.GetSharedWalletTransactions(ctx, userID, int(sharedWalletID), params, editor)
+	}))
+	return response.Transactions, err
+}
+
+// PreviewTransactionFee returns the fee a Transaction would be charged
+func (w *Wallet) PreviewTransactionFee(ctx context.Context, request FeePreviewRequest) (FeePreview, error) {
+	response, err := decode[FeePreviewResponse](w.authorized(ctx, func(userID int, editor RequestEditorFn) (*http.Response, error) {
+		return w.api.PreviewUserTransactionFee(ctx, userID, request, editor)
+	}))
+	return response.Fee, err
+}
+
+// GetBankAccounts lists the bank accounts the User can withdraw to
+func (w *Wallet) GetBankAccounts(ctx context.Context) ([]BankAccount, error) {
+	response, err := decode[BankAccountsResponse](w.authorized(ctx, func(userID int, editor RequestEditorFn) (*http.Response, error) {
+		return w.api.GetUserBankAccounts(ctx, userID, editor)
+	}))
+	return response.BankAccounts, err
+}
+
+// RegisterBankAccount registers a bank account the User can withdraw to
+func (w *Wallet) RegisterBankAccount(ctx context.Context, request RegisterBankAccountRequest) (BankAccount, error) {
+	response, err := decode[BankAccountResponse](w.authorized(ctx, func(userID int, editor RequestEditorFn) (*http.Response, error) {
+		return w.api.RegisterUserBankAccount(ctx, userID, request, editor)
+	}))
+	return response.BankAccount, err
+}
+
+// CreateTopUp issues a virtual account to top up the wallet with
+func (w *Wallet) CreateTopUp(ctx context.Context, request TopUpRequest) (TopUpIntent, error) {
+	response, err := decode[TopUpResponse](w.authorized(ctx, func(userID int, editor RequestEditorFn) (*http.Response, error) {
+		return w.api.CreateUserTopUp(ctx, userID, request, editor)
+	}))
+	return response.Topup, err
+}
+
+// GetTopUp retrieves a top-up, i.e. to check whether it is paid
+func (w *Wallet) GetTopUp(ctx context.Context, topUpID string) (TopUpIntent, error) {
+	response, err := decode[TopUpResponse](w.authorized(ctx, func(userID int, editor RequestEditorFn) (*http.Response, error) {
+		return w.api.GetUserTopUp(ctx, userID, topUpID, editor)
+	}))
+	return response.Topup, err
+}
+
+// CreateFXQuote quotes a conversion between the User's balances, to be used by a Convert Transaction
+func (w *Wallet) CreateFXQuote(ctx context.Context, request FXQuoteRequest) (FXQuote, error) {
+	response, err := decode[FXQuoteResponse](w.authorized(ctx, func(userID int, editor RequestEditorFn) (*http.Response, error) {
+		return w.api.CreateUserFXQuote(ctx, userID, request, editor)
+	}))
+	return response.Quote, err
+}
+
+// GenerateQR generates a QR others can pay the User with
+func (w *Wallet) GenerateQR(ctx context.Context, request QRRequest) (QR, error) {
+	response, err := decode[QRResponse](w.authorized(ctx, func(userID int, editor RequestEditorFn) (*http.Response, error) {
+		return w.api.GenerateUserQR(ctx, userID, request, editor)
+	}))
+	return response.Qr, err
+}
+
+// PayQR pays a QR, it is not retried as it is not idempotent
+func (w *Wallet) PayQR(ctx context.Context, request QRPaymentRequest) (Transaction, error) {
+	response, err := decode[TransactionResponse](w.authorized(ctx, func(userID int, editor RequestEditorFn) (*http.Response, error) {
+		return w.api.CreateUserQRPayment(ctx, userID, request, editor)
+	}))
+	return response.Transaction, err
+}
+
+// decode reads a response into T, or into an APIError if its status is not 2xx
+func decode[T any](response *http.Response, err error) (T, error) {
+	var result T
+	if err != nil {
+		return result, err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return result, err
+	}
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return result, newAPIError(response.StatusCode, body)
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// tokenExpiry reads the exp claim of a JWT, its signature is verified by the wallet and not needed here
+func tokenExpiry(token string) time.Time {
+	fallback := time.Now().Add(DefaultTokenExpiry)
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return fallback
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return fallback
+	}
+
+	claims := struct {
+		ExpiresAt int64 `json:"exp"`
+	}{}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.ExpiresAt == 0 {
+		return fallback
+	}
+
+	return time.Unix(claims.ExpiresAt, 0)
+}
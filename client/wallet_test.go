@@ -0,0 +1,136 @@
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// fakeJWT is a JWT with only the exp claim, Wallet does not verify signatures
+func fakeJWT(expiresAt time.Time) string {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"exp":%d}`, expiresAt.Unix())))
+	return "header." + payload + ".signature"
+}
+
+func TestWallet(t *testing.T) {
+	tests := []struct {
+		name string
+		// respond answers the nth (from 1) request, except POST /v1/user/login
+		respond func(w http.ResponseWriter, n int)
+		call    func(wallet *Wallet) error
+
+		wantLogins   int
+		wantRequests int
+		wantError    error
+	}{
+		{
+			name: "success-login-again-when-jwt-rejected",
+			respond: func(w http.ResponseWriter, n int) {
+				if n == 1 {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				fmt.Fprint(w, `{"header":{"success":true,"messages":["success"]},"user":{"id":123}}`)
+			},
+			call: func(wallet *Wallet) error {
+				_, err := wallet.GetUser(context.Background())
+				return err
+			},
+			wantLogins:   2,
+			wantRequests: 2,
+		},
+		{
+			name: "fail-jwt-rejected-twice",
+			respond: func(w http.ResponseWriter, n int) {
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprint(w, `{"header":{"success":false,"messages":["JWT has expired"]}}`)
+			},
+			call: func(wallet *Wallet) error {
+				_, err := wallet.GetUser(context.Background())
+				return err
+			},
+			wantLogins:   2,
+			wantRequests: 2,
+			wantError:    ErrUnauthorized,
+		},
+		{
+			name: "fail-not-idempotent-not-retried",
+			respond: func(w http.ResponseWriter, n int) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			},
+			call: func(wallet *Wallet) error {
+				_, err := wallet.CreateTransaction(context.Background(), TransactionRequest{Type: TransferOut, Amount: 1})
+				return err
+			},
+			wantLogins:   1,
+			wantRequests: 1,
+			wantError:    ErrServer,
+		},
+		{
+			name: "fail-idempotent-retries-exhausted",
+			respond: func(w http.ResponseWriter, n int) {
+				w.WriteHeader(http.StatusBadGateway)
+			},
+			call: func(wallet *Wallet) error {
+				_, err := wallet.GetBankAccounts(context.Background())
+				return err
+			},
+			wantLogins:   1,
+			wantRequests: 3,
+			wantError:    ErrServer,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			logins, requests := 0, 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/v1/user/login" {
+					logins++
+					w.Header().Set("Authorization", "Bearer "+fakeJWT(time.Now().Add(5*time.Minute)))
+					fmt.Fprint(w, `{"header":{"success":true,"messages":["success"]},"user":{"id":123}}`)
+					return
+				}
+				requests++
+				test.respond(w, requests)
+			}))
+			defer server.Close()
+
+			wallet, err := NewWallet(server.URL, NewWalletOptions{
+				PhoneNumber: "+6281234567890",
+				Password:    "Admin1234!",
+				Retry:       &RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond},
+			})
+			if err != nil {
+				t.Fatalf("NewWallet() error = %v", err)
+			}
+
+			err = test.call(wallet)
+			if test.wantError == nil && err != nil || test.wantError != nil && !errors.Is(err, test.wantError) {
+				t.Errorf("Wallet error = %v, want %v", err, test.wantError)
+			}
+			if logins != test.wantLogins {
+				t.Errorf("Wallet logins = %v, want %v", logins, test.wantLogins)
+			}
+			if requests != test.wantRequests {
+				t.Errorf("Wallet requests = %v, want %v", requests, test.wantRequests)
+			}
+		})
+	}
+}
+
+func Test_tokenExpiry(t *testing.T) {
+	expiresAt := time.Unix(time.Now().Add(time.Minute).Unix(), 0)
+	if got := tokenExpiry(fakeJWT(expiresAt)); !got.Equal(expiresAt) {
+		t.Errorf("tokenExpiry() = %v, want %v", got, expiresAt)
+	}
+
+	if got := tokenExpiry("not-a-jwt"); got.Before(time.Now().Add(DefaultTokenExpiry - time.Second)) {
+		t.Errorf("tokenExpiry() = %v, want DefaultTokenExpiry from now", got)
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/WalletService/repository"
+	"github.com/WalletService/usecase"
+)
+
+const auditUsage = `usage: main audit <command> [flags]
+
+commands:
+  verify   walk the hash chain of the audit log and report the first entry breaking it
+
+flags:
+`
+
+// runAudit runs the audit subcommand, i.e. main audit verify -batch-size 5000
+func runAudit(args []string) error {
+	flags := flag.NewFlagSet("audit", flag.ContinueOnError)
+	batchSize := flags.Int("batch-size", 1000, "number of entries read at once")
+	configFile := flags.String("config", "", "path to the YAML config file, overrides CONFIG_FILE")
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), auditUsage)
+		flags.PrintDefaults()
+	}
+
+	if len(args) == 0 {
+		flags.Usage()
+		return errors.New("missing audit command")
+	}
+	command := args[0]
+	if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	var configArgs []string
+	if *configFile != "" {
+		configArgs = []string{"-config", *configFile}
+	}
+	cfg := loadConfig(configArgs)
+
+	switch command {
+	case "verify":
+		uc := usecase.NewUsecase(usecase.NewUsecaseOptions{
+			Repository: repository.NewRepositoryWithDB(newDB(cfg.Database)),
+		})
+
+		verification, err := uc.VerifyAuditLog(context.Background(), *batchSize)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("%d entries verified, last hash %s\n", verification.Entries, verification.LastHash)
+		if verification.BrokenID != 0 {
+			return fmt.Errorf("audit log entry %d breaks the chain: %s", verification.BrokenID, verification.Reason)
+		}
+		return nil
+	default:
+		flags.Usage()
+		return fmt.Errorf("unknown audit command %s", command)
+	}
+}
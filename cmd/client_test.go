@@ -0,0 +1,401 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/WalletService/client"
+	"github.com/WalletService/config"
+	"github.com/WalletService/handler"
+	"github.com/WalletService/logging"
+	"github.com/WalletService/model"
+	"github.com/WalletService/repository"
+	"github.com/WalletService/tracing"
+	"github.com/WalletService/usecase"
+	"github.com/golang/mock/gomock"
+)
+
+const (
+	testPhoneNumber = "+6281234567890"
+	testPassword    = "Admin1234!"
+)
+
+// newTestWalletServer serves the real handlers and middleware of the wallet, backed by mockUsecase.
+// failures is the number of GET /v1/user requests answered with 503 before reaching the wallet.
+// Users logging in have no roles.
+func newTestWalletServer(t *testing.T, mockUsecase *usecase.MockUsecaseInterface, jwtExpiry time.Duration, failures int32) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	mockUsecase.EXPECT().GetUserPermissions(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("rsa.GenerateKey() error = %v", err)
+	}
+
+	cfg := config.Default()
+	cfg.Auth.JWTExpiry = jwtExpiry
+
+	e := newEcho(cfg, logging.New(io.Discard, slog.LevelError), tracing.NewTracer(nil),
+		jwtKeys{privateKey: privateKey, publicKey: &privateKey.PublicKey},
+		handler.NewServer(mockUsecase, handler.NewServerOptions{}),
+	)
+
+	getUserRequests := &atomic.Int32{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && r.URL.Path == "/v1/user" && getUserRequests.Add(1) <= failures {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		e.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, getUserRequests
+}
+
+func newTestWallet(t *testing.T, server string, refreshBefore time.Duration) *client.Wallet {
+	t.Helper()
+
+	wallet, err := client.NewWallet(server, client.NewWalletOptions{
+		PhoneNumber:   testPhoneNumber,
+		Password:      testPassword,
+		Retry:         &client.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond},
+		RefreshBefore: refreshBefore,
+	})
+	if err != nil {
+		t.Fatalf("client.NewWallet() error = %v", err)
+	}
+
+	return wallet
+}
+
+func TestWallet_cachesJWT(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockUsecase := usecase.NewMockUsecaseInterface(controller)
+	mockUsecase.EXPECT().UserLogin(gomock.Any(), testPhoneNumber, testPassword).Return(int64(123), nil).Times(1)
+	mockUsecase.EXPECT().GetUser(gomock.Any(), int64(123)).Return(model.User{ID: 123, FullName: "Budi", Balance: 1000}, nil).Times(2)
+
+	server, _ := newTestWalletServer(t, mockUsecase, 5*time.Minute, 0)
+	wallet := newTestWallet(t, server.URL, 0)
+
+	for i := 0; i < 2; i++ {
+		user, err := wallet.GetUser(context.Background())
+		if err != nil {
+			t.Fatalf("Wallet.GetUser() error = %v", err)
+		}
+		if user.Id == nil || *user.Id != 123 || user.Balance == nil || *user.Balance != 1000 {
+			t.Errorf("Wallet.GetUser() = %+v, want User 123 with balance 1000", user)
+		}
+	}
+}
+
+func TestWallet_refreshesJWTBeforeExpiry(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockUsecase := usecase.NewMockUsecaseInterface(controller)
+	mockUsecase.EXPECT().UserLogin(gomock.Any(), testPhoneNumber, testPassword).Return(int64(123), nil).Times(2)
+	mockUsecase.EXPECT().GetUser(gomock.Any(), int64(123)).Return(model.User{ID: 123}, nil).Times(2)
+
+	// The JWT expires within RefreshBefore as soon as it is issued, so every call logs in again
+	server, _ := newTestWalletServer(t, mockUsecase, time.Minute, 0)
+	wallet := newTestWallet(t, server.URL, 2*time.Minute)
+
+	for i := 0; i < 2; i++ {
+		if _, err := wallet.GetUser(context.Background()); err != nil {
+			t.Fatalf("Wallet.GetUser() error = %v", err)
+		}
+	}
+}
+
+func TestWallet_retriesIdempotentCalls(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockUsecase := usecase.NewMockUsecaseInterface(controller)
+	mockUsecase.EXPECT().UserLogin(gomock.Any(), testPhoneNumber, testPassword).Return(int64(123), nil)
+	mockUsecase.EXPECT().GetUser(gomock.Any(), int64(123)).Return(model.User{ID: 123}, nil)
+
+	server, getUserRequests := newTestWalletServer(t, mockUsecase, 5*time.Minute, 2)
+	wallet := newTestWallet(t, server.URL, 0)
+
+	if _, err := wallet.GetUser(context.Background()); err != nil {
+		t.Fatalf("Wallet.GetUser() error = %v", err)
+	}
+	if got := getUserRequests.Load(); got != 3 {
+		t.Errorf("GET /v1/user requests = %v, want %v", got, 3)
+	}
+}
+
+func TestWallet_lookupRecipient(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockUsecase := usecase.NewMockUsecaseInterface(controller)
+	mockUsecase.EXPECT().UserLogin(gomock.Any(), testPhoneNumber, testPassword).Return(int64(123), nil)
+	mockUsecase.EXPECT().LookupRecipient(gomock.Any(), int64(123), "+628987654321").Return(model.RecipientLookup{
+		UserID: 123, RecipientID: 456, ExpiryTime: time.Now().Add(time.Minute), RecipientName: "Jo** Do*",
+	}, nil)
+
+	server, _ := newTestWalletServer(t, mockUsecase, 5*time.Minute, 0)
+	wallet := newTestWallet(t, server.URL, 0)
+
+	recipient, err := wallet.LookupRecipient(context.Background(), "0898-7654-321")
+	if err != nil {
+		t.Fatalf("Wallet.LookupRecipient() error = %v", err)
+	}
+	if recipient.Name == nil || *recipient.Name != "Jo** Do*" || recipient.Token == nil {
+		t.Errorf("Wallet.LookupRecipient() = %+v, want masked name and token", recipient)
+	}
+}
+
+func TestWallet_contacts(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockUsecase := usecase.NewMockUsecaseInterface(controller)
+	mockUsecase.EXPECT().UserLogin(gomock.Any(), testPhoneNumber, testPassword).Return(int64(123), nil)
+	mockUsecase.EXPECT().GetContactSuggestions(gomock.Any(), int64(123), 3).Return([]model.ContactSuggestion{
+		{RecipientID: 456, RecipientName: "Jo** Do*", TransferCount: 2, LastTransferTime: time.Now()},
+	}, nil)
+	mockUsecase.EXPECT().AddContact(gomock.Any(), model.ContactRequest{UserID: 123, RecipientID: 456, Nickname: "Johnny"}).Return(model.Contact{
+		ID: 11, UserID: 123, RecipientID: 456, Nickname: "Johnny", RecipientName: "Jo** Do*", RecipientStatus: model.UserStatusClosed,
+	}, nil)
+	mockUsecase.EXPECT().DeleteContact(gomock.Any(), int64(123), int64(12)).Return(repository.ErrContactNotFound)
+
+	server, _ := newTestWalletServer(t, mockUsecase, 5*time.Minute, 0)
+	wallet := newTestWallet(t, server.URL, 0)
+
+	suggestions, err := wallet.GetContactSuggestions(context.Background(), 3)
+	if err != nil {
+		t.Fatalf("Wallet.GetContactSuggestions() error = %v", err)
+	}
+	if len(suggestions) != 1 || suggestions[0].RecipientId == nil || *suggestions[0].RecipientId != 456 {
+		t.Fatalf("Wallet.GetContactSuggestions() = %+v, want Recipient 456", suggestions)
+	}
+
+	contact, err := wallet.AddContact(context.Background(), client.ContactRequest{Nickname: "Johnny", RecipientId: suggestions[0].RecipientId})
+	if err != nil {
+		t.Fatalf("Wallet.AddContact() error = %v", err)
+	}
+	if contact.Id == nil || *contact.Id != 11 || contact.RecipientClosed == nil || !*contact.RecipientClosed {
+		t.Errorf("Wallet.AddContact() = %+v, want closed Contact 11", contact)
+	}
+
+	if err := wallet.DeleteContact(context.Background(), 12); !errors.Is(err, client.ErrContactNotFound) || !errors.Is(err, client.ErrNotFound) {
+		t.Errorf("Wallet.DeleteContact() error = %v, want %v", err, client.ErrContactNotFound)
+	}
+}
+
+func TestWallet_pockets(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockUsecase := usecase.NewMockUsecaseInterface(controller)
+	mockUsecase.EXPECT().UserLogin(gomock.Any(), testPhoneNumber, testPassword).Return(int64(123), nil)
+	mockUsecase.EXPECT().CreatePocket(gomock.Any(), model.Pocket{UserID: 123, Name: "Holiday"}).Return(model.Pocket{ID: 2, UserID: 123, Name: "Holiday"}, nil)
+	mockUsecase.EXPECT().CreateUserTransaction(gomock.Any(), model.Transaction{
+		UserID:   123,
+		Amount:   50000,
+		Type:     model.TransactionTypePocketTransfer,
+		PocketID: 2,
+		Password: testPassword,
+	}).Return(model.Transaction{Status: model.TransactionStatusSuccessful}, nil)
+	mockUsecase.EXPECT().DeletePocket(gomock.Any(), int64(123), int64(2)).Return(usecase.ErrPocketNotEmpty)
+
+	server, _ := newTestWalletServer(t, mockUsecase, 5*time.Minute, 0)
+	wallet := newTestWallet(t, server.URL, 0)
+
+	pocket, err := wallet.CreatePocket(context.Background(), client.PocketRequest{Name: "Holiday"})
+	if err != nil {
+		t.Fatalf("Wallet.CreatePocket() error = %v", err)
+	}
+	if pocket.Id == nil || *pocket.Id != 2 {
+		t.Fatalf("Wallet.CreatePocket() = %+v, want Pocket 2", pocket)
+	}
+
+	if _, err := wallet.CreateTransaction(context.Background(), client.TransactionRequest{
+		Type:     client.PocketTransfer,
+		Amount:   50000,
+		PocketId: pocket.Id,
+		Password: stringPtr(testPassword),
+	}); err != nil {
+		t.Errorf("Wallet.CreateTransaction() error = %v", err)
+	}
+
+	if err := wallet.DeletePocket(context.Background(), *pocket.Id); !errors.Is(err, client.ErrPocketNotEmpty) || !errors.Is(err, client.ErrBadRequest) {
+		t.Errorf("Wallet.DeletePocket() error = %v, want %v", err, client.ErrPocketNotEmpty)
+	}
+}
+
+func TestWallet_sharedWallets(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockUsecase := usecase.NewMockUsecaseInterface(controller)
+	mockUsecase.EXPECT().UserLogin(gomock.Any(), testPhoneNumber, testPassword).Return(int64(123), nil)
+	mockUsecase.EXPECT().CreateSharedWallet(gomock.Any(), model.SharedWallet{OwnerID: 123, Name: "Family"}).Return(model.SharedWallet{
+		ID: 5, OwnerID: 123, Name: "Family", Member: model.SharedWalletMember{SharedWalletID: 5, UserID: 123, Role: model.SharedWalletRoleOwner},
+	}, nil)
+	mockUsecase.EXPECT().AddSharedWalletMember(gomock.Any(), model.SharedWalletMemberRequest{
+		SharedWalletID: 5, OwnerID: 123, PhoneNumber: "+628987654321", Role: model.SharedWalletRoleSpender, MonthlyLimit: 300000,
+	}).Return(model.SharedWalletMember{SharedWalletID: 5, UserID: 456, Role: model.SharedWalletRoleSpender, MonthlyLimit: 300000}, nil)
+	mockUsecase.EXPECT().CreateUserTransaction(gomock.Any(), model.Transaction{
+		UserID:         123,
+		Amount:         500000,
+		Type:           model.TransactionTypeTransferOut,
+		RecipientID:    456,
+		SharedWalletID: 5,
+		Password:       testPassword,
+	}).Return(model.Transaction{}, usecase.ErrSharedWalletMonthlyLimitExceeded)
+	mockUsecase.EXPECT().RemoveSharedWalletMember(gomock.Any(), int64(123), int64(5), int64(123)).Return(usecase.ErrSharedWalletOwnerMember)
+
+	server, _ := newTestWalletServer(t, mockUsecase, 5*time.Minute, 0)
+	wallet := newTestWallet(t, server.URL, 0)
+
+	sharedWallet, err := wallet.CreateSharedWallet(context.Background(), "Family")
+	if err != nil {
+		t.Fatalf("Wallet.CreateSharedWallet() error = %v", err)
+	}
+	if sharedWallet.Id == nil || *sharedWallet.Id != 5 || sharedWallet.Role == nil || *sharedWallet.Role != client.Owner {
+		t.Fatalf("Wallet.CreateSharedWallet() = %+v, want SharedWallet 5 owned by the User", sharedWallet)
+	}
+
+	monthlyLimit := float32(300000)
+	member, err := wallet.AddSharedWalletMember(context.Background(), *sharedWallet.Id, client.SharedWalletMemberRequest{
+		PhoneNumber:  stringPtr("08987654321"),
+		Role:         client.Spender,
+		MonthlyLimit: &monthlyLimit,
+	})
+	if err != nil {
+		t.Fatalf("Wallet.AddSharedWalletMember() error = %v", err)
+	}
+	if member.UserId == nil || *member.UserId != 456 {
+		t.Fatalf("Wallet.AddSharedWalletMember() = %+v, want member 456", member)
+	}
+
+	if _, err := wallet.CreateTransaction(context.Background(), client.TransactionRequest{
+		Type:           client.TransferOut,
+		Amount:         500000,
+		RecipientId:    member.UserId,
+		SharedWalletId: sharedWallet.Id,
+		Password:       stringPtr(testPassword),
+	}); !errors.Is(err, client.ErrSharedWalletMonthlyLimitExceeded) || !errors.Is(err, client.ErrBadRequest) {
+		t.Errorf("Wallet.CreateTransaction() error = %v, want %v", err, client.ErrSharedWalletMonthlyLimitExceeded)
+	}
+
+	if err := wallet.RemoveSharedWalletMember(context.Background(), *sharedWallet.Id, 123); !errors.Is(err, client.ErrBadRequest) {
+		t.Errorf("Wallet.RemoveSharedWalletMember() error = %v, want %v", err, client.ErrBadRequest)
+	}
+}
+
+func TestWallet_createTransaction(t *testing.T) {
+	tests := []struct {
+		name        string
+		request     client.TransactionRequest
+		mockUsecase func(mock *usecase.MockUsecaseInterface)
+
+		wantErrors   []error
+		wantMessages []string
+	}{
+		{
+			name: "success",
+			request: client.TransactionRequest{
+				Type:        client.TransferOut,
+				Amount:      100000,
+				RecipientId: int64Ptr(2),
+				Password:    stringPtr(testPassword),
+			},
+			mockUsecase: func(mock *usecase.MockUsecaseInterface) {
+				mock.EXPECT().CreateUserTransaction(gomock.Any(), gomock.Any()).Return(model.Transaction{
+					Status: model.TransactionStatusSuccessful,
+				}, nil)
+			},
+		},
+		{
+			name: "fail-api-yml",
+			request: client.TransactionRequest{
+				Type:   "Gift",
+				Amount: 0,
+			},
+			mockUsecase: func(mock *usecase.MockUsecaseInterface) {},
+			wantErrors:  []error{client.ErrBadRequest},
+			wantMessages: []string{
+				"amount: number must be more than 0",
+				`type: value is not one of the allowed values ["TransferOut","TopUp","Payment","Withdrawal","Convert","AdjustmentCredit","AdjustmentDebit","PocketTransfer","SharedWalletDeposit"]`,
+			},
+		},
+		{
+			name: "fail-balance-not-enough",
+			request: client.TransactionRequest{
+				Type:        client.TransferOut,
+				Amount:      100000,
+				RecipientId: int64Ptr(2),
+				Password:    stringPtr(testPassword),
+			},
+			mockUsecase: func(mock *usecase.MockUsecaseInterface) {
+				mock.EXPECT().CreateUserTransaction(gomock.Any(), gomock.Any()).Return(model.Transaction{}, errors.New("balance not enough"))
+			},
+			wantErrors:   []error{client.ErrBalanceNotEnough, client.ErrServer},
+			wantMessages: []string{"balance not enough"},
+		},
+		{
+			name: "fail-recipient-lookup-limit-exceeded",
+			request: client.TransactionRequest{
+				Type:                 client.TransferOut,
+				Amount:               100000,
+				RecipientPhoneNumber: stringPtr("0812-3456-789"),
+				Password:             stringPtr(testPassword),
+			},
+			mockUsecase: func(mock *usecase.MockUsecaseInterface) {
+				mock.EXPECT().CreateUserTransaction(gomock.Any(), gomock.Any()).Return(model.Transaction{}, usecase.ErrRecipientLookupLimitExceeded)
+			},
+			wantErrors:   []error{client.ErrTooManyRequests},
+			wantMessages: []string{"too many recipient lookups, try again later"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			mockUsecase := usecase.NewMockUsecaseInterface(controller)
+			mockUsecase.EXPECT().UserLogin(gomock.Any(), testPhoneNumber, testPassword).Return(int64(123), nil)
+			test.mockUsecase(mockUsecase)
+
+			server, _ := newTestWalletServer(t, mockUsecase, 5*time.Minute, 0)
+			wallet := newTestWallet(t, server.URL, 0)
+
+			transaction, err := wallet.CreateTransaction(context.Background(), test.request)
+			if len(test.wantErrors) == 0 {
+				if err != nil {
+					t.Fatalf("Wallet.CreateTransaction() error = %v", err)
+				}
+				if transaction.Status == nil || *transaction.Status != client.TransactionStatusSuccessful {
+					t.Errorf("Wallet.CreateTransaction() = %+v, want a Successful Transaction", transaction)
+				}
+				return
+			}
+
+			for _, wantError := range test.wantErrors {
+				if !errors.Is(err, wantError) {
+					t.Errorf("Wallet.CreateTransaction() error = %v, want %v", err, wantError)
+				}
+			}
+
+			apiError := &client.APIError{}
+			if !errors.As(err, &apiError) {
+				t.Fatalf("Wallet.CreateTransaction() error = %v, want *client.APIError", err)
+			}
+			if !reflect.DeepEqual(apiError.Messages, test.wantMessages) {
+				t.Errorf("Wallet.CreateTransaction() messages = %#v, want %#v", apiError.Messages, test.wantMessages)
+			}
+		})
+	}
+}
+
+func int64Ptr(in int64) *int64 {
+	return &in
+}
+
+func stringPtr(in string) *string {
+	return &in
+}
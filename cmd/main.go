@@ -1,177 +1,480 @@
 package main
 
 import (
+	"context"
+	"crypto/rsa"
 	"errors"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"os"
+	"os/signal"
 	"regexp"
+	"syscall"
 	"time"
 
+	"github.com/WalletService/config"
+	"github.com/WalletService/disbursement"
+	"github.com/WalletService/fees"
+	"github.com/WalletService/fx"
 	"github.com/WalletService/generated"
 	"github.com/WalletService/handler"
+	"github.com/WalletService/logging"
+	"github.com/WalletService/metrics"
+	"github.com/WalletService/payment"
+	"github.com/WalletService/phone"
 	"github.com/WalletService/repository"
+	"github.com/WalletService/sms"
+	"github.com/WalletService/tracing"
 	"github.com/WalletService/usecase"
 	"github.com/WalletService/utils"
 	"github.com/dgrijalva/jwt-go"
 	"github.com/labstack/echo/v4"
 )
 
-const (
-	jwtExpiryDuration = time.Minute * 5 // Token expires in 5 minutes by default
-)
+func main() {
+	// i.e. main migrate up, see runMigrate
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	// i.e. main audit verify, see runAudit
+	if len(os.Args) > 1 && os.Args[1] == "audit" {
+		if err := runAudit(os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	// i.e. main phone migrate, see runPhone
+	if len(os.Args) > 1 && os.Args[1] == "phone" {
+		if err := runPhone(os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	cfg := loadConfig(os.Args[1:])
+
+	// Logger of everything outside of a request, requests log with their request ID, see RequestLogMiddleware
+	logger := newLogger(cfg.Log)
+	slog.SetDefault(logger)
 
-func init() {
-	// Ensure time is set to Asia/Jakarta time.
-	location, err := time.LoadLocation("Asia/Jakarta")
+	tracer := newTracer(cfg.Tracing)
+	tracing.SetDefault(tracer)
+	logger.Info("effective config", slog.String("config", cfg.String()))
+
+	keys, err := loadJWTKeys(cfg.Auth)
+	if err != nil {
+		panic(err)
+	}
+
+	db := newDB(cfg.Database)
+	migrateOnStartup(cfg, db)
+
+	repo := repository.NewRepository(repository.NewRepositoryOptions{
+		DB:               db,
+		PasswordHashCost: cfg.Auth.PasswordHashCost,
+	})
+
+	// There is no real bank integration yet, so withdrawals are disbursed by the in-process fake gateway
+	disbursementGateway := disbursement.NewFakeGateway(cfg.Disbursement.FakeLatency, disbursement.FailureMode(cfg.Disbursement.FakeFailureMode))
+
+	server, uc := newServer(cfg, repo, disbursementGateway, newReadinessChecks(db, keys))
+
+	e := newEcho(cfg, logger, tracer, keys, server)
+	go func() {
+		if err := e.Start(cfg.Server.Address); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error("server failed", slog.Any("error", err))
+			os.Exit(1)
+		}
+	}()
+
+	// Wait for SIGTERM from the orchestrator, or SIGINT, i.e. Ctrl+C
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, os.Interrupt)
+	<-signals
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+	defer cancel()
+	if err := shutdown(ctx, e, uc, disbursementGateway, repo, db, tracer); err != nil {
+		logger.Error("shutdown failed", slog.Any("error", err))
+		os.Exit(1)
+	}
+}
+
+// loadConfig loads and validates Config, see package config for precedence, and sets the local time zone.
+func loadConfig(args []string) config.Config {
+	cfg, err := config.Load(args)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	// Ensure time is set to the configured time zone, Asia/Jakarta by default.
+	location, err := time.LoadLocation(cfg.Timezone)
 	if err != nil {
 		panic(err)
 	}
 	time.Local = location
+
+	return cfg
 }
 
-func main() {
+// newEcho serves server with every middleware, it is shared with the end-to-end tests so they run the same stack
+func newEcho(cfg config.Config, logger *slog.Logger, tracer *tracing.Tracer, keys jwtKeys, server generated.ServerInterface) *echo.Echo {
+	operationIDs, err := handler.OperationIDs()
+	if err != nil {
+		panic(err)
+	}
+
+	// Responses are only validated in development, a mismatch is logged and the response is sent as is
+	validationMiddleware, err := handler.OpenAPIValidationMiddleware(newValidationLimits(cfg.Validation), cfg.IsDevelopment())
+	if err != nil {
+		panic(err)
+	}
+
 	e := echo.New()
-	e.Pre(AuthenticationMiddleware) // Register pre-handler middleware
-	e.Use(AuthenticatedMiddleware)  // Register post-handler middleware
+	e.IPExtractor = newIPExtractor(cfg.Server)
+	e.Pre(handler.RequestLogMiddleware(logger))              // Before any other middleware so every line has the request ID
+	e.Pre(handler.TracingMiddleware(tracer, operationIDs))   // Before AuthenticationMiddleware so rejected requests are traced
+	e.Pre(AuthenticationMiddleware(keys))                    // Register pre-handler middleware
+	e.Use(AuthenticatedMiddleware(keys, cfg.Auth.JWTExpiry)) // Register post-handler middleware
+	e.Use(handler.AuditActorMiddleware())                    // After AuthenticationMiddleware so the audit log knows the authenticated User
+	e.Use(handler.MetricsMiddleware(operationIDs))           // Before TimeoutMiddleware so timed out requests are counted
+	e.Use(validationMiddleware)                              // After MetricsMiddleware so rejected requests are counted
+	e.Use(handler.TimeoutMiddleware(newRouteTimeouts(cfg.Server)))
 
-	var server generated.ServerInterface = newServer()
+	// Scraped by Prometheus, not part of api.yml as it is not served to API clients
+	e.GET("/metrics", echo.WrapHandler(metrics.Default.Handler()))
 
 	generated.RegisterHandlers(e, server)
-	e.Logger.Fatal(e.Start(":1323"))
+
+	return e
 }
 
-func newServer() *handler.Server {
-	var repo repository.RepositoryInterface = repository.NewRepository(os.Getenv("DATABASE_URL"))
-	var usecase usecase.UsecaseInterface = usecase.NewUsecase(repo)
-	return handler.NewServer(usecase)
+// newLogger returns the JSON logger writing to stdout, the level is validated by config.Validate
+func newLogger(cfg config.LogConfig) *slog.Logger {
+	level, err := logging.ParseLevel(cfg.Level)
+	if err != nil {
+		panic(err)
+	}
+	return logging.New(os.Stdout, level)
 }
 
-// AuthenticationMiddleware validates incoming JWT (RS256 algorithm) using public key.
-// See command in Dockerfile: openssl rsa -in /tmp/rsa -pubout -out /tmp/rsa.pub
-func AuthenticationMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
-	return func(ctx echo.Context) error {
-		whitelistedEndpoints := []string{
-			"GET - /v1/user",
-			`POST - /v1/user/\d+/transactions`,
-		}
+// newTracer returns the Tracer exporting spans as configured, the exporter is validated by config.Validate
+func newTracer(cfg config.TracingConfig) *tracing.Tracer {
+	switch cfg.Exporter {
+	case "stdout":
+		return tracing.NewTracer(tracing.NewStdoutExporter(os.Stdout))
+	case "otlp":
+		return tracing.NewTracer(tracing.NewOTLPExporter(cfg.OTLPEndpoint, cfg.ServiceName))
+	default:
+		return tracing.NewTracer(nil)
+	}
+}
 
-		if isEndpointWhitelisted(ctx, whitelistedEndpoints) {
-			claims, err := func() (*utils.CustomClaims, error) {
-				authHeader := ctx.Request().Header.Get("Authorization")
-				if authHeader == "" {
-					return nil, errors.New("missing authorization header")
-				}
+func newServer(cfg config.Config, repo repository.RepositoryInterface, disbursementGateway *disbursement.FakeGateway, readinessChecks []handler.ReadinessCheck) (*handler.Server, *usecase.Usecase) {
+	// There is no real payment gateway integration yet, so virtual accounts are issued by the local simulator.
+	// Pay into a virtual account with: go run ./cmd/paysim -va <virtual_account_number> -amount <amount>
+	paymentCallbackSecret := []byte(cfg.Payment.CallbackSecret)
+	paymentGateway := payment.NewSimulator(cfg.Payment.CallbackURL, paymentCallbackSecret)
 
-				if len(authHeader) < 7 || authHeader[:7] != "Bearer " {
-					return nil, errors.New("token format is invalid")
-				}
+	validationLimits := newValidationLimits(cfg.Validation)
 
-				token := authHeader[7:]
+	uc := usecase.NewUsecase(usecase.NewUsecaseOptions{
+		Repository:            repo,
+		Disbursement:          disbursementGateway,
+		Payment:               paymentGateway,
+		FXQuoter:              newFXQuoter(cfg.FX),
+		Fees:                  newFeeSchedule(cfg.Fees),
+		ValidationLimits:      &validationLimits,
+		EnableDirectTopUp:     cfg.EnableDirectTopUp,
+		FrozenCanReceive:      cfg.Accounts.FrozenCanReceive,
+		ClosureSweepUserID:    cfg.Accounts.ClosureSweepUserID,
+		SMS:                   sms.NewFakeSender(cfg.IsDevelopment()),
+		OTPExpiry:             cfg.Auth.OTPExpiry,
+		OTPMaxAttempts:        cfg.Auth.OTPMaxAttempts,
+		OTPRequestLimit:       cfg.Auth.OTPRequestLimit,
+		OTPRequestWindow:      cfg.Auth.OTPRequestWindow,
+		RecipientLookupLimit:  cfg.Transfers.RecipientLookupLimit,
+		RecipientLookupWindow: cfg.Transfers.RecipientLookupWindow,
+		RecipientTokenExpiry:  cfg.Transfers.RecipientTokenExpiry,
+	})
 
-				publicKeyData, err := os.ReadFile("../rsa.pub")
-				if err != nil {
-					return nil, err
-				}
+	// Fake gateway reports results in-process instead of calling the callback endpoint
+	disbursementGateway.Notify = uc.CompleteWithdrawal
 
-				publicKey, err := jwt.ParseRSAPublicKeyFromPEM(publicKeyData)
-				if err != nil {
-					return nil, err
-				}
+	return handler.NewServer(uc, handler.NewServerOptions{
+		DisbursementCallbackToken: cfg.Disbursement.CallbackToken,
+		PaymentCallbackSecret:     paymentCallbackSecret,
+		ValidationLimits:          &validationLimits,
+		ReadinessChecks:           readinessChecks,
+	}), uc
+}
 
-				// Parse & validate token using publicKey
-				tok, err := jwt.ParseWithClaims(token, &utils.CustomClaims{}, func(token *jwt.Token) (interface{}, error) {
-					// Use publicKey for token verification
-					return publicKey, nil
-				})
-				if err != nil {
-					return nil, err
-				}
+// newValidationLimits bounds User input as configured, both in api.yml and in the handler and usecase validation.
+// The phone countries are validated by config.Validate.
+func newValidationLimits(cfg config.ValidationConfig) utils.ValidationLimits {
+	phoneNumbers, err := phone.NewParser(cfg.PhoneDefaultCountry, cfg.PhoneCountryList())
+	if err != nil {
+		panic(err)
+	}
 
-				// Check if token is valid (has not expired)
-				claims, ok := tok.Claims.(*utils.CustomClaims)
-				if ok && tok.Valid {
-					if time.Now().Unix() >= claims.ExpiresAt {
-						return nil, errors.New("JWT has expired")
-					}
-				}
+	return utils.ValidationLimits{
+		PhoneNumberMinLength: cfg.PhoneNumberMinLength,
+		PhoneNumberMaxLength: cfg.PhoneNumberMaxLength,
+		PasswordMinLength:    cfg.PasswordMinLength,
+		PasswordMaxLength:    cfg.PasswordMaxLength,
+		PhoneNumbers:         phoneNumbers,
+	}
+}
 
-				return claims, nil
-			}()
-			if err != nil {
-				return ctx.JSON(http.StatusUnauthorized, utils.JWTResponse{
-					Header: generated.ResponseHeader{
-						Messages: []string{err.Error()},
-						Success:  false,
-					},
-				})
-			}
+// newFXQuoter quotes currency conversion using rates from fx.rates_file and pricing from fx.pricing_file.
+// Conversion is not available if no rates file is configured.
+func newFXQuoter(cfg config.FXConfig) *fx.Quoter {
+	if cfg.RatesFile == "" {
+		return nil
+	}
 
-			// Set custom claims to context so handler can use the values, i.e. authorization
-			ctx.Set(string(utils.JWTClaimUserID), claims.UserID)
-			ctx.Set(string(utils.JWTClaimPermissions), claims.Permissions)
+	provider, err := fx.NewStaticFileProvider(cfg.RatesFile)
+	if err != nil {
+		panic(err)
+	}
+
+	pricing := fx.DefaultPricing
+	if cfg.PricingFile != "" {
+		if pricing, err = fx.LoadPricing(cfg.PricingFile); err != nil {
+			panic(err)
 		}
+	}
 
-		return next(ctx)
+	return fx.NewQuoter(provider, pricing)
+}
+
+// newRouteTimeouts bounds every request by server.request_timeout,
+// which can be overridden per route by server.route_timeouts, i.e. "POST /v1/user/:user_id/transactions=15s,GET /v1/user=2s".
+func newRouteTimeouts(cfg config.ServerConfig) handler.RouteTimeouts {
+	timeouts, err := handler.ParseRouteTimeouts(cfg.RequestTimeout, cfg.RouteTimeouts)
+	if err != nil {
+		panic(err)
 	}
+
+	return timeouts
 }
 
-// AuthenticatedMiddleware generates JWT (RS256 algorithm) using private key
-// The JWT will contain userID and its claims
-// The JWT will be included in the `Authentication` response header only if handler is returning status OK
-// See command in Dockerfile: openssl genrsa -out /tmp/rsa 4096
-func AuthenticatedMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
-	return func(ctx echo.Context) error {
-		whitelistedEndpoints := []string{
-			"POST - /v1/user/login",
+// newIPExtractor takes the IP of clients from X-Forwarded-For only behind server.trusted_proxies
+func newIPExtractor(cfg config.ServerConfig) echo.IPExtractor {
+	extractor, err := handler.NewIPExtractor(cfg.TrustedProxies)
+	if err != nil {
+		panic(err)
+	}
+
+	return extractor
+}
+
+// newFeeSchedule charges fees defined in fees.file, or fees.DefaultSchedule if not configured.
+func newFeeSchedule(cfg config.FeesConfig) *fees.Schedule {
+	schedule := fees.DefaultSchedule
+
+	if cfg.File != "" {
+		var err error
+		if schedule, err = fees.LoadSchedule(cfg.File); err != nil {
+			panic(err)
 		}
+	}
 
-		if isEndpointWhitelisted(ctx, whitelistedEndpoints) {
-			// use `Before` so this middleware can write token to response header right before handler writes to response body
-			ctx.Response().Before(func() {
-				// Only add JWT in `Authorization` response header if handler returns OK
-				if ctx.Response().Status == http.StatusOK {
-					privateKeyData, err := os.ReadFile("../rsa")
-					if err != nil {
-						return
-					}
+	return &schedule
+}
 
-					privateKey, err := jwt.ParseRSAPrivateKeyFromPEM(privateKeyData)
-					if err != nil {
-						return
-					}
+// jwtKeys is the RSA key pair signing and verifying JWT, loaded once at startup
+type jwtKeys struct {
+	privateKey *rsa.PrivateKey
+	publicKey  *rsa.PublicKey
+}
 
-					// Authorize JWT
-					permissions, ok := ctx.Get(string(utils.JWTClaimPermissions)).([]utils.JWTPermission)
-					if !ok {
-						return
-					}
+// loadJWTKeys reads the key pair generated by `make cert`, or by the Dockerfile
+func loadJWTKeys(cfg config.AuthConfig) (jwtKeys, error) {
+	privateKeyData, err := os.ReadFile(cfg.PrivateKeyFile)
+	if err != nil {
+		return jwtKeys{}, fmt.Errorf("failed to read JWT private key: %w", err)
+	}
+
+	privateKey, err := jwt.ParseRSAPrivateKeyFromPEM(privateKeyData)
+	if err != nil {
+		return jwtKeys{}, fmt.Errorf("failed to parse JWT private key: %w", err)
+	}
 
-					userID, ok := ctx.Get(string(utils.JWTClaimUserID)).(int64)
-					if !ok {
-						return
+	publicKeyData, err := os.ReadFile(cfg.PublicKeyFile)
+	if err != nil {
+		return jwtKeys{}, fmt.Errorf("failed to read JWT public key: %w", err)
+	}
+
+	publicKey, err := jwt.ParseRSAPublicKeyFromPEM(publicKeyData)
+	if err != nil {
+		return jwtKeys{}, fmt.Errorf("failed to parse JWT public key: %w", err)
+	}
+
+	return jwtKeys{privateKey: privateKey, publicKey: publicKey}, nil
+}
+
+// AuthenticationMiddleware validates incoming JWT (RS256 algorithm) using public key.
+// See command in Dockerfile: openssl rsa -in /tmp/rsa -pubout -out /tmp/rsa.pub
+func AuthenticationMiddleware(keys jwtKeys) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			whitelistedEndpoints := []string{
+				"GET - /v1/user",
+				"PATCH - /v1/user",
+				"POST - /v1/user/phone-number/verify",
+				`POST - /v1/user/\d+/transactions`,
+				`POST - /v1/user/\d+/transactions/fee`,
+				`POST - /v1/user/\d+/qr`,
+				`POST - /v1/user/\d+/qr/payments`,
+				`GET - /v1/user/\d+/bank-accounts`,
+				`POST - /v1/user/\d+/bank-accounts`,
+				`POST - /v1/user/\d+/topups`,
+				`GET - /v1/user/\d+/topups/[^/]+`,
+				`POST - /v1/user/\d+/fx-quotes`,
+				`POST - /v1/user/\d+/recipient-lookups`,
+				`GET - /v1/user/\d+/contacts`,
+				`POST - /v1/user/\d+/contacts`,
+				`PATCH - /v1/user/\d+/contacts/\d+`,
+				`DELETE - /v1/user/\d+/contacts/\d+`,
+				`POST - /v1/user/\d+/pockets`,
+				`PATCH - /v1/user/\d+/pockets/\d+`,
+				`DELETE - /v1/user/\d+/pockets/\d+`,
+				`GET - /v1/user/\d+/shared-wallets`,
+				`POST - /v1/user/\d+/shared-wallets`,
+				`GET - /v1/user/\d+/shared-wallets/\d+/members`,
+				`POST - /v1/user/\d+/shared-wallets/\d+/members`,
+				`PATCH - /v1/user/\d+/shared-wallets/\d+/members/\d+`,
+				`DELETE - /v1/user/\d+/shared-wallets/\d+/members/\d+`,
+				`GET - /v1/user/\d+/shared-wallets/\d+/transactions`,
+				"GET - /admin/v1/users",
+				`GET - /admin/v1/users/\d+/transactions`,
+				`POST - /admin/v1/users/\d+/balance-adjustments`,
+				`POST - /admin/v1/users/\d+/status`,
+				"GET - /admin/v1/audit-log",
+			}
+
+			if isEndpointWhitelisted(ctx, whitelistedEndpoints) {
+				// reason labels the rejection in metrics.AuthRejections
+				reason := ""
+				claims, err := func() (*utils.CustomClaims, error) {
+					authHeader := ctx.Request().Header.Get("Authorization")
+					if authHeader == "" {
+						reason = "missing_header"
+						return nil, errors.New("missing authorization header")
 					}
 
-					claims := utils.CustomClaims{
-						UserID:      userID,
-						Permissions: permissions,
-						ExpiresAt:   time.Now().Add(jwtExpiryDuration).Unix(),
+					if len(authHeader) < 7 || authHeader[:7] != "Bearer " {
+						reason = "invalid_format"
+						return nil, errors.New("token format is invalid")
 					}
 
-					token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
-					jwtToken, err := token.SignedString(privateKey) // sign the token with the RSA private key
+					token := authHeader[7:]
+
+					// Parse & validate token using publicKey
+					tok, err := jwt.ParseWithClaims(token, &utils.CustomClaims{}, func(token *jwt.Token) (interface{}, error) {
+						// Use publicKey for token verification
+						return keys.publicKey, nil
+					})
 					if err != nil {
-						return
+						reason = "invalid_token"
+						return nil, err
 					}
 
-					bearerToken := fmt.Sprintf("Bearer %s", jwtToken)
+					// Check if token is valid (has not expired)
+					claims, ok := tok.Claims.(*utils.CustomClaims)
+					if ok && tok.Valid {
+						if time.Now().Unix() >= claims.ExpiresAt {
+							reason = "expired"
+							return nil, errors.New("JWT has expired")
+						}
+					}
 
-					ctx.Response().Header().Set(echo.HeaderAuthorization, bearerToken)
+					return claims, nil
+				}()
+				if err != nil {
+					metrics.AuthRejections.Inc(reason)
+					requestCtx := ctx.Request().Context()
+					logging.FromContext(requestCtx).LogAttrs(requestCtx, slog.LevelInfo, "authentication rejected",
+						slog.String("reason", reason),
+						slog.Any("error", err),
+					)
+					return ctx.JSON(http.StatusUnauthorized, utils.JWTResponse{
+						Header: generated.ResponseHeader{
+							Messages: []string{err.Error()},
+							Success:  false,
+						},
+					})
 				}
-			})
+
+				// Set custom claims to context so handler can use the values, i.e. authorization
+				ctx.Set(string(utils.JWTClaimUserID), claims.UserID)
+				ctx.Set(string(utils.JWTClaimPermissions), claims.Permissions)
+			}
+
+			return next(ctx)
 		}
+	}
+}
+
+// AuthenticatedMiddleware generates JWT (RS256 algorithm) using private key
+// The JWT will contain userID and its claims
+// The JWT will be included in the `Authentication` response header only if handler is returning status OK
+// See command in Dockerfile: openssl genrsa -out /tmp/rsa 4096
+func AuthenticatedMiddleware(keys jwtKeys, jwtExpiry time.Duration) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			whitelistedEndpoints := []string{
+				"POST - /v1/user/login",
+			}
+
+			if isEndpointWhitelisted(ctx, whitelistedEndpoints) {
+				// use `Before` so this middleware can write token to response header right before handler writes to response body
+				ctx.Response().Before(func() {
+					// Only add JWT in `Authorization` response header if handler returns OK
+					if ctx.Response().Status == http.StatusOK {
+						// Authorize JWT
+						permissions, ok := ctx.Get(string(utils.JWTClaimPermissions)).([]utils.JWTPermission)
+						if !ok {
+							return
+						}
+
+						userID, ok := ctx.Get(string(utils.JWTClaimUserID)).(int64)
+						if !ok {
+							return
+						}
 
-		return next(ctx)
+						claims := utils.CustomClaims{
+							UserID:      userID,
+							Permissions: permissions,
+							ExpiresAt:   time.Now().Add(jwtExpiry).Unix(),
+						}
+
+						token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+						jwtToken, err := token.SignedString(keys.privateKey) // sign the token with the RSA private key
+						if err != nil {
+							return
+						}
+
+						bearerToken := fmt.Sprintf("Bearer %s", jwtToken)
+
+						ctx.Response().Header().Set(echo.HeaderAuthorization, bearerToken)
+					}
+				})
+			}
+
+			return next(ctx)
+		}
 	}
 }
 
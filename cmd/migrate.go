@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/WalletService/config"
+	"github.com/WalletService/migrations"
+	_ "github.com/lib/pq"
+)
+
+const migrateUsage = `usage: main migrate <command> [flags]
+
+commands:
+  up       apply all pending migrations
+  down     revert the latest applied migrations, see -steps
+  status   list migrations and whether they are applied
+  seed     insert seed data, only allowed when env is development (APP_ENV)
+
+flags:
+`
+
+// runMigrate runs the migrate subcommand, i.e. main migrate up -dry-run
+func runMigrate(args []string) error {
+	flags := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	dryRun := flags.Bool("dry-run", false, "print the SQL that would run without changing the DB")
+	steps := flags.Int("steps", 1, "number of migrations to revert with down")
+	configFile := flags.String("config", "", "path to the YAML config file, overrides CONFIG_FILE")
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), migrateUsage)
+		flags.PrintDefaults()
+	}
+
+	if len(args) == 0 {
+		flags.Usage()
+		return errors.New("missing migrate command")
+	}
+	command := args[0]
+	if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	var configArgs []string
+	if *configFile != "" {
+		configArgs = []string{"-config", *configFile}
+	}
+	cfg := loadConfig(configArgs)
+
+	migrator, err := migrations.NewMigrator(newDB(cfg.Database), os.Stdout)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	switch command {
+	case "up":
+		return migrator.Up(ctx, *dryRun)
+	case "down":
+		return migrator.Down(ctx, *steps, *dryRun)
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, status := range statuses {
+			if status.Applied {
+				fmt.Printf("%s  applied at %s\n", status.Migration, status.AppliedTime.Format("2006-01-02 15:04:05"))
+			} else {
+				fmt.Printf("%s  pending\n", status.Migration)
+			}
+		}
+		return nil
+	case "seed":
+		// Prevents demo Users with a well-known password from being inserted outside development
+		if !cfg.IsDevelopment() {
+			return fmt.Errorf("seeding is only allowed when env is development, env is %q", cfg.Env)
+		}
+		return migrator.Seed(ctx, *dryRun)
+	default:
+		flags.Usage()
+		return fmt.Errorf("unknown migrate command %s", command)
+	}
+}
+
+// migrateOnStartup applies pending migrations before serving if database.migrate_on_startup is set,
+// and seeds demo data in development
+func migrateOnStartup(cfg config.Config, db *sql.DB) {
+	if !cfg.Database.MigrateOnStartup {
+		return
+	}
+
+	migrator, err := migrations.NewMigrator(db, os.Stdout)
+	if err != nil {
+		panic(err)
+	}
+
+	ctx := context.Background()
+	if err := migrator.Up(ctx, false); err != nil {
+		panic(err)
+	}
+
+	if cfg.IsDevelopment() {
+		if err := migrator.Seed(ctx, false); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// newDB opens the DB at database.url, shared by the Repository and the Migrator
+func newDB(cfg config.DatabaseConfig) *sql.DB {
+	db, err := sql.Open("postgres", cfg.URL)
+	if err != nil {
+		panic(err)
+	}
+
+	return db
+}
@@ -0,0 +1,55 @@
+// Command paysim simulates a payer transferring into a top-up virtual account.
+// It sends the same signed callback the payment gateway sends, so the whole top-up flow can be exercised locally.
+//
+//	go run ./cmd/paysim -va 8808123456789012 -amount 100000
+//
+// Re-send a callback with the same -reference to simulate the gateway delivering a payment twice.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/WalletService/payment"
+)
+
+func main() {
+	var (
+		callbackURL          = flag.String("url", envOrDefault("PAYMENT_CALLBACK_URL", "http://localhost:1323/v1/topups/callback"), "top-up callback endpoint")
+		secret               = flag.String("secret", os.Getenv("PAYMENT_CALLBACK_SECRET"), "shared secret used to sign the callback")
+		virtualAccountNumber = flag.String("va", "", "virtual account number to pay into")
+		amount               = flag.Float64("amount", 0, "amount paid")
+		reference            = flag.String("reference", "", "payment reference, generated when empty")
+	)
+	flag.Parse()
+
+	if *virtualAccountNumber == "" || *amount <= 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	simulator := payment.NewSimulator(*callbackURL, []byte(*secret))
+	if err := simulator.Pay(ctx, payment.Callback{
+		VirtualAccountNumber: *virtualAccountNumber,
+		Amount:               float32(*amount),
+		PaymentReference:     *reference,
+	}); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	fmt.Println("payment accepted")
+}
+
+func envOrDefault(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/WalletService/repository"
+	"github.com/WalletService/usecase"
+)
+
+const phoneUsage = `usage: main phone <command> [flags]
+
+commands:
+  migrate   normalize the phone numbers of existing users into E.164, then enforce E.164 in the DB
+
+flags:
+`
+
+// runPhone runs the phone subcommand, i.e. main phone migrate -dry-run
+func runPhone(args []string) error {
+	flags := flag.NewFlagSet("phone", flag.ContinueOnError)
+	batchSize := flags.Int("batch-size", 1000, "number of users read at once")
+	dryRun := flags.Bool("dry-run", false, "only report the phone numbers that would be normalized")
+	configFile := flags.String("config", "", "path to the YAML config file, overrides CONFIG_FILE")
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), phoneUsage)
+		flags.PrintDefaults()
+	}
+
+	if len(args) == 0 {
+		flags.Usage()
+		return errors.New("missing phone command")
+	}
+	command := args[0]
+	if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	var configArgs []string
+	if *configFile != "" {
+		configArgs = []string{"-config", *configFile}
+	}
+	cfg := loadConfig(configArgs)
+
+	switch command {
+	case "migrate":
+		// Normalized with the countries the app accepts, see validation.phone_countries
+		validationLimits := newValidationLimits(cfg.Validation)
+		uc := usecase.NewUsecase(usecase.NewUsecaseOptions{
+			Repository:       repository.NewRepositoryWithDB(newDB(cfg.Database)),
+			ValidationLimits: &validationLimits,
+		})
+
+		migration, err := uc.MigratePhoneNumbers(context.Background(), *batchSize, *dryRun)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range migration.Normalized {
+			fmt.Printf("user %d: %s -> %s\n", entry.UserID, entry.From, entry.To)
+		}
+		for _, entry := range migration.Invalid {
+			fmt.Printf("user %d: %s is invalid: %s\n", entry.UserID, entry.From, entry.Reason)
+		}
+		for _, entry := range migration.Conflicts {
+			fmt.Printf("user %d: %s -> %s conflicts: %s\n", entry.UserID, entry.From, entry.To, entry.Reason)
+		}
+		fmt.Printf("%d users read, %d normalized, %d invalid, %d conflicts\n", migration.Users, len(migration.Normalized), len(migration.Invalid), len(migration.Conflicts))
+
+		switch {
+		case *dryRun:
+			return nil
+		case !migration.ConstraintValidated:
+			return errors.New("fix the invalid and conflicting phone numbers, then run main phone migrate again")
+		}
+		fmt.Println("every phone number is in E.164, which the DB now enforces")
+		return nil
+	default:
+		flags.Usage()
+		return fmt.Errorf("unknown phone command %s", command)
+	}
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/WalletService/disbursement"
+	"github.com/WalletService/handler"
+	"github.com/WalletService/migrations"
+	"github.com/WalletService/repository"
+	"github.com/WalletService/tracing"
+	"github.com/WalletService/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+// newReadinessChecks reports the instance is ready once the DB is reachable, JWT keys are loaded and the schema is up to date
+func newReadinessChecks(db *sql.DB, keys jwtKeys) []handler.ReadinessCheck {
+	migrator, err := migrations.NewMigrator(db, io.Discard)
+	if err != nil {
+		panic(err)
+	}
+
+	return []handler.ReadinessCheck{
+		{
+			Name:  "database",
+			Check: db.PingContext,
+		},
+		{
+			Name: "signing_keys",
+			Check: func(context.Context) error {
+				if keys.privateKey == nil || keys.publicKey == nil {
+					return errors.New("JWT signing keys are not loaded")
+				}
+				return nil
+			},
+		},
+		{
+			Name:  "migrations",
+			Check: migrator.CheckUpToDate,
+		},
+	}
+}
+
+// shutdown drains the instance before it exits, all steps must complete before ctx is done:
+// 1. Stop accepting requests and wait for in-flight requests
+// 2. Wait for disbursement requests still being sent to the gateway by accepted Withdrawals
+// 3. Stop background workers, the fake disbursement gateway reports pending results
+// 4. Wait for DB transactions still in flight, i.e. completing a Withdrawal reported by the gateway
+// 5. Close the DB pool
+// 6. Export spans still buffered, so the traces of the drained requests are complete
+func shutdown(ctx context.Context, e *echo.Echo, uc *usecase.Usecase, disbursementGateway *disbursement.FakeGateway, repo *repository.Repository, db *sql.DB, tracer *tracing.Tracer) error {
+	if err := e.Shutdown(ctx); err != nil {
+		return fmt.Errorf("failed to drain HTTP requests: %w", err)
+	}
+
+	if err := uc.WaitForDisbursements(ctx); err != nil {
+		return fmt.Errorf("failed to drain disbursement requests: %w", err)
+	}
+
+	if err := disbursementGateway.Shutdown(ctx); err != nil {
+		return fmt.Errorf("failed to stop disbursement gateway: %w", err)
+	}
+
+	if err := repo.WaitForTransactions(ctx); err != nil {
+		return fmt.Errorf("failed to drain DB transactions: %w", err)
+	}
+
+	if err := db.Close(); err != nil {
+		return fmt.Errorf("failed to close DB: %w", err)
+	}
+
+	if err := tracer.Shutdown(ctx); err != nil {
+		return fmt.Errorf("failed to export spans: %w", err)
+	}
+
+	return nil
+}
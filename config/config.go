@@ -0,0 +1,298 @@
+// Package config loads the typed application Config.
+// Every value has a default, which is overridden by the YAML config file, then by env vars, then by flags.
+// Each field declares its YAML key with the `yaml` tag and its env var with the `env` tag,
+// its flag is the dotted path of YAML keys, i.e. -server.address. Fields tagged `secret:"true"` are redacted when printed.
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/WalletService/logging"
+	"github.com/WalletService/phone"
+	"github.com/WalletService/tracing"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type Config struct {
+	// Env is the deployment environment, demo seed data and sandbox features are only allowed in "development"
+	Env      string `yaml:"env" env:"APP_ENV"`
+	Timezone string `yaml:"timezone" env:"TZ"`
+
+	Server       ServerConfig       `yaml:"server"`
+	Log          LogConfig          `yaml:"log"`
+	Tracing      TracingConfig      `yaml:"tracing"`
+	Database     DatabaseConfig     `yaml:"database"`
+	Auth         AuthConfig         `yaml:"auth"`
+	Validation   ValidationConfig   `yaml:"validation"`
+	Disbursement DisbursementConfig `yaml:"disbursement"`
+	Payment      PaymentConfig      `yaml:"payment"`
+	FX           FXConfig           `yaml:"fx"`
+	Fees         FeesConfig         `yaml:"fees"`
+	Accounts     AccountsConfig     `yaml:"accounts"`
+	Transfers    TransfersConfig    `yaml:"transfers"`
+
+	// EnableDirectTopUp allows TopUp Transaction to credit any amount without payment, only for sandbox/dev
+	EnableDirectTopUp bool `yaml:"enable_direct_topup" env:"ENABLE_DIRECT_TOPUP"`
+}
+
+type ServerConfig struct {
+	Address        string        `yaml:"address" env:"SERVER_ADDRESS"`
+	RequestTimeout time.Duration `yaml:"request_timeout" env:"REQUEST_TIMEOUT"`
+	// RouteTimeouts overrides RequestTimeout per route, i.e. "POST /v1/user/:user_id/transactions=15s,GET /v1/user=2s"
+	RouteTimeouts string `yaml:"route_timeouts" env:"ROUTE_TIMEOUTS"`
+	// ShutdownTimeout bounds draining in-flight requests and DB transactions on SIGTERM
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT"`
+	// TrustedProxies are the comma separated CIDRs of the proxies whose X-Forwarded-For is trusted, i.e. "10.0.0.0/8".
+	// The IP of the connection is recorded in the audit log if empty.
+	TrustedProxies string `yaml:"trusted_proxies" env:"TRUSTED_PROXIES"`
+}
+
+type LogConfig struct {
+	// Level is the minimum level logged, debug, info, warn or error. debug logs every DB query.
+	Level string `yaml:"level" env:"LOG_LEVEL"`
+}
+
+type TracingConfig struct {
+	// Exporter is where spans are sent, none, stdout or otlp
+	Exporter string `yaml:"exporter" env:"TRACING_EXPORTER"`
+	// OTLPEndpoint is the OTLP over HTTP traces endpoint of the collector, used by the otlp exporter
+	OTLPEndpoint string `yaml:"otlp_endpoint" env:"OTLP_ENDPOINT"`
+	ServiceName  string `yaml:"service_name" env:"SERVICE_NAME"`
+}
+
+type DatabaseConfig struct {
+	URL              string `yaml:"url" env:"DATABASE_URL" secret:"true"`
+	MigrateOnStartup bool   `yaml:"migrate_on_startup" env:"MIGRATE_ON_STARTUP"`
+}
+
+type AuthConfig struct {
+	// RSA key pair signing JWT, see `make cert`
+	PrivateKeyFile string        `yaml:"private_key_file" env:"JWT_PRIVATE_KEY_FILE"`
+	PublicKeyFile  string        `yaml:"public_key_file" env:"JWT_PUBLIC_KEY_FILE"`
+	JWTExpiry      time.Duration `yaml:"jwt_expiry" env:"JWT_EXPIRY"`
+	// PasswordHashCost is the bcrypt cost of hashing User's password
+	PasswordHashCost int `yaml:"password_hash_cost" env:"PASSWORD_HASH_COST"`
+	// OTPExpiry bounds how long an OTP, i.e. confirming a new phone number, can be used
+	OTPExpiry time.Duration `yaml:"otp_expiry" env:"OTP_EXPIRY"`
+	// OTPMaxAttempts is the number of wrong OTPs accepted before a new one must be requested
+	OTPMaxAttempts int `yaml:"otp_max_attempts" env:"OTP_MAX_ATTEMPTS"`
+	// OTPRequestLimit is the number of OTPs a User can request within OTPRequestWindow, so that SMS are not sent indefinitely
+	OTPRequestLimit  int           `yaml:"otp_request_limit" env:"OTP_REQUEST_LIMIT"`
+	OTPRequestWindow time.Duration `yaml:"otp_request_window" env:"OTP_REQUEST_WINDOW"`
+}
+
+type ValidationConfig struct {
+	PhoneNumberMinLength int `yaml:"phone_number_min_length" env:"PHONE_NUMBER_MIN_LENGTH"`
+	PhoneNumberMaxLength int `yaml:"phone_number_max_length" env:"PHONE_NUMBER_MAX_LENGTH"`
+	PasswordMinLength    int `yaml:"password_min_length" env:"PASSWORD_MIN_LENGTH"`
+	PasswordMaxLength    int `yaml:"password_max_length" env:"PASSWORD_MAX_LENGTH"`
+	// PhoneDefaultCountry is the country of phone numbers typed without calling code, i.e. "0812..."
+	PhoneDefaultCountry string `yaml:"phone_default_country" env:"PHONE_DEFAULT_COUNTRY"`
+	// PhoneCountries lists the other countries Users can register phone numbers of, i.e. "SG,MY", see phone.Countries
+	PhoneCountries string `yaml:"phone_countries" env:"PHONE_COUNTRIES"`
+}
+
+// PhoneCountryList splits PhoneCountries
+func (c ValidationConfig) PhoneCountryList() []string {
+	var countries []string
+	for _, country := range strings.Split(c.PhoneCountries, ",") {
+		if country = strings.TrimSpace(country); country != "" {
+			countries = append(countries, country)
+		}
+	}
+	return countries
+}
+
+type DisbursementConfig struct {
+	// CallbackToken is the shared secret the disbursement gateway sends in X-Callback-Token
+	CallbackToken   string        `yaml:"callback_token" env:"DISBURSEMENT_CALLBACK_TOKEN" secret:"true"`
+	FakeLatency     time.Duration `yaml:"fake_latency" env:"FAKE_DISBURSEMENT_LATENCY"`
+	FakeFailureMode string        `yaml:"fake_failure_mode" env:"FAKE_DISBURSEMENT_FAILURE_MODE"`
+}
+
+type PaymentConfig struct {
+	CallbackURL string `yaml:"callback_url" env:"PAYMENT_CALLBACK_URL"`
+	// CallbackSecret is the shared secret the payment gateway signs top-up callbacks with
+	CallbackSecret string `yaml:"callback_secret" env:"PAYMENT_CALLBACK_SECRET" secret:"true"`
+}
+
+type FXConfig struct {
+	// Conversion is not available if RatesFile is not set
+	RatesFile   string `yaml:"rates_file" env:"FX_RATES_FILE"`
+	PricingFile string `yaml:"pricing_file" env:"FX_PRICING_FILE"`
+}
+
+type FeesConfig struct {
+	// fees.DefaultSchedule is charged if File is not set
+	File string `yaml:"file" env:"FEES_FILE"`
+}
+
+type AccountsConfig struct {
+	// FrozenCanReceive lets Frozen Users receive transfers, they can never send
+	FrozenCanReceive bool `yaml:"frozen_can_receive" env:"FROZEN_ACCOUNT_CAN_RECEIVE"`
+	// ClosureSweepUserID is the User receiving the remaining balance of closed accounts.
+	// If zero, only accounts without balance can be closed.
+	ClosureSweepUserID int64 `yaml:"closure_sweep_user_id" env:"CLOSURE_SWEEP_USER_ID"`
+}
+
+type TransfersConfig struct {
+	// RecipientLookupLimit is the number of recipients a User can look up by phone number within RecipientLookupWindow,
+	// unknown phone numbers and transfers to a phone number included, so that accounts can not be enumerated
+	RecipientLookupLimit  int           `yaml:"recipient_lookup_limit" env:"RECIPIENT_LOOKUP_LIMIT"`
+	RecipientLookupWindow time.Duration `yaml:"recipient_lookup_window" env:"RECIPIENT_LOOKUP_WINDOW"`
+	// RecipientTokenExpiry bounds how long a User can transfer to a looked up recipient with its token
+	RecipientTokenExpiry time.Duration `yaml:"recipient_token_expiry" env:"RECIPIENT_TOKEN_EXPIRY"`
+}
+
+// Default returns the Config used for values not set in the YAML config file, env vars nor flags
+func Default() Config {
+	return Config{
+		Env:      "production",
+		Timezone: "Asia/Jakarta",
+		Server: ServerConfig{
+			Address:         ":1323",
+			RequestTimeout:  10 * time.Second,
+			ShutdownTimeout: 30 * time.Second,
+		},
+		Log: LogConfig{
+			Level: "info",
+		},
+		Tracing: TracingConfig{
+			Exporter:     "none",
+			OTLPEndpoint: tracing.DefaultOTLPEndpoint,
+			ServiceName:  "wallet-service",
+		},
+		Auth: AuthConfig{
+			PrivateKeyFile:   "../rsa",
+			PublicKeyFile:    "../rsa.pub",
+			JWTExpiry:        5 * time.Minute,
+			PasswordHashCost: 12,
+			OTPExpiry:        5 * time.Minute,
+			OTPMaxAttempts:   5,
+			OTPRequestLimit:  5,
+			OTPRequestWindow: time.Hour,
+		},
+		Validation: ValidationConfig{
+			PhoneNumberMinLength: 10,
+			PhoneNumberMaxLength: 16,
+			PasswordMinLength:    6,
+			PasswordMaxLength:    64,
+			PhoneDefaultCountry:  "ID",
+		},
+		Transfers: TransfersConfig{
+			RecipientLookupLimit:  10,
+			RecipientLookupWindow: time.Hour,
+			RecipientTokenExpiry:  5 * time.Minute,
+		},
+	}
+}
+
+// Validate returns an error listing every invalid value, so all of them can be fixed at once
+func (c Config) Validate() error {
+	var errorList []string
+
+	if c.Env == "" {
+		errorList = append(errorList, "env is required")
+	}
+	if c.EnableDirectTopUp && !c.IsDevelopment() {
+		errorList = append(errorList, fmt.Sprintf("enable_direct_topup is only allowed in development, env is %q", c.Env))
+	}
+	if _, err := time.LoadLocation(c.Timezone); c.Timezone == "" || err != nil {
+		errorList = append(errorList, fmt.Sprintf("timezone %q is not a valid IANA time zone", c.Timezone))
+	}
+
+	if c.Server.Address == "" {
+		errorList = append(errorList, "server.address is required")
+	}
+	if c.Server.RequestTimeout <= 0 {
+		errorList = append(errorList, "server.request_timeout should be > 0")
+	}
+	if c.Server.ShutdownTimeout <= 0 {
+		errorList = append(errorList, "server.shutdown_timeout should be > 0")
+	}
+
+	if _, err := logging.ParseLevel(c.Log.Level); err != nil {
+		errorList = append(errorList, fmt.Sprintf("log.level %q should be debug, info, warn or error", c.Log.Level))
+	}
+
+	switch c.Tracing.Exporter {
+	case "none", "stdout":
+	case "otlp":
+		if c.Tracing.OTLPEndpoint == "" {
+			errorList = append(errorList, "tracing.otlp_endpoint is required by the otlp exporter")
+		}
+	default:
+		errorList = append(errorList, fmt.Sprintf("tracing.exporter %q should be none, stdout or otlp", c.Tracing.Exporter))
+	}
+
+	if c.Database.URL == "" {
+		errorList = append(errorList, "database.url is required (DATABASE_URL)")
+	}
+
+	if c.Auth.PrivateKeyFile == "" || c.Auth.PublicKeyFile == "" {
+		errorList = append(errorList, "auth.private_key_file and auth.public_key_file are required")
+	}
+	if c.Auth.JWTExpiry <= 0 {
+		errorList = append(errorList, "auth.jwt_expiry should be > 0")
+	}
+	if c.Auth.PasswordHashCost < bcrypt.MinCost || c.Auth.PasswordHashCost > bcrypt.MaxCost {
+		errorList = append(errorList, fmt.Sprintf("auth.password_hash_cost should be %d to %d", bcrypt.MinCost, bcrypt.MaxCost))
+	}
+	if c.Auth.OTPExpiry <= 0 {
+		errorList = append(errorList, "auth.otp_expiry should be > 0")
+	}
+	if c.Auth.OTPMaxAttempts <= 0 {
+		errorList = append(errorList, "auth.otp_max_attempts should be > 0")
+	}
+	if c.Auth.OTPRequestLimit <= 0 {
+		errorList = append(errorList, "auth.otp_request_limit should be > 0")
+	}
+	if c.Auth.OTPRequestWindow <= 0 {
+		errorList = append(errorList, "auth.otp_request_window should be > 0")
+	}
+
+	if c.Validation.PhoneNumberMinLength <= 0 || c.Validation.PhoneNumberMinLength > c.Validation.PhoneNumberMaxLength {
+		errorList = append(errorList, "validation.phone_number_min_length should be > 0 and <= validation.phone_number_max_length")
+	}
+	if c.Validation.PasswordMinLength <= 0 || c.Validation.PasswordMinLength > c.Validation.PasswordMaxLength {
+		errorList = append(errorList, "validation.password_min_length should be > 0 and <= validation.password_max_length")
+	}
+	if _, err := phone.NewParser(c.Validation.PhoneDefaultCountry, c.Validation.PhoneCountryList()); err != nil {
+		errorList = append(errorList, fmt.Sprintf("validation.phone_default_country and validation.phone_countries: %v", err))
+	}
+
+	if c.Disbursement.FakeLatency < 0 {
+		errorList = append(errorList, "disbursement.fake_latency should be >= 0")
+	}
+
+	if c.Accounts.ClosureSweepUserID < 0 {
+		errorList = append(errorList, "accounts.closure_sweep_user_id should be >= 0")
+	}
+
+	if c.Transfers.RecipientLookupLimit <= 0 {
+		errorList = append(errorList, "transfers.recipient_lookup_limit should be > 0")
+	}
+	if c.Transfers.RecipientLookupWindow <= 0 {
+		errorList = append(errorList, "transfers.recipient_lookup_window should be > 0")
+	}
+	if c.Transfers.RecipientTokenExpiry <= 0 {
+		errorList = append(errorList, "transfers.recipient_token_expiry should be > 0")
+	}
+
+	if c.FX.PricingFile != "" && c.FX.RatesFile == "" {
+		errorList = append(errorList, "fx.pricing_file is set but fx.rates_file is not, conversion would not be available")
+	}
+
+	if len(errorList) > 0 {
+		return fmt.Errorf("invalid config:\n  %s", strings.Join(errorList, "\n  "))
+	}
+
+	return nil
+}
+
+// IsDevelopment reports whether demo seed data and sandbox features are allowed
+func (c Config) IsDevelopment() bool {
+	return c.Env == "development"
+}
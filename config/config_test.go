@@ -0,0 +1,172 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoad_Precedence(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "config.yml")
+	if err := os.WriteFile(configFile, []byte(`
+server:
+  address: ":8080"
+  request_timeout: 20s
+database:
+  url: postgres://file
+auth:
+  jwt_expiry: 10m
+`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	env := map[string]string{
+		"CONFIG_FILE":     configFile,
+		"REQUEST_TIMEOUT": "30s",
+		"DATABASE_URL":    "postgres://env",
+		"JWT_EXPIRY":      "", // empty env var is unset
+	}
+	lookupEnv := func(key string) (string, bool) {
+		value, ok := env[key]
+		return value, ok
+	}
+
+	cfg, err := load([]string{"-database.url", "postgres://flag"}, lookupEnv)
+	if err != nil {
+		t.Fatalf("load() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		got  interface{}
+		want interface{}
+	}{
+		{name: "default", got: cfg.Timezone, want: "Asia/Jakarta"},
+		{name: "file-over-default", got: cfg.Server.Address, want: ":8080"},
+		{name: "file-over-empty-env", got: cfg.Auth.JWTExpiry, want: 10 * time.Minute},
+		{name: "env-over-file", got: cfg.Server.RequestTimeout, want: 30 * time.Second},
+		{name: "flag-over-env", got: cfg.Database.URL, want: "postgres://flag"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.got != test.want {
+				t.Errorf("got %v, want %v", test.got, test.want)
+			}
+		})
+	}
+}
+
+func TestLoad_Errors(t *testing.T) {
+	unknownKeyFile := filepath.Join(t.TempDir(), "config.yml")
+	if err := os.WriteFile(unknownKeyFile, []byte("server:\n  adress: \":8080\"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		args        []string
+		env         map[string]string
+		wantErrPart string
+	}{
+		{
+			name:        "missing-database-url",
+			wantErrPart: "database.url is required",
+		},
+		{
+			name:        "invalid-env-value",
+			env:         map[string]string{"DATABASE_URL": "postgres://env", "REQUEST_TIMEOUT": "ten seconds"},
+			wantErrPart: "env REQUEST_TIMEOUT",
+		},
+		{
+			name:        "invalid-flag-value",
+			args:        []string{"-auth.password_hash_cost", "high"},
+			env:         map[string]string{"DATABASE_URL": "postgres://env"},
+			wantErrPart: "flag -auth.password_hash_cost",
+		},
+		{
+			name:        "unknown-file-key",
+			args:        []string{"-config", unknownKeyFile},
+			env:         map[string]string{"DATABASE_URL": "postgres://env"},
+			wantErrPart: "adress",
+		},
+		{
+			name:        "invalid-values",
+			args:        []string{"-timezone", "Mars/Olympus", "-validation.password_min_length", "100"},
+			env:         map[string]string{"DATABASE_URL": "postgres://env"},
+			wantErrPart: "timezone \"Mars/Olympus\" is not a valid IANA time zone\n  validation.password_min_length",
+		},
+		{
+			name:        "invalid-tracing-exporter",
+			env:         map[string]string{"DATABASE_URL": "postgres://env", "TRACING_EXPORTER": "jaeger"},
+			wantErrPart: "tracing.exporter \"jaeger\"",
+		},
+		{
+			name:        "invalid-phone-country",
+			env:         map[string]string{"DATABASE_URL": "postgres://env", "PHONE_COUNTRIES": "SG, XX"},
+			wantErrPart: "validation.phone_default_country and validation.phone_countries: unknown country \"XX\"",
+		},
+		{
+			name:        "invalid-recipient-lookup-limit",
+			env:         map[string]string{"DATABASE_URL": "postgres://env", "RECIPIENT_LOOKUP_LIMIT": "0"},
+			wantErrPart: "transfers.recipient_lookup_limit should be > 0",
+		},
+		{
+			name:        "invalid-otp-request-limit",
+			env:         map[string]string{"DATABASE_URL": "postgres://env", "OTP_REQUEST_LIMIT": "0"},
+			wantErrPart: "auth.otp_request_limit should be > 0",
+		},
+		{
+			name:        "direct-topup-outside-development",
+			env:         map[string]string{"DATABASE_URL": "postgres://env", "APP_ENV": "staging", "ENABLE_DIRECT_TOPUP": "true"},
+			wantErrPart: "enable_direct_topup is only allowed in development, env is \"staging\"",
+		},
+		{
+			name:        "direct-topup-default-env",
+			env:         map[string]string{"DATABASE_URL": "postgres://env", "ENABLE_DIRECT_TOPUP": "true"},
+			wantErrPart: "enable_direct_topup is only allowed in development, env is \"production\"",
+		},
+		{
+			name:        "invalid-log-level",
+			env:         map[string]string{"DATABASE_URL": "postgres://env", "LOG_LEVEL": "verbose"},
+			wantErrPart: "log.level \"verbose\"",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			lookupEnv := func(key string) (string, bool) {
+				value, ok := test.env[key]
+				return value, ok
+			}
+
+			_, err := load(test.args, lookupEnv)
+			if err == nil || !strings.Contains(err.Error(), test.wantErrPart) {
+				t.Errorf("load() error = %v, want error containing %q", err, test.wantErrPart)
+			}
+		})
+	}
+}
+
+func TestConfig_String(t *testing.T) {
+	cfg := Default()
+	cfg.Database.URL = "postgres://postgres:postgres@db:5432/database"
+	cfg.Payment.CallbackSecret = "payment-callback-secret"
+
+	printed := cfg.String()
+
+	for _, secret := range []string{cfg.Database.URL, cfg.Payment.CallbackSecret} {
+		if strings.Contains(printed, secret) {
+			t.Errorf("String() contains secret %q", secret)
+		}
+	}
+	if !strings.Contains(printed, "url: '[REDACTED]'") {
+		t.Errorf("String() = %s, want database url redacted", printed)
+	}
+	if !strings.Contains(printed, "callback_token: \"\"") {
+		t.Errorf("String() = %s, want empty secret to stay empty", printed)
+	}
+	if cfg.Database.URL == redacted {
+		t.Error("String() modified Config")
+	}
+}
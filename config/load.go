@@ -0,0 +1,154 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const redacted = "[REDACTED]"
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// Load returns the validated Config, overriding Default with the YAML config file, env vars and then flags in args.
+// The YAML config file is read from the -config flag, or CONFIG_FILE env var if the flag is not set.
+func Load(args []string) (Config, error) {
+	return load(args, os.LookupEnv)
+}
+
+func load(args []string, lookupEnv func(string) (string, bool)) (Config, error) {
+	cfg := Default()
+
+	flags := flag.NewFlagSet("wallet", flag.ContinueOnError)
+	configFile := flags.String("config", "", "path to the YAML config file, overrides CONFIG_FILE")
+	overrides := map[string]*string{}
+	visitFields(reflect.ValueOf(&cfg).Elem(), "", func(path string, field reflect.StructField, _ reflect.Value) {
+		overrides[path] = flags.String(path, "", fmt.Sprintf("overrides %s", field.Tag.Get("env")))
+	})
+	if err := flags.Parse(args); err != nil {
+		return Config{}, err
+	}
+
+	// 1. YAML config file
+	if *configFile == "" {
+		*configFile, _ = lookupEnv("CONFIG_FILE")
+	}
+	if *configFile != "" {
+		data, err := os.ReadFile(*configFile)
+		if err != nil {
+			return Config{}, fmt.Errorf("failed to read config file: %w", err)
+		}
+
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		// Empty config file keeps the defaults
+		if err := decoder.Decode(&cfg); err != nil && !errors.Is(err, io.EOF) {
+			return Config{}, fmt.Errorf("failed to parse config file %s: %w", *configFile, err)
+		}
+	}
+
+	// 2. Env vars, empty ones are treated as unset, then 3. flags set in args
+	setFlags := map[string]bool{}
+	flags.Visit(func(f *flag.Flag) {
+		setFlags[f.Name] = true
+	})
+
+	var errorList []string
+	visitFields(reflect.ValueOf(&cfg).Elem(), "", func(path string, field reflect.StructField, value reflect.Value) {
+		if env := field.Tag.Get("env"); env != "" {
+			if raw, ok := lookupEnv(env); ok && raw != "" {
+				if err := setValue(value, raw); err != nil {
+					errorList = append(errorList, fmt.Sprintf("env %s: %s", env, err))
+				}
+			}
+		}
+
+		if setFlags[path] {
+			if err := setValue(value, *overrides[path]); err != nil {
+				errorList = append(errorList, fmt.Sprintf("flag -%s: %s", path, err))
+			}
+		}
+	})
+	if len(errorList) > 0 {
+		return Config{}, fmt.Errorf("invalid config:\n  %s", strings.Join(errorList, "\n  "))
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return Config{}, err
+	}
+
+	return cfg, nil
+}
+
+// Redacted returns a copy of Config with secret values replaced, so it can be printed or logged
+func (c Config) Redacted() Config {
+	visitFields(reflect.ValueOf(&c).Elem(), "", func(_ string, field reflect.StructField, value reflect.Value) {
+		if field.Tag.Get("secret") == "true" && value.String() != "" {
+			value.SetString(redacted)
+		}
+	})
+	return c
+}
+
+// String returns the effective Config as YAML with secrets redacted
+func (c Config) String() string {
+	data, err := yaml.Marshal(c.Redacted())
+	if err != nil {
+		return err.Error()
+	}
+	return string(data)
+}
+
+// visitFields calls fn with every leaf field of the struct v, path is the dotted path of its YAML keys
+func visitFields(v reflect.Value, prefix string, fn func(path string, field reflect.StructField, value reflect.Value)) {
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		path := prefix + strings.Split(field.Tag.Get("yaml"), ",")[0]
+
+		if field.Type.Kind() == reflect.Struct {
+			visitFields(v.Field(i), path+".", fn)
+			continue
+		}
+
+		fn(path, field, v.Field(i))
+	}
+}
+
+// setValue parses raw into value based on its type
+func setValue(value reflect.Value, raw string) error {
+	switch {
+	case value.Type() == durationType:
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return err
+		}
+		value.SetInt(int64(d))
+	case value.Kind() == reflect.String:
+		value.SetString(raw)
+	case value.Kind() == reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		value.SetBool(b)
+	case value.Kind() == reflect.Int || value.Kind() == reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return err
+		}
+		value.SetInt(n)
+	default:
+		return fmt.Errorf("unsupported config type %s", value.Type())
+	}
+
+	return nil
+}
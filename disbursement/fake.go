@@ -0,0 +1,136 @@
+package disbursement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/WalletService/model"
+	"github.com/WalletService/tracing"
+)
+
+type FailureMode string
+
+const (
+	FailureModeNone       FailureMode = ""            // Every disbursement succeeds
+	FailureModeReject     FailureMode = "reject"      // Gateway rejects the request synchronously
+	FailureModeDecline    FailureMode = "decline"     // Gateway accepts the request, then reports it as failed, i.e. bank account closed
+	FailureModeNoCallback FailureMode = "no_callback" // Gateway accepts the request, but never reports the result
+)
+
+var ErrRejected = errors.New("disbursement rejected by gateway")
+
+// FakeGateway is an in-process Gateway for local development and tests.
+// It reports the result to Notify after Latency, mimicking the webhook of a real bank gateway.
+type FakeGateway struct {
+	Latency     time.Duration
+	FailureMode FailureMode
+	Notify      func(ctx context.Context, result model.DisbursementResult) error
+
+	mu       sync.Mutex
+	wg       sync.WaitGroup
+	requests []Request
+	sequence int
+	stop     chan struct{} // Closed by Shutdown to report pending results without waiting for Latency
+	stopped  bool
+}
+
+func NewFakeGateway(latency time.Duration, failureMode FailureMode) *FakeGateway {
+	return &FakeGateway{
+		Latency:     latency,
+		FailureMode: failureMode,
+		stop:        make(chan struct{}),
+	}
+}
+
+func (g *FakeGateway) Disburse(ctx context.Context, request Request) (reference string, err error) {
+	if g.FailureMode == FailureModeReject {
+		return "", ErrRejected
+	}
+
+	g.mu.Lock()
+	if g.stopped {
+		g.mu.Unlock()
+		return "", ErrRejected
+	}
+	g.sequence++
+	g.requests = append(g.requests, request)
+	reference = fmt.Sprintf("FAKE-%06d", g.sequence)
+
+	notify := g.FailureMode != FailureModeNoCallback && g.Notify != nil
+	if notify {
+		// Added under the lock, so Shutdown never waits for results before every accepted disbursement is counted
+		g.wg.Add(1)
+	}
+	g.mu.Unlock()
+
+	if !notify {
+		return reference, nil
+	}
+
+	result := model.DisbursementResult{
+		TransactionID: request.TransactionID,
+		Reference:     reference,
+		Status:        model.DisbursementStatusSuccessful,
+	}
+	if g.FailureMode == FailureModeDecline {
+		result.Status = model.DisbursementStatusFailed
+		result.FailureReason = "account declined by beneficiary bank"
+	}
+
+	// A real gateway sends the traceparent of the request back with its webhook, continuing the trace
+	notifyCtx := tracing.ContextWithRemoteSpanContext(context.Background(), tracing.SpanContextFromContext(ctx))
+
+	go func() {
+		defer g.wg.Done()
+
+		select {
+		case <-time.After(g.Latency):
+		case <-g.stop:
+		}
+		g.Notify(notifyCtx, result)
+	}()
+
+	return reference, nil
+}
+
+// Requests returns the disbursement requests accepted so far.
+func (g *FakeGateway) Requests() []Request {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return append([]Request{}, g.requests...)
+}
+
+// Wait blocks until every pending result is reported.
+func (g *FakeGateway) Wait() {
+	g.wg.Wait()
+}
+
+// Shutdown rejects new disbursements and reports every pending result immediately, so no Withdrawal is left Pending.
+// It blocks until the results are reported or ctx is done.
+func (g *FakeGateway) Shutdown(ctx context.Context) error {
+	g.mu.Lock()
+	if !g.stopped {
+		g.stopped = true
+		if g.stop != nil {
+			close(g.stop)
+		}
+	}
+	g.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		g.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,172 @@
+package disbursement
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/WalletService/model"
+	"github.com/google/uuid"
+)
+
+// recorder collects the results reported to FakeGateway.Notify
+type recorder struct {
+	mu      sync.Mutex
+	results []model.DisbursementResult
+}
+
+func (r *recorder) notify(_ context.Context, result model.DisbursementResult) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.results = append(r.results, result)
+	return nil
+}
+
+func (r *recorder) get() []model.DisbursementResult {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]model.DisbursementResult{}, r.results...)
+}
+
+func TestFakeGateway_Disburse(t *testing.T) {
+	transactionID := uuid.MustParse("3d6e668f-ad02-40ff-8540-90c1528a7c88")
+
+	tests := []struct {
+		name          string
+		failureMode   FailureMode
+		wantReference string
+		wantErr       error
+		wantResults   []model.DisbursementResult
+	}{
+		{
+			name:          "success",
+			failureMode:   FailureModeNone,
+			wantReference: "FAKE-000001",
+			wantResults: []model.DisbursementResult{
+				{TransactionID: transactionID, Reference: "FAKE-000001", Status: model.DisbursementStatusSuccessful},
+			},
+		},
+		{
+			name:        "fail-reject",
+			failureMode: FailureModeReject,
+			wantErr:     ErrRejected,
+		},
+		{
+			name:          "fail-decline",
+			failureMode:   FailureModeDecline,
+			wantReference: "FAKE-000001",
+			wantResults: []model.DisbursementResult{
+				{TransactionID: transactionID, Reference: "FAKE-000001", Status: model.DisbursementStatusFailed, FailureReason: "account declined by beneficiary bank"},
+			},
+		},
+		{
+			name:          "no-callback",
+			failureMode:   FailureModeNoCallback,
+			wantReference: "FAKE-000001",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var received recorder
+			gateway := NewFakeGateway(0, test.failureMode)
+			gateway.Notify = received.notify
+
+			gotReference, err := gateway.Disburse(context.Background(), Request{TransactionID: transactionID, Amount: 100000})
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("FakeGateway.Disburse() err = %v, wantErr %v", err, test.wantErr)
+			}
+			if gotReference != test.wantReference {
+				t.Errorf("FakeGateway.Disburse() reference = %v, want %v", gotReference, test.wantReference)
+			}
+
+			gateway.Wait()
+			if got := received.get(); len(got) != len(test.wantResults) || (len(got) > 0 && got[0] != test.wantResults[0]) {
+				t.Errorf("FakeGateway.Notify() results = %+v, want %+v", got, test.wantResults)
+			}
+		})
+	}
+}
+
+func TestFakeGateway_Latency(t *testing.T) {
+	var received recorder
+	gateway := NewFakeGateway(50*time.Millisecond, FailureModeNone)
+	gateway.Notify = received.notify
+
+	start := time.Now()
+	if _, err := gateway.Disburse(context.Background(), Request{TransactionID: uuid.New()}); err != nil {
+		t.Fatalf("FakeGateway.Disburse() err = %v", err)
+	}
+
+	// The request is accepted immediately, the result is only reported after Latency
+	if got := received.get(); len(got) != 0 {
+		t.Errorf("FakeGateway.Notify() called before Latency, results = %+v", got)
+	}
+	gateway.Wait()
+	if elapsed := time.Since(start); elapsed < gateway.Latency {
+		t.Errorf("FakeGateway.Notify() called after %v, want at least %v", elapsed, gateway.Latency)
+	}
+	if got := received.get(); len(got) != 1 {
+		t.Errorf("FakeGateway.Notify() results = %+v, want 1 result", got)
+	}
+}
+
+func TestFakeGateway_Shutdown(t *testing.T) {
+	var received recorder
+	gateway := NewFakeGateway(time.Hour, FailureModeNone)
+	gateway.Notify = received.notify
+
+	if _, err := gateway.Disburse(context.Background(), Request{TransactionID: uuid.New()}); err != nil {
+		t.Fatalf("FakeGateway.Disburse() err = %v", err)
+	}
+
+	// Pending result is reported immediately instead of waiting for Latency
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := gateway.Shutdown(ctx); err != nil {
+		t.Fatalf("FakeGateway.Shutdown() err = %v", err)
+	}
+	if got := received.get(); len(got) != 1 || got[0].Status != model.DisbursementStatusSuccessful {
+		t.Errorf("FakeGateway.Notify() results = %+v, want 1 successful result", got)
+	}
+
+	// Disbursement after Shutdown is rejected and never reported
+	if _, err := gateway.Disburse(context.Background(), Request{TransactionID: uuid.New()}); !errors.Is(err, ErrRejected) {
+		t.Errorf("FakeGateway.Disburse() after Shutdown err = %v, wantErr %v", err, ErrRejected)
+	}
+	if got := gateway.Requests(); len(got) != 1 {
+		t.Errorf("FakeGateway.Requests() = %+v, want 1 request", got)
+	}
+
+	// Shutdown twice is a no-op
+	if err := gateway.Shutdown(ctx); err != nil {
+		t.Errorf("FakeGateway.Shutdown() second call err = %v", err)
+	}
+}
+
+func TestFakeGateway_ShutdownContextCancelled(t *testing.T) {
+	release := make(chan struct{})
+	gateway := NewFakeGateway(0, FailureModeNone)
+	gateway.Notify = func(context.Context, model.DisbursementResult) error {
+		<-release
+		return nil
+	}
+
+	if _, err := gateway.Disburse(context.Background(), Request{TransactionID: uuid.New()}); err != nil {
+		t.Fatalf("FakeGateway.Disburse() err = %v", err)
+	}
+
+	// Shutdown gives up when ctx is done before the pending result is reported
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := gateway.Shutdown(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("FakeGateway.Shutdown() err = %v, wantErr %v", err, context.Canceled)
+	}
+
+	close(release)
+	gateway.Wait()
+}
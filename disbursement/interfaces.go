@@ -0,0 +1,25 @@
+// This file contains the interfaces for the disbursement gateway.
+// The disbursement gateway transfers money out of the wallet into a User's bank account.
+// For testing purpose we will generate mock implementations of these
+// interfaces using mockgen. See the Makefile for more information.
+package disbursement
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Gateway accepts disbursement requests asynchronously.
+// A nil error only means the request is accepted, the final result is reported later through the callback endpoint.
+type Gateway interface {
+	Disburse(ctx context.Context, request Request) (reference string, err error)
+}
+
+type Request struct {
+	TransactionID uuid.UUID // Used as idempotency key by the gateway
+	BankCode      string
+	AccountNumber string
+	AccountName   string
+	Amount        float32
+}
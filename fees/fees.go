@@ -0,0 +1,178 @@
+// Package fees calculates the fee charged on a Transaction based on configurable rules.
+package fees
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"os"
+
+	"github.com/WalletService/model"
+)
+
+// HouseRevenueAccount is the house account collecting fees.
+const HouseRevenueAccount = "revenue"
+
+// Tier charges Flat + Percent of the amount, bounded by Min and Max when set.
+// A Tier applies from the FromMonthlyCount-th Transaction of the same type within a calendar month, counting from 0.
+type Tier struct {
+	FromMonthlyCount int     `json:"from_monthly_count"`
+	Flat             float64 `json:"flat"`
+	Percent          float64 `json:"percent"` // Fraction of the amount, i.e. 0.007 is 0.7%
+	Min              float64 `json:"min"`
+	Max              float64 `json:"max"`
+}
+
+// Rule defines the fee Tiers of a Transaction type in a currency, empty Currency is IDR.
+type Rule struct {
+	Type     model.TransactionType `json:"type"`
+	Currency model.Currency        `json:"currency"`
+	Tiers    []Tier                `json:"tiers"`
+}
+
+// Schedule is the set of fee Rules, Transactions without a matching Rule are free.
+type Schedule struct {
+	Rules []Rule `json:"rules"`
+}
+
+// Request is a Transaction to calculate the fee for.
+// MonthlyCount is the number of Transactions of the same type User made this month, it is only used by tiered Rules.
+type Request struct {
+	Type         model.TransactionType
+	Currency     model.Currency
+	Amount       float32
+	MonthlyCount int
+}
+
+// feeableTypes are the Transaction types charged by the usecase layer
+var feeableTypes = map[model.TransactionType]bool{
+	model.TransactionTypeTransferOut: true,
+	model.TransactionTypePayment:     true,
+	model.TransactionTypeWithdrawal:  true,
+}
+
+// DefaultSchedule charges Rp2,500 on withdrawals, 0.7% on merchant payments and Rp1,000 on P2P transfers after 20 free ones a month.
+var DefaultSchedule = Schedule{
+	Rules: []Rule{
+		{
+			Type:  model.TransactionTypeWithdrawal,
+			Tiers: []Tier{{Flat: 2500}},
+		},
+		{
+			Type:  model.TransactionTypePayment,
+			Tiers: []Tier{{Percent: 0.007}},
+		},
+		{
+			Type: model.TransactionTypeTransferOut,
+			Tiers: []Tier{
+				{FromMonthlyCount: 0},
+				{FromMonthlyCount: 20, Flat: 1000},
+			},
+		},
+	},
+}
+
+// LoadSchedule reads Schedule from a JSON file, i.e. {"rules": [{"type": "Withdrawal", "tiers": [{"flat": 2500}]}]}.
+func LoadSchedule(path string) (Schedule, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	var schedule Schedule
+	if err := json.Unmarshal(data, &schedule); err != nil {
+		return Schedule{}, err
+	}
+
+	if err := schedule.Validate(); err != nil {
+		return Schedule{}, err
+	}
+
+	return schedule, nil
+}
+
+// Validate checks every Rule is for a type that is charged and its Tiers are in order, starting from the first Transaction of the month.
+func (s Schedule) Validate() error {
+	for _, rule := range s.Rules {
+		if !feeableTypes[rule.Type] {
+			return errors.New("fee rule type is not supported")
+		}
+
+		if len(rule.Tiers) == 0 {
+			return errors.New("fee rule must have at least one tier")
+		}
+
+		for i, tier := range rule.Tiers {
+			if tier.Flat < 0 || tier.Percent < 0 || tier.Percent >= 1 || tier.Min < 0 || tier.Max < 0 || (tier.Max > 0 && tier.Max < tier.Min) {
+				return errors.New("invalid fee tier")
+			}
+			if i == 0 && tier.FromMonthlyCount != 0 {
+				return errors.New("first fee tier must start from from_monthly_count 0")
+			}
+			if i > 0 && tier.FromMonthlyCount <= rule.Tiers[i-1].FromMonthlyCount {
+				return errors.New("fee tiers must be sorted by from_monthly_count")
+			}
+		}
+	}
+
+	return nil
+}
+
+// IsTiered tells whether the fee of the Transaction type and currency depends on MonthlyCount.
+func (s Schedule) IsTiered(transactionType model.TransactionType, currency model.Currency) bool {
+	rule, ok := s.rule(transactionType, currency)
+	return ok && len(rule.Tiers) > 1
+}
+
+// Calculate returns the fee of the Transaction, rounded up to the currency's smallest unit.
+func (s Schedule) Calculate(request Request) float32 {
+	rule, ok := s.rule(request.Type, request.Currency)
+	if !ok {
+		return 0
+	}
+
+	tier := rule.Tiers[0]
+	for _, t := range rule.Tiers[1:] {
+		if request.MonthlyCount >= t.FromMonthlyCount {
+			tier = t
+		}
+	}
+
+	fee := tier.Flat + tier.Percent*float64(request.Amount)
+	if fee < tier.Min {
+		fee = tier.Min
+	}
+	if tier.Max > 0 && fee > tier.Max {
+		fee = tier.Max
+	}
+
+	return float32(roundUp(fee, request.Currency))
+}
+
+func (s Schedule) rule(transactionType model.TransactionType, currency model.Currency) (Rule, bool) {
+	if currency == "" {
+		currency = model.CurrencyIDR
+	}
+
+	for _, rule := range s.Rules {
+		ruleCurrency := rule.Currency
+		if ruleCurrency == "" {
+			ruleCurrency = model.CurrencyIDR
+		}
+
+		if rule.Type == transactionType && ruleCurrency == currency {
+			return rule, true
+		}
+	}
+
+	return Rule{}, false
+}
+
+// roundUp rounds in favour of the wallet, IDR has no minor unit while other currencies have cents
+func roundUp(amount float64, currency model.Currency) float64 {
+	scale := float64(100)
+	if currency == "" || currency == model.CurrencyIDR {
+		scale = 1
+	}
+	return math.Ceil(math.Round(amount*scale*1e6)/1e6) / scale
+}
@@ -0,0 +1,124 @@
+package fees
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/WalletService/model"
+)
+
+func TestSchedule_Calculate(t *testing.T) {
+	schedule := Schedule{
+		Rules: []Rule{
+			{Type: model.TransactionTypeWithdrawal, Tiers: []Tier{{Flat: 2500}}},
+			{Type: model.TransactionTypePayment, Tiers: []Tier{{Percent: 0.007, Min: 100, Max: 50000}}},
+			{Type: model.TransactionTypeTransferOut, Tiers: []Tier{{FromMonthlyCount: 0}, {FromMonthlyCount: 3, Flat: 1000}}},
+			{Type: model.TransactionTypeTransferOut, Currency: model.CurrencyUSD, Tiers: []Tier{{Percent: 0.001}}},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		request Request
+		want    float32
+	}{
+		{
+			name:    "withdrawal-flat",
+			request: Request{Type: model.TransactionTypeWithdrawal, Amount: 100000},
+			want:    2500,
+		},
+		{
+			name:    "payment-percent-rounded-up",
+			request: Request{Type: model.TransactionTypePayment, Currency: model.CurrencyIDR, Amount: 123456},
+			want:    865,
+		},
+		{
+			name:    "payment-percent-min",
+			request: Request{Type: model.TransactionTypePayment, Amount: 1000},
+			want:    100,
+		},
+		{
+			name:    "payment-percent-max",
+			request: Request{Type: model.TransactionTypePayment, Amount: 100000000},
+			want:    50000,
+		},
+		{
+			name:    "transfer-free-tier",
+			request: Request{Type: model.TransactionTypeTransferOut, Amount: 100000, MonthlyCount: 2},
+			want:    0,
+		},
+		{
+			name:    "transfer-after-free-tier",
+			request: Request{Type: model.TransactionTypeTransferOut, Amount: 100000, MonthlyCount: 3},
+			want:    1000,
+		},
+		{
+			name:    "transfer-other-currency-rounded-to-cents",
+			request: Request{Type: model.TransactionTypeTransferOut, Currency: model.CurrencyUSD, Amount: 12.34},
+			want:    0.02,
+		},
+		{
+			name:    "no-rule-is-free",
+			request: Request{Type: model.TransactionTypeWithdrawal, Currency: model.CurrencySGD, Amount: 100},
+			want:    0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := schedule.Calculate(test.request); got != test.want {
+				t.Errorf("Schedule.Calculate() = %v, want %v", got, test.want)
+			}
+		})
+	}
+
+	if !schedule.IsTiered(model.TransactionTypeTransferOut, model.CurrencyIDR) || schedule.IsTiered(model.TransactionTypeWithdrawal, "") {
+		t.Errorf("Schedule.IsTiered() should only be true for rules with more than one tier")
+	}
+}
+
+func TestLoadSchedule(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr bool
+	}{
+		{
+			name:    "success",
+			content: `{"rules": [{"type": "Withdrawal", "tiers": [{"flat": 2500}]}, {"type": "TransferOut", "tiers": [{}, {"from_monthly_count": 20, "flat": 1000}]}]}`,
+		},
+		{
+			name:    "fail-unsupported-type",
+			content: `{"rules": [{"type": "TopUp", "tiers": [{"flat": 2500}]}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "fail-unsorted-tiers",
+			content: `{"rules": [{"type": "TransferOut", "tiers": [{"from_monthly_count": 20}, {"from_monthly_count": 10}]}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "fail-first-tier-not-from-zero",
+			content: `{"rules": [{"type": "TransferOut", "tiers": [{"from_monthly_count": 5, "flat": 1000}]}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "fail-invalid-percent",
+			content: `{"rules": [{"type": "Payment", "tiers": [{"percent": 7}]}]}`,
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "fees.json")
+			os.WriteFile(path, []byte(test.content), 0o600)
+
+			_, err := LoadSchedule(path)
+			if (err != nil) != test.wantErr {
+				t.Errorf("LoadSchedule() err = %v, wantErr %v", err, test.wantErr)
+			}
+		})
+	}
+}
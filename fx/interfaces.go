@@ -0,0 +1,16 @@
+// This file contains the interfaces for the FX rate provider.
+// The FX rate provider supplies the mid-market rate used to quote a Convert Transaction.
+// For testing purpose we will generate mock implementations of these
+// interfaces using mockgen. See the Makefile for more information.
+package fx
+
+import (
+	"context"
+
+	"github.com/WalletService/model"
+)
+
+// FXRateProvider returns the mid-market rate, i.e. 1 unit of from is worth rate units of to.
+type FXRateProvider interface {
+	Rate(ctx context.Context, from, to model.Currency) (rate float64, err error)
+}
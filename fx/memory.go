@@ -0,0 +1,75 @@
+package fx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/WalletService/model"
+)
+
+var ErrRateNotFound = errors.New("fx rate not found")
+
+// InMemoryProvider serves rates kept in memory, i.e. for tests or rates pushed by a feed.
+// A rate for from/to also serves to/from using its inverse.
+type InMemoryProvider struct {
+	mu    sync.RWMutex
+	rates map[string]float64
+}
+
+// NewInMemoryProvider creates a provider from rates keyed by pair, i.e. "USD/IDR": 15500 means 1 USD = 15500 IDR.
+func NewInMemoryProvider(rates map[string]float64) (*InMemoryProvider, error) {
+	p := &InMemoryProvider{
+		rates: map[string]float64{},
+	}
+
+	for pair, rate := range rates {
+		from, to, ok := strings.Cut(pair, "/")
+		if !ok {
+			return nil, fmt.Errorf("invalid currency pair %q", pair)
+		}
+
+		if err := p.SetRate(model.Currency(from), model.Currency(to), rate); err != nil {
+			return nil, err
+		}
+	}
+
+	return p, nil
+}
+
+func (p *InMemoryProvider) SetRate(from, to model.Currency, rate float64) error {
+	if rate <= 0 {
+		return fmt.Errorf("invalid rate %v for %s/%s", rate, from, to)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.rates[pairKey(from, to)] = rate
+	return nil
+}
+
+func (p *InMemoryProvider) Rate(ctx context.Context, from, to model.Currency) (rate float64, err error) {
+	if from == to {
+		return 1, nil
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if rate, ok := p.rates[pairKey(from, to)]; ok {
+		return rate, nil
+	}
+
+	if rate, ok := p.rates[pairKey(to, from)]; ok {
+		return 1 / rate, nil
+	}
+
+	return 0, ErrRateNotFound
+}
+
+func pairKey(from, to model.Currency) string {
+	return string(from) + "/" + string(to)
+}
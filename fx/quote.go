@@ -0,0 +1,138 @@
+package fx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"os"
+	"time"
+
+	"github.com/WalletService/model"
+	"github.com/google/uuid"
+)
+
+// minorUnits is the number of decimals of each currency, amounts are rounded to it
+var minorUnits = map[model.Currency]int{
+	model.CurrencyIDR: 0,
+	model.CurrencySGD: 2,
+	model.CurrencyUSD: 2,
+}
+
+// FeeRule charges Flat + Percent of the source amount, in source currency.
+// Empty From or To matches any currency, the first matching rule applies.
+type FeeRule struct {
+	From    model.Currency `json:"from"`
+	To      model.Currency `json:"to"`
+	Percent float64        `json:"percent"`
+	Flat    float64        `json:"flat"`
+}
+
+type Pricing struct {
+	QuoteTTL time.Duration `json:"-"`
+	Spread   float64       `json:"spread"` // Fraction taken off the mid-market rate, i.e. 0.005 is 0.5%
+	Fees     []FeeRule     `json:"fees"`
+}
+
+// DefaultPricing locks quotes for 30 seconds with 0.5% spread and no fee.
+var DefaultPricing = Pricing{
+	QuoteTTL: 30 * time.Second,
+	Spread:   0.005,
+}
+
+// LoadPricing reads Pricing from a JSON file, i.e. {"quote_ttl_seconds": 30, "spread": 0.005, "fees": [{"from": "IDR", "flat": 5000}]}.
+func LoadPricing(path string) (Pricing, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Pricing{}, err
+	}
+
+	in := struct {
+		Pricing
+		QuoteTTLSeconds int `json:"quote_ttl_seconds"`
+	}{
+		Pricing:         DefaultPricing,
+		QuoteTTLSeconds: int(DefaultPricing.QuoteTTL / time.Second),
+	}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return Pricing{}, err
+	}
+
+	if in.QuoteTTLSeconds <= 0 || in.Spread < 0 || in.Spread >= 1 {
+		return Pricing{}, errors.New("invalid fx pricing")
+	}
+
+	in.Pricing.QuoteTTL = time.Duration(in.QuoteTTLSeconds) * time.Second
+	return in.Pricing, nil
+}
+
+// Quoter prices a conversion using the rate provider and Pricing.
+type Quoter struct {
+	Provider FXRateProvider
+	Pricing  Pricing
+}
+
+func NewQuoter(provider FXRateProvider, pricing Pricing) *Quoter {
+	return &Quoter{
+		Provider: provider,
+		Pricing:  pricing,
+	}
+}
+
+// Quote prices converting request.SourceAmount, the returned quote is valid until its ExpiryTime.
+func (q *Quoter) Quote(ctx context.Context, request model.FXQuoteRequest) (quote model.FXQuote, err error) {
+	sourceUnits, ok := minorUnits[request.SourceCurrency]
+	if !ok {
+		return model.FXQuote{}, errors.New("unsupported source currency")
+	}
+
+	targetUnits, ok := minorUnits[request.TargetCurrency]
+	if !ok {
+		return model.FXQuote{}, errors.New("unsupported target currency")
+	}
+
+	midRate, err := q.Provider.Rate(ctx, request.SourceCurrency, request.TargetCurrency)
+	if err != nil {
+		return model.FXQuote{}, err
+	}
+
+	// Round in favour of the wallet, fee up and converted amount down
+	rate := midRate * (1 - q.Pricing.Spread)
+	fee := roundUp(q.fee(request), sourceUnits)
+	targetAmount := roundDown((float64(request.SourceAmount)-fee)*rate, targetUnits)
+	if targetAmount <= 0 {
+		return model.FXQuote{}, errors.New("amount is too small to convert")
+	}
+
+	return model.FXQuote{
+		ID:             uuid.New(),
+		UserID:         request.UserID,
+		SourceCurrency: request.SourceCurrency,
+		TargetCurrency: request.TargetCurrency,
+		SourceAmount:   request.SourceAmount,
+		Fee:            float32(fee),
+		Rate:           rate,
+		TargetAmount:   float32(targetAmount),
+		ExpiryTime:     time.Now().Add(q.Pricing.QuoteTTL),
+	}, nil
+}
+
+func (q *Quoter) fee(request model.FXQuoteRequest) float64 {
+	for _, rule := range q.Pricing.Fees {
+		if (rule.From == "" || rule.From == request.SourceCurrency) && (rule.To == "" || rule.To == request.TargetCurrency) {
+			return rule.Flat + rule.Percent*float64(request.SourceAmount)
+		}
+	}
+
+	return 0
+}
+
+func roundUp(amount float64, decimals int) float64 {
+	scale := math.Pow10(decimals)
+	return math.Ceil(math.Round(amount*scale*1e6)/1e6) / scale
+}
+
+func roundDown(amount float64, decimals int) float64 {
+	scale := math.Pow10(decimals)
+	return math.Floor(math.Round(amount*scale*1e6)/1e6) / scale
+}
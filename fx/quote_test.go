@@ -0,0 +1,131 @@
+package fx
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/WalletService/model"
+)
+
+func TestQuoter_Quote(t *testing.T) {
+	provider, err := NewInMemoryProvider(map[string]float64{
+		"USD/IDR": 15500,
+		"SGD/IDR": 11600,
+	})
+	if err != nil {
+		t.Fatalf("NewInMemoryProvider() err = %v", err)
+	}
+
+	tests := []struct {
+		name             string
+		pricing          Pricing
+		request          model.FXQuoteRequest
+		wantFee          float32
+		wantTargetAmount float32
+		wantErr          bool
+	}{
+		{
+			name:    "success-direct-rate-without-spread",
+			pricing: Pricing{QuoteTTL: time.Minute},
+			request: model.FXQuoteRequest{
+				SourceCurrency: model.CurrencyUSD,
+				TargetCurrency: model.CurrencyIDR,
+				SourceAmount:   10,
+			},
+			wantFee:          0,
+			wantTargetAmount: 155000,
+		},
+		{
+			name: "success-inverse-rate-with-spread-and-fee",
+			pricing: Pricing{
+				QuoteTTL: time.Minute,
+				Spread:   0.01,
+				Fees: []FeeRule{
+					{From: model.CurrencyUSD, Flat: 1},
+					{From: model.CurrencyIDR, Flat: 5000, Percent: 0.001},
+				},
+			},
+			request: model.FXQuoteRequest{
+				SourceCurrency: model.CurrencyIDR,
+				TargetCurrency: model.CurrencyUSD,
+				SourceAmount:   1000000,
+			},
+			// fee = 5000 + 0.1% * 1000000, target = (1000000 - 6000) / 15500 * 0.99 rounded down to cents
+			wantFee:          6000,
+			wantTargetAmount: 63.48,
+		},
+		{
+			name:    "fail-rate-not-found",
+			pricing: Pricing{QuoteTTL: time.Minute},
+			request: model.FXQuoteRequest{
+				SourceCurrency: model.CurrencyUSD,
+				TargetCurrency: model.CurrencySGD,
+				SourceAmount:   10,
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail-fee-exceeds-amount",
+			pricing: Pricing{
+				QuoteTTL: time.Minute,
+				Fees:     []FeeRule{{Flat: 5000}},
+			},
+			request: model.FXQuoteRequest{
+				SourceCurrency: model.CurrencyIDR,
+				TargetCurrency: model.CurrencyUSD,
+				SourceAmount:   5000,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			quoter := NewQuoter(provider, test.pricing)
+
+			got, err := quoter.Quote(context.Background(), test.request)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("Quoter.Quote() err = %v, wantErr %v", err, test.wantErr)
+			}
+			if test.wantErr {
+				return
+			}
+
+			if got.Fee != test.wantFee || got.TargetAmount != test.wantTargetAmount {
+				t.Errorf("Quoter.Quote() fee = %v, targetAmount = %v, want fee = %v, targetAmount = %v", got.Fee, got.TargetAmount, test.wantFee, test.wantTargetAmount)
+			}
+			if got.ExpiryTime.Before(time.Now().Add(time.Minute - time.Second)) {
+				t.Errorf("Quoter.Quote() expiryTime = %v, should be locked for %v", got.ExpiryTime, test.pricing.QuoteTTL)
+			}
+		})
+	}
+}
+
+func TestLoadPricingAndStaticFileProvider(t *testing.T) {
+	dir := t.TempDir()
+
+	ratesPath := filepath.Join(dir, "rates.json")
+	os.WriteFile(ratesPath, []byte(`{"USD/IDR": 15500}`), 0o600)
+
+	provider, err := NewStaticFileProvider(ratesPath)
+	if err != nil {
+		t.Fatalf("NewStaticFileProvider() err = %v", err)
+	}
+	if rate, _ := provider.Rate(context.Background(), model.CurrencyIDR, model.CurrencyUSD); rate != 1.0/15500 {
+		t.Errorf("StaticFileProvider.Rate() = %v, want inverse rate", rate)
+	}
+
+	pricingPath := filepath.Join(dir, "pricing.json")
+	os.WriteFile(pricingPath, []byte(`{"quote_ttl_seconds": 10, "spread": 0.002, "fees": [{"from": "IDR", "flat": 2500}]}`), 0o600)
+
+	pricing, err := LoadPricing(pricingPath)
+	if err != nil {
+		t.Fatalf("LoadPricing() err = %v", err)
+	}
+	if pricing.QuoteTTL != 10*time.Second || pricing.Spread != 0.002 || len(pricing.Fees) != 1 || pricing.Fees[0].Flat != 2500 {
+		t.Errorf("LoadPricing() = %+v", pricing)
+	}
+}
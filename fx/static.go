@@ -0,0 +1,31 @@
+package fx
+
+import (
+	"encoding/json"
+	"os"
+)
+
+// StaticFileProvider serves rates loaded once from a JSON file of pairs, i.e. {"USD/IDR": 15500, "SGD/IDR": 11600}.
+// Rates never change while running, which is only suitable for local development and sandbox.
+type StaticFileProvider struct {
+	*InMemoryProvider
+}
+
+func NewStaticFileProvider(path string) (*StaticFileProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	rates := map[string]float64{}
+	if err := json.Unmarshal(data, &rates); err != nil {
+		return nil, err
+	}
+
+	provider, err := NewInMemoryProvider(rates)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StaticFileProvider{InMemoryProvider: provider}, nil
+}
@@ -1,6 +1,6 @@
 // Package generated provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/deepmap/oapi-codegen version v1.16.3 DO NOT EDIT.
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
 package generated
 
 import (
@@ -12,134 +12,1935 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Defines values for AuditAction.
+const (
+	AdminBalanceAdjustment AuditAction = "admin.balance_adjustment"
+	AdminUserStatusChange  AuditAction = "admin.user_status_change"
+	BalanceUpdate          AuditAction = "balance.update"
+	UserLogin              AuditAction = "user.login"
+)
+
+// Defines values for Currency.
+const (
+	IDR Currency = "IDR"
+	SGD Currency = "SGD"
+	USD Currency = "USD"
+)
+
+// Defines values for DisbursementCallbackStatus.
+const (
+	DisbursementCallbackStatusFailed     DisbursementCallbackStatus = "Failed"
+	DisbursementCallbackStatusSuccessful DisbursementCallbackStatus = "Successful"
+)
+
+// Defines values for QRType.
+const (
+	Dynamic QRType = "Dynamic"
+	Static  QRType = "Static"
+)
+
+// Defines values for SharedWalletRole.
+const (
+	Owner   SharedWalletRole = "Owner"
+	Spender SharedWalletRole = "Spender"
+	Viewer  SharedWalletRole = "Viewer"
+)
+
+// Defines values for TopUpIntentStatus.
+const (
+	Expired TopUpIntentStatus = "Expired"
+	Paid    TopUpIntentStatus = "Paid"
+	Pending TopUpIntentStatus = "Pending"
+)
+
+// Defines values for TransactionStatus.
+const (
+	TransactionStatusFailed     TransactionStatus = "Failed"
+	TransactionStatusPending    TransactionStatus = "Pending"
+	TransactionStatusSuccessful TransactionStatus = "Successful"
 )
 
 // Defines values for TransactionType.
 const (
-	TopUp       TransactionType = "TopUp"
-	TransferOut TransactionType = "TransferOut"
+	AdjustmentCredit    TransactionType = "AdjustmentCredit"
+	AdjustmentDebit     TransactionType = "AdjustmentDebit"
+	Convert             TransactionType = "Convert"
+	Payment             TransactionType = "Payment"
+	PocketTransfer      TransactionType = "PocketTransfer"
+	SharedWalletDeposit TransactionType = "SharedWalletDeposit"
+	TopUp               TransactionType = "TopUp"
+	TransferOut         TransactionType = "TransferOut"
+	Withdrawal          TransactionType = "Withdrawal"
+)
+
+// Defines values for UserStatus.
+const (
+	Active UserStatus = "Active"
+	Closed UserStatus = "Closed"
+	Frozen UserStatus = "Frozen"
 )
 
-// GetUserResponse defines model for GetUserResponse.
-type GetUserResponse struct {
-	Header ResponseHeader `json:"header"`
-	User   User           `json:"user"`
+// AuditAction defines model for AuditAction.
+type AuditAction string
+
+// AuditLogEntry defines model for AuditLogEntry.
+type AuditLogEntry struct {
+	Action      AuditAction       `json:"action"`
+	ActorId     *int64            `json:"actor_id,omitempty"`
+	CreatedTime time.Time         `json:"created_time"`
+	Details     map[string]string `json:"details"`
+	Hash        string            `json:"hash"`
+	Id          int64             `json:"id"`
+	Ip          *string           `json:"ip,omitempty"`
+	PrevHash    string            `json:"prev_hash"`
+	UserAgent   *string           `json:"user_agent,omitempty"`
+	UserId      *int64            `json:"user_id,omitempty"`
+}
+
+// AuditLogResponse defines model for AuditLogResponse.
+type AuditLogResponse struct {
+	Entries []AuditLogEntry `json:"entries"`
+	Header  ResponseHeader  `json:"header"`
+}
+
+// Balance defines model for Balance.
+type Balance struct {
+	Amount   *float32  `json:"amount,omitempty"`
+	Currency *Currency `json:"currency,omitempty"`
+}
+
+// BalanceAdjustmentRequest defines model for BalanceAdjustmentRequest.
+type BalanceAdjustmentRequest struct {
+	// Amount Credited if positive, debited if negative, never zero.
+	Amount   float32   `json:"amount"`
+	Currency *Currency `json:"currency,omitempty"`
+
+	// Reason Why the balance is adjusted, kept as the transaction description.
+	Reason string `json:"reason"`
+}
+
+// BankAccount defines model for BankAccount.
+type BankAccount struct {
+	// AccountName Name of the bank account holder, without leading or trailing spaces.
+	AccountName   *string `json:"account_name,omitempty"`
+	AccountNumber *string `json:"account_number,omitempty"`
+
+	// BankCode Bank code, i.e. 014 for BCA.
+	BankCode *string `json:"bank_code,omitempty"`
+	Id       *int64  `json:"id,omitempty"`
+}
+
+// BankAccountResponse defines model for BankAccountResponse.
+type BankAccountResponse struct {
+	BankAccount BankAccount    `json:"bank_account"`
+	Header      ResponseHeader `json:"header"`
+}
+
+// BankAccountsResponse defines model for BankAccountsResponse.
+type BankAccountsResponse struct {
+	BankAccounts []BankAccount  `json:"bank_accounts"`
+	Header       ResponseHeader `json:"header"`
+}
+
+// CallbackResponse defines model for CallbackResponse.
+type CallbackResponse struct {
+	Header ResponseHeader `json:"header"`
+}
+
+// Contact defines model for Contact.
+type Contact struct {
+	CreatedTime *time.Time `json:"created_time,omitempty"`
+	Id          *int64     `json:"id,omitempty"`
+	Nickname    *string    `json:"nickname,omitempty"`
+
+	// RecipientClosed The account of the recipient is closed, it can not receive transfers anymore.
+	RecipientClosed *bool  `json:"recipient_closed,omitempty"`
+	RecipientId     *int64 `json:"recipient_id,omitempty"`
+
+	// RecipientName Current masked full name of the recipient, i.e. Jo** Do*.
+	RecipientName *string `json:"recipient_name,omitempty"`
+}
+
+// ContactRequest defines model for ContactRequest.
+type ContactRequest struct {
+	Nickname string `json:"nickname"`
+
+	// RecipientId Only a recipient the user has already transferred to.
+	RecipientId *int64 `json:"recipient_id,omitempty"`
+
+	// RecipientPhoneNumber Phone number of the recipient, counts as a recipient lookup, see LookupRecipient.
+	RecipientPhoneNumber *string `json:"recipient_phone_number,omitempty"`
+
+	// RecipientToken Token of a recipient lookup.
+	RecipientToken *string `json:"recipient_token,omitempty"`
+}
+
+// ContactResponse defines model for ContactResponse.
+type ContactResponse struct {
+	Contact Contact        `json:"contact"`
+	Header  ResponseHeader `json:"header"`
+}
+
+// ContactSuggestion defines model for ContactSuggestion.
+type ContactSuggestion struct {
+	LastTransferTime *time.Time `json:"last_transfer_time,omitempty"`
+
+	// RecipientId Saved as a contact with recipient_id of AddContact.
+	RecipientId *int64 `json:"recipient_id,omitempty"`
+
+	// RecipientName Masked full name of the recipient, i.e. Jo** Do*.
+	RecipientName *string `json:"recipient_name,omitempty"`
+
+	// TransferCount Successful transfers to the recipient over the last 90 days.
+	TransferCount *int `json:"transfer_count,omitempty"`
+}
+
+// ContactSuggestionsResponse defines model for ContactSuggestionsResponse.
+type ContactSuggestionsResponse struct {
+	Header      ResponseHeader      `json:"header"`
+	Suggestions []ContactSuggestion `json:"suggestions"`
+}
+
+// ContactsResponse defines model for ContactsResponse.
+type ContactsResponse struct {
+	Contacts []Contact      `json:"contacts"`
+	Header   ResponseHeader `json:"header"`
+}
+
+// Currency defines model for Currency.
+type Currency string
+
+// DeleteContactResponse defines model for DeleteContactResponse.
+type DeleteContactResponse struct {
+	Header ResponseHeader `json:"header"`
+}
+
+// DeletePocketResponse defines model for DeletePocketResponse.
+type DeletePocketResponse struct {
+	Header ResponseHeader `json:"header"`
+}
+
+// DeleteSharedWalletMemberResponse defines model for DeleteSharedWalletMemberResponse.
+type DeleteSharedWalletMemberResponse struct {
+	Header ResponseHeader `json:"header"`
+}
+
+// DisbursementCallback defines model for DisbursementCallback.
+type DisbursementCallback struct {
+	FailureReason *string `json:"failure_reason,omitempty"`
+
+	// Reference Disbursement reference assigned by the gateway.
+	Reference *string                    `json:"reference,omitempty"`
+	Status    DisbursementCallbackStatus `json:"status"`
+
+	// TransactionId ID of the Withdrawal transaction sent as idempotency key in the disbursement request.
+	TransactionId string `json:"transaction_id"`
+}
+
+// DisbursementCallbackStatus defines model for DisbursementCallback.Status.
+type DisbursementCallbackStatus string
+
+// FXQuote defines model for FXQuote.
+type FXQuote struct {
+	ExpiryTime *time.Time `json:"expiry_time,omitempty"`
+
+	// Fee Fee in source currency, already deducted from source_amount before conversion.
+	Fee            *float32  `json:"fee,omitempty"`
+	Id             *string   `json:"id,omitempty"`
+	Rate           *float64  `json:"rate,omitempty"`
+	SourceAmount   *float32  `json:"source_amount,omitempty"`
+	SourceCurrency *Currency `json:"source_currency,omitempty"`
+	TargetAmount   *float32  `json:"target_amount,omitempty"`
+	TargetCurrency *Currency `json:"target_currency,omitempty"`
+}
+
+// FXQuoteRequest defines model for FXQuoteRequest.
+type FXQuoteRequest struct {
+	SourceAmount   float32  `json:"source_amount"`
+	SourceCurrency Currency `json:"source_currency"`
+	TargetCurrency Currency `json:"target_currency"`
+}
+
+// FXQuoteResponse defines model for FXQuoteResponse.
+type FXQuoteResponse struct {
+	Header ResponseHeader `json:"header"`
+	Quote  FXQuote        `json:"quote"`
+}
+
+// FeePreview defines model for FeePreview.
+type FeePreview struct {
+	Amount   *float32  `json:"amount,omitempty"`
+	Currency *Currency `json:"currency,omitempty"`
+	Fee      *float32  `json:"fee,omitempty"`
+
+	// TotalAmount Amount and fee deducted from the balance.
+	TotalAmount *float32 `json:"total_amount,omitempty"`
+	Type        *string  `json:"type,omitempty"`
+}
+
+// FeePreviewRequest defines model for FeePreviewRequest.
+type FeePreviewRequest struct {
+	Amount   float32   `json:"amount"`
+	Currency *Currency `json:"currency,omitempty"`
+
+	// Type Transaction type, one of TransferOut, Payment or Withdrawal.
+	Type string `json:"type"`
+}
+
+// FeePreviewResponse defines model for FeePreviewResponse.
+type FeePreviewResponse struct {
+	Fee    FeePreview     `json:"fee"`
+	Header ResponseHeader `json:"header"`
+}
+
+// GetUserResponse defines model for GetUserResponse.
+type GetUserResponse struct {
+	Header ResponseHeader `json:"header"`
+	User   User           `json:"user"`
+}
+
+// HealthResponse defines model for HealthResponse.
+type HealthResponse struct {
+	// Checks Result of each readiness check, "ok" or the reason it failed.
+	Checks *map[string]string `json:"checks,omitempty"`
+	Header ResponseHeader     `json:"header"`
+}
+
+// PhoneNumberChange Phone number change waiting for the OTP sent to the new phone number.
+type PhoneNumberChange struct {
+	ExpiryTime  *time.Time `json:"expiry_time,omitempty"`
+	PhoneNumber *string    `json:"phone_number,omitempty"`
+}
+
+// Pocket defines model for Pocket.
+type Pocket struct {
+	// Balance Balance in IDR.
+	Balance     *float32   `json:"balance,omitempty"`
+	CreatedTime *time.Time `json:"created_time,omitempty"`
+	Id          *int64     `json:"id,omitempty"`
+	Name        *string    `json:"name,omitempty"`
+
+	// TargetAmount Amount the user is saving for, not set if zero.
+	TargetAmount *float32 `json:"target_amount,omitempty"`
+
+	// TargetDate Date the user is saving for.
+	TargetDate *openapi_types.Date `json:"target_date,omitempty"`
+}
+
+// PocketRequest defines model for PocketRequest.
+type PocketRequest struct {
+	Name         string              `json:"name"`
+	TargetAmount *float32            `json:"target_amount,omitempty"`
+	TargetDate   *openapi_types.Date `json:"target_date,omitempty"`
+}
+
+// PocketResponse defines model for PocketResponse.
+type PocketResponse struct {
+	Header ResponseHeader `json:"header"`
+	Pocket Pocket         `json:"pocket"`
+}
+
+// QR defines model for QR.
+type QR struct {
+	Amount *float32 `json:"amount,omitempty"`
+
+	// Payload EMVCo merchant-presented QR payload, to be rendered as QR code by the client.
+	Payload *string `json:"payload,omitempty"`
+	Type    *QRType `json:"type,omitempty"`
+}
+
+// QRType defines model for QR.Type.
+type QRType string
+
+// QRPaymentRequest defines model for QRPaymentRequest.
+type QRPaymentRequest struct {
+	// Amount Amount to be paid. Required for static QR, must match the QR amount for dynamic QR.
+	Amount      *float32 `json:"amount,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	Password    *string  `json:"password,omitempty"`
+
+	// Payload Scanned EMVCo merchant-presented QR payload.
+	Payload string `json:"payload"`
+}
+
+// QRRequest defines model for QRRequest.
+type QRRequest struct {
+	// Amount Amount to be paid. Generates a dynamic QR if set, otherwise a static QR.
+	Amount *float32 `json:"amount,omitempty"`
+
+	// Description Purpose of transaction, only used for dynamic QR.
+	Description *string `json:"description,omitempty"`
+
+	// MerchantCategoryCode ISO 18245 merchant category code. Defaults to the category of a verified merchant, or 4829 (money transfer) for personal wallets, which can not use any other.
+	MerchantCategoryCode *string `json:"merchant_category_code,omitempty"`
+
+	// MerchantName Name shown to the payer. Defaults to User's full name.
+	MerchantName *string `json:"merchant_name,omitempty"`
+}
+
+// QRResponse defines model for QRResponse.
+type QRResponse struct {
+	Header ResponseHeader `json:"header"`
+	Qr     QR             `json:"qr"`
+}
+
+// Recipient defines model for Recipient.
+type Recipient struct {
+	ExpiryTime *time.Time `json:"expiry_time,omitempty"`
+
+	// Name Masked full name of the recipient for the sender to confirm, i.e. Jo** Do*.
+	Name *string `json:"name,omitempty"`
+
+	// Token Transfers to the recipient as recipient_token until expiry_time.
+	Token *string `json:"token,omitempty"`
+}
+
+// RecipientLookupRequest defines model for RecipientLookupRequest.
+type RecipientLookupRequest struct {
+	// PhoneNumber Phone number of the recipient, in the formats accepted for User's phone_number.
+	PhoneNumber string `json:"phone_number"`
+}
+
+// RecipientLookupResponse defines model for RecipientLookupResponse.
+type RecipientLookupResponse struct {
+	Header    ResponseHeader `json:"header"`
+	Recipient Recipient      `json:"recipient"`
+}
+
+// RegisterBankAccountRequest defines model for RegisterBankAccountRequest.
+type RegisterBankAccountRequest struct {
+	// AccountName Name of the bank account holder, without leading or trailing spaces.
+	AccountName   string `json:"account_name"`
+	AccountNumber string `json:"account_number"`
+
+	// BankCode Bank code, i.e. 014 for BCA.
+	BankCode string `json:"bank_code"`
+	Id       *int64 `json:"id,omitempty"`
+}
+
+// RegisterUserRequest defines model for RegisterUserRequest.
+type RegisterUserRequest struct {
+	// Balance Balance in IDR available to spend, money in pockets excluded.
+	Balance *float32 `json:"balance,omitempty"`
+
+	// Balances Balance in every currency the user holds.
+	Balances    *[]Balance `json:"balances,omitempty"`
+	CreatedTime *time.Time `json:"created_time,omitempty"`
+
+	// FullName User's full name, without leading or trailing spaces.
+	FullName string `json:"full_name"`
+	Id       *int64 `json:"id,omitempty"`
+
+	// Password User's password, containing a capital letter, a number and a special character.
+	Password string `json:"password"`
+
+	// PhoneNumber User's mobile phone number, returned in E.164 format, i.e. +628123456789. Local numbers of the default country, i.e. 0812-3456-789, are accepted, see validation in config.example.yml for the allowed countries.
+	PhoneNumber string `json:"phone_number"`
+
+	// Pockets Money in IDR the user has set aside, see CreatePocket.
+	Pockets *[]Pocket `json:"pockets,omitempty"`
+
+	// Status Frozen accounts can log in and read but not send, Closed accounts can not log in.
+	Status *UserStatus `json:"status,omitempty"`
+}
+
+// RegisterUserResponse defines model for RegisterUserResponse.
+type RegisterUserResponse struct {
+	Header ResponseHeader `json:"header"`
+	User   User           `json:"user"`
+}
+
+// ResponseHeader defines model for ResponseHeader.
+type ResponseHeader struct {
+	// Messages Array of error message(s).
+	Messages []string `json:"messages"`
+
+	// Success Boolean to denote whether response is OK or not.
+	Success bool `json:"success"`
+}
+
+// SharedWallet defines model for SharedWallet.
+type SharedWallet struct {
+	// Balance Balance in IDR.
+	Balance     *float32   `json:"balance,omitempty"`
+	CreatedTime *time.Time `json:"created_time,omitempty"`
+	Id          *int64     `json:"id,omitempty"`
+
+	// MonthlyLimit Maximum amount the user transfers out of the shared wallet in a calendar month, not set if no limit.
+	MonthlyLimit *float32 `json:"monthly_limit,omitempty"`
+
+	// MonthlySpent Amount the user transferred out of the shared wallet this month, fees excluded.
+	MonthlySpent *float32 `json:"monthly_spent,omitempty"`
+	Name         *string  `json:"name,omitempty"`
+	OwnerId      *int64   `json:"owner_id,omitempty"`
+
+	// Role Owner created the shared wallet, manages its members and is not limited. Spender transfers out of it within its limits. Viewer only views it and its activity. Every member can deposit into it.
+	Role *SharedWalletRole `json:"role,omitempty"`
+
+	// TransactionLimit Maximum amount of a transfer of the user out of the shared wallet, not set if no limit.
+	TransactionLimit *float32 `json:"transaction_limit,omitempty"`
+}
+
+// SharedWalletMember defines model for SharedWalletMember.
+type SharedWalletMember struct {
+	// Closed The account of the member is closed, it can not act on the shared wallet anymore.
+	Closed      *bool      `json:"closed,omitempty"`
+	CreatedTime *time.Time `json:"created_time,omitempty"`
+
+	// MonthlyLimit Maximum amount transferred out of the shared wallet in a calendar month, not set if no limit.
+	MonthlyLimit *float32 `json:"monthly_limit,omitempty"`
+
+	// MonthlySpent Amount transferred out of the shared wallet this month, fees excluded.
+	MonthlySpent *float32 `json:"monthly_spent,omitempty"`
+
+	// Name Masked full name of the member, i.e. Jo** Do*.
+	Name *string `json:"name,omitempty"`
+
+	// Role Owner created the shared wallet, manages its members and is not limited. Spender transfers out of it within its limits. Viewer only views it and its activity. Every member can deposit into it.
+	Role *SharedWalletRole `json:"role,omitempty"`
+
+	// TransactionLimit Maximum amount of a transfer out of the shared wallet, not set if no limit.
+	TransactionLimit *float32 `json:"transaction_limit,omitempty"`
+	UserId           *int64   `json:"user_id,omitempty"`
+}
+
+// SharedWalletMemberRequest defines model for SharedWalletMemberRequest.
+type SharedWalletMemberRequest struct {
+	// MonthlyLimit No limit if not set or zero.
+	MonthlyLimit *float32 `json:"monthly_limit,omitempty"`
+
+	// PhoneNumber Phone number of the member, counts as a recipient lookup, see LookupRecipient.
+	PhoneNumber *string `json:"phone_number,omitempty"`
+
+	// Role Owner created the shared wallet, manages its members and is not limited. Spender transfers out of it within its limits. Viewer only views it and its activity. Every member can deposit into it.
+	Role SharedWalletRole `json:"role"`
+
+	// Token Token of a recipient lookup of the member.
+	Token *string `json:"token,omitempty"`
+
+	// TransactionLimit No limit if not set or zero.
+	TransactionLimit *float32 `json:"transaction_limit,omitempty"`
+}
+
+// SharedWalletMemberResponse defines model for SharedWalletMemberResponse.
+type SharedWalletMemberResponse struct {
+	Header ResponseHeader     `json:"header"`
+	Member SharedWalletMember `json:"member"`
+}
+
+// SharedWalletMembersResponse defines model for SharedWalletMembersResponse.
+type SharedWalletMembersResponse struct {
+	Header  ResponseHeader       `json:"header"`
+	Members []SharedWalletMember `json:"members"`
+}
+
+// SharedWalletRequest defines model for SharedWalletRequest.
+type SharedWalletRequest struct {
+	Name string `json:"name"`
+}
+
+// SharedWalletResponse defines model for SharedWalletResponse.
+type SharedWalletResponse struct {
+	Header       ResponseHeader `json:"header"`
+	SharedWallet SharedWallet   `json:"shared_wallet"`
+}
+
+// SharedWalletRole Owner created the shared wallet, manages its members and is not limited. Spender transfers out of it within its limits. Viewer only views it and its activity. Every member can deposit into it.
+type SharedWalletRole string
+
+// SharedWalletsResponse defines model for SharedWalletsResponse.
+type SharedWalletsResponse struct {
+	Header        ResponseHeader `json:"header"`
+	SharedWallets []SharedWallet `json:"shared_wallets"`
+}
+
+// TopUpCallback defines model for TopUpCallback.
+type TopUpCallback struct {
+	Amount   float32    `json:"amount"`
+	PaidTime *time.Time `json:"paid_time,omitempty"`
+
+	// PaymentReference Unique reference of the payment assigned by the gateway.
+	PaymentReference     string `json:"payment_reference"`
+	VirtualAccountNumber string `json:"virtual_account_number"`
+}
+
+// TopUpIntent defines model for TopUpIntent.
+type TopUpIntent struct {
+	Amount               *float32           `json:"amount,omitempty"`
+	BankCode             *string            `json:"bank_code,omitempty"`
+	ExpiryTime           *time.Time         `json:"expiry_time,omitempty"`
+	Id                   *string            `json:"id,omitempty"`
+	Status               *TopUpIntentStatus `json:"status,omitempty"`
+	TransactionId        *string            `json:"transaction_id,omitempty"`
+	VirtualAccountNumber *string            `json:"virtual_account_number,omitempty"`
+}
+
+// TopUpIntentStatus defines model for TopUpIntent.Status.
+type TopUpIntentStatus string
+
+// TopUpRequest defines model for TopUpRequest.
+type TopUpRequest struct {
+	Amount float32 `json:"amount"`
+}
+
+// TopUpResponse defines model for TopUpResponse.
+type TopUpResponse struct {
+	Header ResponseHeader `json:"header"`
+	Topup  TopUpIntent    `json:"topup"`
+}
+
+// Transaction defines model for Transaction.
+type Transaction struct {
+	// ActorId Admin who made the transaction on the user's behalf, i.e. a balance adjustment.
+	ActorId *int64   `json:"actor_id,omitempty"`
+	Amount  *float32 `json:"amount,omitempty"`
+
+	// BankAccountId Destination bank account, required for Withdrawal.
+	BankAccountId     *int64     `json:"bank_account_id,omitempty"`
+	ConvertedAmount   *float32   `json:"converted_amount,omitempty"`
+	ConvertedCurrency *Currency  `json:"converted_currency,omitempty"`
+	CreatedTime       *time.Time `json:"created_time,omitempty"`
+	Currency          *Currency  `json:"currency,omitempty"`
+	Description       *string    `json:"description,omitempty"`
+
+	// Fee Fee charged on top of amount, in the same currency.
+	Fee *float32 `json:"fee,omitempty"`
+
+	// FxQuoteId Locked quote to use, required for Convert.
+	FxQuoteId *string `json:"fx_quote_id,omitempty"`
+	Id        *string `json:"id,omitempty"`
+	Password  *string `json:"password,omitempty"`
+
+	// PocketId Pocket the money of a PocketTransfer is moved into, the balance if not set.
+	PocketId    *int64 `json:"pocket_id,omitempty"`
+	RecipientId *int64 `json:"recipient_id,omitempty"`
+
+	// RecipientPhoneNumber Phone number of the recipient, alternative to recipient_id for TransferOut. Counts as a recipient lookup, see LookupRecipient.
+	RecipientPhoneNumber *string `json:"recipient_phone_number,omitempty"`
+
+	// RecipientToken Token of a recipient lookup, alternative to recipient_id for TransferOut once the recipient name is confirmed.
+	RecipientToken *string `json:"recipient_token,omitempty"`
+
+	// SharedWalletId Shared wallet a TransferOut is made out of, or a SharedWalletDeposit is made into, the balance if not set. user_id is then the member acting on the shared wallet.
+	SharedWalletId *int64 `json:"shared_wallet_id,omitempty"`
+
+	// SourcePocketId Pocket the money of a PocketTransfer is moved out of, the balance if not set.
+	SourcePocketId *int64             `json:"source_pocket_id,omitempty"`
+	Status         *TransactionStatus `json:"status,omitempty"`
+
+	// Type AdjustmentCredit and AdjustmentDebit are only made by admins, see AdminAdjustUserBalance. PocketTransfer moves money between the balance and the pockets of the user, it is free and does not count towards the transfer limits. SharedWalletDeposit moves money from the balance into the shared wallet of shared_wallet_id.
+	Type   *TransactionType `json:"type,omitempty"`
+	UserId *int64           `json:"user_id,omitempty"`
+}
+
+// TransactionRequest defines model for TransactionRequest.
+type TransactionRequest struct {
+	// ActorId Admin who made the transaction on the user's behalf, i.e. a balance adjustment.
+	ActorId *int64  `json:"actor_id,omitempty"`
+	Amount  float32 `json:"amount"`
+
+	// BankAccountId Destination bank account, required for Withdrawal.
+	BankAccountId     *int64     `json:"bank_account_id,omitempty"`
+	ConvertedAmount   *float32   `json:"converted_amount,omitempty"`
+	ConvertedCurrency *Currency  `json:"converted_currency,omitempty"`
+	CreatedTime       *time.Time `json:"created_time,omitempty"`
+	Currency          *Currency  `json:"currency,omitempty"`
+	Description       *string    `json:"description,omitempty"`
+
+	// Fee Fee charged on top of amount, in the same currency.
+	Fee *float32 `json:"fee,omitempty"`
+
+	// FxQuoteId Locked quote to use, required for Convert.
+	FxQuoteId *string `json:"fx_quote_id,omitempty"`
+	Id        *string `json:"id,omitempty"`
+	Password  *string `json:"password,omitempty"`
+
+	// PocketId Pocket the money of a PocketTransfer is moved into, the balance if not set.
+	PocketId    *int64 `json:"pocket_id,omitempty"`
+	RecipientId *int64 `json:"recipient_id,omitempty"`
+
+	// RecipientPhoneNumber Phone number of the recipient, alternative to recipient_id for TransferOut. Counts as a recipient lookup, see LookupRecipient.
+	RecipientPhoneNumber *string `json:"recipient_phone_number,omitempty"`
+
+	// RecipientToken Token of a recipient lookup, alternative to recipient_id for TransferOut once the recipient name is confirmed.
+	RecipientToken *string `json:"recipient_token,omitempty"`
+
+	// SharedWalletId Shared wallet a TransferOut is made out of, or a SharedWalletDeposit is made into, the balance if not set. user_id is then the member acting on the shared wallet.
+	SharedWalletId *int64 `json:"shared_wallet_id,omitempty"`
+
+	// SourcePocketId Pocket the money of a PocketTransfer is moved out of, the balance if not set.
+	SourcePocketId *int64             `json:"source_pocket_id,omitempty"`
+	Status         *TransactionStatus `json:"status,omitempty"`
+
+	// Type AdjustmentCredit and AdjustmentDebit are only made by admins, see AdminAdjustUserBalance. PocketTransfer moves money between the balance and the pockets of the user, it is free and does not count towards the transfer limits. SharedWalletDeposit moves money from the balance into the shared wallet of shared_wallet_id.
+	Type   TransactionType `json:"type"`
+	UserId *int64          `json:"user_id,omitempty"`
+}
+
+// TransactionResponse defines model for TransactionResponse.
+type TransactionResponse struct {
+	Header      ResponseHeader `json:"header"`
+	Transaction Transaction    `json:"transaction"`
+}
+
+// TransactionStatus defines model for TransactionStatus.
+type TransactionStatus string
+
+// TransactionType AdjustmentCredit and AdjustmentDebit are only made by admins, see AdminAdjustUserBalance. PocketTransfer moves money between the balance and the pockets of the user, it is free and does not count towards the transfer limits. SharedWalletDeposit moves money from the balance into the shared wallet of shared_wallet_id.
+type TransactionType string
+
+// TransactionsResponse defines model for TransactionsResponse.
+type TransactionsResponse struct {
+	Header       ResponseHeader `json:"header"`
+	Transactions []Transaction  `json:"transactions"`
+}
+
+// UpdateContactRequest defines model for UpdateContactRequest.
+type UpdateContactRequest struct {
+	Nickname string `json:"nickname"`
+}
+
+// UpdateSharedWalletMemberRequest defines model for UpdateSharedWalletMemberRequest.
+type UpdateSharedWalletMemberRequest struct {
+	// MonthlyLimit No limit if not set or zero.
+	MonthlyLimit *float32 `json:"monthly_limit,omitempty"`
+
+	// Role Owner created the shared wallet, manages its members and is not limited. Spender transfers out of it within its limits. Viewer only views it and its activity. Every member can deposit into it.
+	Role SharedWalletRole `json:"role"`
+
+	// TransactionLimit No limit if not set or zero.
+	TransactionLimit *float32 `json:"transaction_limit,omitempty"`
+}
+
+// UpdateUserRequest defines model for UpdateUserRequest.
+type UpdateUserRequest struct {
+	// Balance Balance in IDR available to spend, money in pockets excluded.
+	Balance *float32 `json:"balance,omitempty"`
+
+	// Balances Balance in every currency the user holds.
+	Balances    *[]Balance `json:"balances,omitempty"`
+	CreatedTime *time.Time `json:"created_time,omitempty"`
+
+	// FullName User's full name, without leading or trailing spaces.
+	FullName *string `json:"full_name,omitempty"`
+	Id       *int64  `json:"id,omitempty"`
+
+	// Password User's password, containing a capital letter, a number and a special character.
+	Password *string `json:"password,omitempty"`
+
+	// PhoneNumber User's mobile phone number, returned in E.164 format, i.e. +628123456789. Local numbers of the default country, i.e. 0812-3456-789, are accepted, see validation in config.example.yml for the allowed countries.
+	PhoneNumber *string `json:"phone_number,omitempty"`
+
+	// Pockets Money in IDR the user has set aside, see CreatePocket.
+	Pockets *[]Pocket `json:"pockets,omitempty"`
+
+	// Status Frozen accounts can log in and read but not send, Closed accounts can not log in.
+	Status *UserStatus `json:"status,omitempty"`
+}
+
+// UpdateUserResponse defines model for UpdateUserResponse.
+type UpdateUserResponse struct {
+	Header ResponseHeader `json:"header"`
+
+	// PhoneNumberChange Phone number change waiting for the OTP sent to the new phone number.
+	PhoneNumberChange *PhoneNumberChange `json:"phone_number_change,omitempty"`
+	User              User               `json:"user"`
+}
+
+// User defines model for User.
+type User struct {
+	// Balance Balance in IDR available to spend, money in pockets excluded.
+	Balance *float32 `json:"balance,omitempty"`
+
+	// Balances Balance in every currency the user holds.
+	Balances    *[]Balance `json:"balances,omitempty"`
+	CreatedTime *time.Time `json:"created_time,omitempty"`
+
+	// FullName User's full name, without leading or trailing spaces.
+	FullName *string `json:"full_name,omitempty"`
+	Id       *int64  `json:"id,omitempty"`
+
+	// Password User's password, containing a capital letter, a number and a special character.
+	Password *string `json:"password,omitempty"`
+
+	// PhoneNumber User's mobile phone number, returned in E.164 format, i.e. +628123456789. Local numbers of the default country, i.e. 0812-3456-789, are accepted, see validation in config.example.yml for the allowed countries.
+	PhoneNumber *string `json:"phone_number,omitempty"`
+
+	// Pockets Money in IDR the user has set aside, see CreatePocket.
+	Pockets *[]Pocket `json:"pockets,omitempty"`
+
+	// Status Frozen accounts can log in and read but not send, Closed accounts can not log in.
+	Status *UserStatus `json:"status,omitempty"`
+}
+
+// UserLoginRequest defines model for UserLoginRequest.
+type UserLoginRequest struct {
+	// Balance Balance in IDR available to spend, money in pockets excluded.
+	Balance *float32 `json:"balance,omitempty"`
+
+	// Balances Balance in every currency the user holds.
+	Balances    *[]Balance `json:"balances,omitempty"`
+	CreatedTime *time.Time `json:"created_time,omitempty"`
+
+	// FullName User's full name, without leading or trailing spaces.
+	FullName *string `json:"full_name,omitempty"`
+	Id       *int64  `json:"id,omitempty"`
+
+	// Password User's password, containing a capital letter, a number and a special character.
+	Password string `json:"password"`
+
+	// PhoneNumber User's mobile phone number, returned in E.164 format, i.e. +628123456789. Local numbers of the default country, i.e. 0812-3456-789, are accepted, see validation in config.example.yml for the allowed countries.
+	PhoneNumber string `json:"phone_number"`
+
+	// Pockets Money in IDR the user has set aside, see CreatePocket.
+	Pockets *[]Pocket `json:"pockets,omitempty"`
+
+	// Status Frozen accounts can log in and read but not send, Closed accounts can not log in.
+	Status *UserStatus `json:"status,omitempty"`
+}
+
+// UserLoginResponse defines model for UserLoginResponse.
+type UserLoginResponse struct {
+	Header ResponseHeader `json:"header"`
+	User   User           `json:"user"`
+}
+
+// UserStatus Frozen accounts can log in and read but not send, Closed accounts can not log in.
+type UserStatus string
+
+// UserStatusChange defines model for UserStatusChange.
+type UserStatusChange struct {
+	ActorId *int64 `json:"actor_id,omitempty"`
+
+	// FromStatus Frozen accounts can log in and read but not send, Closed accounts can not log in.
+	FromStatus *UserStatus `json:"from_status,omitempty"`
+	Id         *int64      `json:"id,omitempty"`
+	Reason     *string     `json:"reason,omitempty"`
+
+	// Status Frozen accounts can log in and read but not send, Closed accounts can not log in.
+	Status *UserStatus `json:"status,omitempty"`
+	UserId *int64      `json:"user_id,omitempty"`
+}
+
+// UserStatusChangeResponse defines model for UserStatusChangeResponse.
+type UserStatusChangeResponse struct {
+	Header       ResponseHeader   `json:"header"`
+	StatusChange UserStatusChange `json:"status_change"`
+}
+
+// UserStatusRequest defines model for UserStatusRequest.
+type UserStatusRequest struct {
+	// Reason Why the status is changed, kept in the audit trail.
+	Reason string `json:"reason"`
+
+	// Status Frozen accounts can log in and read but not send, Closed accounts can not log in.
+	Status UserStatus `json:"status"`
+}
+
+// UsersResponse defines model for UsersResponse.
+type UsersResponse struct {
+	Header ResponseHeader `json:"header"`
+	Users  []User         `json:"users"`
+}
+
+// VerifyPhoneNumberRequest defines model for VerifyPhoneNumberRequest.
+type VerifyPhoneNumberRequest struct {
+	// Otp OTP sent to the new phone number.
+	Otp string `json:"otp"`
+}
+
+// Limit defines model for Limit.
+type Limit = int
+
+// Offset defines model for Offset.
+type Offset = int
+
+// AdminGetAuditLogParams defines parameters for AdminGetAuditLog.
+type AdminGetAuditLogParams struct {
+	// UserId Only entries about this user.
+	UserId *int64 `form:"user_id,omitempty" json:"user_id,omitempty"`
+
+	// ActorId Only entries of actions performed by this user.
+	ActorId *int64       `form:"actor_id,omitempty" json:"actor_id,omitempty"`
+	Action  *AuditAction `form:"action,omitempty" json:"action,omitempty"`
+
+	// CreatedFrom Only entries recorded at or after this time.
+	CreatedFrom *time.Time `form:"created_from,omitempty" json:"created_from,omitempty"`
+
+	// CreatedTo Only entries recorded before this time.
+	CreatedTo *time.Time `form:"created_to,omitempty" json:"created_to,omitempty"`
+
+	// Limit Maximum number of results, 50 if not set.
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of results to skip.
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// AdminSearchUsersParams defines parameters for AdminSearchUsers.
+type AdminSearchUsersParams struct {
+	// PhoneNumber Normalized to E.164 like User's phone_number, i.e. 0812-3456-789 finds +628123456789.
+	PhoneNumber *string `form:"phone_number,omitempty" json:"phone_number,omitempty"`
+
+	// FullName Case-insensitive part of the full name.
+	FullName *string `form:"full_name,omitempty" json:"full_name,omitempty"`
+
+	// CreatedFrom Only users registered at or after this time.
+	CreatedFrom *time.Time `form:"created_from,omitempty" json:"created_from,omitempty"`
+
+	// CreatedTo Only users registered before this time.
+	CreatedTo *time.Time `form:"created_to,omitempty" json:"created_to,omitempty"`
+
+	// Limit Maximum number of results, 50 if not set.
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of results to skip.
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// AdminGetUserTransactionsParams defines parameters for AdminGetUserTransactions.
+type AdminGetUserTransactionsParams struct {
+	Type   *TransactionType   `form:"type,omitempty" json:"type,omitempty"`
+	Status *TransactionStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Limit Maximum number of results, 50 if not set.
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of results to skip.
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// DisbursementCallbackParams defines parameters for DisbursementCallback.
+type DisbursementCallbackParams struct {
+	XCallbackToken string `json:"X-Callback-Token"`
+}
+
+// TopUpCallbackParams defines parameters for TopUpCallback.
+type TopUpCallbackParams struct {
+	// XSignature Hex encoded HMAC-SHA256 of the raw request body using the shared callback secret.
+	XSignature string `json:"X-Signature"`
+}
+
+// GetContactSuggestionsParams defines parameters for GetContactSuggestions.
+type GetContactSuggestionsParams struct {
+	// Limit Maximum number of suggestions, 5 if not set.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetSharedWalletTransactionsParams defines parameters for GetSharedWalletTransactions.
+type GetSharedWalletTransactionsParams struct {
+	// Limit Maximum number of results, 50 if not set.
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of results to skip.
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// AdminAdjustUserBalanceJSONRequestBody defines body for AdminAdjustUserBalance for application/json ContentType.
+type AdminAdjustUserBalanceJSONRequestBody = BalanceAdjustmentRequest
+
+// AdminChangeUserStatusJSONRequestBody defines body for AdminChangeUserStatus for application/json ContentType.
+type AdminChangeUserStatusJSONRequestBody = UserStatusRequest
+
+// DisbursementCallbackJSONRequestBody defines body for DisbursementCallback for application/json ContentType.
+type DisbursementCallbackJSONRequestBody = DisbursementCallback
+
+// TopUpCallbackJSONRequestBody defines body for TopUpCallback for application/json ContentType.
+type TopUpCallbackJSONRequestBody = TopUpCallback
+
+// UpdateUserJSONRequestBody defines body for UpdateUser for application/json ContentType.
+type UpdateUserJSONRequestBody = UpdateUserRequest
+
+// RegisterUserJSONRequestBody defines body for RegisterUser for application/json ContentType.
+type RegisterUserJSONRequestBody = RegisterUserRequest
+
+// UserLoginJSONRequestBody defines body for UserLogin for application/json ContentType.
+type UserLoginJSONRequestBody = UserLoginRequest
+
+// VerifyUserPhoneNumberJSONRequestBody defines body for VerifyUserPhoneNumber for application/json ContentType.
+type VerifyUserPhoneNumberJSONRequestBody = VerifyPhoneNumberRequest
+
+// RegisterUserBankAccountJSONRequestBody defines body for RegisterUserBankAccount for application/json ContentType.
+type RegisterUserBankAccountJSONRequestBody = RegisterBankAccountRequest
+
+// AddContactJSONRequestBody defines body for AddContact for application/json ContentType.
+type AddContactJSONRequestBody = ContactRequest
+
+// UpdateContactJSONRequestBody defines body for UpdateContact for application/json ContentType.
+type UpdateContactJSONRequestBody = UpdateContactRequest
+
+// CreateUserFXQuoteJSONRequestBody defines body for CreateUserFXQuote for application/json ContentType.
+type CreateUserFXQuoteJSONRequestBody = FXQuoteRequest
+
+// CreatePocketJSONRequestBody defines body for CreatePocket for application/json ContentType.
+type CreatePocketJSONRequestBody = PocketRequest
+
+// UpdatePocketJSONRequestBody defines body for UpdatePocket for application/json ContentType.
+type UpdatePocketJSONRequestBody = PocketRequest
+
+// GenerateUserQRJSONRequestBody defines body for GenerateUserQR for application/json ContentType.
+type GenerateUserQRJSONRequestBody = QRRequest
+
+// CreateUserQRPaymentJSONRequestBody defines body for CreateUserQRPayment for application/json ContentType.
+type CreateUserQRPaymentJSONRequestBody = QRPaymentRequest
+
+// LookupRecipientJSONRequestBody defines body for LookupRecipient for application/json ContentType.
+type LookupRecipientJSONRequestBody = RecipientLookupRequest
+
+// CreateSharedWalletJSONRequestBody defines body for CreateSharedWallet for application/json ContentType.
+type CreateSharedWalletJSONRequestBody = SharedWalletRequest
+
+// AddSharedWalletMemberJSONRequestBody defines body for AddSharedWalletMember for application/json ContentType.
+type AddSharedWalletMemberJSONRequestBody = SharedWalletMemberRequest
+
+// UpdateSharedWalletMemberJSONRequestBody defines body for UpdateSharedWalletMember for application/json ContentType.
+type UpdateSharedWalletMemberJSONRequestBody = UpdateSharedWalletMemberRequest
+
+// CreateUserTopUpJSONRequestBody defines body for CreateUserTopUp for application/json ContentType.
+type CreateUserTopUpJSONRequestBody = TopUpRequest
+
+// CreateUserTransactionJSONRequestBody defines body for CreateUserTransaction for application/json ContentType.
+type CreateUserTransactionJSONRequestBody = TransactionRequest
+
+// PreviewUserTransactionFeeJSONRequestBody defines body for PreviewUserTransactionFee for application/json ContentType.
+type PreviewUserTransactionFeeJSONRequestBody = FeePreviewRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// List audit log entries in the order they were appended, requires the audit:read permission
+	// (GET /admin/v1/audit-log)
+	AdminGetAuditLog(ctx echo.Context, params AdminGetAuditLogParams) error
+	// Search users, requires the user:read_any permission
+	// (GET /admin/v1/users)
+	AdminSearchUsers(ctx echo.Context, params AdminSearchUsersParams) error
+	// Credit or debit the balance of any user with a mandatory reason, requires the balance:adjust permission
+	// (POST /admin/v1/users/{user_id}/balance-adjustments)
+	AdminAdjustUserBalance(ctx echo.Context, userId int) error
+	// Freeze, unfreeze or close the account of any user with a mandatory reason, requires the user:freeze permission
+	// (POST /admin/v1/users/{user_id}/status)
+	AdminChangeUserStatus(ctx echo.Context, userId int) error
+	// List transactions sent or received by any user, most recent first, requires the user:read_any permission
+	// (GET /admin/v1/users/{user_id}/transactions)
+	AdminGetUserTransactions(ctx echo.Context, userId int, params AdminGetUserTransactionsParams) error
+	// Liveness probe, the process is up and serving HTTP
+	// (GET /healthz)
+	Healthz(ctx echo.Context) error
+	// Readiness probe, every dependency needed to serve requests is ready
+	// (GET /readyz)
+	Readyz(ctx echo.Context) error
+	// Webhook for the disbursement gateway to report the result of a Withdrawal
+	// (POST /v1/disbursements/callback)
+	DisbursementCallback(ctx echo.Context, params DisbursementCallbackParams) error
+	// Webhook for the payment gateway to report a payment into a top-up virtual account
+	// (POST /v1/topups/callback)
+	TopUpCallback(ctx echo.Context, params TopUpCallbackParams) error
+	// Get an existing user
+	// (GET /v1/user)
+	GetUser(ctx echo.Context) error
+	// Update the profile of the user, a new phone number is only changed once verified with the OTP sent to it
+	// (PATCH /v1/user)
+	UpdateUser(ctx echo.Context) error
+	// Create a new user
+	// (POST /v1/user)
+	RegisterUser(ctx echo.Context) error
+	// Existing user login
+	// (POST /v1/user/login)
+	UserLogin(ctx echo.Context) error
+	// Change the phone number of the user to the one requested with PATCH /v1/user, with the OTP sent to it
+	// (POST /v1/user/phone-number/verify)
+	VerifyUserPhoneNumber(ctx echo.Context) error
+	// List bank accounts registered by a specific user for Withdrawal
+	// (GET /v1/user/{user_id}/bank-accounts)
+	GetUserBankAccounts(ctx echo.Context, userId int) error
+	// Register a bank account for a specific user as Withdrawal destination
+	// (POST /v1/user/{user_id}/bank-accounts)
+	RegisterUserBankAccount(ctx echo.Context, userId int) error
+	// List the saved contacts of the user by nickname, with the current masked name of each recipient
+	// (GET /v1/user/{user_id}/contacts)
+	GetUserContacts(ctx echo.Context, userId int) error
+	// Save a recipient as a contact of the user
+	// (POST /v1/user/{user_id}/contacts)
+	AddContact(ctx echo.Context, userId int) error
+	// Suggest recipients of recent successful transfers of the user who are not contacts yet, ranked by frequency and recency
+	// (GET /v1/user/{user_id}/contacts/suggestions)
+	GetContactSuggestions(ctx echo.Context, userId int, params GetContactSuggestionsParams) error
+	// Delete a contact of the user
+	// (DELETE /v1/user/{user_id}/contacts/{contact_id})
+	DeleteContact(ctx echo.Context, userId int, contactId int) error
+	// Rename a contact of the user
+	// (PATCH /v1/user/{user_id}/contacts/{contact_id})
+	UpdateContact(ctx echo.Context, userId int, contactId int) error
+	// Quote converting between currencies of a specific user, the quote is locked for a limited time
+	// (POST /v1/user/{user_id}/fx-quotes)
+	CreateUserFXQuote(ctx echo.Context, userId int) error
+	// Create an empty pocket for the user to set money aside in
+	// (POST /v1/user/{user_id}/pockets)
+	CreatePocket(ctx echo.Context, userId int) error
+	// Delete an empty pocket of the user
+	// (DELETE /v1/user/{user_id}/pockets/{pocket_id})
+	DeletePocket(ctx echo.Context, userId int, pocketId int) error
+	// Rename a pocket of the user or change its target
+	// (PATCH /v1/user/{user_id}/pockets/{pocket_id})
+	UpdatePocket(ctx echo.Context, userId int, pocketId int) error
+	// Generate a QRIS-compatible QR payload to receive payment into a specific user's wallet
+	// (POST /v1/user/{user_id}/qr)
+	GenerateUserQR(ctx echo.Context, userId int) error
+	// Pay a scanned QR payload from a specific user's wallet
+	// (POST /v1/user/{user_id}/qr/payments)
+	CreateUserQRPayment(ctx echo.Context, userId int) error
+	// Look up the recipient of a transfer by phone number, returning its masked name to be confirmed and a short-lived token to transfer with
+	// (POST /v1/user/{user_id}/recipient-lookups)
+	LookupRecipient(ctx echo.Context, userId int) error
+	// List the shared wallets the user is a member of, with the role and limits of the user in each
+	// (GET /v1/user/{user_id}/shared-wallets)
+	GetUserSharedWallets(ctx echo.Context, userId int) error
+	// Create an empty shared wallet owned by the user
+	// (POST /v1/user/{user_id}/shared-wallets)
+	CreateSharedWallet(ctx echo.Context, userId int) error
+	// List the members of a shared wallet owned by the user, with what each spent this month
+	// (GET /v1/user/{user_id}/shared-wallets/{shared_wallet_id}/members)
+	GetSharedWalletMembers(ctx echo.Context, userId int, sharedWalletId int) error
+	// Add a member to a shared wallet owned by the user
+	// (POST /v1/user/{user_id}/shared-wallets/{shared_wallet_id}/members)
+	AddSharedWalletMember(ctx echo.Context, userId int, sharedWalletId int) error
+	// Remove a member from a shared wallet owned by the user, its transactions are kept
+	// (DELETE /v1/user/{user_id}/shared-wallets/{shared_wallet_id}/members/{member_id})
+	RemoveSharedWalletMember(ctx echo.Context, userId int, sharedWalletId int, memberId int) error
+	// Change the role or limits of a member of a shared wallet owned by the user
+	// (PATCH /v1/user/{user_id}/shared-wallets/{shared_wallet_id}/members/{member_id})
+	UpdateSharedWalletMember(ctx echo.Context, userId int, sharedWalletId int, memberId int) error
+	// List transactions of a shared wallet, most recent first
+	// (GET /v1/user/{user_id}/shared-wallets/{shared_wallet_id}/transactions)
+	GetSharedWalletTransactions(ctx echo.Context, userId int, sharedWalletId int, params GetSharedWalletTransactionsParams) error
+	// Create a top-up for a specific user, returns a virtual account to pay into
+	// (POST /v1/user/{user_id}/topups)
+	CreateUserTopUp(ctx echo.Context, userId int) error
+	// Get the status of a top-up of a specific user
+	// (GET /v1/user/{user_id}/topups/{topup_id})
+	GetUserTopUp(ctx echo.Context, userId int, topupId string) error
+	// Create a new transaction for a specific user
+	// (POST /v1/user/{user_id}/transactions)
+	CreateUserTransaction(ctx echo.Context, userId int) error
+	// Preview the fee charged for a transaction before performing it
+	// (POST /v1/user/{user_id}/transactions/fee)
+	PreviewUserTransactionFee(ctx echo.Context, userId int) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// AdminGetAuditLog converts echo context to params.
+func (w *ServerInterfaceWrapper) AdminGetAuditLog(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminGetAuditLogParams
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", ctx.QueryParams(), &params.UserId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "actor_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor_id", ctx.QueryParams(), &params.ActorId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter actor_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", ctx.QueryParams(), &params.Action)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter action: %s", err))
+	}
+
+	// ------------- Optional query parameter "created_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_from", ctx.QueryParams(), &params.CreatedFrom)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_from: %s", err))
+	}
+
+	// ------------- Optional query parameter "created_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_to", ctx.QueryParams(), &params.CreatedTo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_to: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AdminGetAuditLog(ctx, params)
+	return err
+}
+
+// AdminSearchUsers converts echo context to params.
+func (w *ServerInterfaceWrapper) AdminSearchUsers(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminSearchUsersParams
+	// ------------- Optional query parameter "phone_number" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "phone_number", ctx.QueryParams(), &params.PhoneNumber)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter phone_number: %s", err))
+	}
+
+	// ------------- Optional query parameter "full_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "full_name", ctx.QueryParams(), &params.FullName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter full_name: %s", err))
+	}
+
+	// ------------- Optional query parameter "created_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_from", ctx.QueryParams(), &params.CreatedFrom)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_from: %s", err))
+	}
+
+	// ------------- Optional query parameter "created_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_to", ctx.QueryParams(), &params.CreatedTo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_to: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AdminSearchUsers(ctx, params)
+	return err
+}
+
+// AdminAdjustUserBalance converts echo context to params.
+func (w *ServerInterfaceWrapper) AdminAdjustUserBalance(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AdminAdjustUserBalance(ctx, userId)
+	return err
+}
+
+// AdminChangeUserStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) AdminChangeUserStatus(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AdminChangeUserStatus(ctx, userId)
+	return err
+}
+
+// AdminGetUserTransactions converts echo context to params.
+func (w *ServerInterfaceWrapper) AdminGetUserTransactions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminGetUserTransactionsParams
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", ctx.QueryParams(), &params.Type)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AdminGetUserTransactions(ctx, userId, params)
+	return err
+}
+
+// Healthz converts echo context to params.
+func (w *ServerInterfaceWrapper) Healthz(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.Healthz(ctx)
+	return err
+}
+
+// Readyz converts echo context to params.
+func (w *ServerInterfaceWrapper) Readyz(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.Readyz(ctx)
+	return err
+}
+
+// DisbursementCallback converts echo context to params.
+func (w *ServerInterfaceWrapper) DisbursementCallback(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DisbursementCallbackParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Callback-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Callback-Token")]; found {
+		var XCallbackToken string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Callback-Token, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Callback-Token", valueList[0], &XCallbackToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Callback-Token: %s", err))
+		}
+
+		params.XCallbackToken = XCallbackToken
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Callback-Token is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DisbursementCallback(ctx, params)
+	return err
+}
+
+// TopUpCallback converts echo context to params.
+func (w *ServerInterfaceWrapper) TopUpCallback(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TopUpCallbackParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Signature" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Signature")]; found {
+		var XSignature string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Signature, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Signature", valueList[0], &XSignature, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Signature: %s", err))
+		}
+
+		params.XSignature = XSignature
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Signature is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TopUpCallback(ctx, params)
+	return err
+}
+
+// GetUser converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUser(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUser(ctx)
+	return err
+}
+
+// UpdateUser converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateUser(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateUser(ctx)
+	return err
+}
+
+// RegisterUser converts echo context to params.
+func (w *ServerInterfaceWrapper) RegisterUser(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RegisterUser(ctx)
+	return err
+}
+
+// UserLogin converts echo context to params.
+func (w *ServerInterfaceWrapper) UserLogin(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserLogin(ctx)
+	return err
+}
+
+// VerifyUserPhoneNumber converts echo context to params.
+func (w *ServerInterfaceWrapper) VerifyUserPhoneNumber(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.VerifyUserPhoneNumber(ctx)
+	return err
+}
+
+// GetUserBankAccounts converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUserBankAccounts(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUserBankAccounts(ctx, userId)
+	return err
+}
+
+// RegisterUserBankAccount converts echo context to params.
+func (w *ServerInterfaceWrapper) RegisterUserBankAccount(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RegisterUserBankAccount(ctx, userId)
+	return err
+}
+
+// GetUserContacts converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUserContacts(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUserContacts(ctx, userId)
+	return err
+}
+
+// AddContact converts echo context to params.
+func (w *ServerInterfaceWrapper) AddContact(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AddContact(ctx, userId)
+	return err
+}
+
+// GetContactSuggestions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetContactSuggestions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetContactSuggestionsParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetContactSuggestions(ctx, userId, params)
+	return err
+}
+
+// DeleteContact converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteContact(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// ------------- Path parameter "contact_id" -------------
+	var contactId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "contact_id", ctx.Param("contact_id"), &contactId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter contact_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteContact(ctx, userId, contactId)
+	return err
+}
+
+// UpdateContact converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateContact(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// ------------- Path parameter "contact_id" -------------
+	var contactId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "contact_id", ctx.Param("contact_id"), &contactId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter contact_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateContact(ctx, userId, contactId)
+	return err
+}
+
+// CreateUserFXQuote converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateUserFXQuote(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateUserFXQuote(ctx, userId)
+	return err
+}
+
+// CreatePocket converts echo context to params.
+func (w *ServerInterfaceWrapper) CreatePocket(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreatePocket(ctx, userId)
+	return err
 }
 
-// RegisterUserResponse defines model for RegisterUserResponse.
-type RegisterUserResponse struct {
-	Header ResponseHeader `json:"header"`
-	User   User           `json:"user"`
+// DeletePocket converts echo context to params.
+func (w *ServerInterfaceWrapper) DeletePocket(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// ------------- Path parameter "pocket_id" -------------
+	var pocketId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pocket_id", ctx.Param("pocket_id"), &pocketId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pocket_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeletePocket(ctx, userId, pocketId)
+	return err
 }
 
-// ResponseHeader defines model for ResponseHeader.
-type ResponseHeader struct {
-	// Messages Array of error message(s).
-	Messages []string `json:"messages"`
+// UpdatePocket converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdatePocket(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId int
 
-	// Success Boolean to denote whether response is OK or not.
-	Success bool `json:"success"`
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// ------------- Path parameter "pocket_id" -------------
+	var pocketId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pocket_id", ctx.Param("pocket_id"), &pocketId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pocket_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdatePocket(ctx, userId, pocketId)
+	return err
 }
 
-// Transaction defines model for Transaction.
-type Transaction struct {
-	Amount      *float32         `json:"amount,omitempty"`
-	Description *string          `json:"description,omitempty"`
-	Id          *string          `json:"id,omitempty"`
-	Password    *string          `json:"password,omitempty"`
-	RecipientId *int64           `json:"recipient_id,omitempty"`
-	Type        *TransactionType `json:"type,omitempty"`
-	UserId      *int64           `json:"user_id,omitempty"`
+// GenerateUserQR converts echo context to params.
+func (w *ServerInterfaceWrapper) GenerateUserQR(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GenerateUserQR(ctx, userId)
+	return err
 }
 
-// TransactionType defines model for Transaction.Type.
-type TransactionType string
+// CreateUserQRPayment converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateUserQRPayment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId int
 
-// TransactionResponse defines model for TransactionResponse.
-type TransactionResponse struct {
-	Header      ResponseHeader `json:"header"`
-	Transaction Transaction    `json:"transaction"`
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateUserQRPayment(ctx, userId)
+	return err
 }
 
-// User defines model for User.
-type User struct {
-	Balance *float32 `json:"balance,omitempty"`
+// LookupRecipient converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupRecipient(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId int
 
-	// FullName User's full name.
-	FullName *string `json:"full_name,omitempty"`
-	Id       *int64  `json:"id,omitempty"`
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
 
-	// Password User's password.
-	Password *string `json:"password,omitempty"`
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.LookupRecipient(ctx, userId)
+	return err
+}
 
-	// PhoneNumber User's phone number.
-	PhoneNumber *string `json:"phone_number,omitempty"`
+// GetUserSharedWallets converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUserSharedWallets(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUserSharedWallets(ctx, userId)
+	return err
 }
 
-// UserLoginResponse defines model for UserLoginResponse.
-type UserLoginResponse struct {
-	Header ResponseHeader `json:"header"`
-	User   User           `json:"user"`
+// CreateSharedWallet converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateSharedWallet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateSharedWallet(ctx, userId)
+	return err
 }
 
-// RegisterUserJSONRequestBody defines body for RegisterUser for application/json ContentType.
-type RegisterUserJSONRequestBody = User
+// GetSharedWalletMembers converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSharedWalletMembers(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId int
 
-// UserLoginJSONRequestBody defines body for UserLogin for application/json ContentType.
-type UserLoginJSONRequestBody = User
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
 
-// CreateUserTransactionJSONRequestBody defines body for CreateUserTransaction for application/json ContentType.
-type CreateUserTransactionJSONRequestBody = Transaction
+	// ------------- Path parameter "shared_wallet_id" -------------
+	var sharedWalletId int
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Get an existing user
-	// (GET /v1/user)
-	GetUser(ctx echo.Context) error
-	// Create a new user
-	// (POST /v1/user)
-	RegisterUser(ctx echo.Context) error
-	// Existing user login
-	// (POST /v1/user/login)
-	UserLogin(ctx echo.Context) error
-	// Create a new transaction for a specific user
-	// (POST /v1/user/{user_id}/transactions)
-	CreateUserTransaction(ctx echo.Context, userId int) error
+	err = runtime.BindStyledParameterWithOptions("simple", "shared_wallet_id", ctx.Param("shared_wallet_id"), &sharedWalletId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter shared_wallet_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSharedWalletMembers(ctx, userId, sharedWalletId)
+	return err
 }
 
-// ServerInterfaceWrapper converts echo contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
+// AddSharedWalletMember converts echo context to params.
+func (w *ServerInterfaceWrapper) AddSharedWalletMember(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// ------------- Path parameter "shared_wallet_id" -------------
+	var sharedWalletId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "shared_wallet_id", ctx.Param("shared_wallet_id"), &sharedWalletId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter shared_wallet_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AddSharedWalletMember(ctx, userId, sharedWalletId)
+	return err
 }
 
-// GetUser converts echo context to params.
-func (w *ServerInterfaceWrapper) GetUser(ctx echo.Context) error {
+// RemoveSharedWalletMember converts echo context to params.
+func (w *ServerInterfaceWrapper) RemoveSharedWalletMember(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// ------------- Path parameter "shared_wallet_id" -------------
+	var sharedWalletId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "shared_wallet_id", ctx.Param("shared_wallet_id"), &sharedWalletId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter shared_wallet_id: %s", err))
+	}
+
+	// ------------- Path parameter "member_id" -------------
+	var memberId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "member_id", ctx.Param("member_id"), &memberId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter member_id: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetUser(ctx)
+	err = w.Handler.RemoveSharedWalletMember(ctx, userId, sharedWalletId, memberId)
 	return err
 }
 
-// RegisterUser converts echo context to params.
-func (w *ServerInterfaceWrapper) RegisterUser(ctx echo.Context) error {
+// UpdateSharedWalletMember converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateSharedWalletMember(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// ------------- Path parameter "shared_wallet_id" -------------
+	var sharedWalletId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "shared_wallet_id", ctx.Param("shared_wallet_id"), &sharedWalletId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter shared_wallet_id: %s", err))
+	}
+
+	// ------------- Path parameter "member_id" -------------
+	var memberId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "member_id", ctx.Param("member_id"), &memberId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter member_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateSharedWalletMember(ctx, userId, sharedWalletId, memberId)
+	return err
+}
+
+// GetSharedWalletTransactions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSharedWalletTransactions(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// ------------- Path parameter "shared_wallet_id" -------------
+	var sharedWalletId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "shared_wallet_id", ctx.Param("shared_wallet_id"), &sharedWalletId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter shared_wallet_id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSharedWalletTransactionsParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.RegisterUser(ctx)
+	err = w.Handler.GetSharedWalletTransactions(ctx, userId, sharedWalletId, params)
 	return err
 }
 
-// UserLogin converts echo context to params.
-func (w *ServerInterfaceWrapper) UserLogin(ctx echo.Context) error {
+// CreateUserTopUp converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateUserTopUp(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateUserTopUp(ctx, userId)
+	return err
+}
+
+// GetUserTopUp converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUserTopUp(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// ------------- Path parameter "topup_id" -------------
+	var topupId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "topup_id", ctx.Param("topup_id"), &topupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter topup_id: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UserLogin(ctx)
+	err = w.Handler.GetUserTopUp(ctx, userId, topupId)
 	return err
 }
 
@@ -149,7 +1950,7 @@ func (w *ServerInterfaceWrapper) CreateUserTransaction(ctx echo.Context) error {
 	// ------------- Path parameter "user_id" -------------
 	var userId int
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, ctx.Param("user_id"), &userId)
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
 	}
@@ -159,6 +1960,22 @@ func (w *ServerInterfaceWrapper) CreateUserTransaction(ctx echo.Context) error {
 	return err
 }
 
+// PreviewUserTransactionFee converts echo context to params.
+func (w *ServerInterfaceWrapper) PreviewUserTransactionFee(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PreviewUserTransactionFee(ctx, userId)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -187,31 +2004,179 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/admin/v1/audit-log", wrapper.AdminGetAuditLog)
+	router.GET(baseURL+"/admin/v1/users", wrapper.AdminSearchUsers)
+	router.POST(baseURL+"/admin/v1/users/:user_id/balance-adjustments", wrapper.AdminAdjustUserBalance)
+	router.POST(baseURL+"/admin/v1/users/:user_id/status", wrapper.AdminChangeUserStatus)
+	router.GET(baseURL+"/admin/v1/users/:user_id/transactions", wrapper.AdminGetUserTransactions)
+	router.GET(baseURL+"/healthz", wrapper.Healthz)
+	router.GET(baseURL+"/readyz", wrapper.Readyz)
+	router.POST(baseURL+"/v1/disbursements/callback", wrapper.DisbursementCallback)
+	router.POST(baseURL+"/v1/topups/callback", wrapper.TopUpCallback)
 	router.GET(baseURL+"/v1/user", wrapper.GetUser)
+	router.PATCH(baseURL+"/v1/user", wrapper.UpdateUser)
 	router.POST(baseURL+"/v1/user", wrapper.RegisterUser)
 	router.POST(baseURL+"/v1/user/login", wrapper.UserLogin)
+	router.POST(baseURL+"/v1/user/phone-number/verify", wrapper.VerifyUserPhoneNumber)
+	router.GET(baseURL+"/v1/user/:user_id/bank-accounts", wrapper.GetUserBankAccounts)
+	router.POST(baseURL+"/v1/user/:user_id/bank-accounts", wrapper.RegisterUserBankAccount)
+	router.GET(baseURL+"/v1/user/:user_id/contacts", wrapper.GetUserContacts)
+	router.POST(baseURL+"/v1/user/:user_id/contacts", wrapper.AddContact)
+	router.GET(baseURL+"/v1/user/:user_id/contacts/suggestions", wrapper.GetContactSuggestions)
+	router.DELETE(baseURL+"/v1/user/:user_id/contacts/:contact_id", wrapper.DeleteContact)
+	router.PATCH(baseURL+"/v1/user/:user_id/contacts/:contact_id", wrapper.UpdateContact)
+	router.POST(baseURL+"/v1/user/:user_id/fx-quotes", wrapper.CreateUserFXQuote)
+	router.POST(baseURL+"/v1/user/:user_id/pockets", wrapper.CreatePocket)
+	router.DELETE(baseURL+"/v1/user/:user_id/pockets/:pocket_id", wrapper.DeletePocket)
+	router.PATCH(baseURL+"/v1/user/:user_id/pockets/:pocket_id", wrapper.UpdatePocket)
+	router.POST(baseURL+"/v1/user/:user_id/qr", wrapper.GenerateUserQR)
+	router.POST(baseURL+"/v1/user/:user_id/qr/payments", wrapper.CreateUserQRPayment)
+	router.POST(baseURL+"/v1/user/:user_id/recipient-lookups", wrapper.LookupRecipient)
+	router.GET(baseURL+"/v1/user/:user_id/shared-wallets", wrapper.GetUserSharedWallets)
+	router.POST(baseURL+"/v1/user/:user_id/shared-wallets", wrapper.CreateSharedWallet)
+	router.GET(baseURL+"/v1/user/:user_id/shared-wallets/:shared_wallet_id/members", wrapper.GetSharedWalletMembers)
+	router.POST(baseURL+"/v1/user/:user_id/shared-wallets/:shared_wallet_id/members", wrapper.AddSharedWalletMember)
+	router.DELETE(baseURL+"/v1/user/:user_id/shared-wallets/:shared_wallet_id/members/:member_id", wrapper.RemoveSharedWalletMember)
+	router.PATCH(baseURL+"/v1/user/:user_id/shared-wallets/:shared_wallet_id/members/:member_id", wrapper.UpdateSharedWalletMember)
+	router.GET(baseURL+"/v1/user/:user_id/shared-wallets/:shared_wallet_id/transactions", wrapper.GetSharedWalletTransactions)
+	router.POST(baseURL+"/v1/user/:user_id/topups", wrapper.CreateUserTopUp)
+	router.GET(baseURL+"/v1/user/:user_id/topups/:topup_id", wrapper.GetUserTopUp)
 	router.POST(baseURL+"/v1/user/:user_id/transactions", wrapper.CreateUserTransaction)
+	router.POST(baseURL+"/v1/user/:user_id/transactions/fee", wrapper.PreviewUserTransactionFee)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9RWbW/kNBD+K5ZBAqTQbLmCRL7REy8VoJOO9tOpqrzOZNenxDbjyZZqlf+Oxk662a73",
-	"rlQL6D419c7r8zwez1Zq13lnwVKQ1VYGvYZOxc+fgW4C4FsI3tkAfOTReUAyEA3WoGpA/vocoZGV/Kzc",
-	"BSvHSOXk/0uyHgrZh497cWY5DIVE+LM3CLWs3k0Jxwi3haQHD7KSbvkeNHHot7AygQA/wcL3kh2U3EEI",
-	"apW+awgajSfjrKzkD4jqQbhGAKJDMRp+Gb46k4U0BF30GRMGQmNXnHA8UOzN/4deawiZ+JfOtaCsICdq",
-	"sI5A3K+B1oACx5qFCeLNr8KhsI446xh7mTwP0JhSFbuucpBco7JB6VTGUzxU53pL/NU47BTJSjatU7TL",
-	"bvtumWjb6ycDhamzx16FcO8w/yOCNt6Apbvk/ViFsfTdxa4KYwlWqYx0spVg+45hiP01gG96rvra+Rs/",
-	"w2GXijXz3CzDh3E8/ZWgfZI+5Dzn8+gFmcfLieIm5G7HUrXKanieHJq+be+s6uBQ6xz9iyDYQrDFTMxP",
-	"5fIMwucCyuaZDLJp/NpZuBvLPhqBjUQyykQZjiD4m1sZ+wnNR7Y3tnEcvDUaxqoTifL3q+soRUMtjNCI",
-	"PwA3RoMs5AYwJMzOzxZnC7Z0HqzyRlbyVTxiqmgdWy835+XUxgrigGFkFMN+VctqehUlN5D6j37fLBb8",
-	"RztLkOaS8r41OjqW70O6HgmEj0H09OGN7R/SLzSCIqjFOE9ZtXGUXyxeHQrmJ4dLU9dg2eLbVO2+xZUl",
-	"QKtaEQA3gOk9iVSFvusUPqTuhbIC/jKBjF2JCBWL1YUMVvPXWCbGIdClqx9OhtUopz09EfYwHPBzfrKc",
-	"2SXjn5OUoeBS1WJESXwtruxGtaYWxvqeks/3hz6vnW1ak+72i3l9HasUSli4H0kdisfLULY8L+KcyPL8",
-	"OFL+d5IXJ825PyYzDEeDGbUvJfbFvP04v4gi0bTH3HbcHoZy9rKG41QmIXDz8+eaBySqDggwyOrdVrIa",
-	"4tCUxTSFpzXlKUnFDPCDdeX23xHM/qrxnw6H3LaVUc7M7MQj4iK/LPBuLhrX2/p0g2ImKdE4FEoED9o0",
-	"Rk8zhH1jsCSbHltZyTWRr8qydVq1a1bhcDv8HQAA///W1FowiA4AAA==",
+	"H4sIAAAAAAAC/+w9a3PbNrZ/BcO7M9t2afnRJNv6y05qt03ubZrETrY7t831QOSRhDUFsABoRfX4v9/B",
+	"iwRJkKJkybZ2+ikORRAHwHm/cBslbJ4zClSK6PQ2yjHHc5DA9f9+InMi1R8piISTXBJGo9PoDf5M5sUc",
+	"0WI+Bo7YBHEQRSZFjJ4fITJBlEkkQI6iOCJqwO8F8GUURxTPITqNMv3ZOBLJDOZYfX9uvhidnhwdxdGc",
+	"UPO/4ziSy1wNIVTCFHh0dxdHbycTAQGwfm6CgyRD4prkXXAw86EaIG7qo8DUd+5NvTkvi5TIl4mZ/DYC",
+	"qob9GhUC+ChjU0KjOBrjDNMERkWeYglRHOF0TujIPr7C6b8LIedAZfmTGn4lJJaFuEpmmE4h+lSCIiQn",
+	"dBrdxWbyn9j0eyr5Uh8cZzlwSUDDhkuw/sJhEp1G/3VYnfOhXcShv4K7WA1i/IqkatiE8TmWZvUvnkXt",
+	"zYijhAOWkF5JMofaELXUA/00AHgKEpPMAJmmRM2Ns3c14Ftj7AM2/jckUj2YYTELvjkYeJIHx+ccbq46",
+	"v67PBk+Byu6fB0JwF0ccfi8Ih1QhDUmj2B1atUeNPfbBs3vwKbA5DjUuQOSMCmhjB1DJ7Z9EwlwMwpMS",
+	"2aoDwZxj/f8Z4BT4qs84gF6Zt5t7YD8Sl+CFFvedoZwAxs9ZQWVt7ycZw7Lae8OtNOYWnANNlqsAPnPv",
+	"3d11g/KypOEL+L0AIftgqzOsMw4pkZAqlpkzQSS5gRilMHYPKUyxeUjhBjj6AzhTzGwXS1SHgQWjbTB/",
+	"mS2RnAGyXAsRgQzjgjRG15BLhIV+QXJMhcFi5H1CQTzHn38COpWz6PTk+XPN4t3/v46jHEsJXM31f7/9",
+	"dvnF6Kvffrv88h9/afOPBsrYjS1BD2MMvX6ZJO4EmnxS/3BlBEJLoOA5KHFiFk+vkX0dzViWAo/RgsgZ",
+	"KyTKAKeEThHjag9Ipv4WOU5ANNb+4mjjpccVsOaUT2/9wb8eHXz76fZ5fHJ0Fxys4L9KWBpYptohpH6K",
+	"ERnBCB0dP0MTxtF3Zy8V+P4kLw/+Fx/8oaf6Oj4+Dk81nAX2HVY3+9JLwdWR9uG3f/pbZ1Q1QFbgnhi2",
+	"nuFMubGy3bLkOoihpZ7hLBvj5Lp7mduEKAgBoxInASLfTFEZrEpQklw7/tH6CoeE5ASovEoyJiBtU9+H",
+	"GZR8xfKacpBitWZcjIhECaZateaQALmx/HYCXCBMl3PGYVQBOGYsA0zrMAxeUzUkzBmN2JBojsU1pGhS",
+	"ZBmiHrcsx1uW8t/sq6/QOftqFGTpXUfZKVD9Lfe46/OjuO8ESGD339JsibC34wr6QgBHMywQzjjgdFlu",
+	"NIcUyboEHrKH+YxR8Nh2HYR36lfPlmrsn6E5JWJ9MDPGros8RgIA/aT/vnC/NUTO1ycNIfO3fyhZgb74",
+	"cnTw6W9BBl5BLtk1BDSCD+qxgrUN0mil0C4P71PfyXdxkaSi8l69xr62dU7o5u8B/rKYTkE4A6wOfoaF",
+	"vHLotCZH6sfkS3wDqUETC6NWT5A/Sh3ZyzS1cK6Nx2Fe8Ob+PCCOyi1JwqryZZEkIMSkyDyuJ1mDXTKl",
+	"JKtHapvRt0coxUsxGqh7tE5PbFuWxZGoPj5Y0LexqiXuu5DVn64HYcVKclsb2t2rJCVkwYV55o9zzLw+",
+	"v4ji6PLH8yiOPl6eB90q55CBhJVMaNeqjAHjHUuu4dGhuJxhDukvOMtAvgElpB4RIiLGBRcwByqdwtmG",
+	"YoJJVnC4quzZACudgEKQADvz50DlewgLQaYUUjQ2xvAUS1jgZZCZGfedj3sV+4ri6AdMMkiD+OdZ0EEm",
+	"//rccddfiJylHC9wVjO7hYIaC0RSmOdMKhpA17BEhOpRaX1xWr1aLbEbUJULDB3RD/96XzAZwA34nBO+",
+	"XFPkTSBwQj8AqPUIVvAEkPN0xKW2lkJaJFKJJM7m9rUr4ypAY5gwDkpC3gAX1jmx2p1izqKNRlg2FsOK",
+	"cQahL9TAGOamskM2ceVIzKcg15rNDtmOb8xiQacC39oN+JxkhSA38MY54CUvIHA0AQf9Nndso+X7tNIE",
+	"of3lJi586tu/bSsgvzvi7BtmZ++WveYrQcAB3nG4IbB4aBdtySxWf1wyibOrLq/sS/0cYZqiCUCDm3h+",
+	"0GGcwzy4HWL1Vns3wJO8HYLZiFLsihomoSeE1BsxYlSbAh+suv62kDF6h5da9DDuCbABAkj9Gkd9BOPt",
+	"XRfNWPzoxfzyM9tXWNX0IdB/BPlRbF+rMvGoVaM+ij6Q9RdCML8CnMlZj9kwg+R6vSBfHZ0udARX4Q/g",
+	"RBmxysEOQiD95Rj9FrHr3yLtcNcWoNL0lItsonWrURSAedfKqfbjmBj0mQnc9rt6THQXLTCRKl4wsWt5",
+	"++Gd0eKsdUthgXJv3EjHAe+tWTV9UgP4k7FHQv7rMibXDCzYgBFFr88vBgauduys7XLUtjSmoFAovYNE",
+	"IIFv7LnFLuFBxeyGx+jslKlVIhtmCJbQMV3t63p0PPz4un2qA/2pq1XLXonTWPTqddRch11uw92YynGU",
+	"lyjfN87M3s1G7VdCgL+/uJ+elONlxnDAUvz+zT/PGJoDV2xGHuQcBFClxry/QHZQrFjMGBAHmgI3zsP3",
+	"FzoS6KzcJHMe5Y6kDM/ElViSJIqj8yXFc5IE7Nu74AZYnWDt6LkjSb2GHJN0hC7s7mtmKjRA6P1FjOaF",
+	"ULEKmcz0qt5fIPNR/V5q4EXvNYfajl5VgzSUZ4KFWDCedvzYcaaXCaYUUjTgbFfrVG6WMFZu4zR+BAoc",
+	"S1Au6WqTFY8UIGPE5Az4gghAuDqrnR1BQxAXPGfCuKorxVXprNlScdw0gBmtc3IHcJVgCVPGlx3x9deX",
+	"b9HxNyfPnpdHhtwITWwjdA4T7BLWNNm5n3WI5QY4mRBIy+Gx0nuefXPyLfpizihU8akvNdg5cKEULrTQ",
+	"TjsRo8WMJLMyfFioLadLcwD9K+vJixAztqAO4BwvgdfXoTTLv4oqLDAw9Pf+wnHhLRq9K8e8v+gxdsPq",
+	"Xhlv25Kra8PgSqk3Cs3F1c4njE4Inw8Ku3TE97pDLFigRngQFVSSDHmLHnjU5Q66AGYHy7lX+NQ6Ps0Z",
+	"CBVph1xa+rYo6n//vsHTJpP1Qf80ZA+2jfvcR9P+oe7FTkqovhVeypQICbyWw1MeKc6yt5Po9Nd1klpu",
+	"a2BUGUytVKi4nsjVhu6TB58xttcEzNjJ8W336caRIlIDgCfgh8CyR4Z/Y7IWyHMQAk9BtOn0pQoDaoOe",
+	"c8aRffEL8aXOznahxa7c3zKIKEwkJWBsmoQXxbFSoEwCWsxASTjELczKinr7P0p2UiZDqTKN3XBTxdWq",
+	"QlviB8j22zieMypn2fIq60/7xw1buArIq1xIy4KF3hWrgqhFYpTgDGiKOdLz1IxmypCedNg2ODhFDkNs",
+	"dT97pxNCOSPCATYBEEhroSmkw0Dq9CuwBYU1kuo5y1Y6KX2Eu1DvNwKHw45Pq5Zua9ye6P3q2qONT+xu",
+	"BdWYsHLAizg8ZW6uP9GRL4cTiRgNHHpv2txmxLYmDQ1BzUchngekmWEarznhIUrto9HQtulm7ZKSAVTW",
+	"qWWvQNyfLdxeeZeSpJ3ezl7bfH2N3p3+Y2RDbopOa+dO1hfbnSfXj6NbPKmGQqR34tNANNu2UjsvhcTQ",
+	"g3gDwVWUSq794rAFiV2taHhqXWhtQzMB3VyrFnvP8MBQf319ym1vrOG+V4tSJR+6q937V//kyiVZltHI",
+	"NVeqILJqRUhMzDFVVgYiUlgeIHQaAhGakDVVQzpCl7n19TQVb2JyfgnVn9DvixH6J4EFcOPbVKFtNYH5",
+	"rnaISHJD5HKEvr8BvrTzatUpBV2UhgiVDBmJ5Zz9eikqm9JAEsWRmSSY1ObvjNjxaW9GTcPpqDFZCBE+",
+	"sPxj3p2duF50h6yrf+YmmnLVk+H4kZLfC/ByG63YsUPXSnW8IVwWKpmmVZvWzxk6xsVVSV97IZ2b/ZrK",
+	"oB92na2u1ce1lrmRR7cjabCdHfoOqCofjOLoHdb5ld+r6QaniN7rUMI7+kAJSOFyzk/dUG2bd0iWF/mq",
+	"YT6OdTIG86Ug6NWBBQv1y5r7hiGWzglFixlDc5xCq77W2rOFcWGPYYaziTWOcFmpW/UXCNZ6cMCpKoBy",
+	"R9d2R2w738yvIwyu+hyEJBTrJfoltzHifmy3njw2pFmBTvhV5vxaWYjlqE0S5QY5EDrOoCLmTWZeFX7u",
+	"TKtOZphPlclPkWTaHjHbVcZRhLLIHUjDbNjJ5yudLxo8759UakSK9AvKdVsIaBz1mTmD0Roctj+6rmYM",
+	"I5/J4zAmmI6raivNPHUhMaRdHqrUSqlFcb0wvtb5ZK3Sqg0qM+8VFsOZBE51cwG17T4gete9rM0ROntg",
+	"ozuOFpxIqMjinjWJay0XMXWU9aCn9kMR4SKrkI4GwVxTFsNFe3V3ZA0OIgznN7q9jvlj5Gut504/ty/2",
+	"IiSyriT1tpwB9b2mSqSo7gUBF+lARLYZ7VujLbfme1FXpWj1SvdKqF6aAV5y08CBH9TrW3DXeZ9cO0Lp",
+	"jW0FKkulWs8YDknW5t66nlXXgQavo1Pb8l5aoXNdDirGikslPKRzN486oKw5Rcv0dNHmdfXwHMbqGQdj",
+	"hGtqHS+R7vgkDAfV6p4ZoUKzNjo4alKIIg9hKWgMcgFAa0Si5lX/N5Qo/LiODokQgSYczHspA+NYSGzq",
+	"1gLz1OvkouZzPoQQ3/FhaRZHGKdBO2TAJqjJFX2/gsf/Iqv0a6toaXtkVXpfFEdWM4ji1vbXHunNj1yO",
+	"qJshiqPAmladvtgpaQx3XtSJfaDvojZTiGo+6i5l22u+sE7hv5n7icYrdhhRegRvvdnq+6XBNAVIHOqr",
+	"USbEaHbj66vavzgGZPripTGaEMhSUe6AYpUFNRUS6Qh9mDmjQyKn3CtWVhoKktlPNZK5Gqvdenq4N5nr",
+	"1rcqV7xVJbLbhJ2PIhRZH5iVgvANJhkeZ1prFjnQNLYMn9BSxqwX9bVTi965QfuinZ3pNWVhWSpqCUP9",
+	"2WRmlYE0ou3Y5iWCB5ysjfTXh24WNtig9I3l4BLcC7HpK0KoAlglJORE4gxloGCKEXbGpqJ1rJAlITjT",
+	"HgWcyFZm5YtntcW9GFCiFIRuzsYkg1qFVIw4yIJTbaOj70fHL57Z/E/rIPvbi5Nvjk++fvb8xd+/+XaE",
+	"fmIJzuzYUmNKTSqz0Y340o48+ub45EANPPj7N9/Gmk25jFKjxt3gjKTGfUWosRenI/iM53kGo+U8K9N1",
+	"cZaxBaT2+wTEPW3m0rMRIKw3jmIVSdcaHGleK0gKBvozTRVGURpMZa76pZNeSqIbZpCpg3WW2F0HR/tJ",
+	"9VfdSRLnisRNb/I9ytr0trTt9uPsD6DOzyq0ZM7YVGcd0VRXXqJxIa1oVgLgTGdY1UeoX80oX51XvWVv",
+	"IIrtJEpn12ODanYFZFU+2e0sH8DZlE1ytT7OrcE7ezqMbDLvfd0HzR3cQay11ph48OKcptMZVe3odxxa",
+	"WqdBsKp9qZlEu/GMXmmbl1rPNlZNbo1E3mLD0g2Znr9L9gO9TU7VcLEbljTcKLXsdaA1ar4dWsw/gZPJ",
+	"0lOUO4+cyTyQZjGkgLrZOPXF3epiDjVbG171FqETpgDJSAL2AIxWGL15/UFvCZEZWKUFXQK/IQlEcWR7",
+	"0ESn0fHoaHSk3mQ5UJyT6DT6Wj/SsM70ag+1v+jw5vhQ4+pBxqbq8dTkuKh90YrH69RFD38E6Vo3R3Gt",
+	"tfuvwT6ItvEywmNW2OxO3c28o326Y1d+//QBfKt3auUVNh4KlANXX3N5CCuAKWXD2tB0fM204q6+NbiR",
+	"+ooVckgYT5X81IY+nkjds44I5EqnQgA5e0XJtPASe1IRhkJkmyMNBkayjUAJ7WSFnIfm0oEBL9p7AJTz",
+	"wZV4aDo5OTpyretsWgjO84wkmjoO/22FxBon6zdT1/TecP6qd7T2U+2o+lcFMkTpa860CvzMwNY0fFPX",
+	"jAsdoNdUGxGI0LyQZszXAbWN8TFJU9D+v+ehr6qMBa7KQAVw1RdR191opiaK+RzzpQq/EiERbsFvRaLC",
+	"Cm2tLNECOCCc63SvtIzQikpynmpFMQc+J0JYOvA4VilJurnVJWCezLQkW8WtflaYlpE/jOfHWHgZuYZQ",
+	"RV/IckMTQlPRsAM7kL1hH1Ros5LIzrCAA0IFUNPaHeWYlxnitbrY0Lx+Ndkak761FcxcYaEpMnsKvKYF",
+	"0Z+8Jqg/iT5G89Fu4p4wF0PQ5ugbLEM90xzjShWi93ONw1uraNwdWt/hQZXXpA8hZ6KLqbQiam3WorFO",
+	"KVkhzabS/4xLo0WJlSrxybwNQn7H0uXWsKLzmom7u7smfHct7DzeGhyh+HAAR7+rpZ49GJI+O3oWiq2o",
+	"8viCpvdCYxvRZdzczFGLcip1lRruZrotYzTHNMWS8aXtStXAfDvy1OzPcNSvzMgebDd2tmdL7huyt838",
+	"QVh+tAMAGi6UkN5nSxCtY8F6FZ4Mwj87+rb9xhmjk4wkalIHvlcz6TqozuyFLmZlOvVISJJl+geLwcrj",
+	"n5ucCbQow/IxwhQVFEwCM5IsPyhyk7pUTwTQ1DKuAjQbk+cPHOAPiFFBJ/ovNZlej9FNqzLRNQlVvXpq",
+	"PzmYSpvB/F7bXOGan1uwO2rtsHL1e/H6/N+kO3V9tfRXrf3dygG2X4pbMEEkwDD89x5cjduhhNRWpI/6",
+	"xv/GuLupRLtwHP2pCLIwl5hQiSaEC7mWdjjTvSf/6CSvV/b3HR54o/1lSDZkKvDR3KYboCAEyjkbg0lu",
+	"zDlTZ6+YcJHrgIvaZ8VTX3348M4sWPPk7vVemJ8fdbkaBoNDXz/gtD/ry3Bw6sKz59+hgnLAyUzlLMRo",
+	"TqYcO28idVF3MSukTnhN2YI2zuiibDRqD8lkIqSgxutcBAqQGr+DpghHlMLko+htUId2c3zoN1sXh4lf",
+	"ERZU4YLd7cMyofSiW577rwM34EDnQQ8RD6WZvCNdLricB1bnWrdSBZDIveNocWsc+ThUeocLOWNcua7u",
+	"xXJ/gfGMsesyncDHNVewZzLdc8aNzcLLlrrYq58psVUXMA1B03p94wpP3Sv4jIAmTJHMqzcvzw4uX708",
+	"ef6irEjAi3IPxyxVAkIRppc36uBBAhLuXSnbpoBLMqVYFhyeAvLXN+lPrK9h/QDb5eQk9BkLq05IOyib",
+	"iZd5zFWHz8ru8I0Ak6iAMkanUOomsZa6FCAVyiQocKZ+YTQhGcEuwXZrdOpqbNskisvfdOo0dmuwxZxu",
+	"ESW9unSRoE5grYtdKgXNtuUd/sqy3r2NXTtzPP6oW/sg+EyElvOFcKluMpm1N6vKEI125NVoJdw+tFej",
+	"nQPbdVw2I7d1XCdHJw8Mzqo4fuzKsVzbA+TI4lC/dmBeO9TtU3dkWHkjyrxk539IK5Jd7YypleuR6ubB",
+	"KmJi2OK3ocI3VSJMl6pzvHBLuad6YU7IWSgTkkG9kgS3TkMBrYtbnBtMl9GVrWv1+TTb22sLvkPL8Dsk",
+	"7ogsQw0hH9ipHuwDuT4n3QSte/Dxvu5yhToGQwqXr1iSprkZv1O5LPMro905mGvJo4/gX67njwZOW7/g",
+	"HfOmh7zxGX7vS05kjqx2iiEG23mmJp9LrdzL6drR+Xbmjj1JiRu4D+Q+5B0j53dX2qYTC8T8qMXDNlyF",
+	"6AD9zFDeBt35WLYn7zZnQgYgLb8ChfAara1aoX4thaaRU+9efjh7VWoTcbfw8knCj1LT6wP/Pu8+Fd2/",
+	"InzH4bodoXrwkvNgZLhq59Hv/t5tutO4AUeVkrJ0dSsTkhgcqfcaGaatePuxd/HXnh7eD6wZ1SAYhlT+",
+	"WT5UTGVXWpQ7CN3Mx1vihPEWkmLh4ShKqw46XQzKv9S3jze564H3ky+1LjcOecHsO4/IjkxXnRtdCmaB",
+	"8aXUeIlc3bQniJL6/fuuT669K61s5F+xq3YD5fI1V0hrBKBOo2zcqj2pdyaJ6/1TnOFXu1phzSv1TfnZ",
+	"jyDbl3Arj28zfu5uMN87/tqorX9gntq817qbICxGPmpk2uCM8tlaT63X71LnpxBRx/UgN77w0dwhX3lF",
+	"/mqnSrOXkIiR5EuEp5hQlGEJ/F4sQN3aXyOW2gX+HiNYxcsPG1fLd/H1NoHtNO0k3D670sY9qGP0vNHj",
+	"J5RdYtoo+NPOzUej05Mjrz3Ccfwo0sbb1z4y817bo3xeA3SFrFpS2TyOCnaPTH1BpjoZYg624YyVdEtQ",
+	"0gTTa6N+T/TCVJTdlJ4m5RXLfZh/a/9ST83KMpDQxv7a5f67T7aqf6qC8eloSLUNGSIVzM5uoiMFebz7",
+	"LN1KFpJZTRf3XBH92Ues2FWgahMl5egxlJSuWNUDqinbReELMN1y1lEAJp8PdPfMntxw45JXFp272X3f",
+	"9ObyPvxH0Zubt/EHUFK/sOUIzc4EuQXWtE9Tbn7XSc423HGFuHU/g0lY1LimdOnMdG41xp5tTa8ruboQ",
+	"1WtPErZKbZuSqr+q1gJsa3s72jpo603x/NTTESq7nWA7yPXP98vvyiR2wfybgsbgG6axvshUOdNdgru7",
+	"7JDICk6VRWNaVAWa7mmVp2xHM14i61ppW7V+75W9o8/63dIPTJ6Ny59DcRaDB9siz1p4xaH15g5DC51r",
+	"MuuM1EJAupVILEUwz6WDtExFckEQAdL29NKdgBChKyj48Lbs8zpA3941RocVqxLCp6ZtD0bWbl37qBOF",
+	"yuabhuGpJoL30G0cXm5VO28g4zo6+v5h0pNgtEcPz2ifgGIeQt4H5b6lLt/GdJ0iZiLURAokMZ9CZzT5",
+	"d+5rTE1/nrlhXWkU7y/2Tmuorpl/YET2LhkP6fLlLfpoajf4yav0DhMQRu8vXl8eqEVjSVSitLccyUpf",
+	"eiPZuKbp/1XYKs1unDy04wdZne8vqg7O+4ahFvJHQtSBRffea/tig77DOtsiwVQZRh6O6k7i6yJk6ZI+",
+	"sFGabivTXFUhjGGmmoc42zUH7vqkU9XQVhnGfvKQ0am8HAPJyprGtjnXuBFjDzNBgpfzPzD+d12PHyxB",
+	"tK/68v6phCttNzUfnVaHHncScFQbqUpNAyF+B69yU4T66SqC0FcTekkHkimfSXkvimv+O2NcHmS68ldf",
+	"16LeKz+vnCldhGyqvg68y/z6ElRqNwvuZ5ZK+HLEUMjQb5/w6AkrdWBKxVYp0O5WGXV/S5mwwllmusBr",
+	"blt3kRGqE1e601XsDaz1CymDDSVC11TUCtMVCLqPuZEzrnLEv3en9r4AUyrcvLQidhdtqlXYNak/9XXv",
+	"SFRulrlxDZrn9Ys8NTDuzs/qOh7R5Rv0F7d38iR0yewD+wmDl86uorR90aeazr46cbCFdT+viibV+e/h",
+	"bRPv7w69W4u7WHPg9uSH9to04X6aDL95tXQAGe0rG/N7dKD5TyEq9lPyqU5tpk4BdItNQiouZyNM/Whq",
+	"JchihqVJbhS5ySjU0RcqZ/05jlYSrZ/g2NCAbCuT+mXtWrEJZie2j3gP0X+3IqB+uc8jCoLGdfWdFIhw",
+	"mj6IFNgmwSq8pZ5lMga7DJc/OS+vkQ/6Rj+KenUO9gc8ZtrkyzStFM2AMrh9eXd4a/5YFfS6ABUV3ncO",
+	"EAcx4fW509vNXpQ5mvWpy416arG3jeiegwnzD6L8utBp0O2OqZ5xN/W2kpHUwisyc465VRJbm0I1m4uD",
+	"vmlhZYDvT6rhTysT8J4S++hxJfa2opCujcx/CmF7xbHaLcO455Xx3De7lKodjTLb3NQ4TpSn5IbAArjQ",
+	"lUKN2/A15KZTm4E+Nk4e4LY2Sc6AcPWttr7esFcftiHnlrnPn00zH8LXvzvruIHRDfoLNM7sIkDTzG1I",
+	"bNbdsbtf3kQN9WM1Qjdz92Csady1Z45D128sUGHsAkDKud9oR6aMrxwvtUu+HxkPb/W/zn7qC+vsGCfD",
+	"zNdBt373wF0xyIF49h/USFg1bvOuajNRSbPKdj56J7o1VIuVHLB6f//4oJ8f8pSvhdhdhsqzDrdVPfNv",
+	"XWeVAM9BfFW7A2hrzbE8RA1x3SH4fTgB6MbxdxyU0txA8h9gD+t/AOxiHskA9QHoxvIfAFTD2qTI9iIF",
+	"yyzIXEelIJ9hPi1LenzstJc12Rv5TB6IWb/5vMGhgmfRaTSTMj89PMzUpcozhZZ3n+7+fwBUqD2GZs4A",
+	"AA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,386 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/WalletService/generated"
+	"github.com/WalletService/model"
+	"github.com/WalletService/repository"
+	"github.com/WalletService/usecase"
+	"github.com/WalletService/utils"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// adminDefaultLimit is the page size of admin searches when the request does not set a limit
+	adminDefaultLimit = 50
+)
+
+// AdminSearchUsers searches any User, i.e. for support to find the User reporting an issue.
+// NOTE: Check AuthenticationMiddleware cmd/main.go that authenticates the JWT token
+func (s *Server) AdminSearchUsers(ctx echo.Context, params generated.AdminSearchUsersParams) error {
+	return ctx.JSON(s.adminSearchUsers(ctx, params))
+}
+func (s *Server) adminSearchUsers(ctx echo.Context, params generated.AdminSearchUsersParams) (int, generated.UsersResponse) {
+	var (
+		context = ctx.Request().Context()
+
+		response = generated.UsersResponse{
+			Header: generated.ResponseHeader{}, //success is false by default
+			Users:  []generated.User{},
+		}
+	)
+
+	if _, err := authorize(ctx, utils.JWTPermissionReadAnyUser); err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	}
+
+	filter := model.UserFilter{Limit: adminDefaultLimit}
+	if params.PhoneNumber != nil {
+		// Stored in E.164, so the number can be searched as the User typed it
+		phoneNumber, errorList := validatePhoneNumber(params.PhoneNumber, s.validationLimits())
+		if len(errorList) > 0 {
+			response.Header.Messages = errorList
+			return http.StatusBadRequest, response
+		}
+		filter.PhoneNumber = phoneNumber
+	}
+	if params.FullName != nil {
+		filter.FullName = strings.TrimSpace(*params.FullName)
+	}
+	if params.CreatedFrom != nil {
+		filter.CreatedTimeFrom = *params.CreatedFrom
+	}
+	if params.CreatedTo != nil {
+		filter.CreatedTimeTo = *params.CreatedTo
+	}
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+	}
+	if params.Offset != nil {
+		filter.Offset = *params.Offset
+	}
+
+	users, err := s.Usecase.GetUsers(context, filter)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
+	}
+
+	for i := range users {
+		user := users[i]
+		response.Users = append(response.Users, generated.User{
+			Id:          &user.ID,
+			FullName:    &user.FullName,
+			PhoneNumber: &user.PhoneNumber,
+			Balance:     &user.Balance,
+			Status:      convertUserStatusToResponse(user.Status),
+			CreatedTime: &user.CreatedTime,
+		})
+	}
+
+	response.Header.Success = true
+	response.Header.Messages = []string{successMsg}
+	return http.StatusOK, response
+}
+
+// AdminGetUserTransactions lists the Transactions of any User, most recent first.
+// NOTE: Check AuthenticationMiddleware cmd/main.go that authenticates the JWT token
+func (s *Server) AdminGetUserTransactions(ctx echo.Context, pathUserID int, params generated.AdminGetUserTransactionsParams) error {
+	return ctx.JSON(s.adminGetUserTransactions(ctx, int64(pathUserID), params))
+}
+func (s *Server) adminGetUserTransactions(ctx echo.Context, pathUserID int64, params generated.AdminGetUserTransactionsParams) (int, generated.TransactionsResponse) {
+	var (
+		context = ctx.Request().Context()
+
+		response = generated.TransactionsResponse{
+			Header:       generated.ResponseHeader{}, //success is false by default
+			Transactions: []generated.Transaction{},
+		}
+	)
+
+	if _, err := authorize(ctx, utils.JWTPermissionReadAnyUser); err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	}
+
+	// Transactions User received are listed along with the ones User made
+	filter := model.TransactionFilter{
+		ParticipantID: pathUserID,
+		Limit:         adminDefaultLimit,
+	}
+	if params.Type != nil {
+		filter.Type = model.TransactionType(*params.Type)
+	}
+	if params.Status != nil {
+		filter.Status = model.TransactionStatus(*params.Status)
+	}
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+	}
+	if params.Offset != nil {
+		filter.Offset = *params.Offset
+	}
+
+	transactions, err := s.Usecase.GetUserTransactions(context, filter)
+	if errors.Is(err, repository.ErrUserNotFound) {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusNotFound, response
+	} else if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
+	}
+
+	for _, transaction := range transactions {
+		response.Transactions = append(response.Transactions, convertTransactionToAdminResponse(transaction))
+	}
+
+	response.Header.Success = true
+	response.Header.Messages = []string{successMsg}
+	return http.StatusOK, response
+}
+
+// AdminAdjustUserBalance credits or debits the balance of any User, i.e. to correct a failed reconciliation.
+// The admin making the adjustment and the reason are recorded with the Transaction.
+// NOTE: Check AuthenticationMiddleware cmd/main.go that authenticates the JWT token
+func (s *Server) AdminAdjustUserBalance(ctx echo.Context, pathUserID int) error {
+	return ctx.JSON(s.adminAdjustUserBalance(ctx, int64(pathUserID)))
+}
+func (s *Server) adminAdjustUserBalance(ctx echo.Context, pathUserID int64) (int, generated.TransactionResponse) {
+	var (
+		context = ctx.Request().Context()
+
+		response = generated.TransactionResponse{
+			Header: generated.ResponseHeader{}, //success is false by default
+		}
+	)
+
+	actorID, err := authorize(ctx, utils.JWTPermissionAdjustBalance)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	}
+
+	request := generated.BalanceAdjustmentRequest{}
+	err = json.NewDecoder(ctx.Request().Body).Decode(&request)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusBadRequest, response
+	}
+
+	adjustment := model.BalanceAdjustment{
+		UserID:  pathUserID,
+		ActorID: actorID,
+		Amount:  request.Amount,
+		Reason:  strings.TrimSpace(request.Reason),
+	}
+	if request.Currency != nil {
+		adjustment.Currency = model.Currency(*request.Currency)
+	}
+
+	newTransaction, err := s.Usecase.AdjustUserBalance(context, adjustment)
+	switch {
+	case err == nil:
+	case errors.Is(err, repository.ErrUserNotFound):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusNotFound, response
+	case errors.Is(err, usecase.ErrAdjustmentReasonRequired), errors.Is(err, usecase.ErrAdjustmentOwnBalance):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusBadRequest, response
+	default:
+		response.Header.Messages = []string{err.Error()}
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
+	}
+
+	response.Transaction = convertTransactionToAdminResponse(newTransaction)
+	response.Header.Success = true
+	response.Header.Messages = []string{successMsg}
+	return http.StatusCreated, response
+}
+
+// AdminChangeUserStatus freezes, unfreezes or closes the account of any User, i.e. when it is compromised or used for fraud.
+// NOTE: Check AuthenticationMiddleware cmd/main.go that authenticates the JWT token
+func (s *Server) AdminChangeUserStatus(ctx echo.Context, pathUserID int) error {
+	return ctx.JSON(s.adminChangeUserStatus(ctx, int64(pathUserID)))
+}
+func (s *Server) adminChangeUserStatus(ctx echo.Context, pathUserID int64) (int, generated.UserStatusChangeResponse) {
+	var (
+		context = ctx.Request().Context()
+
+		response = generated.UserStatusChangeResponse{
+			Header: generated.ResponseHeader{}, //success is false by default
+		}
+	)
+
+	actorID, err := authorize(ctx, utils.JWTPermissionFreezeUser)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	}
+
+	request := generated.UserStatusRequest{}
+	err = json.NewDecoder(ctx.Request().Body).Decode(&request)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusBadRequest, response
+	}
+
+	change, err := s.Usecase.ChangeUserStatus(context, model.UserStatusChange{
+		UserID:   pathUserID,
+		ActorID:  actorID,
+		ToStatus: model.UserStatus(request.Status),
+		Reason:   strings.TrimSpace(request.Reason),
+	})
+	switch {
+	case err == nil:
+	case errors.Is(err, repository.ErrUserNotFound):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusNotFound, response
+	case errors.Is(err, usecase.ErrUserStatusReasonRequired), errors.Is(err, usecase.ErrUserStatusOwn):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusBadRequest, response
+	case errors.Is(err, usecase.ErrUserClosed), errors.Is(err, usecase.ErrUserStatusUnchanged),
+		errors.Is(err, usecase.ErrClosureBalanceNotZero), errors.Is(err, usecase.ErrClosurePendingWithdrawal),
+		errors.Is(err, usecase.ErrClosurePendingTopUp), errors.Is(err, usecase.ErrClosureSharedWalletNotEmpty):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusConflict, response
+	default:
+		response.Header.Messages = []string{err.Error()}
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
+	}
+
+	response.StatusChange = generated.UserStatusChange{
+		Id:         &change.ID,
+		UserId:     &change.UserID,
+		ActorId:    &change.ActorID,
+		FromStatus: convertUserStatusToResponse(change.FromStatus),
+		Status:     convertUserStatusToResponse(change.ToStatus),
+		Reason:     &change.Reason,
+	}
+	response.Header.Success = true
+	response.Header.Messages = []string{successMsg}
+	return http.StatusOK, response
+}
+
+// AdminGetAuditLog lists audit log entries for auditors, i.e. every login of a User or every action of an admin.
+// NOTE: Check AuthenticationMiddleware cmd/main.go that authenticates the JWT token
+func (s *Server) AdminGetAuditLog(ctx echo.Context, params generated.AdminGetAuditLogParams) error {
+	return ctx.JSON(s.adminGetAuditLog(ctx, params))
+}
+func (s *Server) adminGetAuditLog(ctx echo.Context, params generated.AdminGetAuditLogParams) (int, generated.AuditLogResponse) {
+	var (
+		context = ctx.Request().Context()
+
+		response = generated.AuditLogResponse{
+			Header:  generated.ResponseHeader{}, //success is false by default
+			Entries: []generated.AuditLogEntry{},
+		}
+	)
+
+	if _, err := authorize(ctx, utils.JWTPermissionReadAuditLog); err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	}
+
+	filter := model.AuditLogFilter{Limit: adminDefaultLimit}
+	if params.UserId != nil {
+		filter.UserID = *params.UserId
+	}
+	if params.ActorId != nil {
+		filter.ActorID = *params.ActorId
+	}
+	if params.Action != nil {
+		filter.Action = model.AuditAction(*params.Action)
+	}
+	if params.CreatedFrom != nil {
+		filter.CreatedTimeFrom = *params.CreatedFrom
+	}
+	if params.CreatedTo != nil {
+		filter.CreatedTimeTo = *params.CreatedTo
+	}
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+	}
+	if params.Offset != nil {
+		filter.Offset = *params.Offset
+	}
+
+	entries, err := s.Usecase.GetAuditLog(context, filter)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
+	}
+
+	for i := range entries {
+		entry := entries[i]
+		responseEntry := generated.AuditLogEntry{
+			Id:          entry.ID,
+			Action:      generated.AuditAction(entry.Action),
+			Details:     entry.Details,
+			CreatedTime: entry.CreatedTime,
+			PrevHash:    entry.PrevHash,
+			Hash:        entry.Hash,
+		}
+		if entry.UserID != 0 {
+			responseEntry.UserId = &entry.UserID
+		}
+		if entry.ActorID != 0 {
+			responseEntry.ActorId = &entry.ActorID
+		}
+		if entry.IP != "" {
+			responseEntry.Ip = &entry.IP
+		}
+		if entry.UserAgent != "" {
+			responseEntry.UserAgent = &entry.UserAgent
+		}
+		response.Entries = append(response.Entries, responseEntry)
+	}
+
+	response.Header.Success = true
+	response.Header.Messages = []string{successMsg}
+	return http.StatusOK, response
+}
+
+// convertTransactionToAdminResponse returns every detail of the Transaction, unlike convertTransactionToResponse admins do not know them already.
+func convertTransactionToAdminResponse(transaction model.Transaction) generated.Transaction {
+	response := convertTransactionToResponse(transaction)
+
+	transactionType := generated.TransactionType(transaction.Type)
+	currency := generated.Currency(transaction.Currency)
+	response.UserId = &transaction.UserID
+	response.Type = &transactionType
+	response.Amount = &transaction.Amount
+	response.Currency = &currency
+
+	if transaction.RecipientID != 0 {
+		response.RecipientId = &transaction.RecipientID
+	}
+	if transaction.BankAccountID != 0 {
+		response.BankAccountId = &transaction.BankAccountID
+	}
+	if transaction.SourcePocketID != 0 {
+		response.SourcePocketId = &transaction.SourcePocketID
+	}
+	if transaction.PocketID != 0 {
+		response.PocketId = &transaction.PocketID
+	}
+	if transaction.SharedWalletID != 0 {
+		response.SharedWalletId = &transaction.SharedWalletID
+	}
+	if transaction.Description != "" {
+		response.Description = &transaction.Description
+	}
+	if transaction.ActorID != 0 {
+		response.ActorId = &transaction.ActorID
+	}
+	// Created time is only read back by GetUserTransactions
+	if !transaction.CreatedTime.IsZero() {
+		response.CreatedTime = &transaction.CreatedTime
+	}
+
+	return response
+}
@@ -0,0 +1,669 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/WalletService/generated"
+	"github.com/WalletService/model"
+	"github.com/WalletService/repository"
+	"github.com/WalletService/usecase"
+	"github.com/WalletService/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+)
+
+func TestAdminSearchUsers(t *testing.T) {
+	createdTime := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name               string
+		ctxPermissions     []utils.JWTPermission
+		params             generated.AdminSearchUsersParams
+		mockUsecase        func(controller *gomock.Controller) *usecase.MockUsecaseInterface
+		wantResponse       generated.UsersResponse
+		wantHttpStatusCode int
+	}{
+		{
+			name:           "success",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionGetUser, utils.JWTPermissionReadAnyUser},
+			params: generated.AdminSearchUsersParams{
+				FullName:    stringPtr(" budi "),
+				CreatedFrom: &createdTime,
+				Offset:      func() *int { offset := 50; return &offset }(),
+			},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+
+				mock.EXPECT().GetUsers(gomock.Any(), model.UserFilter{
+					FullName:        "budi",
+					CreatedTimeFrom: createdTime,
+					Limit:           adminDefaultLimit,
+					Offset:          50,
+				}).Return([]model.User{{
+					ID:          123,
+					FullName:    "Budi",
+					PhoneNumber: "+628123456789",
+					Balance:     1000,
+					Password:    "hashed",
+					Status:      model.UserStatusFrozen,
+					CreatedTime: createdTime,
+				}}, nil)
+
+				return mock
+			},
+			wantResponse: generated.UsersResponse{
+				Header: generated.ResponseHeader{
+					Success:  true,
+					Messages: []string{successMsg},
+				},
+				Users: []generated.User{{
+					Id:          intPtr(123),
+					FullName:    stringPtr("Budi"),
+					PhoneNumber: stringPtr("+628123456789"),
+					Balance:     floatPtr(1000),
+					Status:      userStatusPtr(generated.Frozen),
+					CreatedTime: &createdTime,
+				}},
+			},
+			wantHttpStatusCode: http.StatusOK,
+		},
+		{
+			name:           "success-local-phone-number",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionReadAnyUser},
+			params: generated.AdminSearchUsersParams{
+				PhoneNumber: stringPtr("0812-3456-789"),
+			},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+
+				mock.EXPECT().GetUsers(gomock.Any(), model.UserFilter{
+					PhoneNumber: "+628123456789",
+					Limit:       adminDefaultLimit,
+				}).Return(nil, nil)
+
+				return mock
+			},
+			wantResponse: generated.UsersResponse{
+				Header: generated.ResponseHeader{
+					Success:  true,
+					Messages: []string{successMsg},
+				},
+				Users: []generated.User{},
+			},
+			wantHttpStatusCode: http.StatusOK,
+		},
+		{
+			name:           "fail-invalid-phone-number",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionReadAnyUser},
+			params: generated.AdminSearchUsersParams{
+				PhoneNumber: stringPtr("021 5551234"),
+			},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				return usecase.NewMockUsecaseInterface(controller)
+			},
+			wantResponse: generated.UsersResponse{
+				Header: generated.ResponseHeader{
+					Success:  false,
+					Messages: []string{"phone_number is not a valid mobile number"},
+				},
+				Users: []generated.User{},
+			},
+			wantHttpStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fail-not-authorized-permission",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionGetUser, utils.JWTPermissionPerformTransaction},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				return usecase.NewMockUsecaseInterface(controller)
+			},
+			wantResponse: generated.UsersResponse{
+				Header: generated.ResponseHeader{
+					Success:  false,
+					Messages: []string{"not authorized: missing required permission"},
+				},
+				Users: []generated.User{},
+			},
+			wantHttpStatusCode: http.StatusForbidden,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			handler := &Server{
+				Usecase: test.mockUsecase(controller),
+			}
+
+			e := echo.New()
+			request := httptest.NewRequest(http.MethodGet, "/admin/v1/users", nil)
+			recorder := httptest.NewRecorder()
+			ctx := e.NewContext(request, recorder)
+			ctx.Set(string(utils.JWTClaimUserID), int64(1))
+			ctx.Set(string(utils.JWTClaimPermissions), test.ctxPermissions)
+
+			gotHttpStatusCode, gotResponse := handler.adminSearchUsers(ctx, test.params)
+
+			if gotHttpStatusCode != test.wantHttpStatusCode {
+				t.Errorf("handler.AdminSearchUsers() httpStatusCode = %v, wantHttpStatusCode %v", gotHttpStatusCode, test.wantHttpStatusCode)
+			}
+
+			if !reflect.DeepEqual(test.wantResponse, gotResponse) {
+				t.Errorf("handler.AdminSearchUsers() response = %v, wantResponse %v", gotResponse, test.wantResponse)
+			}
+		})
+	}
+}
+
+func TestAdminGetUserTransactions(t *testing.T) {
+	createdTime := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name               string
+		ctxPermissions     []utils.JWTPermission
+		params             generated.AdminGetUserTransactionsParams
+		mockUsecase        func(controller *gomock.Controller) *usecase.MockUsecaseInterface
+		wantResponse       generated.TransactionsResponse
+		wantHttpStatusCode int
+	}{
+		{
+			name:           "success",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionReadAnyUser},
+			params: generated.AdminGetUserTransactionsParams{
+				Type:  transactionTypePtr(generated.TransferOut),
+				Limit: func() *int { limit := 10; return &limit }(),
+			},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+
+				mock.EXPECT().GetUserTransactions(gomock.Any(), model.TransactionFilter{
+					ParticipantID: 123,
+					Type:          model.TransactionTypeTransferOut,
+					Limit:         10,
+				}).Return([]model.Transaction{{
+					ID:          convertToUUID("3d6e668f-ad02-40ff-8540-90c1528a7c88"),
+					UserID:      123,
+					Amount:      100000,
+					Type:        model.TransactionTypeTransferOut,
+					RecipientID: 456,
+					Status:      model.TransactionStatusSuccessful,
+					Currency:    model.CurrencyIDR,
+					Fee:         2500,
+					CreatedTime: createdTime,
+				}, {
+					// Received from another User, listed as that User's TransferOut
+					ID:          convertToUUID("9a1d3e42-5b7c-4f0e-8d2a-6c3b1e0f7a55"),
+					UserID:      456,
+					Amount:      50000,
+					Type:        model.TransactionTypeTransferOut,
+					RecipientID: 123,
+					Status:      model.TransactionStatusSuccessful,
+					Currency:    model.CurrencyIDR,
+					CreatedTime: createdTime,
+				}}, nil)
+
+				return mock
+			},
+			wantResponse: generated.TransactionsResponse{
+				Header: generated.ResponseHeader{
+					Success:  true,
+					Messages: []string{successMsg},
+				},
+				Transactions: []generated.Transaction{{
+					Id:          stringPtr("3d6e668f-ad02-40ff-8540-90c1528a7c88"),
+					UserId:      intPtr(123),
+					Amount:      floatPtr(100000),
+					Type:        transactionTypePtr(generated.TransferOut),
+					RecipientId: intPtr(456),
+					Status: func() *generated.TransactionStatus {
+						status := generated.TransactionStatusSuccessful
+						return &status
+					}(),
+					Currency:    currencyPtr(generated.IDR),
+					Fee:         floatPtr(2500),
+					CreatedTime: &createdTime,
+				}, {
+					Id:          stringPtr("9a1d3e42-5b7c-4f0e-8d2a-6c3b1e0f7a55"),
+					UserId:      intPtr(456),
+					Amount:      floatPtr(50000),
+					Type:        transactionTypePtr(generated.TransferOut),
+					RecipientId: intPtr(123),
+					Status: func() *generated.TransactionStatus {
+						status := generated.TransactionStatusSuccessful
+						return &status
+					}(),
+					Currency:    currencyPtr(generated.IDR),
+					Fee:         floatPtr(0),
+					CreatedTime: &createdTime,
+				}},
+			},
+			wantHttpStatusCode: http.StatusOK,
+		},
+		{
+			name:           "fail-user-not-found",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionReadAnyUser},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+
+				mock.EXPECT().GetUserTransactions(gomock.Any(), gomock.Any()).Return(nil, repository.ErrUserNotFound)
+
+				return mock
+			},
+			wantResponse: generated.TransactionsResponse{
+				Header: generated.ResponseHeader{
+					Success:  false,
+					Messages: []string{"user not found"},
+				},
+				Transactions: []generated.Transaction{},
+			},
+			wantHttpStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			handler := &Server{
+				Usecase: test.mockUsecase(controller),
+			}
+
+			e := echo.New()
+			request := httptest.NewRequest(http.MethodGet, "/admin/v1/users/123/transactions", nil)
+			recorder := httptest.NewRecorder()
+			ctx := e.NewContext(request, recorder)
+			ctx.Set(string(utils.JWTClaimUserID), int64(1))
+			ctx.Set(string(utils.JWTClaimPermissions), test.ctxPermissions)
+
+			gotHttpStatusCode, gotResponse := handler.adminGetUserTransactions(ctx, 123, test.params)
+
+			if gotHttpStatusCode != test.wantHttpStatusCode {
+				t.Errorf("handler.AdminGetUserTransactions() httpStatusCode = %v, wantHttpStatusCode %v", gotHttpStatusCode, test.wantHttpStatusCode)
+			}
+
+			if !reflect.DeepEqual(test.wantResponse, gotResponse) {
+				t.Errorf("handler.AdminGetUserTransactions() response = %v, wantResponse %v", gotResponse, test.wantResponse)
+			}
+		})
+	}
+}
+
+func TestAdminAdjustUserBalance(t *testing.T) {
+	tests := []struct {
+		name               string
+		ctxPermissions     []utils.JWTPermission
+		requestBody        generated.BalanceAdjustmentRequest
+		mockUsecase        func(controller *gomock.Controller) *usecase.MockUsecaseInterface
+		wantResponse       generated.TransactionResponse
+		wantHttpStatusCode int
+	}{
+		{
+			name:           "success",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionReadAnyUser, utils.JWTPermissionAdjustBalance},
+			requestBody: generated.BalanceAdjustmentRequest{
+				Amount: -5000,
+				Reason: " Refund credited twice ",
+			},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+
+				mock.EXPECT().AdjustUserBalance(gomock.Any(), model.BalanceAdjustment{
+					UserID:  123,
+					ActorID: 1,
+					Amount:  -5000,
+					Reason:  "Refund credited twice",
+				}).Return(model.Transaction{
+					ID:          convertToUUID("3d6e668f-ad02-40ff-8540-90c1528a7c88"),
+					UserID:      123,
+					Amount:      5000,
+					Type:        model.TransactionTypeAdjustmentDebit,
+					RecipientID: 123,
+					Status:      model.TransactionStatusSuccessful,
+					Description: "Refund credited twice",
+					Currency:    model.CurrencyIDR,
+					ActorID:     1,
+				}, nil)
+
+				return mock
+			},
+			wantResponse: generated.TransactionResponse{
+				Header: generated.ResponseHeader{
+					Success:  true,
+					Messages: []string{successMsg},
+				},
+				Transaction: generated.Transaction{
+					Id:          stringPtr("3d6e668f-ad02-40ff-8540-90c1528a7c88"),
+					UserId:      intPtr(123),
+					Amount:      floatPtr(5000),
+					Type:        transactionTypePtr(generated.AdjustmentDebit),
+					RecipientId: intPtr(123),
+					Status: func() *generated.TransactionStatus {
+						status := generated.TransactionStatusSuccessful
+						return &status
+					}(),
+					Description: stringPtr("Refund credited twice"),
+					Currency:    currencyPtr(generated.IDR),
+					Fee:         floatPtr(0),
+					ActorId:     intPtr(1),
+				},
+			},
+			wantHttpStatusCode: http.StatusCreated,
+		},
+		{
+			name:           "fail-not-authorized-permission",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionReadAnyUser, utils.JWTPermissionFreezeUser},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				return usecase.NewMockUsecaseInterface(controller)
+			},
+			wantResponse: generated.TransactionResponse{
+				Header: generated.ResponseHeader{
+					Success:  false,
+					Messages: []string{"not authorized: missing required permission"},
+				},
+			},
+			wantHttpStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "fail-own-balance",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionAdjustBalance},
+			requestBody: generated.BalanceAdjustmentRequest{
+				Amount: 5000,
+				Reason: "Bonus",
+			},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+
+				mock.EXPECT().AdjustUserBalance(gomock.Any(), gomock.Any()).Return(model.Transaction{}, usecase.ErrAdjustmentOwnBalance)
+
+				return mock
+			},
+			wantResponse: generated.TransactionResponse{
+				Header: generated.ResponseHeader{
+					Success:  false,
+					Messages: []string{"cannot adjust own balance"},
+				},
+			},
+			wantHttpStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fail-usecase-error",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionAdjustBalance},
+			requestBody: generated.BalanceAdjustmentRequest{
+				Amount: -5000,
+				Reason: "Refund credited twice",
+			},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+
+				mock.EXPECT().AdjustUserBalance(gomock.Any(), gomock.Any()).Return(model.Transaction{}, errors.New("balance not enough"))
+
+				return mock
+			},
+			wantResponse: generated.TransactionResponse{
+				Header: generated.ResponseHeader{
+					Success:  false,
+					Messages: []string{"balance not enough"},
+				},
+			},
+			wantHttpStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			handler := &Server{
+				Usecase: test.mockUsecase(controller),
+			}
+
+			requestBodyJSON, _ := json.Marshal(test.requestBody)
+
+			e := echo.New()
+			request := httptest.NewRequest(http.MethodPost, "/admin/v1/users/123/balance-adjustments", bytes.NewBuffer(requestBodyJSON))
+			recorder := httptest.NewRecorder()
+			ctx := e.NewContext(request, recorder)
+			ctx.Set(string(utils.JWTClaimUserID), int64(1))
+			ctx.Set(string(utils.JWTClaimPermissions), test.ctxPermissions)
+
+			gotHttpStatusCode, gotResponse := handler.adminAdjustUserBalance(ctx, 123)
+
+			if gotHttpStatusCode != test.wantHttpStatusCode {
+				t.Errorf("handler.AdminAdjustUserBalance() httpStatusCode = %v, wantHttpStatusCode %v", gotHttpStatusCode, test.wantHttpStatusCode)
+			}
+
+			if !reflect.DeepEqual(test.wantResponse, gotResponse) {
+				t.Errorf("handler.AdminAdjustUserBalance() response = %v, wantResponse %v", gotResponse, test.wantResponse)
+			}
+		})
+	}
+}
+
+func TestAdminChangeUserStatus(t *testing.T) {
+	tests := []struct {
+		name               string
+		ctxPermissions     []utils.JWTPermission
+		requestBody        generated.UserStatusRequest
+		mockUsecase        func(controller *gomock.Controller) *usecase.MockUsecaseInterface
+		wantResponse       generated.UserStatusChangeResponse
+		wantHttpStatusCode int
+	}{
+		{
+			name:           "success",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionReadAnyUser, utils.JWTPermissionFreezeUser},
+			requestBody: generated.UserStatusRequest{
+				Status: generated.Frozen,
+				Reason: " Reported stolen phone ",
+			},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+
+				mock.EXPECT().ChangeUserStatus(gomock.Any(), model.UserStatusChange{
+					UserID:   123,
+					ActorID:  1,
+					ToStatus: model.UserStatusFrozen,
+					Reason:   "Reported stolen phone",
+				}).Return(model.UserStatusChange{
+					ID:         7,
+					UserID:     123,
+					ActorID:    1,
+					FromStatus: model.UserStatusActive,
+					ToStatus:   model.UserStatusFrozen,
+					Reason:     "Reported stolen phone",
+				}, nil)
+
+				return mock
+			},
+			wantResponse: generated.UserStatusChangeResponse{
+				Header: generated.ResponseHeader{
+					Success:  true,
+					Messages: []string{successMsg},
+				},
+				StatusChange: generated.UserStatusChange{
+					Id:         intPtr(7),
+					UserId:     intPtr(123),
+					ActorId:    intPtr(1),
+					FromStatus: userStatusPtr(generated.Active),
+					Status:     userStatusPtr(generated.Frozen),
+					Reason:     stringPtr("Reported stolen phone"),
+				},
+			},
+			wantHttpStatusCode: http.StatusOK,
+		},
+		{
+			name:           "fail-not-authorized-permission",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionReadAnyUser},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				return usecase.NewMockUsecaseInterface(controller)
+			},
+			wantResponse: generated.UserStatusChangeResponse{
+				Header: generated.ResponseHeader{
+					Success:  false,
+					Messages: []string{"not authorized: missing required permission"},
+				},
+			},
+			wantHttpStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "fail-balance-not-zero",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionFreezeUser},
+			requestBody: generated.UserStatusRequest{
+				Status: generated.Closed,
+				Reason: "Requested by user",
+			},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+
+				mock.EXPECT().ChangeUserStatus(gomock.Any(), gomock.Any()).Return(model.UserStatusChange{}, usecase.ErrClosureBalanceNotZero)
+
+				return mock
+			},
+			wantResponse: generated.UserStatusChangeResponse{
+				Header: generated.ResponseHeader{
+					Success:  false,
+					Messages: []string{"account balance must be zero to close it"},
+				},
+			},
+			wantHttpStatusCode: http.StatusConflict,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			handler := &Server{
+				Usecase: test.mockUsecase(controller),
+			}
+
+			requestBodyJSON, _ := json.Marshal(test.requestBody)
+
+			e := echo.New()
+			request := httptest.NewRequest(http.MethodPost, "/admin/v1/users/123/status", bytes.NewBuffer(requestBodyJSON))
+			recorder := httptest.NewRecorder()
+			ctx := e.NewContext(request, recorder)
+			ctx.Set(string(utils.JWTClaimUserID), int64(1))
+			ctx.Set(string(utils.JWTClaimPermissions), test.ctxPermissions)
+
+			gotHttpStatusCode, gotResponse := handler.adminChangeUserStatus(ctx, 123)
+
+			if gotHttpStatusCode != test.wantHttpStatusCode {
+				t.Errorf("handler.AdminChangeUserStatus() httpStatusCode = %v, wantHttpStatusCode %v", gotHttpStatusCode, test.wantHttpStatusCode)
+			}
+
+			if !reflect.DeepEqual(test.wantResponse, gotResponse) {
+				t.Errorf("handler.AdminChangeUserStatus() response = %v, wantResponse %v", gotResponse, test.wantResponse)
+			}
+		})
+	}
+}
+
+func TestAdminGetAuditLog(t *testing.T) {
+	createdTime := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+	action := generated.UserLogin
+
+	tests := []struct {
+		name               string
+		ctxPermissions     []utils.JWTPermission
+		params             generated.AdminGetAuditLogParams
+		mockUsecase        func(controller *gomock.Controller) *usecase.MockUsecaseInterface
+		wantResponse       generated.AuditLogResponse
+		wantHttpStatusCode int
+	}{
+		{
+			name:           "success",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionReadAuditLog},
+			params: generated.AdminGetAuditLogParams{
+				UserId:      intPtr(1234),
+				Action:      &action,
+				CreatedFrom: &createdTime,
+			},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+
+				mock.EXPECT().GetAuditLog(gomock.Any(), model.AuditLogFilter{
+					UserID:          1234,
+					Action:          model.AuditActionLogin,
+					CreatedTimeFrom: createdTime,
+					Limit:           adminDefaultLimit,
+				}).Return([]model.AuditLogEntry{
+					{
+						ID:          7,
+						Action:      model.AuditActionLogin,
+						UserID:      1234,
+						IP:          "10.0.0.1",
+						Details:     map[string]string{"result": "failure", "error": "invalid password"},
+						CreatedTime: createdTime,
+						PrevHash:    "prev",
+						Hash:        "hash",
+					},
+				}, nil)
+
+				return mock
+			},
+			wantResponse: generated.AuditLogResponse{
+				Header: generated.ResponseHeader{
+					Success:  true,
+					Messages: []string{successMsg},
+				},
+				Entries: []generated.AuditLogEntry{
+					{
+						Id:          7,
+						Action:      generated.UserLogin,
+						UserId:      intPtr(1234),
+						Ip:          stringPtr("10.0.0.1"),
+						Details:     map[string]string{"result": "failure", "error": "invalid password"},
+						CreatedTime: createdTime,
+						PrevHash:    "prev",
+						Hash:        "hash",
+					},
+				},
+			},
+			wantHttpStatusCode: http.StatusOK,
+		},
+		{
+			name:           "fail-not-authorized-permission",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionReadAnyUser, utils.JWTPermissionAdjustBalance},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				return usecase.NewMockUsecaseInterface(controller)
+			},
+			wantResponse: generated.AuditLogResponse{
+				Header: generated.ResponseHeader{
+					Success:  false,
+					Messages: []string{"not authorized: missing required permission"},
+				},
+				Entries: []generated.AuditLogEntry{},
+			},
+			wantHttpStatusCode: http.StatusForbidden,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			handler := &Server{
+				Usecase: test.mockUsecase(controller),
+			}
+
+			e := echo.New()
+			request := httptest.NewRequest(http.MethodGet, "/admin/v1/audit-log", nil)
+			recorder := httptest.NewRecorder()
+			ctx := e.NewContext(request, recorder)
+			ctx.Set(string(utils.JWTClaimUserID), int64(1))
+			ctx.Set(string(utils.JWTClaimPermissions), test.ctxPermissions)
+
+			gotHttpStatusCode, gotResponse := handler.adminGetAuditLog(ctx, test.params)
+
+			if gotHttpStatusCode != test.wantHttpStatusCode {
+				t.Errorf("handler.AdminGetAuditLog() httpStatusCode = %v, wantHttpStatusCode %v", gotHttpStatusCode, test.wantHttpStatusCode)
+			}
+
+			if !reflect.DeepEqual(test.wantResponse, gotResponse) {
+				t.Errorf("handler.AdminGetAuditLog() response = %v, wantResponse %v", gotResponse, test.wantResponse)
+			}
+		})
+	}
+}
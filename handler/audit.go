@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/WalletService/model"
+	"github.com/WalletService/repository"
+	"github.com/WalletService/utils"
+	"github.com/labstack/echo/v4"
+)
+
+// NewIPExtractor returns how the IP of the client is taken from requests, see echo.Echo.IPExtractor.
+// X-Forwarded-For is only trusted from proxies in the comma separated CIDRs, i.e. "10.0.0.0/8", otherwise the IP is the one of the connection,
+// so that clients can not record a spoofed IP in the audit log.
+func NewIPExtractor(trustedProxies string) (echo.IPExtractor, error) {
+	var options []echo.TrustOption
+	for _, proxy := range strings.Split(trustedProxies, ",") {
+		proxy = strings.TrimSpace(proxy)
+		if proxy == "" {
+			continue
+		}
+
+		_, ipRange, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q, should be a CIDR, i.e. 10.0.0.0/8", proxy)
+		}
+		options = append(options, echo.TrustIPRange(ipRange))
+	}
+
+	if len(options) == 0 {
+		return echo.ExtractIPDirect(), nil
+	}
+
+	// Only the configured proxies are trusted, not every private network as by default
+	options = append(options, echo.TrustLoopback(false), echo.TrustLinkLocal(false), echo.TrustPrivateNet(false))
+	return echo.ExtractIPFromXFFHeader(options...), nil
+}
+
+// AuditActorMiddleware carries who performs the request and from where in the request context, recorded with every audit log entry of the request.
+// It should be registered after AuthenticationMiddleware, so the User authenticated by the JWT is known, and the IP is only taken from
+// headers if echo.Echo.IPExtractor is set, see NewIPExtractor.
+func AuditActorMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			// User is not authenticated on every endpoint, i.e. login
+			userID, _ := ctx.Get(string(utils.JWTClaimUserID)).(int64)
+
+			requestCtx := repository.ContextWithAuditActor(ctx.Request().Context(), model.AuditActor{
+				UserID:    userID,
+				IP:        ctx.RealIP(),
+				UserAgent: ctx.Request().UserAgent(),
+			})
+			ctx.SetRequest(ctx.Request().WithContext(requestCtx))
+
+			return next(ctx)
+		}
+	}
+}
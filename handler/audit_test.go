@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/WalletService/model"
+	"github.com/WalletService/repository"
+	"github.com/WalletService/utils"
+	"github.com/labstack/echo/v4"
+)
+
+func TestAuditActorMiddleware(t *testing.T) {
+	tests := []struct {
+		name      string
+		ctxUserID interface{}
+		wantActor model.AuditActor
+	}{
+		{
+			name:      "authenticated",
+			ctxUserID: int64(1234),
+			wantActor: model.AuditActor{UserID: 1234, IP: "10.0.0.1", UserAgent: "wallet-app/1.0"},
+		},
+		{
+			name:      "not-authenticated",
+			wantActor: model.AuditActor{IP: "10.0.0.1", UserAgent: "wallet-app/1.0"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			e := echo.New()
+			e.IPExtractor = echo.ExtractIPDirect()
+			request := httptest.NewRequest(http.MethodPost, "/v1/user/login", nil)
+			request.RemoteAddr = "10.0.0.1:51234"
+			request.Header.Set("User-Agent", "wallet-app/1.0")
+			ctx := e.NewContext(request, httptest.NewRecorder())
+			if test.ctxUserID != nil {
+				ctx.Set(string(utils.JWTClaimUserID), test.ctxUserID)
+			}
+
+			var gotActor model.AuditActor
+			handler := AuditActorMiddleware()(func(ctx echo.Context) error {
+				gotActor = repository.AuditActorFromContext(ctx.Request().Context())
+				return nil
+			})
+			if err := handler(ctx); err != nil {
+				t.Fatalf("AuditActorMiddleware() error = %v", err)
+			}
+
+			if gotActor != test.wantActor {
+				t.Errorf("AuditActorMiddleware() actor = %+v, want %+v", gotActor, test.wantActor)
+			}
+		})
+	}
+}
+
+func TestNewIPExtractor(t *testing.T) {
+	tests := []struct {
+		name           string
+		trustedProxies string
+		remoteAddr     string
+		headers        map[string]string
+		wantIP         string
+		wantErr        bool
+	}{
+		{
+			name:       "spoofed-headers-without-trusted-proxies",
+			remoteAddr: "203.0.113.7:51234",
+			headers:    map[string]string{echo.HeaderXForwardedFor: "198.51.100.1", echo.HeaderXRealIP: "198.51.100.2"},
+			wantIP:     "203.0.113.7",
+		},
+		{
+			name:           "forwarded-by-trusted-proxy",
+			trustedProxies: "10.0.0.0/8, 192.168.1.0/24",
+			remoteAddr:     "10.1.2.3:51234",
+			headers:        map[string]string{echo.HeaderXForwardedFor: "198.51.100.1"},
+			wantIP:         "198.51.100.1",
+		},
+		{
+			name:           "spoofed-header-behind-trusted-proxy",
+			trustedProxies: "10.0.0.0/8",
+			remoteAddr:     "10.1.2.3:51234",
+			headers:        map[string]string{echo.HeaderXForwardedFor: "198.51.100.9, 203.0.113.7"},
+			wantIP:         "203.0.113.7",
+		},
+		{
+			name:           "spoofed-header-from-untrusted-private-network",
+			trustedProxies: "10.0.0.0/8",
+			remoteAddr:     "172.16.0.5:51234",
+			headers:        map[string]string{echo.HeaderXForwardedFor: "198.51.100.1"},
+			wantIP:         "172.16.0.5",
+		},
+		{
+			name:           "fail-invalid-cidr",
+			trustedProxies: "10.0.0.1",
+			wantErr:        true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			extractor, err := NewIPExtractor(test.trustedProxies)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("NewIPExtractor() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if test.wantErr {
+				return
+			}
+
+			e := echo.New()
+			e.IPExtractor = extractor
+			request := httptest.NewRequest(http.MethodPost, "/v1/user/login", nil)
+			request.RemoteAddr = test.remoteAddr
+			for key, value := range test.headers {
+				request.Header.Set(key, value)
+			}
+			ctx := e.NewContext(request, httptest.NewRecorder())
+
+			// The IP recorded in the audit log is the one of the client, never a header it sent itself
+			var gotActor model.AuditActor
+			handler := AuditActorMiddleware()(func(ctx echo.Context) error {
+				gotActor = repository.AuditActorFromContext(ctx.Request().Context())
+				return nil
+			})
+			if err := handler(ctx); err != nil {
+				t.Fatalf("AuditActorMiddleware() error = %v", err)
+			}
+
+			if gotActor.IP != test.wantIP {
+				t.Errorf("AuditActorMiddleware() actor.IP = %v, want %v", gotActor.IP, test.wantIP)
+			}
+		})
+	}
+}
@@ -0,0 +1,272 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/WalletService/generated"
+	"github.com/WalletService/model"
+	"github.com/WalletService/repository"
+	"github.com/WalletService/usecase"
+	"github.com/WalletService/utils"
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	// Define function wrappers so we can inject dummy function in UT
+	fnConvertContactRequest func(int64, generated.ContactRequest, utils.ValidationLimits) (model.ContactRequest, []string) = convertContactRequest
+)
+
+const (
+	duplicateContactErrorMsg = "recipient is already a contact"
+)
+
+// AddContact saves a Recipient as a Contact of User, requested like the Recipient of a TransferOut.
+// NOTE: Check AuthenticationMiddleware cmd/main.go that authenticates the JWT token
+func (s *Server) AddContact(ctx echo.Context, pathUserID int) error {
+	return ctx.JSON(s.addContact(ctx, int64(pathUserID)))
+}
+func (s *Server) addContact(ctx echo.Context, pathUserID int64) (int, generated.ContactResponse) {
+	var (
+		context = ctx.Request().Context()
+
+		response = generated.ContactResponse{
+			Header: generated.ResponseHeader{}, //success is false by default
+		}
+	)
+
+	// Authorize and get userID of the requester, saving a Contact may look up its Recipient which is only needed to transfer
+	userID, err := authorize(ctx, utils.JWTPermissionPerformTransaction)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	} else if pathUserID != userID {
+		response.Header.Messages = []string{"JWT userID mismatched with request userID"}
+		return http.StatusForbidden, response
+	}
+
+	request := generated.ContactRequest{}
+	err = json.NewDecoder(ctx.Request().Body).Decode(&request)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusBadRequest, response
+	}
+
+	contactRequest, errorList := fnConvertContactRequest(userID, request, s.validationLimits())
+	if len(errorList) > 0 {
+		response.Header.Messages = errorList
+		return http.StatusBadRequest, response
+	}
+
+	contact, err := s.Usecase.AddContact(context, contactRequest)
+	switch {
+	case utils.IsUniqueConstraintViolation(err):
+		response.Header.Messages = []string{duplicateContactErrorMsg}
+		return http.StatusConflict, response
+	case errors.Is(err, usecase.ErrRecipientNotFound):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusNotFound, response
+	case errors.Is(err, usecase.ErrRecipientLookupLimitExceeded):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusTooManyRequests, response
+	case errors.Is(err, usecase.ErrContactSelf),
+		errors.Is(err, usecase.ErrRecipientTokenInvalid),
+		errors.Is(err, usecase.ErrRecipientTokenExpired):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusBadRequest, response
+	case isAccountStatusError(err):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	case err != nil:
+		response.Header.Messages = []string{err.Error()}
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
+	}
+
+	response.Header.Success = true
+	response.Header.Messages = []string{successMsg}
+	response.Contact = convertContactToResponse(contact)
+	return http.StatusCreated, response
+}
+
+// GetUserContacts lists the Contacts of User by nickname.
+// NOTE: Check AuthenticationMiddleware cmd/main.go that authenticates the JWT token
+func (s *Server) GetUserContacts(ctx echo.Context, pathUserID int) error {
+	return ctx.JSON(s.getUserContacts(ctx, int64(pathUserID)))
+}
+func (s *Server) getUserContacts(ctx echo.Context, pathUserID int64) (int, generated.ContactsResponse) {
+	var (
+		context = ctx.Request().Context()
+
+		response = generated.ContactsResponse{
+			Header:   generated.ResponseHeader{}, //success is false by default
+			Contacts: []generated.Contact{},
+		}
+	)
+
+	// Authorize and get userID of the requester
+	userID, err := authorize(ctx, utils.JWTPermissionGetUser)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	} else if pathUserID != userID {
+		response.Header.Messages = []string{"JWT userID mismatched with request userID"}
+		return http.StatusForbidden, response
+	}
+
+	contacts, err := s.Usecase.GetUserContacts(context, userID)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
+	}
+
+	for _, contact := range contacts {
+		response.Contacts = append(response.Contacts, convertContactToResponse(contact))
+	}
+
+	response.Header.Success = true
+	response.Header.Messages = []string{successMsg}
+	return http.StatusOK, response
+}
+
+// UpdateContact renames a Contact of User.
+// NOTE: Check AuthenticationMiddleware cmd/main.go that authenticates the JWT token
+func (s *Server) UpdateContact(ctx echo.Context, pathUserID int, contactID int) error {
+	return ctx.JSON(s.updateContact(ctx, int64(pathUserID), int64(contactID)))
+}
+func (s *Server) updateContact(ctx echo.Context, pathUserID int64, contactID int64) (int, generated.ContactResponse) {
+	var (
+		context = ctx.Request().Context()
+
+		response = generated.ContactResponse{
+			Header: generated.ResponseHeader{}, //success is false by default
+		}
+	)
+
+	// Authorize and get userID of the requester
+	userID, err := authorize(ctx, utils.JWTPermissionGetUser)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	} else if pathUserID != userID {
+		response.Header.Messages = []string{"JWT userID mismatched with request userID"}
+		return http.StatusForbidden, response
+	}
+
+	request := generated.UpdateContactRequest{}
+	err = json.NewDecoder(ctx.Request().Body).Decode(&request)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusBadRequest, response
+	}
+
+	nickname, errorList := validateNickname(&request.Nickname)
+	if len(errorList) > 0 {
+		response.Header.Messages = errorList
+		return http.StatusBadRequest, response
+	}
+
+	contact, err := s.Usecase.UpdateContact(context, model.UpdateContactRequest{
+		ID:       contactID,
+		UserID:   userID,
+		Nickname: nickname,
+	})
+	switch {
+	case errors.Is(err, repository.ErrContactNotFound):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusNotFound, response
+	case err != nil:
+		response.Header.Messages = []string{err.Error()}
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
+	}
+
+	response.Header.Success = true
+	response.Header.Messages = []string{successMsg}
+	response.Contact = convertContactToResponse(contact)
+	return http.StatusOK, response
+}
+
+// DeleteContact deletes a Contact of User, the Recipient is suggested again if User keeps transferring to it.
+// NOTE: Check AuthenticationMiddleware cmd/main.go that authenticates the JWT token
+func (s *Server) DeleteContact(ctx echo.Context, pathUserID int, contactID int) error {
+	return ctx.JSON(s.deleteContact(ctx, int64(pathUserID), int64(contactID)))
+}
+func (s *Server) deleteContact(ctx echo.Context, pathUserID int64, contactID int64) (int, generated.DeleteContactResponse) {
+	var (
+		context = ctx.Request().Context()
+
+		response = generated.DeleteContactResponse{
+			Header: generated.ResponseHeader{}, //success is false by default
+		}
+	)
+
+	// Authorize and get userID of the requester
+	userID, err := authorize(ctx, utils.JWTPermissionGetUser)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	} else if pathUserID != userID {
+		response.Header.Messages = []string{"JWT userID mismatched with request userID"}
+		return http.StatusForbidden, response
+	}
+
+	err = s.Usecase.DeleteContact(context, userID, contactID)
+	switch {
+	case errors.Is(err, repository.ErrContactNotFound):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusNotFound, response
+	case err != nil:
+		response.Header.Messages = []string{err.Error()}
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
+	}
+
+	response.Header.Success = true
+	response.Header.Messages = []string{successMsg}
+	return http.StatusOK, response
+}
+
+// GetContactSuggestions suggests Recipients of recent transfers of User who are not Contacts yet.
+// NOTE: Check AuthenticationMiddleware cmd/main.go that authenticates the JWT token
+func (s *Server) GetContactSuggestions(ctx echo.Context, pathUserID int, params generated.GetContactSuggestionsParams) error {
+	return ctx.JSON(s.getContactSuggestions(ctx, int64(pathUserID), params))
+}
+func (s *Server) getContactSuggestions(ctx echo.Context, pathUserID int64, params generated.GetContactSuggestionsParams) (int, generated.ContactSuggestionsResponse) {
+	var (
+		context = ctx.Request().Context()
+
+		response = generated.ContactSuggestionsResponse{
+			Header:      generated.ResponseHeader{}, //success is false by default
+			Suggestions: []generated.ContactSuggestion{},
+		}
+	)
+
+	// Authorize and get userID of the requester
+	userID, err := authorize(ctx, utils.JWTPermissionGetUser)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	} else if pathUserID != userID {
+		response.Header.Messages = []string{"JWT userID mismatched with request userID"}
+		return http.StatusForbidden, response
+	}
+
+	// Limit range is validated against api.yml by OpenAPIValidationMiddleware, the usecase defaults it if not set
+	limit := 0
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	suggestions, err := s.Usecase.GetContactSuggestions(context, userID, limit)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
+	}
+
+	for _, suggestion := range suggestions {
+		response.Suggestions = append(response.Suggestions, convertContactSuggestionToResponse(suggestion))
+	}
+
+	response.Header.Success = true
+	response.Header.Messages = []string{successMsg}
+	return http.StatusOK, response
+}
@@ -0,0 +1,233 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/WalletService/generated"
+	"github.com/WalletService/model"
+	"github.com/WalletService/repository"
+	"github.com/WalletService/usecase"
+	"github.com/WalletService/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+)
+
+func TestAddContact(t *testing.T) {
+	var (
+		createdTime     = time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+		recipientClosed = false
+		request         = model.ContactRequest{UserID: 123, RecipientPhoneNumber: "+628987654321", Nickname: "Johnny"}
+	)
+
+	tests := []struct {
+		name               string
+		ctxPermissions     []utils.JWTPermission
+		requestBody        generated.ContactRequest
+		mockUsecase        func(controller *gomock.Controller) *usecase.MockUsecaseInterface
+		wantResponse       generated.ContactResponse
+		wantHttpStatusCode int
+	}{
+		{
+			name:           "success",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionPerformTransaction},
+			requestBody:    generated.ContactRequest{Nickname: " Johnny ", RecipientPhoneNumber: stringPtr("0898-7654-321")},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+				mock.EXPECT().AddContact(gomock.Any(), request).Return(model.Contact{
+					ID: 11, UserID: 123, RecipientID: 456, Nickname: "Johnny", RecipientName: "Jo** Do*", RecipientStatus: model.UserStatusFrozen, CreatedTime: createdTime,
+				}, nil)
+				return mock
+			},
+			wantResponse: generated.ContactResponse{
+				Header: generated.ResponseHeader{
+					Success:  true,
+					Messages: []string{successMsg},
+				},
+				Contact: generated.Contact{
+					Id:              intPtr(11),
+					RecipientId:     intPtr(456),
+					Nickname:        stringPtr("Johnny"),
+					RecipientName:   stringPtr("Jo** Do*"),
+					RecipientClosed: &recipientClosed,
+					CreatedTime:     &createdTime,
+				},
+			},
+			wantHttpStatusCode: http.StatusCreated,
+		},
+		{
+			name:           "fail-not-authorized-permission",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionGetUser},
+			requestBody:    generated.ContactRequest{Nickname: "Johnny", RecipientPhoneNumber: stringPtr("08987654321")},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				return usecase.NewMockUsecaseInterface(controller)
+			},
+			wantResponse: generated.ContactResponse{
+				Header: generated.ResponseHeader{Messages: []string{"not authorized: missing required permission"}},
+			},
+			wantHttpStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "fail-invalid-nickname",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionPerformTransaction},
+			requestBody:    generated.ContactRequest{Nickname: "   ", RecipientPhoneNumber: stringPtr("08987654321")},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				return usecase.NewMockUsecaseInterface(controller)
+			},
+			wantResponse: generated.ContactResponse{
+				Header: generated.ResponseHeader{Messages: []string{"nickname should be 1 to 50 characters"}},
+			},
+			wantHttpStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fail-already-a-contact",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionPerformTransaction},
+			requestBody:    generated.ContactRequest{Nickname: "Johnny", RecipientPhoneNumber: stringPtr("08987654321")},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+				mock.EXPECT().AddContact(gomock.Any(), request).Return(model.Contact{}, &pq.Error{Code: "23505"})
+				return mock
+			},
+			wantResponse: generated.ContactResponse{
+				Header: generated.ResponseHeader{Messages: []string{duplicateContactErrorMsg}},
+			},
+			wantHttpStatusCode: http.StatusConflict,
+		},
+		{
+			name:           "fail-recipient-not-found",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionPerformTransaction},
+			requestBody:    generated.ContactRequest{Nickname: "Johnny", RecipientPhoneNumber: stringPtr("08987654321")},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+				mock.EXPECT().AddContact(gomock.Any(), request).Return(model.Contact{}, usecase.ErrRecipientNotFound)
+				return mock
+			},
+			wantResponse: generated.ContactResponse{
+				Header: generated.ResponseHeader{Messages: []string{usecase.ErrRecipientNotFound.Error()}},
+			},
+			wantHttpStatusCode: http.StatusNotFound,
+		},
+		{
+			name:           "fail-self",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionPerformTransaction},
+			requestBody:    generated.ContactRequest{Nickname: "Johnny", RecipientPhoneNumber: stringPtr("08987654321")},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+				mock.EXPECT().AddContact(gomock.Any(), request).Return(model.Contact{}, usecase.ErrContactSelf)
+				return mock
+			},
+			wantResponse: generated.ContactResponse{
+				Header: generated.ResponseHeader{Messages: []string{usecase.ErrContactSelf.Error()}},
+			},
+			wantHttpStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			handler := &Server{
+				Usecase: test.mockUsecase(controller),
+			}
+
+			requestBodyJSON, _ := json.Marshal(test.requestBody)
+
+			e := echo.New()
+			request := httptest.NewRequest(http.MethodPost, "/v1/user/{userID}/contacts", bytes.NewBuffer(requestBodyJSON))
+			recorder := httptest.NewRecorder()
+			ctx := e.NewContext(request, recorder)
+			ctx.Set(string(utils.JWTClaimUserID), int64(123))
+			ctx.Set(string(utils.JWTClaimPermissions), test.ctxPermissions)
+
+			gotHttpStatusCode, gotResponse := handler.addContact(ctx, 123)
+
+			if gotHttpStatusCode != test.wantHttpStatusCode {
+				t.Errorf("handler.AddContact() httpStatusCode = %v, wantHttpStatusCode %v", gotHttpStatusCode, test.wantHttpStatusCode)
+			}
+
+			if !reflect.DeepEqual(test.wantResponse, gotResponse) {
+				t.Errorf("handler.AddContact() response = %v, wantResponse %v", gotResponse, test.wantResponse)
+			}
+		})
+	}
+}
+
+func TestDeleteContact(t *testing.T) {
+	tests := []struct {
+		name               string
+		pathUserID         int64
+		mockUsecase        func(controller *gomock.Controller) *usecase.MockUsecaseInterface
+		wantResponse       generated.DeleteContactResponse
+		wantHttpStatusCode int
+	}{
+		{
+			name:       "success",
+			pathUserID: 123,
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+				mock.EXPECT().DeleteContact(gomock.Any(), int64(123), int64(11)).Return(nil)
+				return mock
+			},
+			wantResponse: generated.DeleteContactResponse{
+				Header: generated.ResponseHeader{Success: true, Messages: []string{successMsg}},
+			},
+			wantHttpStatusCode: http.StatusOK,
+		},
+		{
+			name:       "fail-user-id-mismatch",
+			pathUserID: 456,
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				return usecase.NewMockUsecaseInterface(controller)
+			},
+			wantResponse: generated.DeleteContactResponse{
+				Header: generated.ResponseHeader{Messages: []string{"JWT userID mismatched with request userID"}},
+			},
+			wantHttpStatusCode: http.StatusForbidden,
+		},
+		{
+			name:       "fail-not-found",
+			pathUserID: 123,
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+				mock.EXPECT().DeleteContact(gomock.Any(), int64(123), int64(11)).Return(repository.ErrContactNotFound)
+				return mock
+			},
+			wantResponse: generated.DeleteContactResponse{
+				Header: generated.ResponseHeader{Messages: []string{repository.ErrContactNotFound.Error()}},
+			},
+			wantHttpStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			handler := &Server{
+				Usecase: test.mockUsecase(controller),
+			}
+
+			e := echo.New()
+			request := httptest.NewRequest(http.MethodDelete, "/v1/user/{userID}/contacts/{contactID}", nil)
+			recorder := httptest.NewRecorder()
+			ctx := e.NewContext(request, recorder)
+			ctx.Set(string(utils.JWTClaimUserID), int64(123))
+			ctx.Set(string(utils.JWTClaimPermissions), []utils.JWTPermission{utils.JWTPermissionGetUser})
+
+			gotHttpStatusCode, gotResponse := handler.deleteContact(ctx, test.pathUserID, 11)
+
+			if gotHttpStatusCode != test.wantHttpStatusCode {
+				t.Errorf("handler.DeleteContact() httpStatusCode = %v, wantHttpStatusCode %v", gotHttpStatusCode, test.wantHttpStatusCode)
+			}
+
+			if !reflect.DeepEqual(test.wantResponse, gotResponse) {
+				t.Errorf("handler.DeleteContact() response = %v, wantResponse %v", gotResponse, test.wantResponse)
+			}
+		})
+	}
+}
@@ -1,21 +1,22 @@
 package handler
 
 import (
-	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 
 	"github.com/WalletService/generated"
 	"github.com/WalletService/model"
+	"github.com/WalletService/repository"
+	"github.com/WalletService/usecase"
 	"github.com/WalletService/utils"
-	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 )
 
 var (
 	// Define function wrappers so we can inject dummy function in UT
-	fnConvertRegisterUserRequestToUser             func(generated.User) (model.User, []string)                      = convertRegisterUserRequestToUser
-	fnConvertCreateTransactionRequestToTransaction func(int64, generated.Transaction) (model.Transaction, []string) = convertCreateTransactionRequestToTransaction
+	fnConvertRegisterUserRequestToUser             func(generated.User, utils.ValidationLimits) (model.User, []string)                             = convertRegisterUserRequestToUser
+	fnConvertCreateTransactionRequestToTransaction func(int64, generated.TransactionRequest, utils.ValidationLimits) (model.Transaction, []string) = convertCreateTransactionRequestToTransaction
 )
 
 // RegisterUser creates a new User with a unique phoneNumber and valid password format.
@@ -24,7 +25,7 @@ func (s *Server) RegisterUser(ctx echo.Context) error {
 }
 func (s *Server) registerUser(ctx echo.Context) (int, generated.RegisterUserResponse) {
 	var (
-		context = context.Background()
+		context = ctx.Request().Context()
 
 		response = generated.RegisterUserResponse{
 			Header: generated.ResponseHeader{}, //success is false by default
@@ -38,7 +39,7 @@ func (s *Server) registerUser(ctx echo.Context) (int, generated.RegisterUserResp
 		return http.StatusBadRequest, response
 	}
 
-	user, errorList := fnConvertRegisterUserRequestToUser(request)
+	user, errorList := fnConvertRegisterUserRequestToUser(request, s.validationLimits())
 	if len(errorList) > 0 {
 		response.Header.Messages = errorList
 		return http.StatusBadRequest, response
@@ -52,7 +53,7 @@ func (s *Server) registerUser(ctx echo.Context) (int, generated.RegisterUserResp
 		}
 
 		response.Header.Messages = []string{err.Error()}
-		return http.StatusInternalServerError, response
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
 	}
 
 	response.Header.Success = true
@@ -68,7 +69,7 @@ func (s *Server) UserLogin(ctx echo.Context) error {
 }
 func (s *Server) userLogin(ctx echo.Context) (int, generated.UserLoginResponse) {
 	var (
-		context = context.Background()
+		context = ctx.Request().Context()
 
 		response = generated.UserLoginResponse{
 			Header: generated.ResponseHeader{}, //success is false by default
@@ -83,14 +84,14 @@ func (s *Server) userLogin(ctx echo.Context) (int, generated.UserLoginResponse)
 	}
 
 	// Get user's phone number from request body
-	validPhoneNumber, errorList := validatePhoneNumber(request.PhoneNumber)
+	validPhoneNumber, errorList := validatePhoneNumber(request.PhoneNumber, s.validationLimits())
 	if len(errorList) > 0 {
 		response.Header.Messages = errorList
 		return http.StatusBadRequest, response
 	}
 
 	// Validate password format is valid
-	validPassword, errorList := validatePassword(request.Password)
+	validPassword, errorList := validatePassword(request.Password, s.validationLimits())
 	if len(errorList) > 0 {
 		response.Header.Messages = errorList
 		return http.StatusBadRequest, response
@@ -98,14 +99,25 @@ func (s *Server) userLogin(ctx echo.Context) (int, generated.UserLoginResponse)
 
 	// Logs in as user
 	userID, err := s.Usecase.UserLogin(context, validPhoneNumber, validPassword)
+	if errors.Is(err, usecase.ErrUserClosed) {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	} else if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return errorStatusCode(context, err, http.StatusBadRequest), response
+	}
+
+	// Every User can manage its own wallet, roles of the User grant further permissions, i.e. admin API
+	rolePermissions, err := s.Usecase.GetUserPermissions(context, userID)
 	if err != nil {
 		response.Header.Messages = []string{err.Error()}
-		return http.StatusBadRequest, response
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
 	}
+	permissions := append([]utils.JWTPermission{utils.JWTPermissionGetUser, utils.JWTPermissionPerformTransaction}, rolePermissions...)
 
 	// Set data to Echo context so we can rely on AuthenticatedMiddleware to generate and return JWT in the Authorization header
 	ctx.Set(string(utils.JWTClaimUserID), userID)
-	ctx.Set(string(utils.JWTClaimPermissions), []utils.JWTPermission{utils.JWTPermissionGetUser, utils.JWTPermissionPerformTransaction})
+	ctx.Set(string(utils.JWTClaimPermissions), permissions)
 
 	response.Header.Success = true
 	response.Header.Messages = []string{successMsg}
@@ -120,7 +132,7 @@ func (s *Server) GetUser(ctx echo.Context) error {
 }
 func (s *Server) getUser(ctx echo.Context) (int, generated.GetUserResponse) {
 	var (
-		context = context.Background()
+		context = ctx.Request().Context()
 
 		response = generated.GetUserResponse{
 			Header: generated.ResponseHeader{}, //success is false by default
@@ -138,17 +150,12 @@ func (s *Server) getUser(ctx echo.Context) (int, generated.GetUserResponse) {
 	user, err := s.Usecase.GetUser(context, userID)
 	if err != nil {
 		response.Header.Messages = []string{err.Error()}
-		return http.StatusInternalServerError, response
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
 	}
 
 	response.Header.Success = true
 	response.Header.Messages = []string{successMsg}
-	response.User = generated.User{
-		Id:          &user.ID,
-		FullName:    &user.FullName,
-		PhoneNumber: &user.PhoneNumber,
-		Balance:     &user.Balance,
-	}
+	response.User = convertUserToResponse(user)
 
 	return http.StatusOK, response
 }
@@ -160,7 +167,7 @@ func (s *Server) CreateUserTransaction(ctx echo.Context, pathUserID int) error {
 }
 func (s *Server) createUserTransaction(ctx echo.Context, pathUserID int64) (int, generated.TransactionResponse) {
 	var (
-		context = context.Background()
+		context = ctx.Request().Context()
 
 		response = generated.TransactionResponse{
 			Header: generated.ResponseHeader{}, //success is false by default
@@ -177,7 +184,7 @@ func (s *Server) createUserTransaction(ctx echo.Context, pathUserID int64) (int,
 		return http.StatusForbidden, response
 	}
 
-	request := generated.Transaction{}
+	request := generated.TransactionRequest{}
 
 	err = json.NewDecoder(ctx.Request().Body).Decode(&request)
 	if err != nil {
@@ -185,24 +192,36 @@ func (s *Server) createUserTransaction(ctx echo.Context, pathUserID int64) (int,
 		return http.StatusBadRequest, response
 	}
 
-	transaction, errorList := fnConvertCreateTransactionRequestToTransaction(userID, request)
+	transaction, errorList := fnConvertCreateTransactionRequestToTransaction(userID, request, s.validationLimits())
 	if len(errorList) > 0 {
 		response.Header.Messages = errorList
 		return http.StatusBadRequest, response
 	}
 
-	newTransactionID, err := s.Usecase.CreateUserTransaction(context, transaction)
-	if err != nil {
+	newTransaction, err := s.Usecase.CreateUserTransaction(context, transaction)
+	if isAccountStatusError(err) {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	} else if errors.Is(err, usecase.ErrRecipientLookupLimitExceeded) {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusTooManyRequests, response
+	} else if errors.Is(err, usecase.ErrRecipientNotFound) || errors.Is(err, repository.ErrPocketNotFound) ||
+		errors.Is(err, repository.ErrSharedWalletNotFound) {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusNotFound, response
+	} else if errors.Is(err, usecase.ErrSharedWalletRoleNotAllowed) {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	} else if errors.Is(err, usecase.ErrRecipientTokenInvalid) || errors.Is(err, usecase.ErrRecipientTokenExpired) ||
+		errors.Is(err, usecase.ErrSharedWalletTransactionLimitExceeded) || errors.Is(err, usecase.ErrSharedWalletMonthlyLimitExceeded) {
 		response.Header.Messages = []string{err.Error()}
-		return http.StatusInternalServerError, response
+		return http.StatusBadRequest, response
+	} else if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
 	}
 
-	if newTransactionID != uuid.Nil {
-		newTransactionIDString := newTransactionID.String()
-		response.Transaction = generated.Transaction{
-			Id: &newTransactionIDString,
-		}
-	}
+	response.Transaction = convertTransactionToResponse(newTransaction)
 	response.Header.Success = true
 	response.Header.Messages = []string{successMsg}
 	return http.StatusOK, response
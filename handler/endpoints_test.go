@@ -36,6 +36,14 @@ var (
 		return &t
 	}
 
+	currencyPtr = func(c generated.Currency) *generated.Currency {
+		return &c
+	}
+
+	userStatusPtr = func(s generated.UserStatus) *generated.UserStatus {
+		return &s
+	}
+
 	convertToUUID = func(in string) uuid.UUID {
 		result, _ := uuid.Parse(in)
 		return result
@@ -59,7 +67,7 @@ func TestRegisterUser(t *testing.T) {
 		name                               string
 		mockUsecase                        func(controller *gomock.Controller) *usecase.MockUsecaseInterface
 		requestBody                        generated.User
-		fnConvertRegisterUserRequestToUser func(generated.User) (model.User, []string)
+		fnConvertRegisterUserRequestToUser func(generated.User, utils.ValidationLimits) (model.User, []string)
 		wantResponse                       generated.RegisterUserResponse
 		wantHttpStatusCode                 int
 	}{
@@ -70,7 +78,7 @@ func TestRegisterUser(t *testing.T) {
 				PhoneNumber: stringPtr("+628123456789"),
 				Password:    stringPtr("P455w0rd!."),
 			},
-			fnConvertRegisterUserRequestToUser: func(generated.User) (model.User, []string) {
+			fnConvertRegisterUserRequestToUser: func(generated.User, utils.ValidationLimits) (model.User, []string) {
 				user := model.User{
 					FullName:    "User",
 					PhoneNumber: "+628123456789",
@@ -110,7 +118,7 @@ func TestRegisterUser(t *testing.T) {
 				PhoneNumber: stringPtr("+628123456789"),
 				Password:    stringPtr("P455w0rd!."),
 			},
-			fnConvertRegisterUserRequestToUser: func(generated.User) (model.User, []string) {
+			fnConvertRegisterUserRequestToUser: func(generated.User, utils.ValidationLimits) (model.User, []string) {
 				user := model.User{
 					FullName:    "User",
 					PhoneNumber: "+628123456789",
@@ -147,7 +155,7 @@ func TestRegisterUser(t *testing.T) {
 				PhoneNumber: stringPtr("+628123456789"),
 				Password:    stringPtr("P455w0rd!."),
 			},
-			fnConvertRegisterUserRequestToUser: func(generated.User) (model.User, []string) {
+			fnConvertRegisterUserRequestToUser: func(generated.User, utils.ValidationLimits) (model.User, []string) {
 				user := model.User{
 					FullName:    "User",
 					PhoneNumber: "+628123456789",
@@ -184,7 +192,7 @@ func TestRegisterUser(t *testing.T) {
 				PhoneNumber: stringPtr("+628123456789"),
 				Password:    stringPtr("P455w0rd!."),
 			},
-			fnConvertRegisterUserRequestToUser: func(generated.User) (model.User, []string) {
+			fnConvertRegisterUserRequestToUser: func(generated.User, utils.ValidationLimits) (model.User, []string) {
 				user := model.User{
 					FullName:    "User",
 					PhoneNumber: "+628123456789",
@@ -221,7 +229,7 @@ func TestRegisterUser(t *testing.T) {
 				PhoneNumber: stringPtr("+62812"),
 				Password:    stringPtr("P455w"),
 			},
-			fnConvertRegisterUserRequestToUser: func(generated.User) (model.User, []string) {
+			fnConvertRegisterUserRequestToUser: func(generated.User, utils.ValidationLimits) (model.User, []string) {
 				return model.User{}, []string{"invalid full name", "invalid phone number", "invalid password"}
 			},
 			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
@@ -285,6 +293,7 @@ func TestUserLogin(t *testing.T) {
 		requestBody        generated.User
 		wantResponse       generated.UserLoginResponse
 		wantCtxUserID      int64
+		wantCtxPermissions []utils.JWTPermission
 		wantHttpStatusCode int
 	}{
 		{
@@ -297,6 +306,7 @@ func TestUserLogin(t *testing.T) {
 				mock := usecase.NewMockUsecaseInterface(controller)
 
 				mock.EXPECT().UserLogin(gomock.Any(), "+628123456789", "Password123!.").Return(int64(123), nil)
+				mock.EXPECT().GetUserPermissions(gomock.Any(), int64(123)).Return(nil, nil)
 
 				return mock
 			},
@@ -310,8 +320,58 @@ func TestUserLogin(t *testing.T) {
 				},
 			},
 			wantCtxUserID:      123,
+			wantCtxPermissions: []utils.JWTPermission{utils.JWTPermissionGetUser, utils.JWTPermissionPerformTransaction},
 			wantHttpStatusCode: http.StatusOK,
 		},
+		{
+			name: "success-with-role-permissions",
+			requestBody: generated.User{
+				PhoneNumber: stringPtr("+628123456789"),
+				Password:    stringPtr("Password123!."),
+			},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+
+				mock.EXPECT().UserLogin(gomock.Any(), "+628123456789", "Password123!.").Return(int64(123), nil)
+				mock.EXPECT().GetUserPermissions(gomock.Any(), int64(123)).Return([]utils.JWTPermission{utils.JWTPermissionReadAnyUser}, nil)
+
+				return mock
+			},
+			wantResponse: generated.UserLoginResponse{
+				Header: generated.ResponseHeader{
+					Success:  true,
+					Messages: []string{successMsg},
+				},
+				User: generated.User{
+					Id: int64Ptr(123),
+				},
+			},
+			wantCtxUserID:      123,
+			wantCtxPermissions: []utils.JWTPermission{utils.JWTPermissionGetUser, utils.JWTPermissionPerformTransaction, utils.JWTPermissionReadAnyUser},
+			wantHttpStatusCode: http.StatusOK,
+		},
+		{
+			name: "fail-get-permissions",
+			requestBody: generated.User{
+				PhoneNumber: stringPtr("+628123456789"),
+				Password:    stringPtr("Password123!."),
+			},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+
+				mock.EXPECT().UserLogin(gomock.Any(), "+628123456789", "Password123!.").Return(int64(123), nil)
+				mock.EXPECT().GetUserPermissions(gomock.Any(), int64(123)).Return(nil, errors.New("connection refused"))
+
+				return mock
+			},
+			wantResponse: generated.UserLoginResponse{
+				Header: generated.ResponseHeader{
+					Success:  false,
+					Messages: []string{"connection refused"},
+				},
+			},
+			wantHttpStatusCode: http.StatusInternalServerError,
+		},
 		{
 			name: "fail-usecase-error",
 			requestBody: generated.User{
@@ -368,9 +428,8 @@ func TestUserLogin(t *testing.T) {
 				}
 
 				gotCtxPermissions, _ := ctx.Get(string(utils.JWTClaimPermissions)).([]utils.JWTPermission)
-				wantCtxPermissions := []utils.JWTPermission{utils.JWTPermissionGetUser, utils.JWTPermissionPerformTransaction}
-				if !reflect.DeepEqual(gotCtxPermissions, wantCtxPermissions) {
-					t.Errorf("handler.UserLogin() gotCtxPermissions = %v, wantCtxPermissions %v", gotCtxPermissions, wantCtxPermissions)
+				if !reflect.DeepEqual(gotCtxPermissions, test.wantCtxPermissions) {
+					t.Errorf("handler.UserLogin() gotCtxPermissions = %v, wantCtxPermissions %v", gotCtxPermissions, test.wantCtxPermissions)
 				}
 			}
 		})
@@ -416,6 +475,7 @@ func TestGetUser(t *testing.T) {
 					FullName:    stringPtr("User"),
 					PhoneNumber: stringPtr("+628123456789"),
 					Balance:     floatPtr(888.90),
+					Status:      userStatusPtr(generated.Active),
 				},
 			},
 			wantHttpStatusCode: http.StatusOK,
@@ -529,8 +589,8 @@ func TestCreateTransaction(t *testing.T) {
 		mockUsecase                                    func(controller *gomock.Controller) *usecase.MockUsecaseInterface
 		ctxPermissions                                 []utils.JWTPermission
 		ctxUserID                                      int64
-		requestBody                                    generated.Transaction
-		fnConvertCreateTransactionRequestToTransaction func(int64, generated.Transaction) (model.Transaction, []string)
+		requestBody                                    generated.TransactionRequest
+		fnConvertCreateTransactionRequestToTransaction func(int64, generated.TransactionRequest, utils.ValidationLimits) (model.Transaction, []string)
 
 		wantResponse       generated.TransactionResponse
 		wantHttpStatusCode int
@@ -541,14 +601,14 @@ func TestCreateTransaction(t *testing.T) {
 				utils.JWTPermissionPerformTransaction,
 			},
 			ctxUserID: 123,
-			requestBody: generated.Transaction{
-				Amount:      floatPtr(100000),
+			requestBody: generated.TransactionRequest{
+				Amount:      100000,
 				RecipientId: intPtr(2),
-				Type:        transactionTypePtr(generated.TransferOut),
+				Type:        generated.TransferOut,
 				Description: stringPtr("Traktir Makan"),
 				Password:    stringPtr("Admin1234!"),
 			},
-			fnConvertCreateTransactionRequestToTransaction: func(int64, generated.Transaction) (model.Transaction, []string) {
+			fnConvertCreateTransactionRequestToTransaction: func(int64, generated.TransactionRequest, utils.ValidationLimits) (model.Transaction, []string) {
 				transaction := model.Transaction{
 					Amount:      100000,
 					RecipientID: 2,
@@ -570,7 +630,7 @@ func TestCreateTransaction(t *testing.T) {
 					Type:        model.TransactionTypeTransferOut,
 					Description: "Traktir Makan",
 					Password:    "Admin1234!",
-				}).Return(convertToUUID("3d6e668f-ad02-40ff-8540-90c1528a7c88"), nil)
+				}).Return(model.Transaction{ID: convertToUUID("3d6e668f-ad02-40ff-8540-90c1528a7c88")}, nil)
 
 				return mock
 			},
@@ -591,14 +651,14 @@ func TestCreateTransaction(t *testing.T) {
 				utils.JWTPermissionGetUser,
 			},
 			ctxUserID: 123,
-			requestBody: generated.Transaction{
-				Amount:      floatPtr(100000),
+			requestBody: generated.TransactionRequest{
+				Amount:      100000,
 				RecipientId: intPtr(2),
-				Type:        transactionTypePtr(generated.TransferOut),
+				Type:        generated.TransferOut,
 				Description: stringPtr("Traktir Makan"),
 				Password:    stringPtr("Admin1234!"),
 			},
-			fnConvertCreateTransactionRequestToTransaction: func(int64, generated.Transaction) (model.Transaction, []string) {
+			fnConvertCreateTransactionRequestToTransaction: func(int64, generated.TransactionRequest, utils.ValidationLimits) (model.Transaction, []string) {
 				return model.Transaction{}, []string{}
 			},
 			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
@@ -619,14 +679,14 @@ func TestCreateTransaction(t *testing.T) {
 				utils.JWTPermissionPerformTransaction,
 			},
 			ctxUserID: 123,
-			requestBody: generated.Transaction{
-				Amount:      floatPtr(100000),
+			requestBody: generated.TransactionRequest{
+				Amount:      100000,
 				RecipientId: intPtr(2),
-				Type:        transactionTypePtr(generated.TransferOut),
+				Type:        generated.TransferOut,
 				Description: stringPtr("Traktir Makan"),
 				Password:    stringPtr("Admin1234!"),
 			},
-			fnConvertCreateTransactionRequestToTransaction: func(int64, generated.Transaction) (model.Transaction, []string) {
+			fnConvertCreateTransactionRequestToTransaction: func(int64, generated.TransactionRequest, utils.ValidationLimits) (model.Transaction, []string) {
 				transaction := model.Transaction{
 					Amount:      100000,
 					RecipientID: 2,
@@ -648,7 +708,7 @@ func TestCreateTransaction(t *testing.T) {
 					Type:        model.TransactionTypeTransferOut,
 					Description: "Traktir Makan",
 					Password:    "Admin1234!",
-				}).Return(uuid.Nil, errors.New("error-create-transaction"))
+				}).Return(model.Transaction{}, errors.New("error-create-transaction"))
 
 				return mock
 			},
@@ -661,6 +721,62 @@ func TestCreateTransaction(t *testing.T) {
 			},
 			wantHttpStatusCode: http.StatusInternalServerError,
 		},
+		{
+			name: "fail-recipient-lookup-limit-exceeded",
+			ctxPermissions: []utils.JWTPermission{
+				utils.JWTPermissionPerformTransaction,
+			},
+			ctxUserID: 123,
+			requestBody: generated.TransactionRequest{
+				Amount:               100000,
+				RecipientPhoneNumber: stringPtr("08123456789"),
+				Type:                 generated.TransferOut,
+				Password:             stringPtr("Admin1234!"),
+			},
+			fnConvertCreateTransactionRequestToTransaction: func(int64, generated.TransactionRequest, utils.ValidationLimits) (model.Transaction, []string) {
+				return model.Transaction{Amount: 100000, RecipientPhoneNumber: "+628123456789", Type: model.TransactionTypeTransferOut, Password: "Admin1234!"}, nil
+			},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+				mock.EXPECT().CreateUserTransaction(gomock.Any(), gomock.Any()).Return(model.Transaction{}, usecase.ErrRecipientLookupLimitExceeded)
+				return mock
+			},
+			wantResponse: generated.TransactionResponse{
+				Header: generated.ResponseHeader{
+					Success:  false,
+					Messages: []string{usecase.ErrRecipientLookupLimitExceeded.Error()},
+				},
+			},
+			wantHttpStatusCode: http.StatusTooManyRequests,
+		},
+		{
+			name: "fail-recipient-token-expired",
+			ctxPermissions: []utils.JWTPermission{
+				utils.JWTPermissionPerformTransaction,
+			},
+			ctxUserID: 123,
+			requestBody: generated.TransactionRequest{
+				Amount:         100000,
+				RecipientToken: stringPtr("5a0d7d2e-3c3f-4a59-8f0e-8f6b0f3f2a10"),
+				Type:           generated.TransferOut,
+				Password:       stringPtr("Admin1234!"),
+			},
+			fnConvertCreateTransactionRequestToTransaction: func(int64, generated.TransactionRequest, utils.ValidationLimits) (model.Transaction, []string) {
+				return model.Transaction{Amount: 100000, RecipientToken: convertToUUID("5a0d7d2e-3c3f-4a59-8f0e-8f6b0f3f2a10"), Type: model.TransactionTypeTransferOut, Password: "Admin1234!"}, nil
+			},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+				mock.EXPECT().CreateUserTransaction(gomock.Any(), gomock.Any()).Return(model.Transaction{}, usecase.ErrRecipientTokenExpired)
+				return mock
+			},
+			wantResponse: generated.TransactionResponse{
+				Header: generated.ResponseHeader{
+					Success:  false,
+					Messages: []string{usecase.ErrRecipientTokenExpired.Error()},
+				},
+			},
+			wantHttpStatusCode: http.StatusBadRequest,
+		},
 	}
 
 	for _, test := range tests {
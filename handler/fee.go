@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/WalletService/generated"
+	"github.com/WalletService/model"
+	"github.com/WalletService/utils"
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	// Define function wrappers so we can inject dummy function in UT
+	fnConvertFeePreviewRequest func(int64, generated.FeePreviewRequest) (model.Transaction, []string) = convertFeePreviewRequest
+)
+
+// PreviewUserTransactionFee shows the fee of a Transaction so User can confirm it before performing the Transaction.
+// NOTE: Check AuthenticationMiddleware cmd/main.go that authenticates the JWT token
+func (s *Server) PreviewUserTransactionFee(ctx echo.Context, pathUserID int) error {
+	return ctx.JSON(s.previewUserTransactionFee(ctx, int64(pathUserID)))
+}
+func (s *Server) previewUserTransactionFee(ctx echo.Context, pathUserID int64) (int, generated.FeePreviewResponse) {
+	var (
+		context = ctx.Request().Context()
+
+		response = generated.FeePreviewResponse{
+			Header: generated.ResponseHeader{}, //success is false by default
+		}
+	)
+
+	// Authorize and get userID of the requester
+	userID, err := authorize(ctx, utils.JWTPermissionPerformTransaction)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	} else if pathUserID != userID {
+		response.Header.Messages = []string{"JWT userID mismatched with request userID"}
+		return http.StatusForbidden, response
+	}
+
+	request := generated.FeePreviewRequest{}
+	err = json.NewDecoder(ctx.Request().Body).Decode(&request)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusBadRequest, response
+	}
+
+	transaction, errorList := fnConvertFeePreviewRequest(userID, request)
+	if len(errorList) > 0 {
+		response.Header.Messages = errorList
+		return http.StatusBadRequest, response
+	}
+
+	fee, err := s.Usecase.PreviewUserTransactionFee(context, transaction)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
+	}
+
+	transaction.Fee = fee
+
+	response.Header.Success = true
+	response.Header.Messages = []string{successMsg}
+	response.Fee = convertFeePreviewToResponse(transaction)
+	return http.StatusOK, response
+}
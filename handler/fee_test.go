@@ -0,0 +1,151 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/WalletService/generated"
+	"github.com/WalletService/model"
+	"github.com/WalletService/usecase"
+	"github.com/WalletService/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+)
+
+func TestPreviewUserTransactionFee(t *testing.T) {
+	tests := []struct {
+		name               string
+		ctxPermissions     []utils.JWTPermission
+		requestBody        generated.FeePreviewRequest
+		mockUsecase        func(controller *gomock.Controller) *usecase.MockUsecaseInterface
+		wantResponse       generated.FeePreviewResponse
+		wantHttpStatusCode int
+	}{
+		{
+			name:           "success",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionPerformTransaction},
+			requestBody: generated.FeePreviewRequest{
+				Type:   "Withdrawal",
+				Amount: 100000,
+			},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+
+				mock.EXPECT().PreviewUserTransactionFee(gomock.Any(), model.Transaction{
+					UserID:   123,
+					Type:     model.TransactionTypeWithdrawal,
+					Currency: model.CurrencyIDR,
+					Amount:   100000,
+				}).Return(float32(2500), nil)
+
+				return mock
+			},
+			wantResponse: generated.FeePreviewResponse{
+				Header: generated.ResponseHeader{
+					Success:  true,
+					Messages: []string{successMsg},
+				},
+				Fee: generated.FeePreview{
+					Type:        stringPtr("Withdrawal"),
+					Currency:    currencyPtr(generated.IDR),
+					Amount:      floatPtr(100000),
+					Fee:         floatPtr(2500),
+					TotalAmount: floatPtr(102500),
+				},
+			},
+			wantHttpStatusCode: http.StatusOK,
+		},
+		{
+			name:           "fail-unsupported-type",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionPerformTransaction},
+			requestBody: generated.FeePreviewRequest{
+				Type:   "TopUp",
+				Amount: 100000,
+			},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				return usecase.NewMockUsecaseInterface(controller)
+			},
+			wantResponse: generated.FeePreviewResponse{
+				Header: generated.ResponseHeader{
+					Success:  false,
+					Messages: []string{"type should be TransferOut, Payment or Withdrawal"},
+				},
+			},
+			wantHttpStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fail-no-permission",
+			ctxPermissions: []utils.JWTPermission{},
+			requestBody: generated.FeePreviewRequest{
+				Type:   "Withdrawal",
+				Amount: 100000,
+			},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				return usecase.NewMockUsecaseInterface(controller)
+			},
+			wantResponse: generated.FeePreviewResponse{
+				Header: generated.ResponseHeader{
+					Success:  false,
+					Messages: []string{"not authorized: missing required permission"},
+				},
+			},
+			wantHttpStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "fail-usecase",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionPerformTransaction},
+			requestBody: generated.FeePreviewRequest{
+				Type:     "TransferOut",
+				Currency: currencyPtr(generated.USD),
+				Amount:   10,
+			},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+
+				mock.EXPECT().PreviewUserTransactionFee(gomock.Any(), gomock.Any()).Return(float32(0), errors.New("error-count"))
+
+				return mock
+			},
+			wantResponse: generated.FeePreviewResponse{
+				Header: generated.ResponseHeader{
+					Success:  false,
+					Messages: []string{"error-count"},
+				},
+			},
+			wantHttpStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			handler := &Server{
+				Usecase: test.mockUsecase(controller),
+			}
+
+			requestBodyJSON, _ := json.Marshal(test.requestBody)
+
+			e := echo.New()
+			request := httptest.NewRequest(http.MethodPost, "/v1/user/{userID}/transactions/fee", bytes.NewBuffer(requestBodyJSON))
+			recorder := httptest.NewRecorder()
+			ctx := e.NewContext(request, recorder)
+			ctx.Set(string(utils.JWTClaimUserID), int64(123))
+			ctx.Set(string(utils.JWTClaimPermissions), test.ctxPermissions)
+
+			gotHttpStatusCode, gotResponse := handler.previewUserTransactionFee(ctx, 123)
+
+			if gotHttpStatusCode != test.wantHttpStatusCode {
+				t.Errorf("handler.PreviewUserTransactionFee() httpStatusCode = %v, wantHttpStatusCode %v", gotHttpStatusCode, test.wantHttpStatusCode)
+			}
+
+			if !reflect.DeepEqual(test.wantResponse, gotResponse) {
+				t.Errorf("handler.PreviewUserTransactionFee() response = %v, wantResponse %v", gotResponse, test.wantResponse)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/WalletService/generated"
+	"github.com/WalletService/model"
+	"github.com/WalletService/utils"
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	// Define function wrappers so we can inject dummy function in UT
+	fnConvertFXQuoteRequest func(int64, generated.FXQuoteRequest) (model.FXQuoteRequest, []string) = convertFXQuoteRequest
+)
+
+// CreateUserFXQuote quotes converting between User's balances, the quote is then used by a Convert Transaction.
+// NOTE: Check AuthenticationMiddleware cmd/main.go that authenticates the JWT token
+func (s *Server) CreateUserFXQuote(ctx echo.Context, pathUserID int) error {
+	return ctx.JSON(s.createUserFXQuote(ctx, int64(pathUserID)))
+}
+func (s *Server) createUserFXQuote(ctx echo.Context, pathUserID int64) (int, generated.FXQuoteResponse) {
+	var (
+		context = ctx.Request().Context()
+
+		response = generated.FXQuoteResponse{
+			Header: generated.ResponseHeader{}, //success is false by default
+		}
+	)
+
+	// Authorize and get userID of the requester
+	userID, err := authorize(ctx, utils.JWTPermissionPerformTransaction)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	} else if pathUserID != userID {
+		response.Header.Messages = []string{"JWT userID mismatched with request userID"}
+		return http.StatusForbidden, response
+	}
+
+	request := generated.FXQuoteRequest{}
+	err = json.NewDecoder(ctx.Request().Body).Decode(&request)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusBadRequest, response
+	}
+
+	quoteRequest, errorList := fnConvertFXQuoteRequest(userID, request)
+	if len(errorList) > 0 {
+		response.Header.Messages = errorList
+		return http.StatusBadRequest, response
+	}
+
+	quote, err := s.Usecase.CreateUserFXQuote(context, quoteRequest)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
+	}
+
+	response.Header.Success = true
+	response.Header.Messages = []string{successMsg}
+	response.Quote = convertFXQuoteToResponse(quote)
+	return http.StatusCreated, response
+}
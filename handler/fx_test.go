@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/WalletService/generated"
+	"github.com/WalletService/model"
+	"github.com/WalletService/usecase"
+	"github.com/WalletService/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+)
+
+func TestCreateUserFXQuote(t *testing.T) {
+	var (
+		expiryTime = time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+		rate       = float64(15422.5)
+	)
+
+	tests := []struct {
+		name               string
+		ctxPermissions     []utils.JWTPermission
+		requestBody        generated.FXQuoteRequest
+		mockUsecase        func(controller *gomock.Controller) *usecase.MockUsecaseInterface
+		wantResponse       generated.FXQuoteResponse
+		wantHttpStatusCode int
+	}{
+		{
+			name:           "success",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionPerformTransaction},
+			requestBody: generated.FXQuoteRequest{
+				SourceCurrency: generated.USD,
+				TargetCurrency: generated.IDR,
+				SourceAmount:   10,
+			},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+
+				mock.EXPECT().CreateUserFXQuote(gomock.Any(), model.FXQuoteRequest{
+					UserID:         123,
+					SourceCurrency: model.CurrencyUSD,
+					TargetCurrency: model.CurrencyIDR,
+					SourceAmount:   10,
+				}).Return(model.FXQuote{
+					ID:             convertToUUID("5a0d7d2e-3c3f-4a59-8f0e-8f6b0f3f2a10"),
+					UserID:         123,
+					SourceCurrency: model.CurrencyUSD,
+					TargetCurrency: model.CurrencyIDR,
+					SourceAmount:   10,
+					Fee:            0.5,
+					Rate:           rate,
+					TargetAmount:   146513,
+					ExpiryTime:     expiryTime,
+				}, nil)
+
+				return mock
+			},
+			wantResponse: generated.FXQuoteResponse{
+				Header: generated.ResponseHeader{
+					Success:  true,
+					Messages: []string{successMsg},
+				},
+				Quote: generated.FXQuote{
+					Id:             stringPtr("5a0d7d2e-3c3f-4a59-8f0e-8f6b0f3f2a10"),
+					SourceCurrency: currencyPtr(generated.USD),
+					TargetCurrency: currencyPtr(generated.IDR),
+					SourceAmount:   floatPtr(10),
+					Fee:            floatPtr(0.5),
+					Rate:           &rate,
+					TargetAmount:   floatPtr(146513),
+					ExpiryTime:     &expiryTime,
+				},
+			},
+			wantHttpStatusCode: http.StatusCreated,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			handler := &Server{
+				Usecase: test.mockUsecase(controller),
+			}
+
+			requestBodyJSON, _ := json.Marshal(test.requestBody)
+
+			e := echo.New()
+			request := httptest.NewRequest(http.MethodPost, "/v1/user/{userID}/fx-quotes", bytes.NewBuffer(requestBodyJSON))
+			recorder := httptest.NewRecorder()
+			ctx := e.NewContext(request, recorder)
+			ctx.Set(string(utils.JWTClaimUserID), int64(123))
+			ctx.Set(string(utils.JWTClaimPermissions), test.ctxPermissions)
+
+			gotHttpStatusCode, gotResponse := handler.createUserFXQuote(ctx, 123)
+
+			if gotHttpStatusCode != test.wantHttpStatusCode {
+				t.Errorf("handler.CreateUserFXQuote() httpStatusCode = %v, wantHttpStatusCode %v", gotHttpStatusCode, test.wantHttpStatusCode)
+			}
+
+			if !reflect.DeepEqual(test.wantResponse, gotResponse) {
+				t.Errorf("handler.CreateUserFXQuote() response = %v, wantResponse %v", gotResponse, test.wantResponse)
+			}
+		})
+	}
+}
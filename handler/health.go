@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/WalletService/generated"
+	"github.com/labstack/echo/v4"
+)
+
+// readinessCheckTimeout bounds each ReadinessCheck, so a hanging dependency fails the probe instead of timing it out
+const readinessCheckTimeout = 2 * time.Second
+
+// ReadinessCheck reports whether a dependency is ready to serve requests, i.e. the DB is reachable
+type ReadinessCheck struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+// Healthz reports the process is alive, it does not check any dependency so a DB outage does not restart every instance.
+func (s *Server) Healthz(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, generated.HealthResponse{
+		Header: generated.ResponseHeader{
+			Success:  true,
+			Messages: []string{successMsg},
+		},
+	})
+}
+
+// Readyz runs every ReadinessCheck, the instance should only receive traffic if all of them pass.
+func (s *Server) Readyz(ctx echo.Context) error {
+	return ctx.JSON(s.readyz(ctx))
+}
+func (s *Server) readyz(ctx echo.Context) (int, generated.HealthResponse) {
+	var (
+		checks   = map[string]string{}
+		response = generated.HealthResponse{
+			Header: generated.ResponseHeader{}, //success is false by default
+			Checks: &checks,
+		}
+	)
+
+	for _, readinessCheck := range s.ReadinessChecks {
+		checkCtx, cancel := context.WithTimeout(ctx.Request().Context(), readinessCheckTimeout)
+		err := readinessCheck.Check(checkCtx)
+		cancel()
+
+		if err != nil {
+			checks[readinessCheck.Name] = err.Error()
+			response.Header.Messages = append(response.Header.Messages, readinessCheck.Name+": "+err.Error())
+			continue
+		}
+		checks[readinessCheck.Name] = "ok"
+	}
+
+	if len(response.Header.Messages) > 0 {
+		return http.StatusServiceUnavailable, response
+	}
+
+	response.Header.Success = true
+	response.Header.Messages = []string{successMsg}
+	return http.StatusOK, response
+}
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestServer_readyz(t *testing.T) {
+	ok := func(context.Context) error { return nil }
+
+	tests := []struct {
+		name            string
+		readinessChecks []ReadinessCheck
+
+		wantHttpStatusCode int
+		wantChecks         map[string]string
+		wantMessages       []string
+	}{
+		{
+			name: "success",
+			readinessChecks: []ReadinessCheck{
+				{Name: "database", Check: ok},
+				{Name: "migrations", Check: ok},
+			},
+			wantHttpStatusCode: http.StatusOK,
+			wantChecks:         map[string]string{"database": "ok", "migrations": "ok"},
+			wantMessages:       []string{successMsg},
+		},
+		{
+			name: "failed-check",
+			readinessChecks: []ReadinessCheck{
+				{Name: "database", Check: ok},
+				{Name: "migrations", Check: func(context.Context) error { return errors.New("2 migrations pending") }},
+			},
+			wantHttpStatusCode: http.StatusServiceUnavailable,
+			wantChecks:         map[string]string{"database": "ok", "migrations": "2 migrations pending"},
+			wantMessages:       []string{"migrations: 2 migrations pending"},
+		},
+		{
+			name: "check-bounded-by-timeout",
+			readinessChecks: []ReadinessCheck{
+				{Name: "database", Check: func(ctx context.Context) error {
+					if _, ok := ctx.Deadline(); !ok {
+						return errors.New("no deadline")
+					}
+					return nil
+				}},
+			},
+			wantHttpStatusCode: http.StatusOK,
+			wantChecks:         map[string]string{"database": "ok"},
+			wantMessages:       []string{successMsg},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler := &Server{
+				ReadinessChecks: test.readinessChecks,
+			}
+
+			ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/readyz", nil), httptest.NewRecorder())
+
+			gotHttpStatusCode, gotResponse := handler.readyz(ctx)
+			if gotHttpStatusCode != test.wantHttpStatusCode {
+				t.Errorf("Server.readyz() httpStatusCode = %v, want %v", gotHttpStatusCode, test.wantHttpStatusCode)
+			}
+			if !reflect.DeepEqual(*gotResponse.Checks, test.wantChecks) {
+				t.Errorf("Server.readyz() checks = %v, want %v", *gotResponse.Checks, test.wantChecks)
+			}
+			if !reflect.DeepEqual(gotResponse.Header.Messages, test.wantMessages) {
+				t.Errorf("Server.readyz() messages = %v, want %v", gotResponse.Header.Messages, test.wantMessages)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/WalletService/logging"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// requestIDRegex limits the X-Request-ID propagated from clients, so it can be logged and echoed back as is
+var requestIDRegex = regexp.MustCompile(`^[A-Za-z0-9._-]{1,128}$`)
+
+// RequestLogMiddleware propagates X-Request-ID, or generates one, and logs every request with its outcome.
+// The request context carries logger with the request ID, retrieve it with logging.FromContext.
+// It should be registered with Pre before any other middleware, so every line logged for a request has its ID.
+func RequestLogMiddleware(logger *slog.Logger) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			start := time.Now()
+
+			requestID := ctx.Request().Header.Get(echo.HeaderXRequestID)
+			if !requestIDRegex.MatchString(requestID) {
+				requestID = uuid.NewString()
+			}
+			ctx.Response().Header().Set(echo.HeaderXRequestID, requestID)
+
+			requestCtx := logging.WithRequestID(logging.NewContext(ctx.Request().Context(), logger), requestID)
+			ctx.SetRequest(ctx.Request().WithContext(requestCtx))
+
+			err := next(ctx)
+
+			// Route is only known once Echo routed the request, after Pre middleware
+			status := responseStatus(ctx, err)
+			level := slog.LevelInfo
+			if status >= http.StatusInternalServerError {
+				level = slog.LevelError
+			}
+			logging.FromContext(requestCtx).LogAttrs(requestCtx, level, "request",
+				slog.String("method", ctx.Request().Method),
+				slog.String("route", ctx.Path()),
+				slog.Int("status", status),
+				slog.Duration("latency", time.Since(start)),
+			)
+
+			return err
+		}
+	}
+}
+
+// responseStatus returns the status code of the response, including an error not yet written by Echo,
+// which only happens after every middleware returns
+func responseStatus(ctx echo.Context, err error) int {
+	if err == nil || ctx.Response().Committed {
+		return ctx.Response().Status
+	}
+
+	var httpError *echo.HTTPError
+	if errors.As(err, &httpError) {
+		return httpError.Code
+	}
+	return http.StatusInternalServerError
+}
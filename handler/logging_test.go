@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/WalletService/logging"
+	"github.com/labstack/echo/v4"
+)
+
+func TestRequestLogMiddleware(t *testing.T) {
+	tests := []struct {
+		name      string
+		requestID string
+
+		wantRequestID string // empty means a generated one
+	}{
+		{
+			name:          "propagated",
+			requestID:     "client-request-1",
+			wantRequestID: "client-request-1",
+		},
+		{
+			name: "generated",
+		},
+		{
+			name:      "invalid-generated",
+			requestID: "line\nbreak",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			e := echo.New()
+			e.Pre(RequestLogMiddleware(logging.New(&buf, slog.LevelInfo)))
+
+			var handlerRequestID string
+			e.GET("/v1/user", func(ctx echo.Context) error {
+				handlerRequestID = logging.RequestID(ctx.Request().Context())
+				return ctx.NoContent(http.StatusTeapot)
+			})
+
+			request := httptest.NewRequest(http.MethodGet, "/v1/user", nil)
+			if test.requestID != "" {
+				request.Header.Set(echo.HeaderXRequestID, test.requestID)
+			}
+			recorder := httptest.NewRecorder()
+			e.ServeHTTP(recorder, request)
+
+			gotRequestID := recorder.Header().Get(echo.HeaderXRequestID)
+			if test.wantRequestID != "" && gotRequestID != test.wantRequestID {
+				t.Errorf("X-Request-ID = %v, want %v", gotRequestID, test.wantRequestID)
+			}
+			if gotRequestID == "" || gotRequestID == test.requestID && test.wantRequestID == "" {
+				t.Errorf("X-Request-ID = %q, want a generated one", gotRequestID)
+			}
+			if handlerRequestID != gotRequestID {
+				t.Errorf("handler request ID = %v, want %v", handlerRequestID, gotRequestID)
+			}
+
+			for _, want := range []string{`"request_id":"` + gotRequestID + `"`, `"route":"/v1/user"`, `"status":418`} {
+				if !strings.Contains(buf.String(), want) {
+					t.Errorf("log line %s does not contain %s", buf.String(), want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/WalletService/generated"
+	"github.com/WalletService/metrics"
+	"github.com/labstack/echo/v4"
+)
+
+// unknownOperation labels requests to routes not in api.yml, so unmatched paths do not each create a series
+const unknownOperation = "unknown"
+
+var pathParamRegex = regexp.MustCompile(`\{([^}]+)\}`)
+
+// OperationIDs maps routes as registered in Echo, i.e. "POST /v1/user/:user_id/transactions", to their operation ID in api.yml
+func OperationIDs() (map[string]string, error) {
+	swagger, err := generated.GetSwagger()
+	if err != nil {
+		return nil, err
+	}
+
+	operationIDs := map[string]string{}
+	for path, pathItem := range swagger.Paths {
+		echoPath := pathParamRegex.ReplaceAllString(path, ":$1")
+		for method, operation := range pathItem.Operations() {
+			operationIDs[method+" "+echoPath] = operation.OperationID
+		}
+	}
+
+	return operationIDs, nil
+}
+
+// MetricsMiddleware counts requests and observes their latency per operation ID.
+// It should be registered before TimeoutMiddleware so timed out requests are counted as 504.
+func MetricsMiddleware(operationIDs map[string]string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			start := time.Now()
+			err := next(ctx)
+
+			operation, ok := operationIDs[ctx.Request().Method+" "+ctx.Path()]
+			if !ok {
+				operation = unknownOperation
+			}
+
+			metrics.HTTPRequests.Inc(operation, strconv.Itoa(responseStatus(ctx, err)))
+			metrics.HTTPRequestDuration.ObserveDuration(start, operation)
+
+			return err
+		}
+	}
+}
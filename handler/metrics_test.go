@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/WalletService/metrics"
+	"github.com/labstack/echo/v4"
+)
+
+func TestOperationIDs(t *testing.T) {
+	operationIDs, err := OperationIDs()
+	if err != nil {
+		t.Fatalf("OperationIDs() error = %v", err)
+	}
+
+	if got := operationIDs["POST /v1/user/:user_id/transactions"]; got != "CreateUserTransaction" {
+		t.Errorf("OperationIDs() POST /v1/user/:user_id/transactions = %v, want %v", got, "CreateUserTransaction")
+	}
+	if got := operationIDs["GET /healthz"]; got != "Healthz" {
+		t.Errorf("OperationIDs() GET /healthz = %v, want %v", got, "Healthz")
+	}
+}
+
+func TestMetricsMiddleware(t *testing.T) {
+	tests := []struct {
+		name        string
+		route       string
+		requestPath string
+		handler     echo.HandlerFunc
+
+		wantOperation string
+		wantCode      string
+	}{
+		{
+			name:        "success",
+			route:       "/test/:id",
+			requestPath: "/test/1",
+			handler: func(ctx echo.Context) error {
+				return ctx.NoContent(http.StatusCreated)
+			},
+			wantOperation: "TestOperation",
+			wantCode:      "201",
+		},
+		{
+			name:        "http-error",
+			route:       "/test/:id",
+			requestPath: "/test/1",
+			handler: func(ctx echo.Context) error {
+				return echo.NewHTTPError(http.StatusServiceUnavailable)
+			},
+			wantOperation: "TestOperation",
+			wantCode:      "503",
+		},
+		{
+			name:        "unknown-route",
+			route:       "/other/:id",
+			requestPath: "/other/1",
+			handler: func(ctx echo.Context) error {
+				return ctx.NoContent(http.StatusOK)
+			},
+			wantOperation: unknownOperation,
+			wantCode:      "200",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			e := echo.New()
+			e.Use(MetricsMiddleware(map[string]string{"GET /test/:id": "TestOperation"}))
+			e.GET(test.route, test.handler)
+
+			wantCount := metrics.HTTPRequests.Value(test.wantOperation, test.wantCode) + 1
+			wantObservations := metrics.HTTPRequestDuration.Count(test.wantOperation) + 1
+
+			e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, test.requestPath, nil))
+
+			if got := metrics.HTTPRequests.Value(test.wantOperation, test.wantCode); got != wantCount {
+				t.Errorf("HTTPRequests = %v, want %v", got, wantCount)
+			}
+			if got := metrics.HTTPRequestDuration.Count(test.wantOperation); got != wantObservations {
+				t.Errorf("HTTPRequestDuration count = %v, want %v", got, wantObservations)
+			}
+		})
+	}
+}
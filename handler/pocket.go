@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/WalletService/generated"
+	"github.com/WalletService/model"
+	"github.com/WalletService/repository"
+	"github.com/WalletService/usecase"
+	"github.com/WalletService/utils"
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	// Define function wrappers so we can inject dummy function in UT
+	fnConvertPocketRequest func(int64, generated.PocketRequest) (model.Pocket, []string) = convertPocketRequest
+)
+
+const (
+	duplicatePocketErrorMsg = "pocket name is already used"
+)
+
+// CreatePocket creates an empty Pocket for User, money is moved in and out of it with PocketTransfer Transactions.
+// NOTE: Check AuthenticationMiddleware cmd/main.go that authenticates the JWT token
+func (s *Server) CreatePocket(ctx echo.Context, pathUserID int) error {
+	return ctx.JSON(s.createPocket(ctx, int64(pathUserID)))
+}
+func (s *Server) createPocket(ctx echo.Context, pathUserID int64) (int, generated.PocketResponse) {
+	var (
+		context = ctx.Request().Context()
+
+		response = generated.PocketResponse{
+			Header: generated.ResponseHeader{}, //success is false by default
+		}
+	)
+
+	// Authorize and get userID of the requester, managing Pockets does not move money
+	userID, err := authorize(ctx, utils.JWTPermissionGetUser)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	} else if pathUserID != userID {
+		response.Header.Messages = []string{"JWT userID mismatched with request userID"}
+		return http.StatusForbidden, response
+	}
+
+	request := generated.PocketRequest{}
+	err = json.NewDecoder(ctx.Request().Body).Decode(&request)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusBadRequest, response
+	}
+
+	pocket, errorList := fnConvertPocketRequest(userID, request)
+	if len(errorList) > 0 {
+		response.Header.Messages = errorList
+		return http.StatusBadRequest, response
+	}
+
+	pocket, err = s.Usecase.CreatePocket(context, pocket)
+	switch {
+	case utils.IsUniqueConstraintViolation(err):
+		response.Header.Messages = []string{duplicatePocketErrorMsg}
+		return http.StatusConflict, response
+	case errors.Is(err, usecase.ErrPocketLimitExceeded):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusBadRequest, response
+	case err != nil:
+		response.Header.Messages = []string{err.Error()}
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
+	}
+
+	response.Header.Success = true
+	response.Header.Messages = []string{successMsg}
+	response.Pocket = convertPocketToResponse(pocket)
+	return http.StatusCreated, response
+}
+
+// UpdatePocket renames a Pocket of User or changes its target.
+// NOTE: Check AuthenticationMiddleware cmd/main.go that authenticates the JWT token
+func (s *Server) UpdatePocket(ctx echo.Context, pathUserID int, pocketID int) error {
+	return ctx.JSON(s.updatePocket(ctx, int64(pathUserID), int64(pocketID)))
+}
+func (s *Server) updatePocket(ctx echo.Context, pathUserID int64, pocketID int64) (int, generated.PocketResponse) {
+	var (
+		context = ctx.Request().Context()
+
+		response = generated.PocketResponse{
+			Header: generated.ResponseHeader{}, //success is false by default
+		}
+	)
+
+	// Authorize and get userID of the requester
+	userID, err := authorize(ctx, utils.JWTPermissionGetUser)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	} else if pathUserID != userID {
+		response.Header.Messages = []string{"JWT userID mismatched with request userID"}
+		return http.StatusForbidden, response
+	}
+
+	request := generated.PocketRequest{}
+	err = json.NewDecoder(ctx.Request().Body).Decode(&request)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusBadRequest, response
+	}
+
+	pocket, errorList := fnConvertPocketRequest(userID, request)
+	if len(errorList) > 0 {
+		response.Header.Messages = errorList
+		return http.StatusBadRequest, response
+	}
+	pocket.ID = pocketID
+
+	pocket, err = s.Usecase.UpdatePocket(context, pocket)
+	switch {
+	case utils.IsUniqueConstraintViolation(err):
+		response.Header.Messages = []string{duplicatePocketErrorMsg}
+		return http.StatusConflict, response
+	case errors.Is(err, repository.ErrPocketNotFound):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusNotFound, response
+	case err != nil:
+		response.Header.Messages = []string{err.Error()}
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
+	}
+
+	response.Header.Success = true
+	response.Header.Messages = []string{successMsg}
+	response.Pocket = convertPocketToResponse(pocket)
+	return http.StatusOK, response
+}
+
+// DeletePocket deletes an empty Pocket of User, its PocketTransfer Transactions are kept.
+// NOTE: Check AuthenticationMiddleware cmd/main.go that authenticates the JWT token
+func (s *Server) DeletePocket(ctx echo.Context, pathUserID int, pocketID int) error {
+	return ctx.JSON(s.deletePocket(ctx, int64(pathUserID), int64(pocketID)))
+}
+func (s *Server) deletePocket(ctx echo.Context, pathUserID int64, pocketID int64) (int, generated.DeletePocketResponse) {
+	var (
+		context = ctx.Request().Context()
+
+		response = generated.DeletePocketResponse{
+			Header: generated.ResponseHeader{}, //success is false by default
+		}
+	)
+
+	// Authorize and get userID of the requester
+	userID, err := authorize(ctx, utils.JWTPermissionGetUser)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	} else if pathUserID != userID {
+		response.Header.Messages = []string{"JWT userID mismatched with request userID"}
+		return http.StatusForbidden, response
+	}
+
+	err = s.Usecase.DeletePocket(context, userID, pocketID)
+	switch {
+	case errors.Is(err, repository.ErrPocketNotFound):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusNotFound, response
+	case errors.Is(err, usecase.ErrPocketNotEmpty):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusBadRequest, response
+	case err != nil:
+		response.Header.Messages = []string{err.Error()}
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
+	}
+
+	response.Header.Success = true
+	response.Header.Messages = []string{successMsg}
+	return http.StatusOK, response
+}
@@ -0,0 +1,218 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/WalletService/generated"
+	"github.com/WalletService/model"
+	"github.com/WalletService/repository"
+	"github.com/WalletService/usecase"
+	"github.com/WalletService/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func TestCreatePocket(t *testing.T) {
+	var (
+		createdTime  = time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+		targetDate   = time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)
+		targetAmount = float32(5000000)
+		balance      = float32(0)
+		request      = model.Pocket{UserID: 123, Name: "Holiday", TargetAmount: 5000000, TargetDate: &targetDate}
+	)
+
+	tests := []struct {
+		name               string
+		requestBody        generated.PocketRequest
+		mockUsecase        func(controller *gomock.Controller) *usecase.MockUsecaseInterface
+		wantResponse       generated.PocketResponse
+		wantHttpStatusCode int
+	}{
+		{
+			name:        "success",
+			requestBody: generated.PocketRequest{Name: " Holiday ", TargetAmount: &targetAmount, TargetDate: &openapi_types.Date{Time: targetDate}},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+				mock.EXPECT().CreatePocket(gomock.Any(), request).Return(model.Pocket{
+					ID: 2, UserID: 123, Name: "Holiday", TargetAmount: 5000000, TargetDate: &targetDate, CreatedTime: createdTime,
+				}, nil)
+				return mock
+			},
+			wantResponse: generated.PocketResponse{
+				Header: generated.ResponseHeader{
+					Success:  true,
+					Messages: []string{successMsg},
+				},
+				Pocket: generated.Pocket{
+					Id:           intPtr(2),
+					Name:         stringPtr("Holiday"),
+					Balance:      &balance,
+					TargetAmount: &targetAmount,
+					TargetDate:   &openapi_types.Date{Time: targetDate},
+					CreatedTime:  &createdTime,
+				},
+			},
+			wantHttpStatusCode: http.StatusCreated,
+		},
+		{
+			name:        "fail-invalid-name",
+			requestBody: generated.PocketRequest{Name: "  "},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				return usecase.NewMockUsecaseInterface(controller)
+			},
+			wantResponse: generated.PocketResponse{
+				Header: generated.ResponseHeader{Messages: []string{"name should be 1 to 50 characters"}},
+			},
+			wantHttpStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:        "fail-name-already-used",
+			requestBody: generated.PocketRequest{Name: "Holiday", TargetAmount: &targetAmount, TargetDate: &openapi_types.Date{Time: targetDate}},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+				mock.EXPECT().CreatePocket(gomock.Any(), request).Return(model.Pocket{}, &pq.Error{Code: "23505"})
+				return mock
+			},
+			wantResponse: generated.PocketResponse{
+				Header: generated.ResponseHeader{Messages: []string{duplicatePocketErrorMsg}},
+			},
+			wantHttpStatusCode: http.StatusConflict,
+		},
+		{
+			name:        "fail-limit-exceeded",
+			requestBody: generated.PocketRequest{Name: "Holiday", TargetAmount: &targetAmount, TargetDate: &openapi_types.Date{Time: targetDate}},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+				mock.EXPECT().CreatePocket(gomock.Any(), request).Return(model.Pocket{}, usecase.ErrPocketLimitExceeded)
+				return mock
+			},
+			wantResponse: generated.PocketResponse{
+				Header: generated.ResponseHeader{Messages: []string{usecase.ErrPocketLimitExceeded.Error()}},
+			},
+			wantHttpStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			handler := &Server{
+				Usecase: test.mockUsecase(controller),
+			}
+
+			requestBodyJSON, _ := json.Marshal(test.requestBody)
+
+			e := echo.New()
+			request := httptest.NewRequest(http.MethodPost, "/v1/user/{userID}/pockets", bytes.NewBuffer(requestBodyJSON))
+			recorder := httptest.NewRecorder()
+			ctx := e.NewContext(request, recorder)
+			ctx.Set(string(utils.JWTClaimUserID), int64(123))
+			ctx.Set(string(utils.JWTClaimPermissions), []utils.JWTPermission{utils.JWTPermissionGetUser})
+
+			gotHttpStatusCode, gotResponse := handler.createPocket(ctx, 123)
+
+			if gotHttpStatusCode != test.wantHttpStatusCode {
+				t.Errorf("handler.CreatePocket() httpStatusCode = %v, wantHttpStatusCode %v", gotHttpStatusCode, test.wantHttpStatusCode)
+			}
+
+			if !reflect.DeepEqual(test.wantResponse, gotResponse) {
+				t.Errorf("handler.CreatePocket() response = %v, wantResponse %v", gotResponse, test.wantResponse)
+			}
+		})
+	}
+}
+
+func TestDeletePocket(t *testing.T) {
+	tests := []struct {
+		name               string
+		pathUserID         int64
+		mockUsecase        func(controller *gomock.Controller) *usecase.MockUsecaseInterface
+		wantResponse       generated.DeletePocketResponse
+		wantHttpStatusCode int
+	}{
+		{
+			name:       "success",
+			pathUserID: 123,
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+				mock.EXPECT().DeletePocket(gomock.Any(), int64(123), int64(2)).Return(nil)
+				return mock
+			},
+			wantResponse: generated.DeletePocketResponse{
+				Header: generated.ResponseHeader{Success: true, Messages: []string{successMsg}},
+			},
+			wantHttpStatusCode: http.StatusOK,
+		},
+		{
+			name:       "fail-user-id-mismatch",
+			pathUserID: 456,
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				return usecase.NewMockUsecaseInterface(controller)
+			},
+			wantResponse: generated.DeletePocketResponse{
+				Header: generated.ResponseHeader{Messages: []string{"JWT userID mismatched with request userID"}},
+			},
+			wantHttpStatusCode: http.StatusForbidden,
+		},
+		{
+			name:       "fail-not-found",
+			pathUserID: 123,
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+				mock.EXPECT().DeletePocket(gomock.Any(), int64(123), int64(2)).Return(repository.ErrPocketNotFound)
+				return mock
+			},
+			wantResponse: generated.DeletePocketResponse{
+				Header: generated.ResponseHeader{Messages: []string{repository.ErrPocketNotFound.Error()}},
+			},
+			wantHttpStatusCode: http.StatusNotFound,
+		},
+		{
+			name:       "fail-not-empty",
+			pathUserID: 123,
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+				mock.EXPECT().DeletePocket(gomock.Any(), int64(123), int64(2)).Return(usecase.ErrPocketNotEmpty)
+				return mock
+			},
+			wantResponse: generated.DeletePocketResponse{
+				Header: generated.ResponseHeader{Messages: []string{usecase.ErrPocketNotEmpty.Error()}},
+			},
+			wantHttpStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			handler := &Server{
+				Usecase: test.mockUsecase(controller),
+			}
+
+			e := echo.New()
+			request := httptest.NewRequest(http.MethodDelete, "/v1/user/{userID}/pockets/{pocketID}", nil)
+			recorder := httptest.NewRecorder()
+			ctx := e.NewContext(request, recorder)
+			ctx.Set(string(utils.JWTClaimUserID), int64(123))
+			ctx.Set(string(utils.JWTClaimPermissions), []utils.JWTPermission{utils.JWTPermissionGetUser})
+
+			gotHttpStatusCode, gotResponse := handler.deletePocket(ctx, test.pathUserID, 2)
+
+			if gotHttpStatusCode != test.wantHttpStatusCode {
+				t.Errorf("handler.DeletePocket() httpStatusCode = %v, wantHttpStatusCode %v", gotHttpStatusCode, test.wantHttpStatusCode)
+			}
+
+			if !reflect.DeepEqual(test.wantResponse, gotResponse) {
+				t.Errorf("handler.DeletePocket() response = %v, wantResponse %v", gotResponse, test.wantResponse)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"regexp"
+
+	"github.com/WalletService/generated"
+	"github.com/WalletService/model"
+	"github.com/WalletService/repository"
+	"github.com/WalletService/usecase"
+	"github.com/WalletService/utils"
+	"github.com/labstack/echo/v4"
+)
+
+var otpRegex = regexp.MustCompile(`^[0-9]{6}$`)
+
+// UpdateUser updates User's full name, and sends an OTP to the new phone number when it is changed.
+// The phone number is only changed once the OTP is confirmed with VerifyUserPhoneNumber.
+// NOTE: Check AuthenticationMiddleware cmd/main.go that authenticates the JWT token
+func (s *Server) UpdateUser(ctx echo.Context) error {
+	return ctx.JSON(s.updateUser(ctx))
+}
+func (s *Server) updateUser(ctx echo.Context) (int, generated.UpdateUserResponse) {
+	var (
+		context = ctx.Request().Context()
+
+		response = generated.UpdateUserResponse{
+			Header: generated.ResponseHeader{}, //success is false by default
+		}
+	)
+
+	// Authorize and get userID of the requester
+	userID, err := authorize(ctx, utils.JWTPermissionGetUser)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	}
+
+	request := generated.UpdateUserRequest{}
+	err = json.NewDecoder(ctx.Request().Body).Decode(&request)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusBadRequest, response
+	}
+
+	fullName, phoneNumber, password, errorList := convertUpdateUserRequest(request, s.validationLimits())
+	if len(errorList) > 0 {
+		response.Header.Messages = errorList
+		return http.StatusBadRequest, response
+	}
+
+	// Update the full name, or get the current profile to be returned
+	var user model.User
+	if fullName != "" {
+		user, err = s.Usecase.UpdateUserProfile(context, model.UpdateUserRequest{
+			UserID:   userID,
+			FullName: fullName,
+		})
+	} else {
+		user, err = s.Usecase.GetUser(context, userID)
+	}
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
+	}
+	response.User = convertUserToResponse(user)
+
+	if phoneNumber == "" {
+		response.Header.Success = true
+		response.Header.Messages = []string{successMsg}
+		return http.StatusOK, response
+	}
+
+	// Send an OTP to the new phone number, the full name is already updated if the request fails
+	change, err := s.Usecase.RequestPhoneNumberChange(context, userID, phoneNumber, password)
+	switch {
+	case errors.Is(err, usecase.ErrPhoneNumberTaken):
+		response.Header.Messages = []string{duplicatePhoneNumberErrorMsg}
+		return http.StatusConflict, response
+	case errors.Is(err, usecase.ErrPhoneNumberUnchanged):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusBadRequest, response
+	case errors.Is(err, usecase.ErrUserClosed), errors.Is(err, usecase.ErrInvalidPassword):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	case errors.Is(err, usecase.ErrOTPRequestLimitExceeded):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusTooManyRequests, response
+	case err != nil:
+		response.Header.Messages = []string{err.Error()}
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
+	}
+
+	response.PhoneNumberChange = &generated.PhoneNumberChange{
+		PhoneNumber: &change.PhoneNumber,
+		ExpiryTime:  &change.ExpiryTime,
+	}
+	response.Header.Success = true
+	response.Header.Messages = []string{"OTP sent to the new phone number"}
+	return http.StatusAccepted, response
+}
+
+// VerifyUserPhoneNumber changes User's phone number to the one requested with UpdateUser, with the OTP sent to it.
+// NOTE: Check AuthenticationMiddleware cmd/main.go that authenticates the JWT token
+func (s *Server) VerifyUserPhoneNumber(ctx echo.Context) error {
+	return ctx.JSON(s.verifyUserPhoneNumber(ctx))
+}
+func (s *Server) verifyUserPhoneNumber(ctx echo.Context) (int, generated.UpdateUserResponse) {
+	var (
+		context = ctx.Request().Context()
+
+		response = generated.UpdateUserResponse{
+			Header: generated.ResponseHeader{}, //success is false by default
+		}
+	)
+
+	// Authorize and get userID of the requester
+	userID, err := authorize(ctx, utils.JWTPermissionGetUser)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	}
+
+	request := generated.VerifyPhoneNumberRequest{}
+	err = json.NewDecoder(ctx.Request().Body).Decode(&request)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusBadRequest, response
+	}
+
+	if !otpRegex.MatchString(request.Otp) {
+		response.Header.Messages = []string{"otp should be 6 digits"}
+		return http.StatusBadRequest, response
+	}
+
+	user, err := s.Usecase.ConfirmPhoneNumberChange(context, userID, request.Otp)
+	switch {
+	case errors.Is(err, repository.ErrPhoneNumberChangeNotFound):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusNotFound, response
+	case errors.Is(err, usecase.ErrOTPInvalid), errors.Is(err, usecase.ErrOTPExpired), errors.Is(err, usecase.ErrOTPAttemptsExceeded):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusBadRequest, response
+	case utils.IsUniqueConstraintViolation(err):
+		response.Header.Messages = []string{duplicatePhoneNumberErrorMsg}
+		return http.StatusConflict, response
+	case err != nil:
+		response.Header.Messages = []string{err.Error()}
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
+	}
+
+	response.User = convertUserToResponse(user)
+	response.Header.Success = true
+	response.Header.Messages = []string{successMsg}
+	return http.StatusOK, response
+}
@@ -0,0 +1,385 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/WalletService/generated"
+	"github.com/WalletService/model"
+	"github.com/WalletService/repository"
+	"github.com/WalletService/usecase"
+	"github.com/WalletService/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+)
+
+func TestUpdateUser(t *testing.T) {
+	var (
+		user = model.User{
+			ID:          123,
+			FullName:    "Jane Doe",
+			PhoneNumber: "+628123456789",
+			Balance:     100,
+			Status:      model.UserStatusActive,
+		}
+		wantUser = generated.User{
+			Id:          intPtr(123),
+			FullName:    stringPtr("Jane Doe"),
+			PhoneNumber: stringPtr("+628123456789"),
+			Balance:     floatPtr(100),
+			Status:      userStatusPtr(generated.Active),
+		}
+		expiryTime = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	)
+
+	tests := []struct {
+		name           string
+		body           string
+		ctxPermissions []utils.JWTPermission
+		mockUsecase    func(controller *gomock.Controller) *usecase.MockUsecaseInterface
+
+		wantResponse       generated.UpdateUserResponse
+		wantHttpStatusCode int
+	}{
+		{
+			name:           "success-full-name",
+			body:           `{"full_name": " Jane Doe "}`,
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionGetUser},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+				mock.EXPECT().UpdateUserProfile(gomock.Any(), model.UpdateUserRequest{UserID: 123, FullName: "Jane Doe"}).Return(user, nil).Times(1)
+				return mock
+			},
+			wantResponse: generated.UpdateUserResponse{
+				Header: generated.ResponseHeader{Success: true, Messages: []string{successMsg}},
+				User:   wantUser,
+			},
+			wantHttpStatusCode: http.StatusOK,
+		},
+		{
+			name:           "success-phone-number-otp-sent",
+			body:           `{"phone_number": "+6281234567890", "password": "Secret123!"}`,
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionGetUser},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+				mock.EXPECT().GetUser(gomock.Any(), int64(123)).Return(user, nil).Times(1)
+				mock.EXPECT().RequestPhoneNumberChange(gomock.Any(), int64(123), "+6281234567890", "Secret123!").Return(model.PhoneNumberChange{
+					UserID:      123,
+					PhoneNumber: "+6281234567890",
+					ExpiryTime:  expiryTime,
+				}, nil).Times(1)
+				return mock
+			},
+			wantResponse: generated.UpdateUserResponse{
+				Header: generated.ResponseHeader{Success: true, Messages: []string{"OTP sent to the new phone number"}},
+				User:   wantUser,
+				PhoneNumberChange: &generated.PhoneNumberChange{
+					PhoneNumber: stringPtr("+6281234567890"),
+					ExpiryTime:  &expiryTime,
+				},
+			},
+			wantHttpStatusCode: http.StatusAccepted,
+		},
+		{
+			name:           "fail-phone-number-taken",
+			body:           `{"phone_number": "+6281234567890", "password": "Secret123!"}`,
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionGetUser},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+				mock.EXPECT().GetUser(gomock.Any(), int64(123)).Return(user, nil).Times(1)
+				mock.EXPECT().RequestPhoneNumberChange(gomock.Any(), int64(123), "+6281234567890", "Secret123!").Return(model.PhoneNumberChange{}, usecase.ErrPhoneNumberTaken).Times(1)
+				return mock
+			},
+			wantResponse: generated.UpdateUserResponse{
+				Header: generated.ResponseHeader{Messages: []string{duplicatePhoneNumberErrorMsg}},
+				User:   wantUser,
+			},
+			wantHttpStatusCode: http.StatusConflict,
+		},
+		{
+			name:           "fail-phone-number-unchanged",
+			body:           `{"phone_number": "+628123456789", "password": "Secret123!"}`,
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionGetUser},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+				mock.EXPECT().GetUser(gomock.Any(), int64(123)).Return(user, nil).Times(1)
+				mock.EXPECT().RequestPhoneNumberChange(gomock.Any(), int64(123), "+628123456789", "Secret123!").Return(model.PhoneNumberChange{}, usecase.ErrPhoneNumberUnchanged).Times(1)
+				return mock
+			},
+			wantResponse: generated.UpdateUserResponse{
+				Header: generated.ResponseHeader{Messages: []string{usecase.ErrPhoneNumberUnchanged.Error()}},
+				User:   wantUser,
+			},
+			wantHttpStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fail-invalid-password",
+			body:           `{"phone_number": "+6281234567890", "password": "Wrong123!"}`,
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionGetUser},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+				mock.EXPECT().GetUser(gomock.Any(), int64(123)).Return(user, nil).Times(1)
+				mock.EXPECT().RequestPhoneNumberChange(gomock.Any(), int64(123), "+6281234567890", "Wrong123!").Return(model.PhoneNumberChange{}, usecase.ErrInvalidPassword).Times(1)
+				return mock
+			},
+			wantResponse: generated.UpdateUserResponse{
+				Header: generated.ResponseHeader{Messages: []string{usecase.ErrInvalidPassword.Error()}},
+				User:   wantUser,
+			},
+			wantHttpStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "fail-otp-request-limit-exceeded",
+			body:           `{"phone_number": "+6281234567890", "password": "Secret123!"}`,
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionGetUser},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+				mock.EXPECT().GetUser(gomock.Any(), int64(123)).Return(user, nil).Times(1)
+				mock.EXPECT().RequestPhoneNumberChange(gomock.Any(), int64(123), "+6281234567890", "Secret123!").Return(model.PhoneNumberChange{}, usecase.ErrOTPRequestLimitExceeded).Times(1)
+				return mock
+			},
+			wantResponse: generated.UpdateUserResponse{
+				Header: generated.ResponseHeader{Messages: []string{usecase.ErrOTPRequestLimitExceeded.Error()}},
+				User:   wantUser,
+			},
+			wantHttpStatusCode: http.StatusTooManyRequests,
+		},
+		{
+			name:           "fail-phone-number-password-required",
+			body:           `{"full_name": "Jane Doe", "phone_number": "+6281234567890"}`,
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionGetUser},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				return usecase.NewMockUsecaseInterface(controller)
+			},
+			wantResponse: generated.UpdateUserResponse{
+				Header: generated.ResponseHeader{Messages: []string{"password is required to update phone_number"}},
+			},
+			wantHttpStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fail-invalid-input",
+			body:           `{"full_name": "J", "phone_number": "021 5551234", "password": "Secret123!"}`,
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionGetUser},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				return usecase.NewMockUsecaseInterface(controller)
+			},
+			wantResponse: generated.UpdateUserResponse{
+				Header: generated.ResponseHeader{Messages: []string{
+					"full_name should be 3 to 60 characters",
+					"phone_number is not a valid mobile number",
+				}},
+			},
+			wantHttpStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fail-nothing-to-update",
+			body:           `{}`,
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionGetUser},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				return usecase.NewMockUsecaseInterface(controller)
+			},
+			wantResponse: generated.UpdateUserResponse{
+				Header: generated.ResponseHeader{Messages: []string{"full_name or phone_number is required"}},
+			},
+			wantHttpStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fail-password-without-phone-number",
+			body:           `{"full_name": "Jane Doe", "password": "Secret123!"}`,
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionGetUser},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				return usecase.NewMockUsecaseInterface(controller)
+			},
+			wantResponse: generated.UpdateUserResponse{
+				Header: generated.ResponseHeader{Messages: []string{"password is only required to update phone_number"}},
+			},
+			wantHttpStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fail-status-not-updatable",
+			body:           `{"status": "frozen"}`,
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionGetUser},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				return usecase.NewMockUsecaseInterface(controller)
+			},
+			wantResponse: generated.UpdateUserResponse{
+				Header: generated.ResponseHeader{Messages: []string{"only full_name and phone_number can be updated"}},
+			},
+			wantHttpStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fail-not-authorized",
+			body:           `{"full_name": "Jane Doe"}`,
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionPerformTransaction},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				return usecase.NewMockUsecaseInterface(controller)
+			},
+			wantResponse: generated.UpdateUserResponse{
+				Header: generated.ResponseHeader{Messages: []string{"not authorized: missing required permission"}},
+			},
+			wantHttpStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "fail-update-profile",
+			body:           `{"full_name": "Jane Doe"}`,
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionGetUser},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+				mock.EXPECT().UpdateUserProfile(gomock.Any(), gomock.Any()).Return(model.User{}, errors.New("error-update-profile")).Times(1)
+				return mock
+			},
+			wantResponse: generated.UpdateUserResponse{
+				Header: generated.ResponseHeader{Messages: []string{"error-update-profile"}},
+			},
+			wantHttpStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			handler := &Server{
+				Usecase: test.mockUsecase(controller),
+			}
+
+			e := echo.New()
+			request := httptest.NewRequest(http.MethodPatch, "/v1/user", bytes.NewBufferString(test.body))
+			recorder := httptest.NewRecorder()
+			ctx := e.NewContext(request, recorder)
+			ctx.Set(string(utils.JWTClaimUserID), int64(123))
+			ctx.Set(string(utils.JWTClaimPermissions), test.ctxPermissions)
+
+			gotHttpStatusCode, gotResponse := handler.updateUser(ctx)
+
+			if gotHttpStatusCode != test.wantHttpStatusCode {
+				t.Errorf("handler.UpdateUser() httpStatusCode = %v, wantHttpStatusCode %v", gotHttpStatusCode, test.wantHttpStatusCode)
+			}
+
+			if !reflect.DeepEqual(test.wantResponse, gotResponse) {
+				t.Errorf("handler.UpdateUser() response = %v, wantResponse %v", gotResponse, test.wantResponse)
+			}
+		})
+	}
+}
+
+func TestVerifyUserPhoneNumber(t *testing.T) {
+	user := model.User{
+		ID:          123,
+		FullName:    "Jane Doe",
+		PhoneNumber: "+6281234567890",
+		Balance:     100,
+		Status:      model.UserStatusActive,
+	}
+
+	tests := []struct {
+		name        string
+		body        string
+		mockUsecase func(controller *gomock.Controller) *usecase.MockUsecaseInterface
+
+		wantResponse       generated.UpdateUserResponse
+		wantHttpStatusCode int
+	}{
+		{
+			name: "success",
+			body: `{"otp": "123456"}`,
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+				mock.EXPECT().ConfirmPhoneNumberChange(gomock.Any(), int64(123), "123456").Return(user, nil).Times(1)
+				return mock
+			},
+			wantResponse: generated.UpdateUserResponse{
+				Header: generated.ResponseHeader{Success: true, Messages: []string{successMsg}},
+				User: generated.User{
+					Id:          intPtr(123),
+					FullName:    stringPtr("Jane Doe"),
+					PhoneNumber: stringPtr("+6281234567890"),
+					Balance:     floatPtr(100),
+					Status:      userStatusPtr(generated.Active),
+				},
+			},
+			wantHttpStatusCode: http.StatusOK,
+		},
+		{
+			name: "fail-otp-format",
+			body: `{"otp": "12ab"}`,
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				return usecase.NewMockUsecaseInterface(controller)
+			},
+			wantResponse: generated.UpdateUserResponse{
+				Header: generated.ResponseHeader{Messages: []string{"otp should be 6 digits"}},
+			},
+			wantHttpStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "fail-invalid-otp",
+			body: `{"otp": "654321"}`,
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+				mock.EXPECT().ConfirmPhoneNumberChange(gomock.Any(), int64(123), "654321").Return(model.User{}, usecase.ErrOTPInvalid).Times(1)
+				return mock
+			},
+			wantResponse: generated.UpdateUserResponse{
+				Header: generated.ResponseHeader{Messages: []string{usecase.ErrOTPInvalid.Error()}},
+			},
+			wantHttpStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "fail-not-requested",
+			body: `{"otp": "123456"}`,
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+				mock.EXPECT().ConfirmPhoneNumberChange(gomock.Any(), int64(123), "123456").Return(model.User{}, repository.ErrPhoneNumberChangeNotFound).Times(1)
+				return mock
+			},
+			wantResponse: generated.UpdateUserResponse{
+				Header: generated.ResponseHeader{Messages: []string{repository.ErrPhoneNumberChangeNotFound.Error()}},
+			},
+			wantHttpStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "fail-phone-number-registered",
+			body: `{"otp": "123456"}`,
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+				mock.EXPECT().ConfirmPhoneNumberChange(gomock.Any(), int64(123), "123456").Return(model.User{}, &pq.Error{Code: "23505"}).Times(1)
+				return mock
+			},
+			wantResponse: generated.UpdateUserResponse{
+				Header: generated.ResponseHeader{Messages: []string{duplicatePhoneNumberErrorMsg}},
+			},
+			wantHttpStatusCode: http.StatusConflict,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			handler := &Server{
+				Usecase: test.mockUsecase(controller),
+			}
+
+			e := echo.New()
+			request := httptest.NewRequest(http.MethodPost, "/v1/user/phone-number/verify", bytes.NewBufferString(test.body))
+			recorder := httptest.NewRecorder()
+			ctx := e.NewContext(request, recorder)
+			ctx.Set(string(utils.JWTClaimUserID), int64(123))
+			ctx.Set(string(utils.JWTClaimPermissions), []utils.JWTPermission{utils.JWTPermissionGetUser})
+
+			gotHttpStatusCode, gotResponse := handler.verifyUserPhoneNumber(ctx)
+
+			if gotHttpStatusCode != test.wantHttpStatusCode {
+				t.Errorf("handler.VerifyUserPhoneNumber() httpStatusCode = %v, wantHttpStatusCode %v", gotHttpStatusCode, test.wantHttpStatusCode)
+			}
+
+			if !reflect.DeepEqual(test.wantResponse, gotResponse) {
+				t.Errorf("handler.VerifyUserPhoneNumber() response = %v, wantResponse %v", gotResponse, test.wantResponse)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/WalletService/generated"
+	"github.com/WalletService/model"
+	"github.com/WalletService/usecase"
+	"github.com/WalletService/utils"
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	// Define function wrappers so we can inject dummy function in UT
+	fnConvertQRRequest        func(int64, generated.QRRequest) (model.QRRequest, []string)        = convertQRRequest
+	fnConvertQRPaymentRequest func(int64, generated.QRPaymentRequest) (model.QRPayment, []string) = convertQRPaymentRequest
+)
+
+// GenerateUserQR generates a QRIS-compatible QR payload so that other Users can pay into the User's wallet.
+// NOTE: Check AuthenticationMiddleware cmd/main.go that authenticates the JWT token
+func (s *Server) GenerateUserQR(ctx echo.Context, pathUserID int) error {
+	return ctx.JSON(s.generateUserQR(ctx, int64(pathUserID)))
+}
+func (s *Server) generateUserQR(ctx echo.Context, pathUserID int64) (int, generated.QRResponse) {
+	var (
+		context = ctx.Request().Context()
+
+		response = generated.QRResponse{
+			Header: generated.ResponseHeader{}, //success is false by default
+		}
+	)
+
+	// Authorize and get userID of the requester
+	userID, err := authorize(ctx, utils.JWTPermissionGetUser)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	} else if pathUserID != userID {
+		response.Header.Messages = []string{"JWT userID mismatched with request userID"}
+		return http.StatusForbidden, response
+	}
+
+	request := generated.QRRequest{}
+	err = json.NewDecoder(ctx.Request().Body).Decode(&request)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusBadRequest, response
+	}
+
+	qrRequest, errorList := fnConvertQRRequest(userID, request)
+	if len(errorList) > 0 {
+		response.Header.Messages = errorList
+		return http.StatusBadRequest, response
+	}
+
+	payload, err := s.Usecase.GenerateUserQR(context, qrRequest)
+	if errors.Is(err, usecase.ErrQRMerchantNotVerified) {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	} else if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
+	}
+
+	qrType := generated.Static
+	if qrRequest.Amount > 0 {
+		qrType = generated.Dynamic
+		response.Qr.Amount = &qrRequest.Amount
+	}
+
+	response.Header.Success = true
+	response.Header.Messages = []string{successMsg}
+	response.Qr.Payload = &payload
+	response.Qr.Type = &qrType
+	return http.StatusOK, response
+}
+
+// CreateUserQRPayment pays a scanned QR payload, i.e. TransferOut to another User or Payment to a merchant.
+// NOTE: Check AuthenticationMiddleware cmd/main.go that authenticates the JWT token
+func (s *Server) CreateUserQRPayment(ctx echo.Context, pathUserID int) error {
+	return ctx.JSON(s.createUserQRPayment(ctx, int64(pathUserID)))
+}
+func (s *Server) createUserQRPayment(ctx echo.Context, pathUserID int64) (int, generated.TransactionResponse) {
+	var (
+		context = ctx.Request().Context()
+
+		response = generated.TransactionResponse{
+			Header: generated.ResponseHeader{}, //success is false by default
+		}
+	)
+
+	// Authorize and get userID of the requester
+	userID, err := authorize(ctx, utils.JWTPermissionPerformTransaction)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	} else if pathUserID != userID {
+		response.Header.Messages = []string{"JWT userID mismatched with request userID"}
+		return http.StatusForbidden, response
+	}
+
+	request := generated.QRPaymentRequest{}
+	err = json.NewDecoder(ctx.Request().Body).Decode(&request)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusBadRequest, response
+	}
+
+	payment, errorList := fnConvertQRPaymentRequest(userID, request)
+	if len(errorList) > 0 {
+		response.Header.Messages = errorList
+		return http.StatusBadRequest, response
+	}
+
+	newTransaction, err := s.Usecase.CreateUserQRPayment(context, payment)
+	if isAccountStatusError(err) {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	} else if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
+	}
+
+	response.Transaction = convertTransactionToResponse(newTransaction)
+	response.Header.Success = true
+	response.Header.Messages = []string{successMsg}
+	return http.StatusOK, response
+}
@@ -0,0 +1,280 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/WalletService/generated"
+	"github.com/WalletService/model"
+	"github.com/WalletService/usecase"
+	"github.com/WalletService/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+)
+
+func TestGenerateUserQR(t *testing.T) {
+	qrTypePtr := func(in generated.QRType) *generated.QRType {
+		return &in
+	}
+
+	tests := []struct {
+		name               string
+		ctxUserID          int64
+		ctxPermissions     []utils.JWTPermission
+		pathUserID         int64
+		requestBody        generated.QRRequest
+		mockUsecase        func(controller *gomock.Controller) *usecase.MockUsecaseInterface
+		wantResponse       generated.QRResponse
+		wantHttpStatusCode int
+	}{
+		{
+			name:           "success-dynamic",
+			ctxUserID:      123,
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionGetUser},
+			pathUserID:     123,
+			requestBody: generated.QRRequest{
+				Amount: floatPtr(250000),
+			},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+
+				mock.EXPECT().GenerateUserQR(gomock.Any(), model.QRRequest{
+					UserID: 123,
+					Amount: 250000,
+				}).Return("000201010212", nil)
+
+				return mock
+			},
+			wantResponse: generated.QRResponse{
+				Header: generated.ResponseHeader{
+					Success:  true,
+					Messages: []string{successMsg},
+				},
+				Qr: generated.QR{
+					Payload: stringPtr("000201010212"),
+					Type:    qrTypePtr(generated.Dynamic),
+					Amount:  floatPtr(250000),
+				},
+			},
+			wantHttpStatusCode: http.StatusOK,
+		},
+		{
+			name:           "fail-user-id-mismatch",
+			ctxUserID:      123,
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionGetUser},
+			pathUserID:     456,
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				return usecase.NewMockUsecaseInterface(controller)
+			},
+			wantResponse: generated.QRResponse{
+				Header: generated.ResponseHeader{
+					Success:  false,
+					Messages: []string{"JWT userID mismatched with request userID"},
+				},
+			},
+			wantHttpStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "fail-merchant-not-verified",
+			ctxUserID:      123,
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionGetUser},
+			pathUserID:     123,
+			requestBody: generated.QRRequest{
+				MerchantCategoryCode: stringPtr("5812"),
+			},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+
+				mock.EXPECT().GenerateUserQR(gomock.Any(), model.QRRequest{
+					UserID:               123,
+					MerchantCategoryCode: "5812",
+				}).Return("", usecase.ErrQRMerchantNotVerified)
+
+				return mock
+			},
+			wantResponse: generated.QRResponse{
+				Header: generated.ResponseHeader{
+					Success:  false,
+					Messages: []string{usecase.ErrQRMerchantNotVerified.Error()},
+				},
+			},
+			wantHttpStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "fail-invalid-merchant-category-code",
+			ctxUserID:      123,
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionGetUser},
+			pathUserID:     123,
+			requestBody: generated.QRRequest{
+				MerchantCategoryCode: stringPtr("58A2"),
+			},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				return usecase.NewMockUsecaseInterface(controller)
+			},
+			wantResponse: generated.QRResponse{
+				Header: generated.ResponseHeader{
+					Success:  false,
+					Messages: []string{"merchant_category_code should be 4 digits"},
+				},
+			},
+			wantHttpStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			handler := &Server{
+				Usecase: test.mockUsecase(controller),
+			}
+
+			requestBodyJSON, _ := json.Marshal(test.requestBody)
+
+			e := echo.New()
+			request := httptest.NewRequest(http.MethodPost, "/v1/user/{userID}/qr", bytes.NewBuffer(requestBodyJSON))
+			recorder := httptest.NewRecorder()
+			ctx := e.NewContext(request, recorder)
+			ctx.Set(string(utils.JWTClaimUserID), test.ctxUserID)
+			ctx.Set(string(utils.JWTClaimPermissions), test.ctxPermissions)
+
+			gotHttpStatusCode, gotResponse := handler.generateUserQR(ctx, test.pathUserID)
+
+			if gotHttpStatusCode != test.wantHttpStatusCode {
+				t.Errorf("handler.GenerateUserQR() httpStatusCode = %v, wantHttpStatusCode %v", gotHttpStatusCode, test.wantHttpStatusCode)
+			}
+
+			if !reflect.DeepEqual(test.wantResponse, gotResponse) {
+				t.Errorf("handler.GenerateUserQR() response = %v, wantResponse %v", gotResponse, test.wantResponse)
+			}
+		})
+	}
+}
+
+func TestCreateUserQRPayment(t *testing.T) {
+	tests := []struct {
+		name               string
+		ctxPermissions     []utils.JWTPermission
+		requestBody        generated.QRPaymentRequest
+		mockUsecase        func(controller *gomock.Controller) *usecase.MockUsecaseInterface
+		wantResponse       generated.TransactionResponse
+		wantHttpStatusCode int
+	}{
+		{
+			name:           "success",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionPerformTransaction},
+			requestBody: generated.QRPaymentRequest{
+				Payload:  "000201010211",
+				Amount:   floatPtr(10000),
+				Password: stringPtr("Admin1234!"),
+			},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+
+				mock.EXPECT().CreateUserQRPayment(gomock.Any(), model.QRPayment{
+					UserID:   123,
+					Payload:  "000201010211",
+					Amount:   10000,
+					Password: "Admin1234!",
+				}).Return(model.Transaction{ID: convertToUUID("3d6e668f-ad02-40ff-8540-90c1528a7c88")}, nil)
+
+				return mock
+			},
+			wantResponse: generated.TransactionResponse{
+				Header: generated.ResponseHeader{
+					Success:  true,
+					Messages: []string{successMsg},
+				},
+				Transaction: generated.Transaction{
+					Id: stringPtr("3d6e668f-ad02-40ff-8540-90c1528a7c88"),
+				},
+			},
+			wantHttpStatusCode: http.StatusOK,
+		},
+		{
+			name:           "fail-missing-payload",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionPerformTransaction},
+			requestBody:    generated.QRPaymentRequest{},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				return usecase.NewMockUsecaseInterface(controller)
+			},
+			wantResponse: generated.TransactionResponse{
+				Header: generated.ResponseHeader{
+					Success:  false,
+					Messages: []string{"payload is required"},
+				},
+			},
+			wantHttpStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fail-not-authorized-permission",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionGetUser},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				return usecase.NewMockUsecaseInterface(controller)
+			},
+			wantResponse: generated.TransactionResponse{
+				Header: generated.ResponseHeader{
+					Success:  false,
+					Messages: []string{"not authorized: missing required permission"},
+				},
+			},
+			wantHttpStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "fail-create-qr-payment",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionPerformTransaction},
+			requestBody: generated.QRPaymentRequest{
+				Payload: "000201010211",
+			},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+
+				mock.EXPECT().CreateUserQRPayment(gomock.Any(), model.QRPayment{
+					UserID:  123,
+					Payload: "000201010211",
+				}).Return(model.Transaction{}, errors.New("qr: invalid checksum"))
+
+				return mock
+			},
+			wantResponse: generated.TransactionResponse{
+				Header: generated.ResponseHeader{
+					Success:  false,
+					Messages: []string{"qr: invalid checksum"},
+				},
+			},
+			wantHttpStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			handler := &Server{
+				Usecase: test.mockUsecase(controller),
+			}
+
+			requestBodyJSON, _ := json.Marshal(test.requestBody)
+
+			e := echo.New()
+			request := httptest.NewRequest(http.MethodPost, "/v1/user/{userID}/qr/payments", bytes.NewBuffer(requestBodyJSON))
+			recorder := httptest.NewRecorder()
+			ctx := e.NewContext(request, recorder)
+			ctx.Set(string(utils.JWTClaimUserID), int64(123))
+			ctx.Set(string(utils.JWTClaimPermissions), test.ctxPermissions)
+
+			gotHttpStatusCode, gotResponse := handler.createUserQRPayment(ctx, 123)
+
+			if gotHttpStatusCode != test.wantHttpStatusCode {
+				t.Errorf("handler.CreateUserQRPayment() httpStatusCode = %v, wantHttpStatusCode %v", gotHttpStatusCode, test.wantHttpStatusCode)
+			}
+
+			if !reflect.DeepEqual(test.wantResponse, gotResponse) {
+				t.Errorf("handler.CreateUserQRPayment() response = %v, wantResponse %v", gotResponse, test.wantResponse)
+			}
+		})
+	}
+}
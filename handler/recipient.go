@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/WalletService/generated"
+	"github.com/WalletService/usecase"
+	"github.com/WalletService/utils"
+	"github.com/labstack/echo/v4"
+)
+
+// LookupRecipient finds the Recipient of a transfer by phone number, returning its masked name for the User to confirm and a token to
+// transfer with, see recipient_token of CreateUserTransaction.
+// NOTE: Check AuthenticationMiddleware cmd/main.go that authenticates the JWT token
+func (s *Server) LookupRecipient(ctx echo.Context, pathUserID int) error {
+	return ctx.JSON(s.lookupRecipient(ctx, int64(pathUserID)))
+}
+func (s *Server) lookupRecipient(ctx echo.Context, pathUserID int64) (int, generated.RecipientLookupResponse) {
+	var (
+		context = ctx.Request().Context()
+
+		response = generated.RecipientLookupResponse{
+			Header: generated.ResponseHeader{}, //success is false by default
+		}
+	)
+
+	// Authorize and get userID of the requester
+	userID, err := authorize(ctx, utils.JWTPermissionPerformTransaction)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	} else if pathUserID != userID {
+		response.Header.Messages = []string{"JWT userID mismatched with request userID"}
+		return http.StatusForbidden, response
+	}
+
+	request := generated.RecipientLookupRequest{}
+	err = json.NewDecoder(ctx.Request().Body).Decode(&request)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusBadRequest, response
+	}
+
+	phoneNumber, errorList := fnValidatePhoneNumber(&request.PhoneNumber, s.validationLimits())
+	if len(errorList) > 0 {
+		response.Header.Messages = errorList
+		return http.StatusBadRequest, response
+	}
+
+	lookup, err := s.Usecase.LookupRecipient(context, userID, phoneNumber)
+	switch {
+	case errors.Is(err, usecase.ErrRecipientNotFound):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusNotFound, response
+	case errors.Is(err, usecase.ErrRecipientLookupLimitExceeded):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusTooManyRequests, response
+	case isAccountStatusError(err):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	case err != nil:
+		response.Header.Messages = []string{err.Error()}
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
+	}
+
+	response.Header.Success = true
+	response.Header.Messages = []string{successMsg}
+	response.Recipient = convertRecipientLookupToResponse(lookup)
+	return http.StatusOK, response
+}
@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/WalletService/generated"
+	"github.com/WalletService/model"
+	"github.com/WalletService/usecase"
+	"github.com/WalletService/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+)
+
+func TestLookupRecipient(t *testing.T) {
+	expiryTime := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name               string
+		ctxPermissions     []utils.JWTPermission
+		requestBody        generated.RecipientLookupRequest
+		mockUsecase        func(controller *gomock.Controller) *usecase.MockUsecaseInterface
+		wantResponse       generated.RecipientLookupResponse
+		wantHttpStatusCode int
+	}{
+		{
+			name:           "success",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionPerformTransaction},
+			requestBody:    generated.RecipientLookupRequest{PhoneNumber: "0898-7654-321"},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+
+				mock.EXPECT().LookupRecipient(gomock.Any(), int64(123), "+628987654321").Return(model.RecipientLookup{
+					ID:            convertToUUID("5a0d7d2e-3c3f-4a59-8f0e-8f6b0f3f2a10"),
+					UserID:        123,
+					RecipientID:   456,
+					PhoneNumber:   "+628987654321",
+					ExpiryTime:    expiryTime,
+					RecipientName: "Jo** Do*",
+				}, nil)
+
+				return mock
+			},
+			wantResponse: generated.RecipientLookupResponse{
+				Header: generated.ResponseHeader{
+					Success:  true,
+					Messages: []string{successMsg},
+				},
+				Recipient: generated.Recipient{
+					Name:       stringPtr("Jo** Do*"),
+					Token:      stringPtr("5a0d7d2e-3c3f-4a59-8f0e-8f6b0f3f2a10"),
+					ExpiryTime: &expiryTime,
+				},
+			},
+			wantHttpStatusCode: http.StatusOK,
+		},
+		{
+			name:           "fail-not-authorized-permission",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionGetUser},
+			requestBody:    generated.RecipientLookupRequest{PhoneNumber: "08987654321"},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				return usecase.NewMockUsecaseInterface(controller)
+			},
+			wantResponse: generated.RecipientLookupResponse{
+				Header: generated.ResponseHeader{Messages: []string{"not authorized: missing required permission"}},
+			},
+			wantHttpStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "fail-invalid-phone-number",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionPerformTransaction},
+			requestBody:    generated.RecipientLookupRequest{PhoneNumber: "021 5551234"},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				return usecase.NewMockUsecaseInterface(controller)
+			},
+			wantResponse: generated.RecipientLookupResponse{
+				Header: generated.ResponseHeader{Messages: []string{"phone_number is not a valid mobile number"}},
+			},
+			wantHttpStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fail-not-found",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionPerformTransaction},
+			requestBody:    generated.RecipientLookupRequest{PhoneNumber: "08987654321"},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+				mock.EXPECT().LookupRecipient(gomock.Any(), int64(123), "+628987654321").Return(model.RecipientLookup{}, usecase.ErrRecipientNotFound)
+				return mock
+			},
+			wantResponse: generated.RecipientLookupResponse{
+				Header: generated.ResponseHeader{Messages: []string{usecase.ErrRecipientNotFound.Error()}},
+			},
+			wantHttpStatusCode: http.StatusNotFound,
+		},
+		{
+			name:           "fail-limit-exceeded",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionPerformTransaction},
+			requestBody:    generated.RecipientLookupRequest{PhoneNumber: "08987654321"},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+				mock.EXPECT().LookupRecipient(gomock.Any(), int64(123), "+628987654321").Return(model.RecipientLookup{}, usecase.ErrRecipientLookupLimitExceeded)
+				return mock
+			},
+			wantResponse: generated.RecipientLookupResponse{
+				Header: generated.ResponseHeader{Messages: []string{usecase.ErrRecipientLookupLimitExceeded.Error()}},
+			},
+			wantHttpStatusCode: http.StatusTooManyRequests,
+		},
+		{
+			name:           "fail-user-frozen",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionPerformTransaction},
+			requestBody:    generated.RecipientLookupRequest{PhoneNumber: "08987654321"},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+				mock.EXPECT().LookupRecipient(gomock.Any(), int64(123), "+628987654321").Return(model.RecipientLookup{}, usecase.ErrUserFrozen)
+				return mock
+			},
+			wantResponse: generated.RecipientLookupResponse{
+				Header: generated.ResponseHeader{Messages: []string{usecase.ErrUserFrozen.Error()}},
+			},
+			wantHttpStatusCode: http.StatusForbidden,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			handler := &Server{
+				Usecase: test.mockUsecase(controller),
+			}
+
+			requestBodyJSON, _ := json.Marshal(test.requestBody)
+
+			e := echo.New()
+			request := httptest.NewRequest(http.MethodPost, "/v1/user/{userID}/recipient-lookups", bytes.NewBuffer(requestBodyJSON))
+			recorder := httptest.NewRecorder()
+			ctx := e.NewContext(request, recorder)
+			ctx.Set(string(utils.JWTClaimUserID), int64(123))
+			ctx.Set(string(utils.JWTClaimPermissions), test.ctxPermissions)
+
+			gotHttpStatusCode, gotResponse := handler.lookupRecipient(ctx, 123)
+
+			if gotHttpStatusCode != test.wantHttpStatusCode {
+				t.Errorf("handler.LookupRecipient() httpStatusCode = %v, wantHttpStatusCode %v", gotHttpStatusCode, test.wantHttpStatusCode)
+			}
+
+			if !reflect.DeepEqual(test.wantResponse, gotResponse) {
+				t.Errorf("handler.LookupRecipient() response = %v, wantResponse %v", gotResponse, test.wantResponse)
+			}
+		})
+	}
+}
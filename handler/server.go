@@ -2,17 +2,44 @@ package handler
 
 import (
 	"github.com/WalletService/usecase"
+	"github.com/WalletService/utils"
 )
 
 type Server struct {
 	Usecase usecase.UsecaseInterface
+
+	// DisbursementCallbackToken is the shared secret the disbursement gateway sends in X-Callback-Token
+	DisbursementCallbackToken string
+
+	// PaymentCallbackSecret is the shared secret the payment gateway signs top-up callbacks with
+	PaymentCallbackSecret []byte
+
+	ValidationLimits *utils.ValidationLimits // utils.DefaultValidationLimits if nil
+
+	// ReadinessChecks must all pass for Readyz to report the instance is ready
+	ReadinessChecks []ReadinessCheck
 }
 
 type NewServerOptions struct {
+	DisbursementCallbackToken string
+	PaymentCallbackSecret     []byte
+	ValidationLimits          *utils.ValidationLimits
+	ReadinessChecks           []ReadinessCheck
 }
 
-func NewServer(usecase usecase.UsecaseInterface) *Server {
+func NewServer(usecase usecase.UsecaseInterface, opts NewServerOptions) *Server {
 	return &Server{
-		Usecase: usecase,
+		Usecase:                   usecase,
+		DisbursementCallbackToken: opts.DisbursementCallbackToken,
+		PaymentCallbackSecret:     opts.PaymentCallbackSecret,
+		ValidationLimits:          opts.ValidationLimits,
+		ReadinessChecks:           opts.ReadinessChecks,
+	}
+}
+
+func (s *Server) validationLimits() utils.ValidationLimits {
+	if s.ValidationLimits == nil {
+		return utils.DefaultValidationLimits
 	}
+	return *s.ValidationLimits
 }
@@ -0,0 +1,391 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/WalletService/generated"
+	"github.com/WalletService/model"
+	"github.com/WalletService/repository"
+	"github.com/WalletService/usecase"
+	"github.com/WalletService/utils"
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	// Define function wrappers so we can inject dummy function in UT
+	fnConvertSharedWalletMemberRequest func(int64, int64, generated.SharedWalletMemberRequest, utils.ValidationLimits) (model.SharedWalletMemberRequest, []string) = convertSharedWalletMemberRequest
+)
+
+const (
+	duplicateSharedWalletMemberErrorMsg = "user is already a member of the shared wallet"
+)
+
+// CreateSharedWallet creates an empty SharedWallet owned by User, its members deposit into it with SharedWalletDeposit Transactions
+// and spend from it with TransferOut Transactions.
+// NOTE: Check AuthenticationMiddleware cmd/main.go that authenticates the JWT token
+func (s *Server) CreateSharedWallet(ctx echo.Context, pathUserID int) error {
+	return ctx.JSON(s.createSharedWallet(ctx, int64(pathUserID)))
+}
+func (s *Server) createSharedWallet(ctx echo.Context, pathUserID int64) (int, generated.SharedWalletResponse) {
+	var (
+		context = ctx.Request().Context()
+
+		response = generated.SharedWalletResponse{
+			Header: generated.ResponseHeader{}, //success is false by default
+		}
+	)
+
+	// Authorize and get userID of the requester, creating a SharedWallet does not move money
+	userID, err := authorize(ctx, utils.JWTPermissionGetUser)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	} else if pathUserID != userID {
+		response.Header.Messages = []string{"JWT userID mismatched with request userID"}
+		return http.StatusForbidden, response
+	}
+
+	request := generated.SharedWalletRequest{}
+	err = json.NewDecoder(ctx.Request().Body).Decode(&request)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusBadRequest, response
+	}
+
+	wallet, errorList := convertSharedWalletRequest(userID, request)
+	if len(errorList) > 0 {
+		response.Header.Messages = errorList
+		return http.StatusBadRequest, response
+	}
+
+	wallet, err = s.Usecase.CreateSharedWallet(context, wallet)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
+	}
+
+	response.Header.Success = true
+	response.Header.Messages = []string{successMsg}
+	response.SharedWallet = convertSharedWalletToResponse(wallet)
+	return http.StatusCreated, response
+}
+
+// GetUserSharedWallets lists the SharedWallets User is a member of, with User's role and limits in each.
+// NOTE: Check AuthenticationMiddleware cmd/main.go that authenticates the JWT token
+func (s *Server) GetUserSharedWallets(ctx echo.Context, pathUserID int) error {
+	return ctx.JSON(s.getUserSharedWallets(ctx, int64(pathUserID)))
+}
+func (s *Server) getUserSharedWallets(ctx echo.Context, pathUserID int64) (int, generated.SharedWalletsResponse) {
+	var (
+		context = ctx.Request().Context()
+
+		response = generated.SharedWalletsResponse{
+			Header:        generated.ResponseHeader{}, //success is false by default
+			SharedWallets: []generated.SharedWallet{},
+		}
+	)
+
+	// Authorize and get userID of the requester
+	userID, err := authorize(ctx, utils.JWTPermissionGetUser)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	} else if pathUserID != userID {
+		response.Header.Messages = []string{"JWT userID mismatched with request userID"}
+		return http.StatusForbidden, response
+	}
+
+	wallets, err := s.Usecase.GetUserSharedWallets(context, userID)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
+	}
+
+	for _, wallet := range wallets {
+		response.SharedWallets = append(response.SharedWallets, convertSharedWalletToResponse(wallet))
+	}
+
+	response.Header.Success = true
+	response.Header.Messages = []string{successMsg}
+	return http.StatusOK, response
+}
+
+// GetSharedWalletMembers lists the members of a SharedWallet owned by User.
+// NOTE: Check AuthenticationMiddleware cmd/main.go that authenticates the JWT token
+func (s *Server) GetSharedWalletMembers(ctx echo.Context, pathUserID int, sharedWalletID int) error {
+	return ctx.JSON(s.getSharedWalletMembers(ctx, int64(pathUserID), int64(sharedWalletID)))
+}
+func (s *Server) getSharedWalletMembers(ctx echo.Context, pathUserID int64, sharedWalletID int64) (int, generated.SharedWalletMembersResponse) {
+	var (
+		context = ctx.Request().Context()
+
+		response = generated.SharedWalletMembersResponse{
+			Header:  generated.ResponseHeader{}, //success is false by default
+			Members: []generated.SharedWalletMember{},
+		}
+	)
+
+	// Authorize and get userID of the requester
+	userID, err := authorize(ctx, utils.JWTPermissionGetUser)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	} else if pathUserID != userID {
+		response.Header.Messages = []string{"JWT userID mismatched with request userID"}
+		return http.StatusForbidden, response
+	}
+
+	members, err := s.Usecase.GetSharedWalletMembers(context, userID, sharedWalletID)
+	switch {
+	case errors.Is(err, repository.ErrSharedWalletNotFound):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusNotFound, response
+	case errors.Is(err, usecase.ErrSharedWalletRoleNotAllowed):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	case err != nil:
+		response.Header.Messages = []string{err.Error()}
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
+	}
+
+	for _, member := range members {
+		response.Members = append(response.Members, convertSharedWalletMemberToResponse(member))
+	}
+
+	response.Header.Success = true
+	response.Header.Messages = []string{successMsg}
+	return http.StatusOK, response
+}
+
+// AddSharedWalletMember adds a member to a SharedWallet owned by User, requested like the Recipient of a TransferOut.
+// NOTE: Check AuthenticationMiddleware cmd/main.go that authenticates the JWT token
+func (s *Server) AddSharedWalletMember(ctx echo.Context, pathUserID int, sharedWalletID int) error {
+	return ctx.JSON(s.addSharedWalletMember(ctx, int64(pathUserID), int64(sharedWalletID)))
+}
+func (s *Server) addSharedWalletMember(ctx echo.Context, pathUserID int64, sharedWalletID int64) (int, generated.SharedWalletMemberResponse) {
+	var (
+		context = ctx.Request().Context()
+
+		response = generated.SharedWalletMemberResponse{
+			Header: generated.ResponseHeader{}, //success is false by default
+		}
+	)
+
+	// Authorize and get userID of the requester, adding a member may look up its Recipient which is only needed to transfer
+	userID, err := authorize(ctx, utils.JWTPermissionPerformTransaction)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	} else if pathUserID != userID {
+		response.Header.Messages = []string{"JWT userID mismatched with request userID"}
+		return http.StatusForbidden, response
+	}
+
+	request := generated.SharedWalletMemberRequest{}
+	err = json.NewDecoder(ctx.Request().Body).Decode(&request)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusBadRequest, response
+	}
+
+	memberRequest, errorList := fnConvertSharedWalletMemberRequest(userID, sharedWalletID, request, s.validationLimits())
+	if len(errorList) > 0 {
+		response.Header.Messages = errorList
+		return http.StatusBadRequest, response
+	}
+
+	member, err := s.Usecase.AddSharedWalletMember(context, memberRequest)
+	switch {
+	case utils.IsUniqueConstraintViolation(err):
+		response.Header.Messages = []string{duplicateSharedWalletMemberErrorMsg}
+		return http.StatusConflict, response
+	case errors.Is(err, repository.ErrSharedWalletNotFound),
+		errors.Is(err, usecase.ErrRecipientNotFound):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusNotFound, response
+	case errors.Is(err, usecase.ErrSharedWalletRoleNotAllowed):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	case errors.Is(err, usecase.ErrRecipientLookupLimitExceeded):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusTooManyRequests, response
+	case errors.Is(err, usecase.ErrSharedWalletOwnerMember),
+		errors.Is(err, usecase.ErrRecipientTokenInvalid),
+		errors.Is(err, usecase.ErrRecipientTokenExpired):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusBadRequest, response
+	case err != nil:
+		response.Header.Messages = []string{err.Error()}
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
+	}
+
+	response.Header.Success = true
+	response.Header.Messages = []string{successMsg}
+	response.Member = convertSharedWalletMemberToResponse(member)
+	return http.StatusCreated, response
+}
+
+// UpdateSharedWalletMember changes the role or limits of a member of a SharedWallet owned by User.
+// NOTE: Check AuthenticationMiddleware cmd/main.go that authenticates the JWT token
+func (s *Server) UpdateSharedWalletMember(ctx echo.Context, pathUserID int, sharedWalletID int, memberID int) error {
+	return ctx.JSON(s.updateSharedWalletMember(ctx, int64(pathUserID), int64(sharedWalletID), int64(memberID)))
+}
+func (s *Server) updateSharedWalletMember(ctx echo.Context, pathUserID int64, sharedWalletID int64, memberID int64) (int, generated.SharedWalletMemberResponse) {
+	var (
+		context = ctx.Request().Context()
+
+		response = generated.SharedWalletMemberResponse{
+			Header: generated.ResponseHeader{}, //success is false by default
+		}
+	)
+
+	// Authorize and get userID of the requester
+	userID, err := authorize(ctx, utils.JWTPermissionGetUser)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	} else if pathUserID != userID {
+		response.Header.Messages = []string{"JWT userID mismatched with request userID"}
+		return http.StatusForbidden, response
+	}
+
+	request := generated.UpdateSharedWalletMemberRequest{}
+	err = json.NewDecoder(ctx.Request().Body).Decode(&request)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusBadRequest, response
+	}
+
+	member, errorList := convertUpdateSharedWalletMemberRequest(sharedWalletID, memberID, request)
+	if len(errorList) > 0 {
+		response.Header.Messages = errorList
+		return http.StatusBadRequest, response
+	}
+
+	member, err = s.Usecase.UpdateSharedWalletMember(context, userID, member)
+	switch {
+	case errors.Is(err, repository.ErrSharedWalletNotFound),
+		errors.Is(err, repository.ErrSharedWalletMemberNotFound):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusNotFound, response
+	case errors.Is(err, usecase.ErrSharedWalletRoleNotAllowed):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	case errors.Is(err, usecase.ErrSharedWalletOwnerMember):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusBadRequest, response
+	case err != nil:
+		response.Header.Messages = []string{err.Error()}
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
+	}
+
+	response.Header.Success = true
+	response.Header.Messages = []string{successMsg}
+	response.Member = convertSharedWalletMemberToResponse(member)
+	return http.StatusOK, response
+}
+
+// RemoveSharedWalletMember removes a member from a SharedWallet owned by User, its Transactions are kept.
+// NOTE: Check AuthenticationMiddleware cmd/main.go that authenticates the JWT token
+func (s *Server) RemoveSharedWalletMember(ctx echo.Context, pathUserID int, sharedWalletID int, memberID int) error {
+	return ctx.JSON(s.removeSharedWalletMember(ctx, int64(pathUserID), int64(sharedWalletID), int64(memberID)))
+}
+func (s *Server) removeSharedWalletMember(ctx echo.Context, pathUserID int64, sharedWalletID int64, memberID int64) (int, generated.DeleteSharedWalletMemberResponse) {
+	var (
+		context = ctx.Request().Context()
+
+		response = generated.DeleteSharedWalletMemberResponse{
+			Header: generated.ResponseHeader{}, //success is false by default
+		}
+	)
+
+	// Authorize and get userID of the requester
+	userID, err := authorize(ctx, utils.JWTPermissionGetUser)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	} else if pathUserID != userID {
+		response.Header.Messages = []string{"JWT userID mismatched with request userID"}
+		return http.StatusForbidden, response
+	}
+
+	err = s.Usecase.RemoveSharedWalletMember(context, userID, sharedWalletID, memberID)
+	switch {
+	case errors.Is(err, repository.ErrSharedWalletNotFound),
+		errors.Is(err, repository.ErrSharedWalletMemberNotFound):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusNotFound, response
+	case errors.Is(err, usecase.ErrSharedWalletRoleNotAllowed):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	case errors.Is(err, usecase.ErrSharedWalletOwnerMember):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusBadRequest, response
+	case err != nil:
+		response.Header.Messages = []string{err.Error()}
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
+	}
+
+	response.Header.Success = true
+	response.Header.Messages = []string{successMsg}
+	return http.StatusOK, response
+}
+
+// GetSharedWalletTransactions lists the Transactions of a SharedWallet User is a member of, most recent first.
+// Spenders only see their own Transactions.
+// NOTE: Check AuthenticationMiddleware cmd/main.go that authenticates the JWT token
+func (s *Server) GetSharedWalletTransactions(ctx echo.Context, pathUserID int, sharedWalletID int, params generated.GetSharedWalletTransactionsParams) error {
+	return ctx.JSON(s.getSharedWalletTransactions(ctx, int64(pathUserID), int64(sharedWalletID), params))
+}
+func (s *Server) getSharedWalletTransactions(ctx echo.Context, pathUserID int64, sharedWalletID int64, params generated.GetSharedWalletTransactionsParams) (int, generated.TransactionsResponse) {
+	var (
+		context = ctx.Request().Context()
+
+		response = generated.TransactionsResponse{
+			Header:       generated.ResponseHeader{}, //success is false by default
+			Transactions: []generated.Transaction{},
+		}
+	)
+
+	// Authorize and get userID of the requester
+	userID, err := authorize(ctx, utils.JWTPermissionGetUser)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	} else if pathUserID != userID {
+		response.Header.Messages = []string{"JWT userID mismatched with request userID"}
+		return http.StatusForbidden, response
+	}
+
+	// Pages are as large as admin searches, see Limit in api.yml
+	filter := model.TransactionFilter{
+		SharedWalletID: sharedWalletID,
+		Limit:          adminDefaultLimit,
+	}
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+	}
+	if params.Offset != nil {
+		filter.Offset = *params.Offset
+	}
+
+	transactions, err := s.Usecase.GetSharedWalletTransactions(context, userID, filter)
+	if errors.Is(err, repository.ErrSharedWalletNotFound) {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusNotFound, response
+	} else if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
+	}
+
+	// Members see who acted on the SharedWallet, like admins see the Transactions of a User
+	for _, transaction := range transactions {
+		response.Transactions = append(response.Transactions, convertTransactionToAdminResponse(transaction))
+	}
+
+	response.Header.Success = true
+	response.Header.Messages = []string{successMsg}
+	return http.StatusOK, response
+}
@@ -0,0 +1,240 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/WalletService/generated"
+	"github.com/WalletService/model"
+	"github.com/WalletService/repository"
+	"github.com/WalletService/usecase"
+	"github.com/WalletService/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+)
+
+func TestAddSharedWalletMember(t *testing.T) {
+	var (
+		createdTime      = time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+		closed           = false
+		role             = generated.Spender
+		transactionLimit = float32(100000)
+		monthlySpent     = float32(0)
+		request          = model.SharedWalletMemberRequest{
+			SharedWalletID: 5, OwnerID: 123, PhoneNumber: "+628987654321", Role: model.SharedWalletRoleSpender, TransactionLimit: 100000,
+		}
+	)
+
+	tests := []struct {
+		name               string
+		ctxPermissions     []utils.JWTPermission
+		requestBody        generated.SharedWalletMemberRequest
+		mockUsecase        func(controller *gomock.Controller) *usecase.MockUsecaseInterface
+		wantResponse       generated.SharedWalletMemberResponse
+		wantHttpStatusCode int
+	}{
+		{
+			name:           "success",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionPerformTransaction},
+			requestBody:    generated.SharedWalletMemberRequest{PhoneNumber: stringPtr("0898-7654-321"), Role: generated.Spender, TransactionLimit: &transactionLimit},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+				mock.EXPECT().AddSharedWalletMember(gomock.Any(), request).Return(model.SharedWalletMember{
+					SharedWalletID: 5, UserID: 456, Role: model.SharedWalletRoleSpender, TransactionLimit: 100000, FullName: "Jo** Do*",
+					Status: model.UserStatusActive, CreatedTime: createdTime,
+				}, nil)
+				return mock
+			},
+			wantResponse: generated.SharedWalletMemberResponse{
+				Header: generated.ResponseHeader{
+					Success:  true,
+					Messages: []string{successMsg},
+				},
+				Member: generated.SharedWalletMember{
+					UserId:           intPtr(456),
+					Name:             stringPtr("Jo** Do*"),
+					Role:             &role,
+					TransactionLimit: &transactionLimit,
+					MonthlySpent:     &monthlySpent,
+					Closed:           &closed,
+					CreatedTime:      &createdTime,
+				},
+			},
+			wantHttpStatusCode: http.StatusCreated,
+		},
+		{
+			name:           "fail-owner-role",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionPerformTransaction},
+			requestBody:    generated.SharedWalletMemberRequest{PhoneNumber: stringPtr("08987654321"), Role: generated.Owner},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				return usecase.NewMockUsecaseInterface(controller)
+			},
+			wantResponse: generated.SharedWalletMemberResponse{
+				Header: generated.ResponseHeader{Messages: []string{"role should be Spender or Viewer"}},
+			},
+			wantHttpStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fail-phone-number-and-token",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionPerformTransaction},
+			requestBody:    generated.SharedWalletMemberRequest{PhoneNumber: stringPtr("08987654321"), Token: stringPtr("3d6e668f-ad02-40ff-8540-90c1528a7c88"), Role: generated.Viewer},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				return usecase.NewMockUsecaseInterface(controller)
+			},
+			wantResponse: generated.SharedWalletMemberResponse{
+				Header: generated.ResponseHeader{Messages: []string{"only one of phone_number and token can be set"}},
+			},
+			wantHttpStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fail-not-owner",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionPerformTransaction},
+			requestBody:    generated.SharedWalletMemberRequest{PhoneNumber: stringPtr("08987654321"), Role: generated.Spender, TransactionLimit: &transactionLimit},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+				mock.EXPECT().AddSharedWalletMember(gomock.Any(), request).Return(model.SharedWalletMember{}, usecase.ErrSharedWalletRoleNotAllowed)
+				return mock
+			},
+			wantResponse: generated.SharedWalletMemberResponse{
+				Header: generated.ResponseHeader{Messages: []string{usecase.ErrSharedWalletRoleNotAllowed.Error()}},
+			},
+			wantHttpStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "fail-already-member",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionPerformTransaction},
+			requestBody:    generated.SharedWalletMemberRequest{PhoneNumber: stringPtr("08987654321"), Role: generated.Spender, TransactionLimit: &transactionLimit},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+				mock.EXPECT().AddSharedWalletMember(gomock.Any(), request).Return(model.SharedWalletMember{}, &pq.Error{Code: "23505"})
+				return mock
+			},
+			wantResponse: generated.SharedWalletMemberResponse{
+				Header: generated.ResponseHeader{Messages: []string{duplicateSharedWalletMemberErrorMsg}},
+			},
+			wantHttpStatusCode: http.StatusConflict,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			handler := &Server{
+				Usecase: test.mockUsecase(controller),
+			}
+
+			requestBodyJSON, _ := json.Marshal(test.requestBody)
+
+			e := echo.New()
+			request := httptest.NewRequest(http.MethodPost, "/v1/user/{userID}/shared-wallets/{sharedWalletID}/members", bytes.NewBuffer(requestBodyJSON))
+			recorder := httptest.NewRecorder()
+			ctx := e.NewContext(request, recorder)
+			ctx.Set(string(utils.JWTClaimUserID), int64(123))
+			ctx.Set(string(utils.JWTClaimPermissions), test.ctxPermissions)
+
+			gotHttpStatusCode, gotResponse := handler.addSharedWalletMember(ctx, 123, 5)
+
+			if gotHttpStatusCode != test.wantHttpStatusCode {
+				t.Errorf("handler.AddSharedWalletMember() httpStatusCode = %v, wantHttpStatusCode %v", gotHttpStatusCode, test.wantHttpStatusCode)
+			}
+
+			if !reflect.DeepEqual(test.wantResponse, gotResponse) {
+				t.Errorf("handler.AddSharedWalletMember() response = %v, wantResponse %v", gotResponse, test.wantResponse)
+			}
+		})
+	}
+}
+
+func TestGetSharedWalletTransactions(t *testing.T) {
+	var (
+		createdTime = time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+		status      = generated.TransactionStatusSuccessful
+	)
+
+	tests := []struct {
+		name               string
+		params             generated.GetSharedWalletTransactionsParams
+		mockUsecase        func(controller *gomock.Controller) *usecase.MockUsecaseInterface
+		wantResponse       generated.TransactionsResponse
+		wantHttpStatusCode int
+	}{
+		{
+			name:   "success",
+			params: generated.GetSharedWalletTransactionsParams{Limit: func() *int { limit := 10; return &limit }()},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+				mock.EXPECT().GetSharedWalletTransactions(gomock.Any(), int64(123), model.TransactionFilter{SharedWalletID: 5, Limit: 10}).Return([]model.Transaction{
+					{
+						ID: convertToUUID("3d6e668f-ad02-40ff-8540-90c1528a7c88"), UserID: 456, Amount: 50000, RecipientID: 789, Type: model.TransactionTypeTransferOut,
+						Status: model.TransactionStatusSuccessful, Currency: model.CurrencyIDR, SharedWalletID: 5, CreatedTime: createdTime,
+					},
+				}, nil)
+				return mock
+			},
+			wantResponse: generated.TransactionsResponse{
+				Header: generated.ResponseHeader{Success: true, Messages: []string{successMsg}},
+				Transactions: []generated.Transaction{
+					{
+						Id:             stringPtr("3d6e668f-ad02-40ff-8540-90c1528a7c88"),
+						UserId:         intPtr(456),
+						Amount:         floatPtr(50000),
+						RecipientId:    intPtr(789),
+						Type:           transactionTypePtr(generated.TransferOut),
+						Status:         &status,
+						Fee:            floatPtr(0),
+						Currency:       currencyPtr(generated.IDR),
+						SharedWalletId: intPtr(5),
+						CreatedTime:    &createdTime,
+					},
+				},
+			},
+			wantHttpStatusCode: http.StatusOK,
+		},
+		{
+			name:   "fail-not-member",
+			params: generated.GetSharedWalletTransactionsParams{},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+				mock.EXPECT().GetSharedWalletTransactions(gomock.Any(), int64(123), model.TransactionFilter{SharedWalletID: 5, Limit: adminDefaultLimit}).Return(nil, repository.ErrSharedWalletNotFound)
+				return mock
+			},
+			wantResponse: generated.TransactionsResponse{
+				Header:       generated.ResponseHeader{Messages: []string{repository.ErrSharedWalletNotFound.Error()}},
+				Transactions: []generated.Transaction{},
+			},
+			wantHttpStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			handler := &Server{
+				Usecase: test.mockUsecase(controller),
+			}
+
+			e := echo.New()
+			request := httptest.NewRequest(http.MethodGet, "/v1/user/{userID}/shared-wallets/{sharedWalletID}/transactions", nil)
+			recorder := httptest.NewRecorder()
+			ctx := e.NewContext(request, recorder)
+			ctx.Set(string(utils.JWTClaimUserID), int64(123))
+			ctx.Set(string(utils.JWTClaimPermissions), []utils.JWTPermission{utils.JWTPermissionGetUser})
+
+			gotHttpStatusCode, gotResponse := handler.getSharedWalletTransactions(ctx, 123, 5, test.params)
+
+			if gotHttpStatusCode != test.wantHttpStatusCode {
+				t.Errorf("handler.GetSharedWalletTransactions() httpStatusCode = %v, wantHttpStatusCode %v", gotHttpStatusCode, test.wantHttpStatusCode)
+			}
+
+			if !reflect.DeepEqual(test.wantResponse, gotResponse) {
+				t.Errorf("handler.GetSharedWalletTransactions() response = %v, wantResponse %v", gotResponse, test.wantResponse)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// RouteTimeouts bounds how long a request may run before its context is cancelled.
+// Routes are keyed by method and path as registered in Echo, i.e. "POST /v1/user/:user_id/transactions".
+type RouteTimeouts struct {
+	Default time.Duration
+	Routes  map[string]time.Duration
+}
+
+// ParseRouteTimeouts parses a comma separated list of route timeouts, i.e. "POST /v1/user/:user_id/transactions=10s,GET /v1/user=2s".
+func ParseRouteTimeouts(defaultTimeout time.Duration, routes string) (RouteTimeouts, error) {
+	timeouts := RouteTimeouts{
+		Default: defaultTimeout,
+		Routes:  map[string]time.Duration{},
+	}
+
+	for _, route := range strings.Split(routes, ",") {
+		route = strings.TrimSpace(route)
+		if route == "" {
+			continue
+		}
+
+		key, value, ok := strings.Cut(route, "=")
+		if !ok {
+			return RouteTimeouts{}, fmt.Errorf("invalid route timeout %q, should be METHOD /path=duration", route)
+		}
+
+		timeout, err := time.ParseDuration(strings.TrimSpace(value))
+		if err != nil || timeout <= 0 {
+			return RouteTimeouts{}, fmt.Errorf("invalid route timeout %q, should be a positive duration", route)
+		}
+
+		timeouts.Routes[strings.Join(strings.Fields(key), " ")] = timeout
+	}
+
+	return timeouts, nil
+}
+
+// Timeout returns the timeout of a route, zero means no timeout
+func (t RouteTimeouts) Timeout(method, path string) time.Duration {
+	if timeout, ok := t.Routes[method+" "+path]; ok {
+		return timeout
+	}
+	return t.Default
+}
+
+// TimeoutMiddleware sets a deadline on the request context, which cancels DB work still running when it passes.
+// Handlers respond with 504 when their request runs out of time, see errorStatusCode.
+func TimeoutMiddleware(timeouts RouteTimeouts) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			timeout := timeouts.Timeout(ctx.Request().Method, ctx.Path())
+			if timeout <= 0 {
+				return next(ctx)
+			}
+
+			requestCtx, cancel := context.WithTimeout(ctx.Request().Context(), timeout)
+			defer cancel()
+
+			ctx.SetRequest(ctx.Request().WithContext(requestCtx))
+
+			err := next(ctx)
+			if errors.Is(err, context.DeadlineExceeded) {
+				return echo.NewHTTPError(http.StatusGatewayTimeout, err.Error())
+			}
+			return err
+		}
+	}
+}
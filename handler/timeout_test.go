@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/WalletService/model"
+	"github.com/WalletService/repository"
+	"github.com/WalletService/usecase"
+	"github.com/WalletService/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+)
+
+func TestParseRouteTimeouts(t *testing.T) {
+	tests := []struct {
+		name    string
+		routes  string
+		want    map[string]time.Duration
+		wantErr bool
+	}{
+		{
+			name:   "success",
+			routes: "POST /v1/user/:user_id/transactions=10s, GET  /v1/user=500ms",
+			want: map[string]time.Duration{
+				"POST /v1/user/:user_id/transactions": 10 * time.Second,
+				"GET /v1/user":                        500 * time.Millisecond,
+			},
+		},
+		{
+			name:   "success-empty",
+			routes: "",
+			want:   map[string]time.Duration{},
+		},
+		{
+			name:    "fail-missing-duration",
+			routes:  "GET /v1/user",
+			wantErr: true,
+		},
+		{
+			name:    "fail-invalid-duration",
+			routes:  "GET /v1/user=-1s",
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseRouteTimeouts(5*time.Second, test.routes)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("ParseRouteTimeouts() err = %v, wantErr %v", err, test.wantErr)
+			}
+			if test.wantErr {
+				return
+			}
+
+			if got.Default != 5*time.Second || len(got.Routes) != len(test.want) {
+				t.Fatalf("ParseRouteTimeouts() = %+v, want %+v", got, test.want)
+			}
+			for route, timeout := range test.want {
+				if got.Timeout(http.MethodPost, "/unknown") != 5*time.Second || got.Routes[route] != timeout {
+					t.Errorf("ParseRouteTimeouts() = %+v, want %+v", got, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestTimeoutMiddleware(t *testing.T) {
+	controller := gomock.NewController(t)
+
+	// Usecase blocks until the request context passes its deadline, as a DB query would
+	mockUsecase := usecase.NewMockUsecaseInterface(controller)
+	mockUsecase.EXPECT().GetUser(gomock.Any(), int64(123)).DoAndReturn(func(ctx context.Context, userID int64) (model.User, error) {
+		<-ctx.Done()
+		return model.User{}, ctx.Err()
+	}).Times(1)
+
+	server := &Server{Usecase: mockUsecase}
+
+	e := echo.New()
+	e.Use(TimeoutMiddleware(RouteTimeouts{
+		Default: time.Minute,
+		Routes:  map[string]time.Duration{"GET /v1/user": 10 * time.Millisecond},
+	}))
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			ctx.Set(string(utils.JWTClaimUserID), int64(123))
+			ctx.Set(string(utils.JWTClaimPermissions), []utils.JWTPermission{utils.JWTPermissionGetUser})
+			return next(ctx)
+		}
+	})
+	e.GET("/v1/user", server.GetUser)
+
+	recorder := httptest.NewRecorder()
+	e.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/v1/user", nil))
+
+	if recorder.Code != http.StatusGatewayTimeout {
+		t.Errorf("TimeoutMiddleware() httpStatusCode = %v, want %v", recorder.Code, http.StatusGatewayTimeout)
+	}
+}
+
+// TestTimeoutMiddleware_QueryCancelled verifies a query cancelled by the DB when the deadline passes, rather than failing with ctx.Err(),
+// is reported as a timeout too
+func TestTimeoutMiddleware_QueryCancelled(t *testing.T) {
+	controller := gomock.NewController(t)
+
+	// lib/pq cancels the running query once ctx is done, the query then fails with query_canceled
+	mockRepository := repository.NewMockRepositoryInterface(controller)
+	mockRepository.EXPECT().GetUser(gomock.Any(), int64(123)).DoAndReturn(func(ctx context.Context, userID int64) (model.User, error) {
+		<-ctx.Done()
+		return model.User{}, &pq.Error{Code: "57014", Message: "canceling statement due to user request"}
+	}).Times(1)
+
+	server := &Server{Usecase: usecase.NewUsecase(usecase.NewUsecaseOptions{Repository: mockRepository})}
+
+	e := echo.New()
+	e.Use(TimeoutMiddleware(RouteTimeouts{
+		Default: time.Minute,
+		Routes:  map[string]time.Duration{"GET /v1/user": 10 * time.Millisecond},
+	}))
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			ctx.Set(string(utils.JWTClaimUserID), int64(123))
+			ctx.Set(string(utils.JWTClaimPermissions), []utils.JWTPermission{utils.JWTPermissionGetUser})
+			return next(ctx)
+		}
+	})
+	e.GET("/v1/user", server.GetUser)
+
+	recorder := httptest.NewRecorder()
+	e.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/v1/user", nil))
+
+	if recorder.Code != http.StatusGatewayTimeout {
+		t.Errorf("TimeoutMiddleware() httpStatusCode = %v, want %v", recorder.Code, http.StatusGatewayTimeout)
+	}
+}
@@ -0,0 +1,181 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/WalletService/generated"
+	"github.com/WalletService/model"
+	"github.com/WalletService/payment"
+	"github.com/WalletService/usecase"
+	"github.com/WalletService/utils"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	// Define function wrappers so we can inject dummy function in UT
+	fnConvertTopUpRequest          func(int64, generated.TopUpRequest) (model.TopUpIntent, []string) = convertTopUpRequest
+	fnConvertTopUpCallbackRequest  func(generated.TopUpCallback) (model.TopUpPayment, []string)      = convertTopUpCallbackRequest
+	fnConvertTopUpIntentToResponse func(model.TopUpIntent) generated.TopUpIntent                     = convertTopUpIntentToResponse
+)
+
+// CreateUserTopUp creates a top-up and returns the virtual account User pays into.
+// User's balance is credited when the payment gateway reports the payment to TopUpCallback.
+// NOTE: Check AuthenticationMiddleware cmd/main.go that authenticates the JWT token
+func (s *Server) CreateUserTopUp(ctx echo.Context, pathUserID int) error {
+	return ctx.JSON(s.createUserTopUp(ctx, int64(pathUserID)))
+}
+func (s *Server) createUserTopUp(ctx echo.Context, pathUserID int64) (int, generated.TopUpResponse) {
+	var (
+		context = ctx.Request().Context()
+
+		response = generated.TopUpResponse{
+			Header: generated.ResponseHeader{}, //success is false by default
+		}
+	)
+
+	// Authorize and get userID of the requester
+	userID, err := authorize(ctx, utils.JWTPermissionPerformTransaction)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	} else if pathUserID != userID {
+		response.Header.Messages = []string{"JWT userID mismatched with request userID"}
+		return http.StatusForbidden, response
+	}
+
+	request := generated.TopUpRequest{}
+	err = json.NewDecoder(ctx.Request().Body).Decode(&request)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusBadRequest, response
+	}
+
+	intent, errorList := fnConvertTopUpRequest(userID, request)
+	if len(errorList) > 0 {
+		response.Header.Messages = errorList
+		return http.StatusBadRequest, response
+	}
+
+	newIntent, err := s.Usecase.CreateUserTopUpIntent(context, intent)
+	if isAccountStatusError(err) {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	} else if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
+	}
+
+	response.Header.Success = true
+	response.Header.Messages = []string{successMsg}
+	response.Topup = fnConvertTopUpIntentToResponse(newIntent)
+	return http.StatusCreated, response
+}
+
+// GetUserTopUp returns a top-up of User so that clients can poll whether it has been paid.
+// NOTE: Check AuthenticationMiddleware cmd/main.go that authenticates the JWT token
+func (s *Server) GetUserTopUp(ctx echo.Context, pathUserID int, pathTopUpID string) error {
+	return ctx.JSON(s.getUserTopUp(ctx, int64(pathUserID), pathTopUpID))
+}
+func (s *Server) getUserTopUp(ctx echo.Context, pathUserID int64, pathTopUpID string) (int, generated.TopUpResponse) {
+	var (
+		context = ctx.Request().Context()
+
+		response = generated.TopUpResponse{
+			Header: generated.ResponseHeader{}, //success is false by default
+		}
+	)
+
+	// Authorize and get userID of the requester
+	userID, err := authorize(ctx, utils.JWTPermissionGetUser)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	} else if pathUserID != userID {
+		response.Header.Messages = []string{"JWT userID mismatched with request userID"}
+		return http.StatusForbidden, response
+	}
+
+	topUpID, err := uuid.Parse(pathTopUpID)
+	if err != nil {
+		response.Header.Messages = []string{"topup_id should be a UUID"}
+		return http.StatusBadRequest, response
+	}
+
+	intent, err := s.Usecase.GetUserTopUpIntent(context, userID, topUpID)
+	if errors.Is(err, usecase.ErrTopUpNotFound) {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusNotFound, response
+	} else if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
+	}
+
+	response.Header.Success = true
+	response.Header.Messages = []string{successMsg}
+	response.Topup = fnConvertTopUpIntentToResponse(intent)
+	return http.StatusOK, response
+}
+
+// TopUpCallback is called by the payment gateway when a top-up virtual account is paid.
+// The gateway signs the raw request body with the shared secret, see payment.Sign.
+func (s *Server) TopUpCallback(ctx echo.Context, params generated.TopUpCallbackParams) error {
+	return ctx.JSON(s.topUpCallback(ctx, params))
+}
+func (s *Server) topUpCallback(ctx echo.Context, params generated.TopUpCallbackParams) (int, generated.CallbackResponse) {
+	var (
+		context = ctx.Request().Context()
+
+		response = generated.CallbackResponse{
+			Header: generated.ResponseHeader{}, //success is false by default
+		}
+	)
+
+	// Signature is computed over the exact bytes sent, so verify before decoding
+	body, err := io.ReadAll(ctx.Request().Body)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusBadRequest, response
+	}
+
+	if !payment.Verify(s.PaymentCallbackSecret, body, params.XSignature) {
+		response.Header.Messages = []string{"invalid callback signature"}
+		return http.StatusUnauthorized, response
+	}
+
+	request := generated.TopUpCallback{}
+	err = json.Unmarshal(body, &request)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusBadRequest, response
+	}
+
+	topUpPayment, errorList := fnConvertTopUpCallbackRequest(request)
+	if len(errorList) > 0 {
+		response.Header.Messages = errorList
+		return http.StatusBadRequest, response
+	}
+
+	// Gateway retries the callback on non-2xx responses, a payment that is already credited is acknowledged as is
+	_, err = s.Usecase.CompleteTopUp(context, topUpPayment)
+	switch {
+	case err == nil:
+	case errors.Is(err, usecase.ErrTopUpNotFound):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusNotFound, response
+	case errors.Is(err, usecase.ErrTopUpAmountMismatch), errors.Is(err, usecase.ErrTopUpExpired), errors.Is(err, usecase.ErrTopUpAlreadyPaid),
+		errors.Is(err, usecase.ErrRecipientCannotReceive):
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusUnprocessableEntity, response
+	default:
+		response.Header.Messages = []string{err.Error()}
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
+	}
+
+	response.Header.Success = true
+	response.Header.Messages = []string{successMsg}
+	return http.StatusOK, response
+}
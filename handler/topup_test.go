@@ -0,0 +1,270 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/WalletService/generated"
+	"github.com/WalletService/model"
+	"github.com/WalletService/payment"
+	"github.com/WalletService/usecase"
+	"github.com/WalletService/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+)
+
+func TestCreateUserTopUp(t *testing.T) {
+	expiryTime := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name               string
+		ctxPermissions     []utils.JWTPermission
+		requestBody        generated.TopUpRequest
+		mockUsecase        func(controller *gomock.Controller) *usecase.MockUsecaseInterface
+		wantResponse       generated.TopUpResponse
+		wantHttpStatusCode int
+	}{
+		{
+			name:           "success",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionPerformTransaction},
+			requestBody: generated.TopUpRequest{
+				Amount: 100000,
+			},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+
+				mock.EXPECT().CreateUserTopUpIntent(gomock.Any(), model.TopUpIntent{
+					UserID: 123,
+					Amount: 100000,
+				}).Return(model.TopUpIntent{
+					ID:                   convertToUUID("9b1f4a4e-0c43-4f5e-9d6f-1d2b8f1f6c11"),
+					UserID:               123,
+					Amount:               100000,
+					BankCode:             "BCA",
+					VirtualAccountNumber: "8808000000001234",
+					Status:               model.TopUpIntentStatusPending,
+					ExpiryTime:           expiryTime,
+				}, nil)
+
+				return mock
+			},
+			wantResponse: generated.TopUpResponse{
+				Header: generated.ResponseHeader{
+					Success:  true,
+					Messages: []string{successMsg},
+				},
+				Topup: generated.TopUpIntent{
+					Id:                   stringPtr("9b1f4a4e-0c43-4f5e-9d6f-1d2b8f1f6c11"),
+					Amount:               floatPtr(100000),
+					BankCode:             stringPtr("BCA"),
+					VirtualAccountNumber: stringPtr("8808000000001234"),
+					Status: func() *generated.TopUpIntentStatus {
+						status := generated.Pending
+						return &status
+					}(),
+					ExpiryTime: &expiryTime,
+				},
+			},
+			wantHttpStatusCode: http.StatusCreated,
+		},
+		{
+			name:           "fail-not-authorized-permission",
+			ctxPermissions: []utils.JWTPermission{utils.JWTPermissionGetUser},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				return usecase.NewMockUsecaseInterface(controller)
+			},
+			wantResponse: generated.TopUpResponse{
+				Header: generated.ResponseHeader{
+					Success:  false,
+					Messages: []string{"not authorized: missing required permission"},
+				},
+			},
+			wantHttpStatusCode: http.StatusForbidden,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			handler := &Server{
+				Usecase: test.mockUsecase(controller),
+			}
+
+			requestBodyJSON, _ := json.Marshal(test.requestBody)
+
+			e := echo.New()
+			request := httptest.NewRequest(http.MethodPost, "/v1/user/{userID}/topups", bytes.NewBuffer(requestBodyJSON))
+			recorder := httptest.NewRecorder()
+			ctx := e.NewContext(request, recorder)
+			ctx.Set(string(utils.JWTClaimUserID), int64(123))
+			ctx.Set(string(utils.JWTClaimPermissions), test.ctxPermissions)
+
+			gotHttpStatusCode, gotResponse := handler.createUserTopUp(ctx, 123)
+
+			if gotHttpStatusCode != test.wantHttpStatusCode {
+				t.Errorf("handler.CreateUserTopUp() httpStatusCode = %v, wantHttpStatusCode %v", gotHttpStatusCode, test.wantHttpStatusCode)
+			}
+
+			if !reflect.DeepEqual(test.wantResponse, gotResponse) {
+				t.Errorf("handler.CreateUserTopUp() response = %v, wantResponse %v", gotResponse, test.wantResponse)
+			}
+		})
+	}
+}
+
+func TestTopUpCallback(t *testing.T) {
+	var (
+		secret = []byte("secret")
+		body   = []byte(`{"virtual_account_number":"8808000000001234","amount":100000,"payment_reference":"PAY-1"}`)
+	)
+
+	tests := []struct {
+		name               string
+		body               []byte
+		signature          string
+		mockUsecase        func(controller *gomock.Controller) *usecase.MockUsecaseInterface
+		wantResponse       generated.CallbackResponse
+		wantHttpStatusCode int
+	}{
+		{
+			name:      "success",
+			body:      body,
+			signature: payment.Sign(secret, body),
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+
+				mock.EXPECT().CompleteTopUp(gomock.Any(), model.TopUpPayment{
+					VirtualAccountNumber: "8808000000001234",
+					Amount:               100000,
+					PaymentReference:     "PAY-1",
+				}).Return(model.Transaction{ID: convertToUUID("3d6e668f-ad02-40ff-8540-90c1528a7c88")}, nil)
+
+				return mock
+			},
+			wantResponse: generated.CallbackResponse{
+				Header: generated.ResponseHeader{
+					Success:  true,
+					Messages: []string{successMsg},
+				},
+			},
+			wantHttpStatusCode: http.StatusOK,
+		},
+		{
+			name:      "fail-invalid-signature",
+			body:      []byte(`{"virtual_account_number":"8808000000001234","amount":900000,"payment_reference":"PAY-1"}`),
+			signature: payment.Sign(secret, body),
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				return usecase.NewMockUsecaseInterface(controller)
+			},
+			wantResponse: generated.CallbackResponse{
+				Header: generated.ResponseHeader{
+					Success:  false,
+					Messages: []string{"invalid callback signature"},
+				},
+			},
+			wantHttpStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:      "fail-invalid-request",
+			body:      []byte(`{"amount":100000}`),
+			signature: payment.Sign(secret, []byte(`{"amount":100000}`)),
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				return usecase.NewMockUsecaseInterface(controller)
+			},
+			wantResponse: generated.CallbackResponse{
+				Header: generated.ResponseHeader{
+					Success:  false,
+					Messages: []string{"virtual_account_number is required", "payment_reference is required"},
+				},
+			},
+			wantHttpStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:      "fail-amount-mismatch",
+			body:      body,
+			signature: payment.Sign(secret, body),
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+
+				mock.EXPECT().CompleteTopUp(gomock.Any(), gomock.Any()).Return(model.Transaction{}, usecase.ErrTopUpAmountMismatch)
+
+				return mock
+			},
+			wantResponse: generated.CallbackResponse{
+				Header: generated.ResponseHeader{
+					Success:  false,
+					Messages: []string{usecase.ErrTopUpAmountMismatch.Error()},
+				},
+			},
+			wantHttpStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:      "fail-user-cannot-receive",
+			body:      body,
+			signature: payment.Sign(secret, body),
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+
+				mock.EXPECT().CompleteTopUp(gomock.Any(), gomock.Any()).Return(model.Transaction{}, usecase.ErrRecipientCannotReceive)
+
+				return mock
+			},
+			wantResponse: generated.CallbackResponse{
+				Header: generated.ResponseHeader{
+					Success:  false,
+					Messages: []string{usecase.ErrRecipientCannotReceive.Error()},
+				},
+			},
+			wantHttpStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:      "fail-unknown-virtual-account",
+			body:      body,
+			signature: payment.Sign(secret, body),
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+
+				mock.EXPECT().CompleteTopUp(gomock.Any(), gomock.Any()).Return(model.Transaction{}, usecase.ErrTopUpNotFound)
+
+				return mock
+			},
+			wantResponse: generated.CallbackResponse{
+				Header: generated.ResponseHeader{
+					Success:  false,
+					Messages: []string{usecase.ErrTopUpNotFound.Error()},
+				},
+			},
+			wantHttpStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			handler := &Server{
+				Usecase:               test.mockUsecase(controller),
+				PaymentCallbackSecret: secret,
+			}
+
+			e := echo.New()
+			request := httptest.NewRequest(http.MethodPost, "/v1/topups/callback", bytes.NewBuffer(test.body))
+			recorder := httptest.NewRecorder()
+			ctx := e.NewContext(request, recorder)
+
+			gotHttpStatusCode, gotResponse := handler.topUpCallback(ctx, generated.TopUpCallbackParams{XSignature: test.signature})
+
+			if gotHttpStatusCode != test.wantHttpStatusCode {
+				t.Errorf("handler.TopUpCallback() httpStatusCode = %v, wantHttpStatusCode %v", gotHttpStatusCode, test.wantHttpStatusCode)
+			}
+
+			if !reflect.DeepEqual(test.wantResponse, gotResponse) {
+				t.Errorf("handler.TopUpCallback() response = %v, wantResponse %v", gotResponse, test.wantResponse)
+			}
+		})
+	}
+}
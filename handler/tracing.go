@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/WalletService/logging"
+	"github.com/WalletService/tracing"
+	"github.com/labstack/echo/v4"
+)
+
+// TracingMiddleware starts the server span of every request, continuing the trace of the traceparent header if any.
+// Spans started from the request context, i.e. by usecase and repository, are its children.
+// It should be registered with Pre after RequestLogMiddleware, so its lines also carry the trace ID.
+func TracingMiddleware(tracer *tracing.Tracer, operationIDs map[string]string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			request := ctx.Request()
+
+			requestCtx := tracing.Extract(request.Context(), request.Header)
+			requestCtx, span := tracer.Start(requestCtx, request.Method, tracing.SpanKindServer,
+				tracing.String("http.request.method", request.Method),
+			)
+
+			traceID := span.SpanContext().TraceID.String()
+			requestCtx = logging.NewContext(requestCtx, logging.FromContext(requestCtx).With("trace_id", traceID))
+			ctx.SetRequest(request.WithContext(requestCtx))
+
+			err := next(ctx)
+
+			// Route is only known once Echo routed the request, after Pre middleware
+			status := responseStatus(ctx, err)
+			name := request.Method + " " + ctx.Path()
+			if operation, ok := operationIDs[name]; ok {
+				name = operation
+			}
+			span.SetName(name)
+			span.SetAttributes(
+				tracing.String("http.route", ctx.Path()),
+				tracing.Int64("http.response.status_code", int64(status)),
+			)
+
+			var spanErr error
+			if status >= http.StatusInternalServerError {
+				spanErr = fmt.Errorf("%d %s", status, http.StatusText(status))
+			}
+			span.End(spanErr)
+
+			return err
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/WalletService/tracing"
+	"github.com/labstack/echo/v4"
+)
+
+func TestTracingMiddleware(t *testing.T) {
+	const traceparent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+	tests := []struct {
+		name        string
+		traceparent string
+		status      int
+
+		wantParent string // empty means root span
+		wantStatus tracing.StatusCode
+	}{
+		{
+			name:        "continues-incoming-trace",
+			traceparent: traceparent,
+			status:      http.StatusOK,
+			wantParent:  "00f067aa0ba902b7",
+			wantStatus:  tracing.StatusUnset,
+		},
+		{
+			name:       "new-trace",
+			status:     http.StatusOK,
+			wantStatus: tracing.StatusUnset,
+		},
+		{
+			name:       "server-error",
+			status:     http.StatusInternalServerError,
+			wantStatus: tracing.StatusError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			exporter := tracing.NewInMemoryExporter()
+			tracer := tracing.NewTracer(exporter)
+
+			e := echo.New()
+			e.Pre(TracingMiddleware(tracer, map[string]string{"GET /v1/user": "GetUser"}))
+			e.GET("/v1/user", func(ctx echo.Context) error {
+				_, span := tracer.Start(ctx.Request().Context(), "Usecase.GetUser", tracing.SpanKindInternal)
+				span.End(nil)
+				return ctx.NoContent(test.status)
+			})
+
+			request := httptest.NewRequest(http.MethodGet, "/v1/user", nil)
+			if test.traceparent != "" {
+				request.Header.Set(tracing.TraceparentHeader, test.traceparent)
+			}
+			e.ServeHTTP(httptest.NewRecorder(), request)
+
+			spans := exporter.Spans()
+			if len(spans) != 2 {
+				t.Fatalf("exported %d spans, want 2", len(spans))
+			}
+			child, server := spans[0], spans[1]
+
+			if server.Name != "GetUser" || server.Kind != tracing.SpanKindServer {
+				t.Errorf("server span = %v %v, want GetUser server", server.Name, server.Kind)
+			}
+			if server.StatusCode != test.wantStatus {
+				t.Errorf("server span status = %v, want %v", server.StatusCode, test.wantStatus)
+			}
+			if gotParent := parentOf(server); gotParent != test.wantParent {
+				t.Errorf("server span parent = %q, want %q", gotParent, test.wantParent)
+			}
+			if test.traceparent != "" && server.SpanContext.TraceID.String() != "4bf92f3577b34da6a3ce929d0e0e4736" {
+				t.Errorf("server span trace = %v, want the incoming trace", server.SpanContext.TraceID)
+			}
+			if child.ParentSpanID != server.SpanContext.SpanID {
+				t.Errorf("usecase span parent = %v, want server span %v", child.ParentSpanID, server.SpanContext.SpanID)
+			}
+		})
+	}
+}
+
+func parentOf(span tracing.SpanData) string {
+	if !span.ParentSpanID.IsValid() {
+		return ""
+	}
+	return span.ParentSpanID.String()
+}
@@ -1,21 +1,31 @@
 package handler
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/WalletService/generated"
+	"github.com/WalletService/logging"
 	"github.com/WalletService/model"
+	"github.com/WalletService/phone"
+	"github.com/WalletService/usecase"
 	"github.com/WalletService/utils"
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 var (
 	// Define function wrappers so we can inject dummy function in UT
-	fnValidatePhoneNumber func(*string) (string, []string) = validatePhoneNumber
-	fnValidatePassword    func(*string) (string, []string) = validatePassword
-	fnValidateFullName    func(*string) (string, []string) = validateFullName
+	fnValidatePhoneNumber func(*string, utils.ValidationLimits) (string, []string) = validatePhoneNumber
+	fnValidatePassword    func(*string, utils.ValidationLimits) (string, []string) = validatePassword
+	fnValidateFullName    func(*string) (string, []string)                         = validateFullName
 )
 
 const (
@@ -23,6 +33,23 @@ const (
 	duplicatePhoneNumberErrorMsg = "phone number is already registered to an existing user"
 )
 
+// errorStatusCode returns 504 if the request ran out of time, otherwise the status code of the error.
+// A query cancelled by the deadline fails with the error of the DB, i.e. pq's "canceling statement due to user request", so the deadline
+// of ctx is checked too. The error is logged with the request, as clients only see its message.
+func errorStatusCode(ctx context.Context, err error, statusCode int) int {
+	if errors.Is(err, context.DeadlineExceeded) || ctx.Err() == context.DeadlineExceeded {
+		statusCode = http.StatusGatewayTimeout
+	}
+
+	level := slog.LevelInfo
+	if statusCode >= http.StatusInternalServerError {
+		level = slog.LevelError
+	}
+	logging.FromContext(ctx).LogAttrs(ctx, level, "request failed", slog.Int("status", statusCode), slog.Any("error", err))
+
+	return statusCode
+}
+
 func authorize(ctx echo.Context, requiredPermission utils.JWTPermission) (userID int64, err error) {
 	permissions, _ := ctx.Get(string(utils.JWTClaimPermissions)).([]utils.JWTPermission)
 
@@ -45,37 +72,33 @@ func authorize(ctx echo.Context, requiredPermission utils.JWTPermission) (userID
 	return userID, nil
 }
 
-func validatePhoneNumber(input *string) (validPhoneNumber string, errorList []string) {
-	phoneNumber := ""
-
-	if input != nil {
-		phoneNumber = strings.TrimSpace(*input)
-	}
-
-	// Verify "+62" prefix
-	if !strings.HasPrefix(phoneNumber, "+62") {
-		errorList = append(errorList, "phone_number should start with +62")
-	}
+// isAccountStatusError reports whether a Transaction was refused because the account of the User or Recipient is frozen or closed
+func isAccountStatusError(err error) bool {
+	return errors.Is(err, usecase.ErrUserFrozen) || errors.Is(err, usecase.ErrUserClosed) || errors.Is(err, usecase.ErrRecipientCannotReceive)
+}
 
-	// Check the length of the phone number
-	if len(phoneNumber) < 10 || len(phoneNumber) > 14 {
-		errorList = append(errorList, "phone_number should be 10 to 14 digits (+62 inclusive)")
+// validatePhoneNumber normalizes the phone number into E.164, so the same User is found however the number is typed, i.e. "0812-3456-789"
+func validatePhoneNumber(input *string, limits utils.ValidationLimits) (validPhoneNumber string, errorList []string) {
+	if input == nil {
+		return "", []string{"phone_number is required"}
 	}
 
-	// Check if all remaining characters are digits
-	for i := 3; i < len(phoneNumber); i++ {
-		c := phoneNumber[i]
-		if !unicode.IsDigit(rune(c)) {
-			errorList = append(errorList, "phone_number should only contain numbers")
-			break
-		}
+	phoneNumber, err := limits.PhoneNumberParser().Normalize(*input)
+	switch {
+	case errors.Is(err, phone.ErrInvalidCharacters):
+		return "", []string{"phone_number should only contain numbers, spaces, dashes and a leading +"}
+	case errors.Is(err, phone.ErrCountryNotSupported):
+		return "", []string{"phone_number country is not supported"}
+	case err != nil:
+		return "", []string{"phone_number is not a valid mobile number"}
 	}
 
-	if len(errorList) == 0 {
-		validPhoneNumber = phoneNumber
+	// Check the length of the normalized phone number
+	if len(phoneNumber) < limits.PhoneNumberMinLength || len(phoneNumber) > limits.PhoneNumberMaxLength {
+		return "", []string{fmt.Sprintf("phone_number should be %d to %d characters in E.164 format (+ inclusive)", limits.PhoneNumberMinLength, limits.PhoneNumberMaxLength)}
 	}
 
-	return validPhoneNumber, errorList
+	return phoneNumber, nil
 }
 
 func validateFullName(input *string) (validFullName string, errorList []string) {
@@ -96,15 +119,15 @@ func validateFullName(input *string) (validFullName string, errorList []string)
 	return validFullName, errorList
 }
 
-func validatePassword(input *string) (validPassword string, errorList []string) {
+func validatePassword(input *string, limits utils.ValidationLimits) (validPassword string, errorList []string) {
 	password := ""
 
 	if input != nil {
 		password = *input
 	}
 
-	if len(password) < 6 || len(password) > 64 {
-		errorList = append(errorList, "password should be 6 to 64 characters")
+	if len(password) < limits.PasswordMinLength || len(password) > limits.PasswordMaxLength {
+		errorList = append(errorList, fmt.Sprintf("password should be %d to %d characters", limits.PasswordMinLength, limits.PasswordMaxLength))
 	}
 
 	containsCapital, containsNumber, containsSpecialAlphaNumeric := false, false, false
@@ -141,10 +164,10 @@ func validatePassword(input *string) (validPassword string, errorList []string)
 	return validPassword, errorList
 }
 
-func convertRegisterUserRequestToUser(request generated.User) (user model.User, errorMsgs []string) {
-	validPhoneNumber, phoneNumberErrorMsgs := fnValidatePhoneNumber(request.PhoneNumber)
+func convertRegisterUserRequestToUser(request generated.User, limits utils.ValidationLimits) (user model.User, errorMsgs []string) {
+	validPhoneNumber, phoneNumberErrorMsgs := fnValidatePhoneNumber(request.PhoneNumber, limits)
 	validFullName, fullNameErrorMsgs := fnValidateFullName(request.FullName)
-	validPassword, passwordErrorMsgs := fnValidatePassword(request.Password)
+	validPassword, passwordErrorMsgs := fnValidatePassword(request.Password, limits)
 
 	errorList := append(phoneNumberErrorMsgs, fullNameErrorMsgs...)
 	errorList = append(errorList, passwordErrorMsgs...)
@@ -160,27 +183,77 @@ func convertRegisterUserRequestToUser(request generated.User) (user model.User,
 	}, nil
 }
 
-func convertCreateTransactionRequestToTransaction(userID int64, request generated.Transaction) (transaction model.Transaction, errorMsgs []string) {
-	if request.Amount == nil || *request.Amount <= 0 {
-		errorMsgs = append(errorMsgs, "amount should be > 0")
-		return model.Transaction{}, errorMsgs
+// convertUpdateUserRequest validates the profile fields to update, empty fields are unchanged.
+// The phone number is the login of User, so changing it requires the current password.
+func convertUpdateUserRequest(request generated.UpdateUserRequest, limits utils.ValidationLimits) (fullName, phoneNumber, password string, errorMsgs []string) {
+	if request.Balance != nil || request.Balances != nil || request.Status != nil {
+		return "", "", "", []string{"only full_name and phone_number can be updated"}
+	}
+	if request.FullName == nil && request.PhoneNumber == nil {
+		return "", "", "", []string{"full_name or phone_number is required"}
+	}
+	if request.PhoneNumber == nil && request.Password != nil {
+		return "", "", "", []string{"password is only required to update phone_number"}
 	}
 
-	if request.Type == nil {
-		errorMsgs = append(errorMsgs, "type is required")
-		return model.Transaction{}, errorMsgs
+	if request.FullName != nil {
+		var fullNameErrorMsgs []string
+		fullName, fullNameErrorMsgs = fnValidateFullName(request.FullName)
+		errorMsgs = append(errorMsgs, fullNameErrorMsgs...)
+	}
+	if request.PhoneNumber != nil {
+		var phoneNumberErrorMsgs []string
+		phoneNumber, phoneNumberErrorMsgs = fnValidatePhoneNumber(request.PhoneNumber, limits)
+		errorMsgs = append(errorMsgs, phoneNumberErrorMsgs...)
+
+		if request.Password == nil || *request.Password == "" {
+			errorMsgs = append(errorMsgs, "password is required to update phone_number")
+		} else {
+			password = *request.Password
+		}
+	}
+
+	if len(errorMsgs) > 0 {
+		return "", "", "", errorMsgs
 	}
+	return fullName, phoneNumber, password, nil
+}
 
+func convertCreateTransactionRequestToTransaction(userID int64, request generated.TransactionRequest, limits utils.ValidationLimits) (transaction model.Transaction, errorMsgs []string) {
+	// Required fields, type enum and amount > 0 are validated against api.yml by OpenAPIValidationMiddleware
 	transaction = model.Transaction{
 		UserID: userID,
-		Amount: *request.Amount,
-		Type:   model.TransactionType(*request.Type),
+		Amount: request.Amount,
+		Type:   model.TransactionType(request.Type),
+	}
+
+	// Recipient is requested by only one of its ID, its phone number or the token of a lookup
+	if (request.RecipientId != nil && (request.RecipientPhoneNumber != nil || request.RecipientToken != nil)) ||
+		(request.RecipientPhoneNumber != nil && request.RecipientToken != nil) {
+		return model.Transaction{}, []string{"only one of recipient_id, recipient_phone_number and recipient_token can be set"}
 	}
 
 	if request.RecipientId != nil {
 		transaction.RecipientID = *request.RecipientId
 	}
 
+	if request.RecipientPhoneNumber != nil {
+		phoneNumber, errorList := validatePhoneNumber(request.RecipientPhoneNumber, limits)
+		// Messages of validatePhoneNumber are about phone_number
+		if len(errorList) > 0 {
+			return model.Transaction{}, []string{"recipient_" + errorList[0]}
+		}
+		transaction.RecipientPhoneNumber = phoneNumber
+	}
+
+	if request.RecipientToken != nil {
+		recipientToken, err := uuid.Parse(*request.RecipientToken)
+		if err != nil {
+			return model.Transaction{}, []string{"recipient_token should be a UUID"}
+		}
+		transaction.RecipientToken = recipientToken
+	}
+
 	if request.Description != nil {
 		transaction.Description = *request.Description
 	}
@@ -189,5 +262,595 @@ func convertCreateTransactionRequestToTransaction(userID int64, request generate
 		transaction.Password = *request.Password
 	}
 
+	if request.BankAccountId != nil {
+		transaction.BankAccountID = *request.BankAccountId
+	}
+
+	if request.SourcePocketId != nil {
+		transaction.SourcePocketID = *request.SourcePocketId
+	}
+
+	if request.PocketId != nil {
+		transaction.PocketID = *request.PocketId
+	}
+
+	if request.SharedWalletId != nil {
+		transaction.SharedWalletID = *request.SharedWalletId
+	}
+
+	if request.Currency != nil {
+		transaction.Currency = model.Currency(*request.Currency)
+	}
+
+	if request.FxQuoteId != nil {
+		fxQuoteID, err := uuid.Parse(*request.FxQuoteId)
+		if err != nil {
+			errorMsgs = append(errorMsgs, "fx_quote_id should be a UUID")
+			return model.Transaction{}, errorMsgs
+		}
+		transaction.FXQuoteID = fxQuoteID
+	}
+
 	return transaction, nil
 }
+
+// convertTransactionToResponse only returns the Transaction identifiers, the rest of the data is already known by the requester.
+func convertTransactionToResponse(transaction model.Transaction) (response generated.Transaction) {
+	if transaction.ID != uuid.Nil {
+		id := transaction.ID.String()
+		response.Id = &id
+	}
+
+	// Fee is only known after the Transaction is performed
+	if transaction.Status != "" {
+		status := generated.TransactionStatus(transaction.Status)
+		response.Status = &status
+		response.Fee = &transaction.Fee
+	}
+
+	// Converted amount is only known after the Transaction is performed
+	if transaction.ConvertedCurrency != "" {
+		convertedCurrency := generated.Currency(transaction.ConvertedCurrency)
+		response.ConvertedCurrency = &convertedCurrency
+		response.ConvertedAmount = &transaction.ConvertedAmount
+	}
+
+	return response
+}
+
+func convertBalancesToResponse(balances []model.Balance) *[]generated.Balance {
+	if len(balances) == 0 {
+		return nil
+	}
+
+	response := make([]generated.Balance, 0, len(balances))
+	for i := range balances {
+		balance := balances[i]
+		currency := generated.Currency(balance.Currency)
+		response = append(response, generated.Balance{
+			Currency: &currency,
+			Amount:   &balance.Amount,
+		})
+	}
+
+	return &response
+}
+
+func convertFeePreviewRequest(userID int64, request generated.FeePreviewRequest) (transaction model.Transaction, errorMsgs []string) {
+	// Required fields and amount > 0 are validated against api.yml by OpenAPIValidationMiddleware
+	transaction.UserID = userID
+	transaction.Amount = request.Amount
+
+	switch transactionType := model.TransactionType(request.Type); transactionType {
+	case model.TransactionTypeTransferOut, model.TransactionTypePayment, model.TransactionTypeWithdrawal:
+		transaction.Type = transactionType
+	default:
+		errorMsgs = append(errorMsgs, "type should be TransferOut, Payment or Withdrawal")
+		return model.Transaction{}, errorMsgs
+	}
+
+	transaction.Currency = model.CurrencyIDR
+	if request.Currency != nil {
+		transaction.Currency = model.Currency(*request.Currency)
+	}
+
+	return transaction, nil
+}
+
+func convertFeePreviewToResponse(transaction model.Transaction) generated.FeePreview {
+	var (
+		transactionType = string(transaction.Type)
+		currency        = generated.Currency(transaction.Currency)
+		totalAmount     = transaction.Amount + transaction.Fee
+	)
+
+	return generated.FeePreview{
+		Type:        &transactionType,
+		Currency:    &currency,
+		Amount:      &transaction.Amount,
+		Fee:         &transaction.Fee,
+		TotalAmount: &totalAmount,
+	}
+}
+
+func convertFXQuoteRequest(userID int64, request generated.FXQuoteRequest) (quoteRequest model.FXQuoteRequest, errorMsgs []string) {
+	// Required fields and source_amount > 0 are validated against api.yml by OpenAPIValidationMiddleware
+	return model.FXQuoteRequest{
+		UserID:         userID,
+		SourceCurrency: model.Currency(request.SourceCurrency),
+		TargetCurrency: model.Currency(request.TargetCurrency),
+		SourceAmount:   request.SourceAmount,
+	}, nil
+}
+
+func convertFXQuoteToResponse(quote model.FXQuote) generated.FXQuote {
+	var (
+		id             = quote.ID.String()
+		sourceCurrency = generated.Currency(quote.SourceCurrency)
+		targetCurrency = generated.Currency(quote.TargetCurrency)
+	)
+
+	return generated.FXQuote{
+		Id:             &id,
+		SourceCurrency: &sourceCurrency,
+		TargetCurrency: &targetCurrency,
+		SourceAmount:   &quote.SourceAmount,
+		Fee:            &quote.Fee,
+		Rate:           &quote.Rate,
+		TargetAmount:   &quote.TargetAmount,
+		ExpiryTime:     &quote.ExpiryTime,
+	}
+}
+
+// convertRecipientLookupToResponse returns the masked name of the Recipient, its ID and phone number are not disclosed
+func convertRecipientLookupToResponse(lookup model.RecipientLookup) generated.Recipient {
+	token := lookup.ID.String()
+
+	return generated.Recipient{
+		Name:       &lookup.RecipientName,
+		Token:      &token,
+		ExpiryTime: &lookup.ExpiryTime,
+	}
+}
+
+func validateNickname(input *string) (validNickname string, errorList []string) {
+	nickname := ""
+
+	if input != nil {
+		nickname = strings.TrimSpace(*input)
+	}
+
+	if nickname == "" || utf8.RuneCountInString(nickname) > 50 {
+		errorList = append(errorList, "nickname should be 1 to 50 characters")
+	}
+
+	if len(errorList) == 0 {
+		validNickname = nickname
+	}
+
+	return validNickname, errorList
+}
+
+func convertContactRequest(userID int64, request generated.ContactRequest, limits utils.ValidationLimits) (contactRequest model.ContactRequest, errorMsgs []string) {
+	nickname, errorMsgs := validateNickname(&request.Nickname)
+	if len(errorMsgs) > 0 {
+		return model.ContactRequest{}, errorMsgs
+	}
+
+	// Recipient is requested like the Recipient of a TransferOut, see convertCreateTransactionRequestToTransaction
+	transaction, errorMsgs := convertCreateTransactionRequestToTransaction(userID, generated.TransactionRequest{
+		RecipientId:          request.RecipientId,
+		RecipientPhoneNumber: request.RecipientPhoneNumber,
+		RecipientToken:       request.RecipientToken,
+	}, limits)
+	if len(errorMsgs) > 0 {
+		return model.ContactRequest{}, errorMsgs
+	}
+	if transaction.RecipientID == 0 && transaction.RecipientPhoneNumber == "" && transaction.RecipientToken == uuid.Nil {
+		return model.ContactRequest{}, []string{"one of recipient_id, recipient_phone_number and recipient_token is required"}
+	}
+
+	return model.ContactRequest{
+		UserID:               userID,
+		RecipientID:          transaction.RecipientID,
+		RecipientPhoneNumber: transaction.RecipientPhoneNumber,
+		RecipientToken:       transaction.RecipientToken,
+		Nickname:             nickname,
+	}, nil
+}
+
+// convertContactToResponse only tells whether the Recipient is closed, the other statuses of the Recipient are not disclosed
+func convertContactToResponse(contact model.Contact) generated.Contact {
+	closed := contact.RecipientStatus == model.UserStatusClosed
+
+	return generated.Contact{
+		Id:              &contact.ID,
+		RecipientId:     &contact.RecipientID,
+		Nickname:        &contact.Nickname,
+		RecipientName:   &contact.RecipientName,
+		RecipientClosed: &closed,
+		CreatedTime:     &contact.CreatedTime,
+	}
+}
+
+func convertContactSuggestionToResponse(suggestion model.ContactSuggestion) generated.ContactSuggestion {
+	return generated.ContactSuggestion{
+		RecipientId:      &suggestion.RecipientID,
+		RecipientName:    &suggestion.RecipientName,
+		TransferCount:    &suggestion.TransferCount,
+		LastTransferTime: &suggestion.LastTransferTime,
+	}
+}
+
+func convertPocketRequest(userID int64, request generated.PocketRequest) (pocket model.Pocket, errorMsgs []string) {
+	// Target amount >= 0 is validated against api.yml by OpenAPIValidationMiddleware
+	name := strings.TrimSpace(request.Name)
+	if name == "" || utf8.RuneCountInString(name) > 50 {
+		return model.Pocket{}, []string{"name should be 1 to 50 characters"}
+	}
+
+	pocket = model.Pocket{
+		UserID: userID,
+		Name:   name,
+	}
+
+	if request.TargetAmount != nil {
+		pocket.TargetAmount = *request.TargetAmount
+	}
+
+	if request.TargetDate != nil {
+		pocket.TargetDate = &request.TargetDate.Time
+	}
+
+	return pocket, nil
+}
+
+func convertPocketToResponse(pocket model.Pocket) generated.Pocket {
+	response := generated.Pocket{
+		Id:          &pocket.ID,
+		Name:        &pocket.Name,
+		Balance:     &pocket.Balance,
+		CreatedTime: &pocket.CreatedTime,
+	}
+
+	if pocket.TargetAmount != 0 {
+		response.TargetAmount = &pocket.TargetAmount
+	}
+	if pocket.TargetDate != nil {
+		response.TargetDate = &openapi_types.Date{Time: *pocket.TargetDate}
+	}
+
+	return response
+}
+
+func convertPocketsToResponse(pockets []model.Pocket) *[]generated.Pocket {
+	if len(pockets) == 0 {
+		return nil
+	}
+
+	response := make([]generated.Pocket, 0, len(pockets))
+	for _, pocket := range pockets {
+		response = append(response, convertPocketToResponse(pocket))
+	}
+
+	return &response
+}
+
+func convertSharedWalletRequest(userID int64, request generated.SharedWalletRequest) (wallet model.SharedWallet, errorMsgs []string) {
+	name := strings.TrimSpace(request.Name)
+	if name == "" || utf8.RuneCountInString(name) > 50 {
+		return model.SharedWallet{}, []string{"name should be 1 to 50 characters"}
+	}
+
+	return model.SharedWallet{
+		OwnerID: userID,
+		Name:    name,
+	}, nil
+}
+
+func convertSharedWalletMemberRequest(userID, sharedWalletID int64, request generated.SharedWalletMemberRequest, limits utils.ValidationLimits) (memberRequest model.SharedWalletMemberRequest, errorMsgs []string) {
+	member, errorMsgs := convertUpdateSharedWalletMemberRequest(sharedWalletID, 0, generated.UpdateSharedWalletMemberRequest{
+		Role:             request.Role,
+		TransactionLimit: request.TransactionLimit,
+		MonthlyLimit:     request.MonthlyLimit,
+	})
+	if len(errorMsgs) > 0 {
+		return model.SharedWalletMemberRequest{}, errorMsgs
+	}
+
+	memberRequest = model.SharedWalletMemberRequest{
+		SharedWalletID:   sharedWalletID,
+		OwnerID:          userID,
+		Role:             member.Role,
+		TransactionLimit: member.TransactionLimit,
+		MonthlyLimit:     member.MonthlyLimit,
+	}
+
+	// Member is requested by only one of its phone number or the token of a lookup, like the Recipient of a TransferOut
+	switch {
+	case request.PhoneNumber != nil && request.Token != nil:
+		return model.SharedWalletMemberRequest{}, []string{"only one of phone_number and token can be set"}
+	case request.PhoneNumber != nil:
+		memberRequest.PhoneNumber, errorMsgs = validatePhoneNumber(request.PhoneNumber, limits)
+		if len(errorMsgs) > 0 {
+			return model.SharedWalletMemberRequest{}, errorMsgs
+		}
+	case request.Token != nil:
+		token, err := uuid.Parse(*request.Token)
+		if err != nil {
+			return model.SharedWalletMemberRequest{}, []string{"token should be a UUID"}
+		}
+		memberRequest.Token = token
+	default:
+		return model.SharedWalletMemberRequest{}, []string{"one of phone_number and token is required"}
+	}
+
+	return memberRequest, nil
+}
+
+func convertUpdateSharedWalletMemberRequest(sharedWalletID, memberID int64, request generated.UpdateSharedWalletMemberRequest) (member model.SharedWalletMember, errorMsgs []string) {
+	// Role enum and limits >= 0 are validated against api.yml by OpenAPIValidationMiddleware, a SharedWallet only has one owner
+	role := model.SharedWalletRole(request.Role)
+	if role != model.SharedWalletRoleSpender && role != model.SharedWalletRoleViewer {
+		return model.SharedWalletMember{}, []string{"role should be Spender or Viewer"}
+	}
+
+	member = model.SharedWalletMember{
+		SharedWalletID: sharedWalletID,
+		UserID:         memberID,
+		Role:           role,
+	}
+
+	if request.TransactionLimit != nil {
+		member.TransactionLimit = *request.TransactionLimit
+	}
+
+	if request.MonthlyLimit != nil {
+		member.MonthlyLimit = *request.MonthlyLimit
+	}
+
+	return member, nil
+}
+
+// convertSharedWalletToResponse returns the SharedWallet with the role and limits of the User it is retrieved for
+func convertSharedWalletToResponse(wallet model.SharedWallet) generated.SharedWallet {
+	role := generated.SharedWalletRole(wallet.Member.Role)
+
+	response := generated.SharedWallet{
+		Id:           &wallet.ID,
+		OwnerId:      &wallet.OwnerID,
+		Name:         &wallet.Name,
+		Balance:      &wallet.Balance,
+		Role:         &role,
+		MonthlySpent: &wallet.Member.MonthlySpent,
+		CreatedTime:  &wallet.CreatedTime,
+	}
+
+	if wallet.Member.TransactionLimit != 0 {
+		response.TransactionLimit = &wallet.Member.TransactionLimit
+	}
+	if wallet.Member.MonthlyLimit != 0 {
+		response.MonthlyLimit = &wallet.Member.MonthlyLimit
+	}
+
+	return response
+}
+
+// convertSharedWalletMemberToResponse only tells whether the member is closed, the other statuses of the member are not disclosed
+func convertSharedWalletMemberToResponse(member model.SharedWalletMember) generated.SharedWalletMember {
+	role := generated.SharedWalletRole(member.Role)
+	closed := member.Status == model.UserStatusClosed
+
+	response := generated.SharedWalletMember{
+		UserId:       &member.UserID,
+		Name:         &member.FullName,
+		Role:         &role,
+		MonthlySpent: &member.MonthlySpent,
+		Closed:       &closed,
+		CreatedTime:  &member.CreatedTime,
+	}
+
+	if member.TransactionLimit != 0 {
+		response.TransactionLimit = &member.TransactionLimit
+	}
+	if member.MonthlyLimit != 0 {
+		response.MonthlyLimit = &member.MonthlyLimit
+	}
+
+	return response
+}
+
+func convertRegisterBankAccountRequest(userID int64, request generated.BankAccount) (bankAccount model.BankAccount, errorMsgs []string) {
+	bankCode := ""
+	if request.BankCode != nil {
+		bankCode = strings.ToUpper(strings.TrimSpace(*request.BankCode))
+	}
+	if len(bankCode) < 3 || len(bankCode) > 11 || strings.IndexFunc(bankCode, func(c rune) bool { return !unicode.IsLetter(c) && !unicode.IsDigit(c) }) >= 0 {
+		errorMsgs = append(errorMsgs, "bank_code should be 3 to 11 alphanumeric characters")
+	}
+
+	accountNumber := ""
+	if request.AccountNumber != nil {
+		accountNumber = strings.TrimSpace(*request.AccountNumber)
+	}
+	if len(accountNumber) < 5 || len(accountNumber) > 20 || strings.IndexFunc(accountNumber, func(c rune) bool { return !unicode.IsDigit(c) }) >= 0 {
+		errorMsgs = append(errorMsgs, "account_number should be 5 to 20 digits")
+	}
+
+	accountName, accountNameErrorMsgs := fnValidateFullName(request.AccountName)
+	if len(accountNameErrorMsgs) > 0 {
+		errorMsgs = append(errorMsgs, "account_name should be 3 to 60 characters")
+	}
+
+	if len(errorMsgs) > 0 {
+		return model.BankAccount{}, errorMsgs
+	}
+
+	return model.BankAccount{
+		UserID:        userID,
+		BankCode:      bankCode,
+		AccountNumber: accountNumber,
+		AccountName:   accountName,
+	}, nil
+}
+
+func convertDisbursementCallbackRequest(request generated.DisbursementCallback) (result model.DisbursementResult, errorMsgs []string) {
+	// Required fields are validated against api.yml by OpenAPIValidationMiddleware
+	transactionID, err := uuid.Parse(request.TransactionId)
+	if err != nil {
+		errorMsgs = append(errorMsgs, "transaction_id should be a UUID")
+		return model.DisbursementResult{}, errorMsgs
+	}
+	result.TransactionID = transactionID
+	result.Status = model.DisbursementStatus(request.Status)
+
+	if request.Reference != nil {
+		result.Reference = *request.Reference
+	}
+
+	if request.FailureReason != nil {
+		result.FailureReason = *request.FailureReason
+	}
+
+	return result, nil
+}
+
+func convertQRRequest(userID int64, request generated.QRRequest) (qrRequest model.QRRequest, errorMsgs []string) {
+	qrRequest = model.QRRequest{
+		UserID: userID,
+	}
+
+	if request.Amount != nil {
+		qrRequest.Amount = *request.Amount
+	}
+
+	if request.MerchantName != nil {
+		merchantName, errorList := fnValidateFullName(request.MerchantName)
+		if len(errorList) > 0 {
+			errorMsgs = append(errorMsgs, "merchant_name should be 3 to 60 characters")
+		}
+		qrRequest.MerchantName = merchantName
+	}
+
+	if request.MerchantCategoryCode != nil {
+		merchantCategoryCode := strings.TrimSpace(*request.MerchantCategoryCode)
+		if len(merchantCategoryCode) != 4 || strings.IndexFunc(merchantCategoryCode, func(c rune) bool { return !unicode.IsDigit(c) }) >= 0 {
+			errorMsgs = append(errorMsgs, "merchant_category_code should be 4 digits")
+		}
+		qrRequest.MerchantCategoryCode = merchantCategoryCode
+	}
+
+	if request.Description != nil {
+		qrRequest.Description = *request.Description
+	}
+
+	if len(errorMsgs) > 0 {
+		return model.QRRequest{}, errorMsgs
+	}
+
+	return qrRequest, nil
+}
+
+func convertQRPaymentRequest(userID int64, request generated.QRPaymentRequest) (payment model.QRPayment, errorMsgs []string) {
+	if strings.TrimSpace(request.Payload) == "" {
+		errorMsgs = append(errorMsgs, "payload is required")
+		return model.QRPayment{}, errorMsgs
+	}
+
+	payment = model.QRPayment{
+		UserID:  userID,
+		Payload: strings.TrimSpace(request.Payload),
+	}
+
+	if request.Amount != nil {
+		payment.Amount = *request.Amount
+	}
+
+	if request.Description != nil {
+		payment.Description = *request.Description
+	}
+
+	if request.Password != nil {
+		payment.Password = *request.Password
+	}
+
+	return payment, nil
+}
+
+func convertTopUpRequest(userID int64, request generated.TopUpRequest) (intent model.TopUpIntent, errorMsgs []string) {
+	// amount > 0 is validated against api.yml by OpenAPIValidationMiddleware
+	return model.TopUpIntent{
+		UserID: userID,
+		Amount: request.Amount,
+	}, nil
+}
+
+func convertTopUpIntentToResponse(intent model.TopUpIntent) (response generated.TopUpIntent) {
+	id := intent.ID.String()
+	status := generated.TopUpIntentStatus(intent.Status)
+
+	response = generated.TopUpIntent{
+		Id:                   &id,
+		Amount:               &intent.Amount,
+		BankCode:             &intent.BankCode,
+		VirtualAccountNumber: &intent.VirtualAccountNumber,
+		Status:               &status,
+		ExpiryTime:           &intent.ExpiryTime,
+	}
+
+	if intent.TransactionID != uuid.Nil {
+		transactionID := intent.TransactionID.String()
+		response.TransactionId = &transactionID
+	}
+
+	return response
+}
+
+func convertTopUpCallbackRequest(request generated.TopUpCallback) (topUpPayment model.TopUpPayment, errorMsgs []string) {
+	// amount > 0 is validated against api.yml by OpenAPIValidationMiddleware
+	if strings.TrimSpace(request.VirtualAccountNumber) == "" {
+		errorMsgs = append(errorMsgs, "virtual_account_number is required")
+	} else {
+		topUpPayment.VirtualAccountNumber = strings.TrimSpace(request.VirtualAccountNumber)
+	}
+
+	topUpPayment.Amount = request.Amount
+
+	if strings.TrimSpace(request.PaymentReference) == "" {
+		errorMsgs = append(errorMsgs, "payment_reference is required")
+	} else {
+		topUpPayment.PaymentReference = strings.TrimSpace(request.PaymentReference)
+	}
+
+	if len(errorMsgs) > 0 {
+		return model.TopUpPayment{}, errorMsgs
+	}
+
+	if request.PaidTime != nil {
+		topUpPayment.PaidTime = *request.PaidTime
+	}
+
+	return topUpPayment, nil
+}
+
+// convertUserStatusToResponse returns Active for Users created before account status
+func convertUserToResponse(user model.User) generated.User {
+	return generated.User{
+		Id:          &user.ID,
+		FullName:    &user.FullName,
+		PhoneNumber: &user.PhoneNumber,
+		Balance:     &user.Balance,
+		Balances:    convertBalancesToResponse(user.Balances),
+		Pockets:     convertPocketsToResponse(user.Pockets),
+		Status:      convertUserStatusToResponse(user.Status),
+	}
+}
+
+func convertUserStatusToResponse(status model.UserStatus) *generated.UserStatus {
+	if status == "" {
+		status = model.UserStatusActive
+	}
+
+	response := generated.UserStatus(status)
+	return &response
+}
@@ -5,10 +5,12 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/WalletService/generated"
 	"github.com/WalletService/model"
+	"github.com/WalletService/phone"
 	"github.com/WalletService/utils"
 	"github.com/labstack/echo/v4"
 )
@@ -18,13 +20,18 @@ func Test_validatePhoneNumber(t *testing.T) {
 		return &in
 	}
 
+	singapore, err := phone.NewParser("ID", []string{"SG"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	tests := []struct {
-		name  string
-		input *string
+		name   string
+		input  *string
+		limits utils.ValidationLimits
 
 		wantValidPhoneNumber string
 		wantErrorList        []string
-		wantError            bool
 	}{
 		{
 			name:                 "success",
@@ -33,70 +40,74 @@ func Test_validatePhoneNumber(t *testing.T) {
 			wantErrorList:        nil,
 		},
 		{
-			name:                 "nil",
-			input:                nil,
-			wantValidPhoneNumber: "",
-			wantErrorList: []string{
-				"phone_number should start with +62",
-				"phone_number should be 10 to 13 digits",
-			},
+			name:                 "success-local",
+			input:                stringPtr(" 0812-3456-789 "),
+			wantValidPhoneNumber: "+628123456789",
+		},
+		{
+			name:                 "success-calling-code-without-plus",
+			input:                stringPtr("62 812 3456 7890"),
+			wantValidPhoneNumber: "+6281234567890",
 		},
 		{
-			name:                 "empty",
-			input:                stringPtr(""),
-			wantValidPhoneNumber: "",
+			name:                 "success-allowed-country",
+			input:                stringPtr("+65 9123 4567"),
+			limits:               utils.ValidationLimits{PhoneNumberMinLength: 10, PhoneNumberMaxLength: 16, PhoneNumbers: singapore},
+			wantValidPhoneNumber: "+6591234567",
+		},
+		{
+			name:  "nil",
+			input: nil,
 			wantErrorList: []string{
-				"phone_number should start with +62",
-				"phone_number should be 10 to 13 digits",
+				"phone_number is required",
 			},
 		},
 		{
-			name:                 "fail-rule-1-length-min",
-			input:                stringPtr("+62812345  "),
-			wantValidPhoneNumber: "",
+			name:  "empty",
+			input: stringPtr(""),
 			wantErrorList: []string{
-				"phone_number should be 10 to 13 digits",
+				"phone_number is not a valid mobile number",
 			},
 		},
 		{
-			name:                 "fail-rule-1-length-max",
-			input:                stringPtr("  +6281234567890"),
-			wantValidPhoneNumber: "",
+			name:  "fail-too-short",
+			input: stringPtr("+62812345  "),
 			wantErrorList: []string{
-				"phone_number should be 10 to 13 digits",
+				"phone_number is not a valid mobile number",
 			},
 		},
 		{
-			name:                 "fail-rule-1-non-numbers",
-			input:                stringPtr("+628123456a"),
-			wantValidPhoneNumber: "",
+			name:  "fail-non-numbers",
+			input: stringPtr("+628123456a"),
 			wantErrorList: []string{
-				"phone_number should only contain numbers",
+				"phone_number should only contain numbers, spaces, dashes and a leading +",
 			},
 		},
 		{
-			name:                 "fail-rule-2",
-			input:                stringPtr("08123456789"),
-			wantValidPhoneNumber: "",
+			name:  "fail-country-not-allowed",
+			input: stringPtr("+65 9123 4567"),
 			wantErrorList: []string{
-				"phone_number should start with +62",
+				"phone_number country is not supported",
 			},
 		},
 		{
-			name:                 "fail-all-rules",
-			input:                stringPtr("0345abc"),
-			wantValidPhoneNumber: "",
+			name:   "fail-configured-length",
+			input:  stringPtr("+6281234567890"),
+			limits: utils.ValidationLimits{PhoneNumberMinLength: 10, PhoneNumberMaxLength: 13},
 			wantErrorList: []string{
-				"phone_number should start with +62",
-				"phone_number should be 10 to 13 digits",
-				"phone_number should only contain numbers",
+				"phone_number should be 10 to 13 characters in E.164 format (+ inclusive)",
 			},
 		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			gotValidPhoneNumber, gotErrorList := validatePhoneNumber(test.input)
+			limits := test.limits
+			if limits == (utils.ValidationLimits{}) {
+				limits = utils.DefaultValidationLimits
+			}
+
+			gotValidPhoneNumber, gotErrorList := validatePhoneNumber(test.input, limits)
 			if !reflect.DeepEqual(gotValidPhoneNumber, test.wantValidPhoneNumber) {
 				t.Errorf("util.validatePhoneNumber() gotValidPhoneNumber = %v, wantValidPhoneNumber %v", gotValidPhoneNumber, test.wantValidPhoneNumber)
 			}
@@ -172,8 +183,9 @@ func Test_validatePassword(t *testing.T) {
 	}
 
 	tests := []struct {
-		name  string
-		input *string
+		name   string
+		input  *string
+		limits *utils.ValidationLimits
 
 		wantValidPassword string
 		wantErrorList     []string
@@ -195,11 +207,28 @@ func Test_validatePassword(t *testing.T) {
 				"password should contain a special alphanumeric character",
 			},
 		},
+		{
+			name:  "fail-configured-length",
+			input: stringPtr("A1.123"),
+			limits: &utils.ValidationLimits{
+				PasswordMinLength: 8,
+				PasswordMaxLength: 32,
+			},
+			wantValidPassword: "",
+			wantErrorList: []string{
+				"password should be 8 to 32 characters",
+			},
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			gotValidPassword, gotErrorList := validatePassword(test.input)
+			limits := utils.DefaultValidationLimits
+			if test.limits != nil {
+				limits = *test.limits
+			}
+
+			gotValidPassword, gotErrorList := validatePassword(test.input, limits)
 			if !reflect.DeepEqual(gotValidPassword, test.wantValidPassword) {
 				t.Errorf("util.validatePassword() gotValidPassword = %v, wantValidPassword %v", gotValidPassword, test.wantValidPassword)
 			}
@@ -219,8 +248,8 @@ func Test_convertRegisterUserRequestToUser(t *testing.T) {
 	tests := []struct {
 		name                  string
 		input                 generated.User
-		fnValidatePhoneNumber func(*string) (string, []string)
-		fnValidatePassword    func(*string) (string, []string)
+		fnValidatePhoneNumber func(*string, utils.ValidationLimits) (string, []string)
+		fnValidatePassword    func(*string, utils.ValidationLimits) (string, []string)
 		fnValidateFullName    func(*string) (string, []string)
 
 		wantUser      model.User
@@ -233,13 +262,13 @@ func Test_convertRegisterUserRequestToUser(t *testing.T) {
 				PhoneNumber: stringPtr("+628123456789"),
 				Password:    stringPtr("P455w0rd!."),
 			},
-			fnValidatePhoneNumber: func(*string) (string, []string) {
+			fnValidatePhoneNumber: func(*string, utils.ValidationLimits) (string, []string) {
 				return "+628123456789", []string{}
 			},
 			fnValidateFullName: func(*string) (string, []string) {
 				return "User", []string{}
 			},
-			fnValidatePassword: func(*string) (string, []string) {
+			fnValidatePassword: func(*string, utils.ValidationLimits) (string, []string) {
 				return "P455w0rd!.", []string{}
 			},
 			wantUser: model.User{
@@ -256,13 +285,13 @@ func Test_convertRegisterUserRequestToUser(t *testing.T) {
 				PhoneNumber: stringPtr("+628123456789"),
 				Password:    stringPtr("P455w0rd!."),
 			},
-			fnValidatePhoneNumber: func(*string) (string, []string) {
+			fnValidatePhoneNumber: func(*string, utils.ValidationLimits) (string, []string) {
 				return "", []string{"invalid-phone-number-1", "invalid-phone-number-2", "invalid-phone-number-3"}
 			},
 			fnValidateFullName: func(*string) (string, []string) {
 				return "", []string{"invalid-full-name-1", "invalid-full-name-2"}
 			},
-			fnValidatePassword: func(*string) (string, []string) {
+			fnValidatePassword: func(*string, utils.ValidationLimits) (string, []string) {
 				return "", []string{"invalid-password"}
 			},
 			wantUser: model.User{},
@@ -280,7 +309,7 @@ func Test_convertRegisterUserRequestToUser(t *testing.T) {
 			fnValidatePassword = test.fnValidatePassword
 			fnValidatePhoneNumber = test.fnValidatePhoneNumber
 
-			gotUser, gotErrorMsgs := convertRegisterUserRequestToUser(test.input)
+			gotUser, gotErrorMsgs := convertRegisterUserRequestToUser(test.input, utils.DefaultValidationLimits)
 			if !reflect.DeepEqual(gotUser, test.wantUser) {
 				t.Errorf("util.convertRegisterUserRequestToUser() gotUser = %v, wantUser %v", gotUser, test.wantUser)
 			}
@@ -300,7 +329,7 @@ func Test_convertCreateTransactionRequestToTransaction(t *testing.T) {
 	tests := []struct {
 		name        string
 		inputUserID int64
-		input       generated.Transaction
+		input       generated.TransactionRequest
 
 		wantTransaction model.Transaction
 		wantErrorMsgs   []string
@@ -308,10 +337,10 @@ func Test_convertCreateTransactionRequestToTransaction(t *testing.T) {
 		{
 			name:        "success",
 			inputUserID: 123,
-			input: generated.Transaction{
-				Amount:      floatPtr(100000),
+			input: generated.TransactionRequest{
+				Amount:      100000,
 				RecipientId: intPtr(2),
-				Type:        transactionTypePtr(generated.TransferOut),
+				Type:        generated.TransferOut,
 				Description: stringPtr("Traktir Makan"),
 				Password:    stringPtr("Admin1234!"),
 			},
@@ -328,9 +357,9 @@ func Test_convertCreateTransactionRequestToTransaction(t *testing.T) {
 		{
 			name:        "success-nil-inputs",
 			inputUserID: 123,
-			input: generated.Transaction{
-				Amount:      floatPtr(100000),
-				Type:        transactionTypePtr(generated.TopUp),
+			input: generated.TransactionRequest{
+				Amount:      100000,
+				Type:        generated.TopUp,
 				RecipientId: nil,
 				Description: nil,
 				Password:    nil,
@@ -346,35 +375,107 @@ func Test_convertCreateTransactionRequestToTransaction(t *testing.T) {
 			wantErrorMsgs: nil,
 		},
 		{
-			name:        "invalid-amount-zero",
+			name:        "success-convert",
 			inputUserID: 123,
-			input: generated.Transaction{
-				Amount:      floatPtr(0),
-				RecipientId: intPtr(2),
-				Type:        transactionTypePtr(generated.TransferOut),
-				Description: stringPtr("Traktir Makan"),
-				Password:    stringPtr("Admin1234!"),
+			input: generated.TransactionRequest{
+				Amount:    10,
+				Type:      generated.Convert,
+				Currency:  currencyPtr(generated.USD),
+				FxQuoteId: stringPtr("5a0d7d2e-3c3f-4a59-8f0e-8f6b0f3f2a10"),
+				Password:  stringPtr("Admin1234!"),
+			},
+			wantTransaction: model.Transaction{
+				UserID:    123,
+				Amount:    10,
+				Type:      model.TransactionTypeConvert,
+				Currency:  model.CurrencyUSD,
+				FXQuoteID: convertToUUID("5a0d7d2e-3c3f-4a59-8f0e-8f6b0f3f2a10"),
+				Password:  "Admin1234!",
+			},
+			wantErrorMsgs: nil,
+		},
+		{
+			name:        "invalid-fx-quote-id",
+			inputUserID: 123,
+			input: generated.TransactionRequest{
+				Amount:    10,
+				Type:      generated.Convert,
+				FxQuoteId: stringPtr("quote"),
 			},
 			wantTransaction: model.Transaction{},
-			wantErrorMsgs:   []string{"amount should be > 0"},
+			wantErrorMsgs:   []string{"fx_quote_id should be a UUID"},
 		},
 		{
-			name:        "invalid-amount-nil",
+			name:        "success-recipient-phone-number",
 			inputUserID: 123,
-			input: generated.Transaction{
-				RecipientId: intPtr(2),
-				Type:        transactionTypePtr(generated.TransferOut),
-				Description: stringPtr("Traktir Makan"),
-				Password:    stringPtr("Admin1234!"),
+			input: generated.TransactionRequest{
+				Amount:               100000,
+				Type:                 generated.TransferOut,
+				RecipientPhoneNumber: stringPtr("0812-3456-789"),
+				Password:             stringPtr("Admin1234!"),
+			},
+			wantTransaction: model.Transaction{
+				UserID:               123,
+				Amount:               100000,
+				Type:                 model.TransactionTypeTransferOut,
+				RecipientPhoneNumber: "+628123456789",
+				Password:             "Admin1234!",
+			},
+		},
+		{
+			name:        "success-recipient-token",
+			inputUserID: 123,
+			input: generated.TransactionRequest{
+				Amount:         100000,
+				Type:           generated.TransferOut,
+				RecipientToken: stringPtr("5a0d7d2e-3c3f-4a59-8f0e-8f6b0f3f2a10"),
+			},
+			wantTransaction: model.Transaction{
+				UserID:         123,
+				Amount:         100000,
+				Type:           model.TransactionTypeTransferOut,
+				RecipientToken: convertToUUID("5a0d7d2e-3c3f-4a59-8f0e-8f6b0f3f2a10"),
+			},
+		},
+		{
+			name:        "invalid-multiple-recipients",
+			inputUserID: 123,
+			input: generated.TransactionRequest{
+				Amount:               100000,
+				Type:                 generated.TransferOut,
+				RecipientId:          intPtr(2),
+				RecipientPhoneNumber: stringPtr("08123456789"),
+			},
+			wantTransaction: model.Transaction{},
+			wantErrorMsgs:   []string{"only one of recipient_id, recipient_phone_number and recipient_token can be set"},
+		},
+		{
+			name:        "invalid-recipient-phone-number",
+			inputUserID: 123,
+			input: generated.TransactionRequest{
+				Amount:               100000,
+				Type:                 generated.TransferOut,
+				RecipientPhoneNumber: stringPtr("021 5551234"),
 			},
 			wantTransaction: model.Transaction{},
-			wantErrorMsgs:   []string{"amount should be > 0"},
+			wantErrorMsgs:   []string{"recipient_phone_number is not a valid mobile number"},
+		},
+		{
+			name:        "invalid-recipient-token",
+			inputUserID: 123,
+			input: generated.TransactionRequest{
+				Amount:         100000,
+				Type:           generated.TransferOut,
+				RecipientToken: stringPtr("token"),
+			},
+			wantTransaction: model.Transaction{},
+			wantErrorMsgs:   []string{"recipient_token should be a UUID"},
 		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			gotTransaction, gotErrorMsgs := convertCreateTransactionRequestToTransaction(test.inputUserID, test.input)
+			gotTransaction, gotErrorMsgs := convertCreateTransactionRequestToTransaction(test.inputUserID, test.input, utils.DefaultValidationLimits)
 			if !reflect.DeepEqual(gotTransaction, test.wantTransaction) {
 				t.Errorf("util.convertCreateTransactionRequestToTransaction() gotTransaction = %v, wanwantTransactiontUser %v", gotTransaction, test.wantTransaction)
 			}
@@ -386,6 +487,59 @@ func Test_convertCreateTransactionRequestToTransaction(t *testing.T) {
 	}
 }
 
+func Test_convertContactRequest(t *testing.T) {
+	stringPtr := func(in string) *string {
+		return &in
+	}
+
+	tests := []struct {
+		name  string
+		input generated.ContactRequest
+
+		wantRequest   model.ContactRequest
+		wantErrorMsgs []string
+	}{
+		{
+			name:        "success-recipient-id",
+			input:       generated.ContactRequest{Nickname: " Mom ", RecipientId: intPtr(2)},
+			wantRequest: model.ContactRequest{UserID: 123, RecipientID: 2, Nickname: "Mom"},
+		},
+		{
+			name:        "success-recipient-phone-number",
+			input:       generated.ContactRequest{Nickname: "Mom", RecipientPhoneNumber: stringPtr("0898-7654-321")},
+			wantRequest: model.ContactRequest{UserID: 123, RecipientPhoneNumber: "+628987654321", Nickname: "Mom"},
+		},
+		{
+			name:          "invalid-nickname-too-long",
+			input:         generated.ContactRequest{Nickname: strings.Repeat("Ä", 51), RecipientId: intPtr(2)},
+			wantErrorMsgs: []string{"nickname should be 1 to 50 characters"},
+		},
+		{
+			name:          "invalid-no-recipient",
+			input:         generated.ContactRequest{Nickname: "Mom"},
+			wantErrorMsgs: []string{"one of recipient_id, recipient_phone_number and recipient_token is required"},
+		},
+		{
+			name:          "invalid-multiple-recipients",
+			input:         generated.ContactRequest{Nickname: "Mom", RecipientId: intPtr(2), RecipientToken: stringPtr("token")},
+			wantErrorMsgs: []string{"only one of recipient_id, recipient_phone_number and recipient_token can be set"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gotRequest, gotErrorMsgs := convertContactRequest(123, test.input, utils.DefaultValidationLimits)
+			if !reflect.DeepEqual(gotRequest, test.wantRequest) {
+				t.Errorf("util.convertContactRequest() gotRequest = %v, wantRequest %v", gotRequest, test.wantRequest)
+			}
+
+			if !reflect.DeepEqual(gotErrorMsgs, test.wantErrorMsgs) {
+				t.Errorf("util.convertContactRequest() gotErrorMsgs = %v, wantErrorMsgs %v", gotErrorMsgs, test.wantErrorMsgs)
+			}
+		})
+	}
+}
+
 func Test_authorize(t *testing.T) {
 	tests := []struct {
 		name               string
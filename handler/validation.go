@@ -0,0 +1,234 @@
+package handler
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/WalletService/generated"
+	"github.com/WalletService/logging"
+	"github.com/WalletService/utils"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/labstack/echo/v4"
+)
+
+// validationErrorResponse has the ResponseHeader every response of api.yml starts with
+type validationErrorResponse struct {
+	Header generated.ResponseHeader `json:"header"`
+}
+
+// OpenAPIValidationMiddleware validates requests against api.yml and rejects invalid ones with 400, listing every violation in ResponseHeader.Messages.
+// User length constraints in api.yml are replaced by limits, so they follow the validation config.
+// If validateResponses is set, responses are validated too and violations are logged without altering the response, it is meant for development.
+// Routes not in api.yml, i.e. /metrics, are not validated. It should be registered after AuthenticatedMiddleware, JWT is not checked again.
+func OpenAPIValidationMiddleware(limits utils.ValidationLimits, validateResponses bool) (echo.MiddlewareFunc, error) {
+	swagger, err := generated.GetSwagger()
+	if err != nil {
+		return nil, err
+	}
+	if err := applyValidationLimits(swagger, limits); err != nil {
+		return nil, err
+	}
+	flattenAllOf(swagger)
+
+	// Routes are keyed by method and path as registered in Echo, the same as OperationIDs
+	routes := map[string]*routers.Route{}
+	for path, pathItem := range swagger.Paths {
+		echoPath := pathParamRegex.ReplaceAllString(path, ":$1")
+		for method, operation := range pathItem.Operations() {
+			routes[method+" "+echoPath] = &routers.Route{
+				Spec:      swagger,
+				Path:      path,
+				PathItem:  pathItem,
+				Method:    method,
+				Operation: operation,
+			}
+		}
+	}
+
+	options := &openapi3filter.Options{
+		MultiError:         true,
+		AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			route, ok := routes[ctx.Request().Method+" "+ctx.Path()]
+			if !ok {
+				return next(ctx)
+			}
+
+			pathParams := map[string]string{}
+			for i, name := range ctx.ParamNames() {
+				pathParams[name] = ctx.ParamValues()[i]
+			}
+
+			input := &openapi3filter.RequestValidationInput{
+				Request:    ctx.Request(),
+				PathParams: pathParams,
+				Route:      route,
+				Options:    options,
+			}
+			if err := openapi3filter.ValidateRequest(ctx.Request().Context(), input); err != nil {
+				return ctx.JSON(http.StatusBadRequest, validationErrorResponse{
+					Header: generated.ResponseHeader{
+						Messages: validationErrorMessages(err, ""),
+					},
+				})
+			}
+
+			if !validateResponses {
+				return next(ctx)
+			}
+
+			recorder := &responseRecorder{ResponseWriter: ctx.Response().Writer}
+			ctx.Response().Writer = recorder
+			defer func() { ctx.Response().Writer = recorder.ResponseWriter }()
+
+			if err := next(ctx); err != nil || !ctx.Response().Committed {
+				// Errors are rendered later by the HTTPErrorHandler, there is no response to validate yet
+				return err
+			}
+
+			err := openapi3filter.ValidateResponse(ctx.Request().Context(), &openapi3filter.ResponseValidationInput{
+				RequestValidationInput: input,
+				Status:                 ctx.Response().Status,
+				Header:                 ctx.Response().Header(),
+				Body:                   io.NopCloser(&recorder.body),
+				Options:                options,
+			})
+			if err != nil {
+				logging.FromContext(ctx.Request().Context()).Error("response does not match api.yml",
+					slog.String("operation_id", route.Operation.OperationID),
+					slog.Int("status", ctx.Response().Status),
+					slog.Any("violations", validationErrorMessages(err, "")),
+				)
+			}
+			return nil
+		}
+	}, nil
+}
+
+// applyValidationLimits replaces the length constraints of User's password in api.yml.
+// The limits of phone_number apply once normalized into E.164 by validatePhoneNumber, as spaces and dashes are accepted.
+func applyValidationLimits(swagger *openapi3.T, limits utils.ValidationLimits) error {
+	user, ok := swagger.Components.Schemas["User"]
+	if !ok || user.Value == nil {
+		return fmt.Errorf("api.yml has no User schema")
+	}
+
+	for name, bounds := range map[string][2]int{
+		"password": {limits.PasswordMinLength, limits.PasswordMaxLength},
+	} {
+		property, ok := user.Value.Properties[name]
+		if !ok || property.Value == nil {
+			return fmt.Errorf("api.yml has no User.%s property", name)
+		}
+
+		maxLength := uint64(bounds[1])
+		property.Value.MinLength = uint64(bounds[0])
+		property.Value.MaxLength = &maxLength
+	}
+
+	return nil
+}
+
+// flattenAllOf merges the object schemas of every allOf in api.yml, i.e. TransactionRequest, into one schema.
+// openapi3 stops at the first schema of an allOf that fails, so the violations of the others would not be reported.
+func flattenAllOf(swagger *openapi3.T) {
+	for _, schemaRef := range swagger.Components.Schemas {
+		schema := schemaRef.Value
+		if schema == nil || len(schema.AllOf) == 0 {
+			continue
+		}
+
+		merged := openapi3.NewObjectSchema()
+		merged.Description = schema.Description
+		for _, item := range schema.AllOf {
+			for name, property := range item.Value.Properties {
+				merged.Properties[name] = property
+			}
+			merged.Required = append(merged.Required, item.Value.Required...)
+		}
+
+		// Replaced in place, so request bodies referencing the schema see the merged one
+		*schema = *merged
+	}
+}
+
+// validationErrorMessages flattens the errors of openapi3filter into one message per violation, i.e. "amount: number must be more than 0".
+// prefix is the name of the parameter or body field the error belongs to.
+func validationErrorMessages(err error, prefix string) (messages []string) {
+	switch err := err.(type) {
+	case openapi3.MultiError:
+		for _, e := range err {
+			messages = append(messages, validationErrorMessages(e, prefix)...)
+		}
+		return messages
+
+	case *openapi3filter.RequestError:
+		if err.Parameter != nil {
+			prefix = err.Parameter.Name
+		}
+		if err.Err == nil {
+			return []string{joinPrefix(prefix, err.Reason)}
+		}
+		if err.Err == openapi3filter.ErrInvalidRequired {
+			if prefix == "" {
+				prefix = "request body"
+			}
+			return []string{prefix + " is required"}
+		}
+		return validationErrorMessages(err.Err, prefix)
+
+	case *openapi3filter.ResponseError:
+		if err.Err == nil {
+			return []string{joinPrefix(prefix, err.Reason)}
+		}
+		return validationErrorMessages(err.Err, prefix)
+
+	case *openapi3.SchemaError:
+		if origin, ok := err.Origin.(openapi3.MultiError); ok {
+			return validationErrorMessages(origin, prefix)
+		}
+
+		path := strings.Join(err.JSONPointer(), ".")
+		if prefix != "" && path != "" {
+			path = prefix + "." + path
+		} else if path == "" {
+			path = prefix
+		}
+
+		if err.SchemaField == "required" {
+			return []string{path + " is required"}
+		}
+		return []string{joinPrefix(path, err.Reason)}
+
+	default:
+		return []string{joinPrefix(prefix, err.Error())}
+	}
+}
+
+// joinPrefix prefixes message with the name of the field it is about
+func joinPrefix(prefix, message string) string {
+	if prefix == "" {
+		return message
+	}
+	return prefix + ": " + message
+}
+
+// responseRecorder keeps a copy of the response body for ValidateResponse
+type responseRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
@@ -0,0 +1,211 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/WalletService/generated"
+	"github.com/WalletService/logging"
+	"github.com/WalletService/utils"
+	"github.com/labstack/echo/v4"
+)
+
+func TestOpenAPIValidationMiddleware(t *testing.T) {
+	tests := []struct {
+		name        string
+		limits      utils.ValidationLimits
+		method      string
+		route       string
+		requestPath string
+		requestBody string
+
+		wantHttpStatusCode int
+		wantMessages       []string
+		wantNextCalled     bool
+	}{
+		{
+			name:               "success",
+			limits:             utils.DefaultValidationLimits,
+			method:             http.MethodPost,
+			route:              "/v1/user/:user_id/transactions",
+			requestPath:        "/v1/user/123/transactions",
+			requestBody:        `{"type":"TransferOut","amount":100000,"recipient_id":2,"password":"Admin1234!"}`,
+			wantHttpStatusCode: http.StatusOK,
+			wantNextCalled:     true,
+		},
+		{
+			name:               "fail-aggregated-messages",
+			limits:             utils.DefaultValidationLimits,
+			method:             http.MethodPost,
+			route:              "/v1/user/:user_id/transactions",
+			requestPath:        "/v1/user/123/transactions",
+			requestBody:        `{"type":"Gift","recipient_id":"2"}`,
+			wantHttpStatusCode: http.StatusBadRequest,
+			wantMessages:       []string{"recipient_id: value must be an integer", `type: value is not one of the allowed values ["TransferOut","TopUp","Payment","Withdrawal","Convert","AdjustmentCredit","AdjustmentDebit","PocketTransfer","SharedWalletDeposit"]`, "amount is required"},
+		},
+		{
+			name:               "fail-missing-body",
+			limits:             utils.DefaultValidationLimits,
+			method:             http.MethodPost,
+			route:              "/v1/user/:user_id/transactions",
+			requestPath:        "/v1/user/123/transactions",
+			wantHttpStatusCode: http.StatusBadRequest,
+			wantMessages:       []string{"request body is required"},
+		},
+		{
+			name:               "fail-amount-minimum",
+			limits:             utils.DefaultValidationLimits,
+			method:             http.MethodPost,
+			route:              "/v1/user/:user_id/topups",
+			requestPath:        "/v1/user/123/topups",
+			requestBody:        `{"amount":0}`,
+			wantHttpStatusCode: http.StatusBadRequest,
+			wantMessages:       []string{"amount: number must be more than 0"},
+		},
+		{
+			name:               "fail-path-parameter",
+			limits:             utils.DefaultValidationLimits,
+			method:             http.MethodGet,
+			route:              "/v1/user/:user_id/bank-accounts",
+			requestPath:        "/v1/user/abc/bank-accounts",
+			wantHttpStatusCode: http.StatusBadRequest,
+			wantMessages:       []string{"user_id: value abc: an invalid integer: invalid syntax"},
+		},
+		{
+			name:               "fail-phone-number-pattern",
+			limits:             utils.DefaultValidationLimits,
+			method:             http.MethodPost,
+			route:              "/v1/user",
+			requestPath:        "/v1/user",
+			requestBody:        `{"phone_number":"0812/3456/7890","full_name":"Budi","password":"Admin1234!"}`,
+			wantHttpStatusCode: http.StatusBadRequest,
+			wantMessages:       []string{`phone_number: string doesn't match the regular expression "^\+?[0-9 ().-]+$"`},
+		},
+		{
+			name: "fail-configured-password-length",
+			limits: utils.ValidationLimits{
+				PhoneNumberMinLength: 10,
+				PhoneNumberMaxLength: 16,
+				PasswordMinLength:    12,
+				PasswordMaxLength:    64,
+			},
+			method:             http.MethodPost,
+			route:              "/v1/user",
+			requestPath:        "/v1/user",
+			requestBody:        `{"phone_number":"+6281234567890","full_name":"Budi","password":"Admin1234!"}`,
+			wantHttpStatusCode: http.StatusBadRequest,
+			wantMessages:       []string{"password: minimum string length is 12"},
+		},
+		{
+			name:               "success-unknown-route",
+			limits:             utils.DefaultValidationLimits,
+			method:             http.MethodGet,
+			route:              "/metrics",
+			requestPath:        "/metrics",
+			wantHttpStatusCode: http.StatusOK,
+			wantNextCalled:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			middleware, err := OpenAPIValidationMiddleware(test.limits, false)
+			if err != nil {
+				t.Fatalf("OpenAPIValidationMiddleware() error = %v", err)
+			}
+
+			nextCalled := false
+			e := echo.New()
+			e.Use(middleware)
+			e.Add(test.method, test.route, func(ctx echo.Context) error {
+				nextCalled = true
+				return ctx.NoContent(http.StatusOK)
+			})
+
+			request := httptest.NewRequest(test.method, test.requestPath, strings.NewReader(test.requestBody))
+			if test.requestBody != "" {
+				request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			}
+			recorder := httptest.NewRecorder()
+			e.ServeHTTP(recorder, request)
+
+			if recorder.Code != test.wantHttpStatusCode {
+				t.Errorf("OpenAPIValidationMiddleware() httpStatusCode = %v, want %v", recorder.Code, test.wantHttpStatusCode)
+			}
+			if nextCalled != test.wantNextCalled {
+				t.Errorf("OpenAPIValidationMiddleware() nextCalled = %v, want %v", nextCalled, test.wantNextCalled)
+			}
+
+			if test.wantMessages == nil {
+				return
+			}
+			response := validationErrorResponse{}
+			_ = json.Unmarshal(recorder.Body.Bytes(), &response)
+			if !reflect.DeepEqual(response.Header.Messages, test.wantMessages) {
+				t.Errorf("OpenAPIValidationMiddleware() messages = %#v, want %#v", response.Header.Messages, test.wantMessages)
+			}
+		})
+	}
+}
+
+func TestOpenAPIValidationMiddleware_validateResponses(t *testing.T) {
+	middleware, err := OpenAPIValidationMiddleware(utils.DefaultValidationLimits, true)
+	if err != nil {
+		t.Fatalf("OpenAPIValidationMiddleware() error = %v", err)
+	}
+
+	logs := &bytes.Buffer{}
+	e := echo.New()
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			logger := logging.New(logs, slog.LevelInfo)
+			ctx.SetRequest(ctx.Request().WithContext(logging.NewContext(ctx.Request().Context(), logger)))
+			return next(ctx)
+		}
+	})
+	e.Use(middleware)
+	e.GET("/v1/user", func(ctx echo.Context) error {
+		// id should be an integer
+		return ctx.JSON(http.StatusOK, map[string]any{
+			"header": generated.ResponseHeader{Success: true, Messages: []string{}},
+			"user":   map[string]any{"id": "123"},
+		})
+	})
+
+	request := httptest.NewRequest(http.MethodGet, "/v1/user", nil)
+	recorder := httptest.NewRecorder()
+	e.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusOK {
+		t.Errorf("OpenAPIValidationMiddleware() httpStatusCode = %v, want %v", recorder.Code, http.StatusOK)
+	}
+	if !strings.Contains(recorder.Body.String(), `"id":"123"`) {
+		t.Errorf("OpenAPIValidationMiddleware() body = %v, want the handler response unaltered", recorder.Body.String())
+	}
+	if !strings.Contains(logs.String(), "response does not match api.yml") {
+		t.Errorf("OpenAPIValidationMiddleware() logs = %v, want a response violation", logs.String())
+	}
+}
+
+func TestApiYmlMatchesDefaultValidationLimits(t *testing.T) {
+	swagger, err := generated.GetSwagger()
+	if err != nil {
+		t.Fatalf("GetSwagger() error = %v", err)
+	}
+
+	// phone_number is only bounded once normalized, see applyValidationLimits
+	properties := swagger.Components.Schemas["User"].Value.Properties
+	got := [2]int{
+		int(properties["password"].Value.MinLength),
+		int(*properties["password"].Value.MaxLength),
+	}
+	if want := [2]int{utils.DefaultValidationLimits.PasswordMinLength, utils.DefaultValidationLimits.PasswordMaxLength}; got != want {
+		t.Errorf("api.yml User password limits = %v, want utils.DefaultValidationLimits %v", got, want)
+	}
+}
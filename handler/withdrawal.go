@@ -0,0 +1,165 @@
+package handler
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+
+	"github.com/WalletService/generated"
+	"github.com/WalletService/model"
+	"github.com/WalletService/utils"
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	// Define function wrappers so we can inject dummy function in UT
+	fnConvertRegisterBankAccountRequest  func(int64, generated.BankAccount) (model.BankAccount, []string)          = convertRegisterBankAccountRequest
+	fnConvertDisbursementCallbackRequest func(generated.DisbursementCallback) (model.DisbursementResult, []string) = convertDisbursementCallbackRequest
+)
+
+const (
+	duplicateBankAccountErrorMsg = "bank account is already registered"
+)
+
+// RegisterUserBankAccount registers a bank account that User can Withdraw to.
+// NOTE: Check AuthenticationMiddleware cmd/main.go that authenticates the JWT token
+func (s *Server) RegisterUserBankAccount(ctx echo.Context, pathUserID int) error {
+	return ctx.JSON(s.registerUserBankAccount(ctx, int64(pathUserID)))
+}
+func (s *Server) registerUserBankAccount(ctx echo.Context, pathUserID int64) (int, generated.BankAccountResponse) {
+	var (
+		context = ctx.Request().Context()
+
+		response = generated.BankAccountResponse{
+			Header: generated.ResponseHeader{}, //success is false by default
+		}
+	)
+
+	// Authorize and get userID of the requester
+	userID, err := authorize(ctx, utils.JWTPermissionPerformTransaction)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	} else if pathUserID != userID {
+		response.Header.Messages = []string{"JWT userID mismatched with request userID"}
+		return http.StatusForbidden, response
+	}
+
+	request := generated.BankAccount{}
+	err = json.NewDecoder(ctx.Request().Body).Decode(&request)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusBadRequest, response
+	}
+
+	bankAccount, errorList := fnConvertRegisterBankAccountRequest(userID, request)
+	if len(errorList) > 0 {
+		response.Header.Messages = errorList
+		return http.StatusBadRequest, response
+	}
+
+	bankAccountID, err := s.Usecase.RegisterUserBankAccount(context, bankAccount)
+	if err != nil {
+		if utils.IsUniqueConstraintViolation(err) {
+			response.Header.Messages = []string{duplicateBankAccountErrorMsg}
+			return http.StatusConflict, response
+		}
+
+		response.Header.Messages = []string{err.Error()}
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
+	}
+
+	response.Header.Success = true
+	response.Header.Messages = []string{successMsg}
+	response.BankAccount.Id = &bankAccountID
+	return http.StatusCreated, response
+}
+
+// GetUserBankAccounts lists the bank accounts registered by User.
+// NOTE: Check AuthenticationMiddleware cmd/main.go that authenticates the JWT token
+func (s *Server) GetUserBankAccounts(ctx echo.Context, pathUserID int) error {
+	return ctx.JSON(s.getUserBankAccounts(ctx, int64(pathUserID)))
+}
+func (s *Server) getUserBankAccounts(ctx echo.Context, pathUserID int64) (int, generated.BankAccountsResponse) {
+	var (
+		context = ctx.Request().Context()
+
+		response = generated.BankAccountsResponse{
+			Header:       generated.ResponseHeader{}, //success is false by default
+			BankAccounts: []generated.BankAccount{},
+		}
+	)
+
+	// Authorize and get userID of the requester
+	userID, err := authorize(ctx, utils.JWTPermissionGetUser)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusForbidden, response
+	} else if pathUserID != userID {
+		response.Header.Messages = []string{"JWT userID mismatched with request userID"}
+		return http.StatusForbidden, response
+	}
+
+	bankAccounts, err := s.Usecase.GetUserBankAccounts(context, userID)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
+	}
+
+	for i := range bankAccounts {
+		bankAccount := bankAccounts[i]
+		response.BankAccounts = append(response.BankAccounts, generated.BankAccount{
+			Id:            &bankAccount.ID,
+			BankCode:      &bankAccount.BankCode,
+			AccountNumber: &bankAccount.AccountNumber,
+			AccountName:   &bankAccount.AccountName,
+		})
+	}
+
+	response.Header.Success = true
+	response.Header.Messages = []string{successMsg}
+	return http.StatusOK, response
+}
+
+// DisbursementCallback is called by the disbursement gateway to report the final result of a Withdrawal.
+// The gateway authenticates itself with the shared secret in X-Callback-Token instead of a User's JWT.
+func (s *Server) DisbursementCallback(ctx echo.Context, params generated.DisbursementCallbackParams) error {
+	return ctx.JSON(s.disbursementCallback(ctx, params))
+}
+func (s *Server) disbursementCallback(ctx echo.Context, params generated.DisbursementCallbackParams) (int, generated.CallbackResponse) {
+	var (
+		context = ctx.Request().Context()
+
+		response = generated.CallbackResponse{
+			Header: generated.ResponseHeader{}, //success is false by default
+		}
+	)
+
+	if s.DisbursementCallbackToken == "" || subtle.ConstantTimeCompare([]byte(params.XCallbackToken), []byte(s.DisbursementCallbackToken)) != 1 {
+		response.Header.Messages = []string{"invalid callback token"}
+		return http.StatusUnauthorized, response
+	}
+
+	request := generated.DisbursementCallback{}
+	err := json.NewDecoder(ctx.Request().Body).Decode(&request)
+	if err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return http.StatusBadRequest, response
+	}
+
+	result, errorList := fnConvertDisbursementCallbackRequest(request)
+	if len(errorList) > 0 {
+		response.Header.Messages = errorList
+		return http.StatusBadRequest, response
+	}
+
+	// Gateway retries the callback on non-2xx responses, completing the same Withdrawal again is a no-op
+	if err := s.Usecase.CompleteWithdrawal(context, result); err != nil {
+		response.Header.Messages = []string{err.Error()}
+		return errorStatusCode(context, err, http.StatusInternalServerError), response
+	}
+
+	response.Header.Success = true
+	response.Header.Messages = []string{successMsg}
+	return http.StatusOK, response
+}
@@ -0,0 +1,158 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/WalletService/generated"
+	"github.com/WalletService/model"
+	"github.com/WalletService/usecase"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+)
+
+func TestDisbursementCallback(t *testing.T) {
+	tests := []struct {
+		name               string
+		serverToken        string
+		params             generated.DisbursementCallbackParams
+		requestBody        generated.DisbursementCallback
+		mockUsecase        func(controller *gomock.Controller) *usecase.MockUsecaseInterface
+		wantResponse       generated.CallbackResponse
+		wantHttpStatusCode int
+	}{
+		{
+			name:        "success",
+			serverToken: "secret",
+			params:      generated.DisbursementCallbackParams{XCallbackToken: "secret"},
+			requestBody: generated.DisbursementCallback{
+				TransactionId: "3d6e668f-ad02-40ff-8540-90c1528a7c88",
+				Reference:     stringPtr("REF-1"),
+				Status:        generated.DisbursementCallbackStatusFailed,
+				FailureReason: stringPtr("account closed"),
+			},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+
+				mock.EXPECT().CompleteWithdrawal(gomock.Any(), model.DisbursementResult{
+					TransactionID: convertToUUID("3d6e668f-ad02-40ff-8540-90c1528a7c88"),
+					Reference:     "REF-1",
+					Status:        model.DisbursementStatusFailed,
+					FailureReason: "account closed",
+				}).Return(nil)
+
+				return mock
+			},
+			wantResponse: generated.CallbackResponse{
+				Header: generated.ResponseHeader{
+					Success:  true,
+					Messages: []string{successMsg},
+				},
+			},
+			wantHttpStatusCode: http.StatusOK,
+		},
+		{
+			name:        "fail-invalid-token",
+			serverToken: "secret",
+			params:      generated.DisbursementCallbackParams{XCallbackToken: "guess"},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				return usecase.NewMockUsecaseInterface(controller)
+			},
+			wantResponse: generated.CallbackResponse{
+				Header: generated.ResponseHeader{
+					Success:  false,
+					Messages: []string{"invalid callback token"},
+				},
+			},
+			wantHttpStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:        "fail-token-not-configured",
+			serverToken: "",
+			params:      generated.DisbursementCallbackParams{XCallbackToken: ""},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				return usecase.NewMockUsecaseInterface(controller)
+			},
+			wantResponse: generated.CallbackResponse{
+				Header: generated.ResponseHeader{
+					Success:  false,
+					Messages: []string{"invalid callback token"},
+				},
+			},
+			wantHttpStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:        "fail-invalid-request",
+			serverToken: "secret",
+			params:      generated.DisbursementCallbackParams{XCallbackToken: "secret"},
+			requestBody: generated.DisbursementCallback{
+				TransactionId: "123",
+			},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				return usecase.NewMockUsecaseInterface(controller)
+			},
+			wantResponse: generated.CallbackResponse{
+				Header: generated.ResponseHeader{
+					Success:  false,
+					Messages: []string{"transaction_id should be a UUID"},
+				},
+			},
+			wantHttpStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:        "fail-complete-withdrawal",
+			serverToken: "secret",
+			params:      generated.DisbursementCallbackParams{XCallbackToken: "secret"},
+			requestBody: generated.DisbursementCallback{
+				TransactionId: "3d6e668f-ad02-40ff-8540-90c1528a7c88",
+				Status:        generated.DisbursementCallbackStatusSuccessful,
+			},
+			mockUsecase: func(controller *gomock.Controller) *usecase.MockUsecaseInterface {
+				mock := usecase.NewMockUsecaseInterface(controller)
+
+				mock.EXPECT().CompleteWithdrawal(gomock.Any(), gomock.Any()).Return(errors.New("withdrawal is already completed with a different status"))
+
+				return mock
+			},
+			wantResponse: generated.CallbackResponse{
+				Header: generated.ResponseHeader{
+					Success:  false,
+					Messages: []string{"withdrawal is already completed with a different status"},
+				},
+			},
+			wantHttpStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			handler := &Server{
+				Usecase:                   test.mockUsecase(controller),
+				DisbursementCallbackToken: test.serverToken,
+			}
+
+			requestBodyJSON, _ := json.Marshal(test.requestBody)
+
+			e := echo.New()
+			request := httptest.NewRequest(http.MethodPost, "/v1/disbursements/callback", bytes.NewBuffer(requestBodyJSON))
+			recorder := httptest.NewRecorder()
+			ctx := e.NewContext(request, recorder)
+
+			gotHttpStatusCode, gotResponse := handler.disbursementCallback(ctx, test.params)
+
+			if gotHttpStatusCode != test.wantHttpStatusCode {
+				t.Errorf("handler.DisbursementCallback() httpStatusCode = %v, wantHttpStatusCode %v", gotHttpStatusCode, test.wantHttpStatusCode)
+			}
+
+			if !reflect.DeepEqual(test.wantResponse, gotResponse) {
+				t.Errorf("handler.DisbursementCallback() response = %v, wantResponse %v", gotResponse, test.wantResponse)
+			}
+		})
+	}
+}
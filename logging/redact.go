@@ -0,0 +1,132 @@
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+)
+
+const redacted = "[REDACTED]"
+
+var (
+	// Attributes whose key contains any of these are never logged, compared in lower case
+	sensitiveKeys = []string{"password", "pin", "otp", "token", "jwt", "secret", "authorization"}
+	// Attributes whose key contains any of these are logged masked, compared in lower case
+	phoneNumberKeys = []string{"phone"}
+
+	// Redacted wherever they appear in a string, i.e. an error message
+	jwtRegex         = regexp.MustCompile(`eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`)
+	bearerRegex      = regexp.MustCompile(`(?i)bearer\s+\S+`)
+	phoneNumberRegex = regexp.MustCompile(`\+[1-9]\d{6,14}\b|\b(?:62|0)8\d{6,12}\b`)
+)
+
+func redactAttr(_ []string, attr slog.Attr) slog.Attr {
+	switch attr.Value.Kind() {
+	case slog.KindString:
+		return slog.String(attr.Key, redactString(attr.Key, attr.Value.String()))
+	case slog.KindAny:
+		return slog.Any(attr.Key, redactValue(attr.Key, attr.Value.Any()))
+	case slog.KindGroup:
+		return attr
+	}
+
+	if isSensitiveKey(attr.Key) {
+		return slog.String(attr.Key, redacted)
+	}
+	return attr
+}
+
+// Redact redacts every JWT and phone number in s, for text leaving the service outside of logs, i.e. span error messages
+func Redact(s string) string {
+	return redactString("", s)
+}
+
+// redactString redacts s entirely if key is sensitive, otherwise every JWT and phone number in s
+func redactString(key, s string) string {
+	switch {
+	case isSensitiveKey(key):
+		return redacted
+	case isPhoneNumberKey(key):
+		return MaskPhoneNumber(s)
+	}
+
+	s = jwtRegex.ReplaceAllString(s, redacted)
+	s = bearerRegex.ReplaceAllString(s, "Bearer "+redacted)
+	return phoneNumberRegex.ReplaceAllStringFunc(s, MaskPhoneNumber)
+}
+
+// redactValue redacts v of any type, i.e. errors are logged as their redacted message and structs field by field
+func redactValue(key string, v any) any {
+	if isSensitiveKey(key) {
+		return redacted
+	}
+
+	switch value := v.(type) {
+	case nil:
+		return nil
+	case error:
+		return redactString(key, value.Error())
+	case fmt.Stringer:
+		return redactString(key, value.String())
+	case map[string]any:
+		redactedMap := make(map[string]any, len(value))
+		for k, v := range value {
+			redactedMap[k] = redactValue(k, v)
+		}
+		return redactedMap
+	case []any:
+		redactedSlice := make([]any, len(value))
+		for i, v := range value {
+			redactedSlice[i] = redactValue(key, v)
+		}
+		return redactedSlice
+	case string:
+		return redactString(key, value)
+	case bool, float64:
+		return value
+	}
+
+	// Round trip through JSON, which is how JSONHandler would write v, to redact each field by its name
+	b, err := json.Marshal(v)
+	if err != nil {
+		return redactString(key, fmt.Sprintf("%+v", v))
+	}
+	var generic any
+	if err := json.Unmarshal(b, &generic); err != nil {
+		return redactString(key, string(b))
+	}
+	return redactValue(key, generic)
+}
+
+func isSensitiveKey(key string) bool {
+	return containsAny(strings.ToLower(key), sensitiveKeys)
+}
+
+func isPhoneNumberKey(key string) bool {
+	return containsAny(strings.ToLower(key), phoneNumberKeys)
+}
+
+func containsAny(s string, substrings []string) bool {
+	for _, substring := range substrings {
+		if strings.Contains(s, substring) {
+			return true
+		}
+	}
+	return false
+}
+
+// MaskPhoneNumber keeps the first 2 digits of E.164 phone numbers, i.e. the country code, and the last 3 digits,
+// enough to tell Users apart when investigating, i.e. +62*******455
+func MaskPhoneNumber(phoneNumber string) string {
+	prefixLength := 0
+	if strings.HasPrefix(phoneNumber, "+") {
+		prefixLength = 3
+	}
+	if len(phoneNumber)-prefixLength <= 3 {
+		return strings.Repeat("*", len(phoneNumber))
+	}
+
+	return phoneNumber[:prefixLength] + strings.Repeat("*", len(phoneNumber)-prefixLength-3) + phoneNumber[len(phoneNumber)-3:]
+}
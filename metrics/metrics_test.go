@@ -0,0 +1,102 @@
+package metrics
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRegistry_WriteTo(t *testing.T) {
+	registry := NewRegistry()
+	requests := registry.NewCounterVec("test_requests_total", "Requests.", "operation", "code")
+	duration := registry.NewHistogramVec("test_duration_seconds", "Duration.", []float64{0.1, 1}, "operation")
+
+	requests.Inc("GetUser", "200")
+	requests.Add(2, "GetUser", "200")
+	requests.Inc("CreateUser", "400")
+	duration.Observe(0.05, "GetUser")
+	duration.Observe(0.5, "GetUser")
+	duration.Observe(5, "GetUser")
+
+	want := `# HELP test_duration_seconds Duration.
+# TYPE test_duration_seconds histogram
+test_duration_seconds_bucket{operation="GetUser",le="0.1"} 1
+test_duration_seconds_bucket{operation="GetUser",le="1"} 2
+test_duration_seconds_bucket{operation="GetUser",le="+Inf"} 3
+test_duration_seconds_sum{operation="GetUser"} 5.55
+test_duration_seconds_count{operation="GetUser"} 3
+# HELP test_requests_total Requests.
+# TYPE test_requests_total counter
+test_requests_total{operation="CreateUser",code="400"} 1
+test_requests_total{operation="GetUser",code="200"} 3
+`
+
+	var got bytes.Buffer
+	if _, err := registry.WriteTo(&got); err != nil {
+		t.Fatalf("Registry.WriteTo() error = %v", err)
+	}
+	if got.String() != want {
+		t.Errorf("Registry.WriteTo() = \n%v\nwant\n%v", got.String(), want)
+	}
+
+	if value := requests.Value("GetUser", "200"); value != 3 {
+		t.Errorf("CounterVec.Value() = %v, want %v", value, 3)
+	}
+	if count := duration.Count("GetUser"); count != 3 {
+		t.Errorf("HistogramVec.Count() = %v, want %v", count, 3)
+	}
+}
+
+func TestRegistry_Handler(t *testing.T) {
+	registry := NewRegistry()
+	registry.NewCounterVec("test_logins_total", "Logins.", "result").Inc("success")
+
+	recorder := httptest.NewRecorder()
+	registry.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	if contentType := recorder.Header().Get("Content-Type"); contentType != "text/plain; version=0.0.4; charset=utf-8" {
+		t.Errorf("Registry.Handler() Content-Type = %v", contentType)
+	}
+	if !bytes.Contains(recorder.Body.Bytes(), []byte(`test_logins_total{result="success"} 1`)) {
+		t.Errorf("Registry.Handler() body = %v", recorder.Body.String())
+	}
+}
+
+func TestCounterVec_panics(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func(registry *Registry)
+	}{
+		{
+			name: "duplicate-name",
+			fn: func(registry *Registry) {
+				registry.NewCounterVec("test_total", "Test.")
+				registry.NewCounterVec("test_total", "Test.")
+			},
+		},
+		{
+			name: "wrong-label-count",
+			fn: func(registry *Registry) {
+				registry.NewCounterVec("test_total", "Test.", "result").Inc()
+			},
+		},
+		{
+			name: "negative-add",
+			fn: func(registry *Registry) {
+				registry.NewCounterVec("test_total", "Test.").Add(-1)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected panic")
+				}
+			}()
+			test.fn(NewRegistry())
+		})
+	}
+}
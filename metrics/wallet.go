@@ -0,0 +1,29 @@
+package metrics
+
+// Default is the Registry of the wallet metrics below, served at /metrics
+var Default = NewRegistry()
+
+var (
+	// HTTP requests by operation ID of api.yml, "unknown" for routes not in api.yml
+	HTTPRequests        = Default.NewCounterVec("wallet_http_requests_total", "HTTP requests by operation and status code.", "operation", "code")
+	HTTPRequestDuration = Default.NewHistogramVec("wallet_http_request_duration_seconds", "HTTP request latency by operation.", nil, "operation")
+
+	// DB queries by method, i.e. exec, query or query_row, and whether it ran within a DB transaction
+	DBQueryDuration = Default.NewHistogramVec("wallet_db_query_duration_seconds", "DB query latency by method.", nil, "method", "in_tx")
+	DBTransactions  = Default.NewCounterVec("wallet_db_transactions_total", "DB transactions by result, commit or rollback.", "result")
+
+	// Transactions by type and the status they reached, Withdrawal is counted as Pending then again when completed
+	Transactions      = Default.NewCounterVec("wallet_transactions_total", "Transactions by type and status.", "type", "status")
+	TransactionVolume = Default.NewCounterVec("wallet_transaction_volume_total", "Amount of Successful Transactions by type and currency.", "type", "currency")
+
+	Logins         = Default.NewCounterVec("wallet_logins_total", "User logins by result, success or failure.", "result")
+	AuthRejections = Default.NewCounterVec("wallet_auth_rejections_total", "Requests rejected by the authentication middleware by reason.", "reason")
+)
+
+const (
+	DBTransactionCommit   = "commit"
+	DBTransactionRollback = "rollback"
+
+	LoginSuccess = "success"
+	LoginFailure = "failure"
+)
@@ -0,0 +1,189 @@
+// Package migrations versions the DB schema as embedded SQL migrations.
+// Migration files are named NNNN_name.up.sql and NNNN_name.down.sql, applied in version order.
+// Applied migrations are recorded in schema_migrations with the checksum of their up SQL,
+// so a migration must never be edited once released, add a new one instead.
+package migrations
+
+import (
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+)
+
+//go:embed sql/*.sql
+var migrationFS embed.FS
+
+// Seed data is only for local development, see Migrator.Seed
+//
+//go:embed seeds/*.sql
+var seedFS embed.FS
+
+var migrationFileRegex = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+type Migration struct {
+	Version  int64
+	Name     string
+	Up       string
+	Down     string
+	Checksum string // sha256 of Up
+}
+
+// String returns the file name prefix of Migration, i.e. 0001_create_user_and_transaction
+func (m Migration) String() string {
+	return fmt.Sprintf("%04d_%s", m.Version, m.Name)
+}
+
+// AppliedMigration is a row of schema_migrations
+type AppliedMigration struct {
+	Version     int64
+	Name        string
+	Checksum    string
+	AppliedTime time.Time
+}
+
+// Load returns the embedded Migrations in version order
+func Load() ([]Migration, error) {
+	return load(migrationFS, "sql")
+}
+
+func load(fsys fs.FS, dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[int64]*Migration{}
+	for _, entry := range entries {
+		matches := migrationFileRegex.FindStringSubmatch(entry.Name())
+		if matches == nil {
+			return nil, fmt.Errorf("invalid migration file name %s", entry.Name())
+		}
+
+		version, err := strconv.ParseInt(matches[1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: matches[2]}
+			byVersion[version] = migration
+		} else if migration.Name != matches[2] {
+			return nil, fmt.Errorf("migration version %d is used by both %s and %s", version, migration.Name, matches[2])
+		}
+
+		if matches[3] == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" {
+			return nil, fmt.Errorf("migration %s has no up SQL", migration)
+		}
+		if migration.Down == "" {
+			return nil, fmt.Errorf("migration %s has no down SQL", migration)
+		}
+
+		checksum := sha256.Sum256([]byte(migration.Up))
+		migration.Checksum = hex.EncodeToString(checksum[:])
+
+		migrations = append(migrations, *migration)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// plan returns the Migrations not applied yet, in the order they should be applied.
+// It fails if an applied migration was modified or is unknown to this build, or if a pending one
+// is older than the latest applied, since applying it would not reproduce the schema of a fresh DB.
+func plan(migrations []Migration, applied []AppliedMigration) (pending []Migration, err error) {
+	known := map[int64]Migration{}
+	for _, migration := range migrations {
+		known[migration.Version] = migration
+	}
+
+	appliedVersions := map[int64]bool{}
+	var latestApplied int64
+	for _, a := range applied {
+		migration, ok := known[a.Version]
+		if !ok {
+			return nil, fmt.Errorf("applied migration %04d_%s is unknown to this build", a.Version, a.Name)
+		}
+		if migration.Checksum != a.Checksum {
+			return nil, fmt.Errorf("checksum mismatch for migration %s, it was modified after being applied", migration)
+		}
+
+		appliedVersions[a.Version] = true
+		if a.Version > latestApplied {
+			latestApplied = a.Version
+		}
+	}
+
+	for _, migration := range migrations {
+		if appliedVersions[migration.Version] {
+			continue
+		}
+		if migration.Version < latestApplied {
+			return nil, fmt.Errorf("migration %s is older than the latest applied migration %04d", migration, latestApplied)
+		}
+
+		pending = append(pending, migration)
+	}
+
+	return pending, nil
+}
+
+// planDown returns the latest steps applied Migrations, in the order they should be reverted
+func planDown(migrations []Migration, applied []AppliedMigration, steps int) ([]Migration, error) {
+	if steps <= 0 {
+		return nil, errors.New("steps must be positive")
+	}
+
+	// Validate applied migrations are the ones this build knows, down SQL of another build may not revert them
+	if _, err := plan(migrations, applied); err != nil {
+		return nil, err
+	}
+
+	known := map[int64]Migration{}
+	for _, migration := range migrations {
+		known[migration.Version] = migration
+	}
+
+	sorted := make([]AppliedMigration, len(applied))
+	copy(sorted, applied)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Version > sorted[j].Version
+	})
+
+	if steps > len(sorted) {
+		steps = len(sorted)
+	}
+
+	reverted := make([]Migration, 0, steps)
+	for _, a := range sorted[:steps] {
+		reverted = append(reverted, known[a.Version])
+	}
+
+	return reverted, nil
+}
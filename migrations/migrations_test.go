@@ -0,0 +1,207 @@
+package migrations
+
+import (
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoad_Embedded(t *testing.T) {
+	migrations, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	if len(migrations) == 0 {
+		t.Fatal("Load() returned no migrations")
+	}
+
+	// Versions must be contiguous so a gap is not mistaken for a pending migration
+	for i, migration := range migrations {
+		if migration.Version != int64(i+1) {
+			t.Errorf("migration %s has version %d, want %d", migration, migration.Version, i+1)
+		}
+	}
+}
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name    string
+		fsys    fstest.MapFS
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "sorted-by-version",
+			fsys: fstest.MapFS{
+				"sql/0002_second.up.sql":   {Data: []byte("CREATE TABLE b ();")},
+				"sql/0002_second.down.sql": {Data: []byte("DROP TABLE b;")},
+				"sql/0001_first.up.sql":    {Data: []byte("CREATE TABLE a ();")},
+				"sql/0001_first.down.sql":  {Data: []byte("DROP TABLE a;")},
+			},
+			want: []string{"0001_first", "0002_second"},
+		},
+		{
+			name: "failed-missing-down",
+			fsys: fstest.MapFS{
+				"sql/0001_first.up.sql": {Data: []byte("CREATE TABLE a ();")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "failed-invalid-file-name",
+			fsys: fstest.MapFS{
+				"sql/first.sql": {Data: []byte("CREATE TABLE a ();")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "failed-duplicate-version",
+			fsys: fstest.MapFS{
+				"sql/0001_first.up.sql":    {Data: []byte("CREATE TABLE a ();")},
+				"sql/0001_first.down.sql":  {Data: []byte("DROP TABLE a;")},
+				"sql/0001_second.up.sql":   {Data: []byte("CREATE TABLE b ();")},
+				"sql/0001_second.down.sql": {Data: []byte("DROP TABLE b;")},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			migrations, err := load(tt.fsys, "sql")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("load() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if len(migrations) != len(tt.want) {
+				t.Fatalf("load() returned %d migrations, want %d", len(migrations), len(tt.want))
+			}
+			for i, migration := range migrations {
+				if migration.String() != tt.want[i] {
+					t.Errorf("load()[%d] = %s, want %s", i, migration, tt.want[i])
+				}
+				if migration.Checksum == "" {
+					t.Errorf("load()[%d] has no checksum", i)
+				}
+			}
+		})
+	}
+}
+
+func TestPlan(t *testing.T) {
+	migrations := []Migration{
+		{Version: 1, Name: "first", Checksum: "c1"},
+		{Version: 2, Name: "second", Checksum: "c2"},
+		{Version: 3, Name: "third", Checksum: "c3"},
+	}
+
+	tests := []struct {
+		name    string
+		applied []AppliedMigration
+		want    []int64
+		wantErr bool
+	}{
+		{
+			name: "fresh-db",
+			want: []int64{1, 2, 3},
+		},
+		{
+			name:    "partially-applied",
+			applied: []AppliedMigration{{Version: 1, Name: "first", Checksum: "c1"}},
+			want:    []int64{2, 3},
+		},
+		{
+			name: "up-to-date",
+			applied: []AppliedMigration{
+				{Version: 1, Name: "first", Checksum: "c1"},
+				{Version: 2, Name: "second", Checksum: "c2"},
+				{Version: 3, Name: "third", Checksum: "c3"},
+			},
+		},
+		{
+			name:    "failed-checksum-mismatch",
+			applied: []AppliedMigration{{Version: 1, Name: "first", Checksum: "modified"}},
+			wantErr: true,
+		},
+		{
+			name:    "failed-unknown-applied-migration",
+			applied: []AppliedMigration{{Version: 4, Name: "fourth", Checksum: "c4"}},
+			wantErr: true,
+		},
+		{
+			name: "failed-pending-older-than-applied",
+			applied: []AppliedMigration{
+				{Version: 1, Name: "first", Checksum: "c1"},
+				{Version: 3, Name: "third", Checksum: "c3"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pending, err := plan(migrations, tt.applied)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("plan() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if len(pending) != len(tt.want) {
+				t.Fatalf("plan() returned %d migrations, want %d", len(pending), len(tt.want))
+			}
+			for i, migration := range pending {
+				if migration.Version != tt.want[i] {
+					t.Errorf("plan()[%d] = %d, want %d", i, migration.Version, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestPlanDown(t *testing.T) {
+	migrations := []Migration{
+		{Version: 1, Name: "first", Checksum: "c1"},
+		{Version: 2, Name: "second", Checksum: "c2"},
+	}
+	applied := []AppliedMigration{
+		{Version: 1, Name: "first", Checksum: "c1"},
+		{Version: 2, Name: "second", Checksum: "c2"},
+	}
+
+	tests := []struct {
+		name    string
+		steps   int
+		want    []int64
+		wantErr bool
+	}{
+		{
+			name:  "latest-first",
+			steps: 1,
+			want:  []int64{2},
+		},
+		{
+			name:  "steps-more-than-applied",
+			steps: 5,
+			want:  []int64{2, 1},
+		},
+		{
+			name:    "failed-non-positive-steps",
+			steps:   0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reverted, err := planDown(migrations, applied, tt.steps)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("planDown() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if len(reverted) != len(tt.want) {
+				t.Fatalf("planDown() returned %d migrations, want %d", len(reverted), len(tt.want))
+			}
+			for i, migration := range reverted {
+				if migration.Version != tt.want[i] {
+					t.Errorf("planDown()[%d] = %d, want %d", i, migration.Version, tt.want[i])
+				}
+			}
+		})
+	}
+}
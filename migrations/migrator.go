@@ -0,0 +1,297 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"time"
+)
+
+// advisoryLockID identifies the Postgres advisory lock held while migrating,
+// so instances migrating on startup at the same time apply each migration once
+const advisoryLockID int64 = 4242034
+
+const createSchemaMigrationsQuery = `CREATE TABLE IF NOT EXISTS schema_migrations (
+    version bigint PRIMARY KEY,
+    name text NOT NULL,
+    checksum text NOT NULL,
+    applied_time timestamp NOT NULL default now()
+)`
+
+type Migrator struct {
+	DB         *sql.DB
+	Migrations []Migration
+	Out        io.Writer // Progress and dry-run SQL are written to Out
+}
+
+// MigrationStatus is a Migration and whether it has been applied
+type MigrationStatus struct {
+	Migration
+	Applied     bool
+	AppliedTime time.Time
+}
+
+// NewMigrator creates a Migrator applying the embedded Migrations
+func NewMigrator(db *sql.DB, out io.Writer) (*Migrator, error) {
+	migrations, err := Load()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{
+		DB:         db,
+		Migrations: migrations,
+		Out:        out,
+	}, nil
+}
+
+// Up applies all pending Migrations, each in its own DB transaction.
+// On dry-run the SQL of pending Migrations is written to Out instead, and the DB is left untouched.
+func (m *Migrator) Up(ctx context.Context, dryRun bool) error {
+	return m.withLock(ctx, func(conn *sql.Conn) error {
+		applied, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		pending, err := plan(m.Migrations, applied)
+		if err != nil {
+			return err
+		}
+
+		if len(pending) == 0 {
+			fmt.Fprintln(m.Out, "schema is up to date")
+			return nil
+		}
+
+		if !dryRun {
+			if _, err := conn.ExecContext(ctx, createSchemaMigrationsQuery); err != nil {
+				return err
+			}
+		}
+
+		for _, migration := range pending {
+			if dryRun {
+				fmt.Fprintf(m.Out, "-- would apply %s\n%s\n", migration, migration.Up)
+				continue
+			}
+
+			fmt.Fprintf(m.Out, "applying %s\n", migration)
+			if err := m.run(ctx, conn, migration.Up, func(tx *sql.Tx) error {
+				_, err := tx.ExecContext(ctx, `INSERT INTO schema_migrations (version, name, checksum) VALUES ($1, $2, $3)`,
+					migration.Version, migration.Name, migration.Checksum)
+				return err
+			}); err != nil {
+				return fmt.Errorf("migration %s failed: %w", migration, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// Down reverts the latest steps applied Migrations, each in its own DB transaction.
+// On dry-run the SQL of reverted Migrations is written to Out instead, and the DB is left untouched.
+func (m *Migrator) Down(ctx context.Context, steps int, dryRun bool) error {
+	return m.withLock(ctx, func(conn *sql.Conn) error {
+		applied, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		if len(applied) == 0 {
+			fmt.Fprintln(m.Out, "no migration to revert")
+			return nil
+		}
+
+		reverted, err := planDown(m.Migrations, applied, steps)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range reverted {
+			if dryRun {
+				fmt.Fprintf(m.Out, "-- would revert %s\n%s\n", migration, migration.Down)
+				continue
+			}
+
+			fmt.Fprintf(m.Out, "reverting %s\n", migration)
+			if err := m.run(ctx, conn, migration.Down, func(tx *sql.Tx) error {
+				_, err := tx.ExecContext(ctx, `DELETE FROM schema_migrations WHERE version = $1`, migration.Version)
+				return err
+			}); err != nil {
+				return fmt.Errorf("reverting migration %s failed: %w", migration, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// Status returns every known Migration and whether it has been applied.
+// It fails if applied migrations do not match the known ones, see plan.
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	conn, err := m.DB.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	applied, err := m.applied(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := plan(m.Migrations, applied); err != nil {
+		return nil, err
+	}
+
+	appliedTimes := map[int64]time.Time{}
+	for _, a := range applied {
+		appliedTimes[a.Version] = a.AppliedTime
+	}
+
+	statuses := make([]MigrationStatus, 0, len(m.Migrations))
+	for _, migration := range m.Migrations {
+		appliedTime, ok := appliedTimes[migration.Version]
+		statuses = append(statuses, MigrationStatus{
+			Migration:   migration,
+			Applied:     ok,
+			AppliedTime: appliedTime,
+		})
+	}
+
+	return statuses, nil
+}
+
+// CheckUpToDate returns an error if any known Migration has not been applied, i.e. for the readiness probe
+func (m *Migrator) CheckUpToDate(ctx context.Context) error {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	pending := 0
+	for _, status := range statuses {
+		if !status.Applied {
+			pending++
+		}
+	}
+	if pending > 0 {
+		return fmt.Errorf("%d migrations pending", pending)
+	}
+
+	return nil
+}
+
+// Seed inserts the embedded seed data in a single DB transaction.
+// Seeds are idempotent so they can be run on every startup, but must only be run in development.
+func (m *Migrator) Seed(ctx context.Context, dryRun bool) error {
+	entries, err := fs.ReadDir(seedFS, "seeds")
+	if err != nil {
+		return err
+	}
+
+	return m.withLock(ctx, func(conn *sql.Conn) error {
+		tx, err := conn.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		defer tx.Rollback()
+
+		for _, entry := range entries {
+			seed, err := fs.ReadFile(seedFS, path.Join("seeds", entry.Name()))
+			if err != nil {
+				return err
+			}
+
+			if dryRun {
+				fmt.Fprintf(m.Out, "-- would seed %s\n%s\n", entry.Name(), seed)
+				continue
+			}
+
+			fmt.Fprintf(m.Out, "seeding %s\n", entry.Name())
+			if _, err := tx.ExecContext(ctx, string(seed)); err != nil {
+				return fmt.Errorf("seed %s failed: %w", entry.Name(), err)
+			}
+		}
+
+		if dryRun {
+			return nil
+		}
+		return tx.Commit()
+	})
+}
+
+// withLock runs fn on a dedicated connection holding the migration advisory lock.
+// Session level advisory lock is tied to the connection, so all statements must run on conn.
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) (err error) {
+	conn, err := m.DB.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// Blocks until another instance is done migrating
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, advisoryLockID); err != nil {
+		return err
+	}
+	defer func() {
+		if _, unlockErr := conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`, advisoryLockID); unlockErr != nil && err == nil {
+			err = unlockErr
+		}
+	}()
+
+	return fn(conn)
+}
+
+// applied returns rows of schema_migrations, or none if the table has not been created yet
+func (m *Migrator) applied(ctx context.Context, conn *sql.Conn) ([]AppliedMigration, error) {
+	var table sql.NullString
+	if err := conn.QueryRowContext(ctx, `SELECT to_regclass('schema_migrations')::text`).Scan(&table); err != nil {
+		return nil, err
+	}
+	if !table.Valid {
+		return nil, nil
+	}
+
+	rows, err := conn.QueryContext(ctx, `SELECT version, name, checksum, applied_time FROM schema_migrations ORDER BY version`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var applied []AppliedMigration
+	for rows.Next() {
+		var a AppliedMigration
+		if err := rows.Scan(&a.Version, &a.Name, &a.Checksum, &a.AppliedTime); err != nil {
+			return nil, err
+		}
+		applied = append(applied, a)
+	}
+
+	return applied, rows.Err()
+}
+
+// run executes the SQL of a Migration and records it in schema_migrations within a single DB transaction,
+// so a failed Migration leaves neither partial schema changes nor its record behind
+func (m *Migrator) run(ctx context.Context, conn *sql.Conn, query string, record func(tx *sql.Tx) error) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		return err
+	}
+
+	if err := record(tx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,3 @@
+-- Demo Users, both with password Admin1234!
+INSERT INTO "user" (full_name, phone_number, "password", balance) VALUES ('name1', '+6281122334455', '$2a$12$3gbfndmoRHh9k0qNlHL78e1tXEFceJqxFKWGKz92D2ibtVt91niM6', 0) ON CONFLICT (phone_number) DO NOTHING;
+INSERT INTO "user" (full_name, phone_number, "password", balance) VALUES ('name2', '+6285544332211', '$2a$12$3gbfndmoRHh9k0qNlHL78e1tXEFceJqxFKWGKz92D2ibtVt91niM6', 10000000) ON CONFLICT (phone_number) DO NOTHING;
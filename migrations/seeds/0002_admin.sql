@@ -0,0 +1,3 @@
+-- Demo admin, with password Admin1234!
+INSERT INTO "user" (full_name, phone_number, "password", balance) VALUES ('admin', '+6281200000000', '$2a$12$3gbfndmoRHh9k0qNlHL78e1tXEFceJqxFKWGKz92D2ibtVt91niM6', 0) ON CONFLICT (phone_number) DO NOTHING;
+INSERT INTO user_role (user_id, role) SELECT id, 'admin' FROM "user" WHERE phone_number = '+6281200000000' ON CONFLICT DO NOTHING;
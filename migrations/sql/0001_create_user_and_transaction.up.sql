@@ -1,4 +1,5 @@
-CREATE TABLE "user" (
+-- Baseline schema, IF NOT EXISTS adopts databases created by the former database.sql
+CREATE TABLE IF NOT EXISTS "user" (
   id serial PRIMARY KEY,
   full_name text NOT NULL,
   phone_number text NOT NULL,
@@ -11,10 +12,7 @@ CREATE TABLE "user" (
   CONSTRAINT balance_non_negative CHECK (balance >= 0)
 );
 
-INSERT INTO "user" (full_name, phone_number, "password", balance) VALUES ('name1', '+6281122334455', '$2a$12$3gbfndmoRHh9k0qNlHL78e1tXEFceJqxFKWGKz92D2ibtVt91niM6', 0);
-INSERT INTO "user" (full_name, phone_number, "password", balance) VALUES ('name2', '+6285544332211', '$2a$12$3gbfndmoRHh9k0qNlHL78e1tXEFceJqxFKWGKz92D2ibtVt91niM6', 10000000);
-
-CREATE TABLE transaction (
+CREATE TABLE IF NOT EXISTS transaction (
     id UUID PRIMARY KEY,
     user_id integer NOT NULL,
     recipient_id integer NOT NULL,
@@ -26,4 +24,4 @@ CREATE TABLE transaction (
     description text,
 
     CONSTRAINT fk_transaction_user_id FOREIGN KEY (user_id) REFERENCES "user"(id) ON DELETE CASCADE
-);
\ No newline at end of file
+);
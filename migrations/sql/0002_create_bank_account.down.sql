@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS transaction_status_type_idx;
+
+ALTER TABLE transaction
+    DROP COLUMN IF EXISTS disbursement_reference,
+    DROP COLUMN IF EXISTS bank_account_id;
+
+DROP TABLE IF EXISTS bank_account;
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS bank_account (
+    id serial PRIMARY KEY,
+    user_id integer NOT NULL,
+    bank_code text NOT NULL,
+    account_number text NOT NULL,
+    account_name text NOT NULL,
+    created_time timestamp NOT NULL default now(),
+
+    CONSTRAINT bank_account_user_id_account_uniquekey UNIQUE (user_id, bank_code, account_number),
+    CONSTRAINT fk_bank_account_user_id FOREIGN KEY (user_id) REFERENCES "user"(id) ON DELETE CASCADE
+);
+
+-- Withdrawal specific fields
+ALTER TABLE transaction
+    ADD COLUMN IF NOT EXISTS bank_account_id integer CONSTRAINT fk_transaction_bank_account_id REFERENCES bank_account(id),
+    ADD COLUMN IF NOT EXISTS disbursement_reference text;
+
+CREATE INDEX IF NOT EXISTS transaction_status_type_idx ON transaction (status, type);
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS topup_intent (
+    id UUID PRIMARY KEY,
+    user_id integer NOT NULL,
+    amount decimal NOT NULL,
+    bank_code text NOT NULL,
+    virtual_account_number text NOT NULL,
+    status text NOT NULL,
+    expiry_time timestamp NOT NULL,
+    payment_reference text,
+    transaction_id UUID,
+    created_time timestamp NOT NULL default now(),
+    updated_time timestamp,
+
+    CONSTRAINT topup_intent_virtual_account_number_uniquekey UNIQUE (virtual_account_number),
+    CONSTRAINT topup_intent_payment_reference_uniquekey UNIQUE (payment_reference),
+    CONSTRAINT fk_topup_intent_user_id FOREIGN KEY (user_id) REFERENCES "user"(id) ON DELETE CASCADE,
+    CONSTRAINT fk_topup_intent_transaction_id FOREIGN KEY (transaction_id) REFERENCES transaction(id)
+);
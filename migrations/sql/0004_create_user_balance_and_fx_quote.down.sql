@@ -0,0 +1,8 @@
+ALTER TABLE transaction
+    DROP COLUMN IF EXISTS converted_amount,
+    DROP COLUMN IF EXISTS converted_currency,
+    DROP COLUMN IF EXISTS fx_quote_id,
+    DROP COLUMN IF EXISTS currency;
+
+DROP TABLE IF EXISTS fx_quote;
+DROP TABLE IF EXISTS user_balance;
@@ -0,0 +1,36 @@
+-- Balances other than IDR, IDR balance stays in "user".balance
+CREATE TABLE IF NOT EXISTS user_balance (
+    user_id integer NOT NULL,
+    currency text NOT NULL,
+    balance decimal NOT NULL default 0,
+    created_time timestamp NOT NULL default now(),
+    updated_time timestamp,
+
+    PRIMARY KEY (user_id, currency),
+    CONSTRAINT user_balance_non_negative CHECK (balance >= 0),
+    CONSTRAINT fk_user_balance_user_id FOREIGN KEY (user_id) REFERENCES "user"(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS fx_quote (
+    id UUID PRIMARY KEY,
+    user_id integer NOT NULL,
+    source_currency text NOT NULL,
+    target_currency text NOT NULL,
+    source_amount decimal NOT NULL,
+    fee decimal NOT NULL,
+    rate decimal NOT NULL,
+    target_amount decimal NOT NULL,
+    expiry_time timestamp NOT NULL,
+    transaction_id UUID,
+    created_time timestamp NOT NULL default now(),
+    updated_time timestamp,
+
+    CONSTRAINT fk_fx_quote_user_id FOREIGN KEY (user_id) REFERENCES "user"(id) ON DELETE CASCADE
+);
+
+-- Existing Transactions are in IDR
+ALTER TABLE transaction
+    ADD COLUMN IF NOT EXISTS currency text NOT NULL default 'IDR',
+    ADD COLUMN IF NOT EXISTS fx_quote_id UUID CONSTRAINT fk_transaction_fx_quote_id REFERENCES fx_quote(id),
+    ADD COLUMN IF NOT EXISTS converted_currency text,
+    ADD COLUMN IF NOT EXISTS converted_amount decimal;
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS house_account;
+
+DROP INDEX IF EXISTS transaction_user_id_type_created_time_idx;
+
+ALTER TABLE transaction
+    DROP COLUMN IF EXISTS fee;
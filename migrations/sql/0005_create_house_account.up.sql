@@ -0,0 +1,19 @@
+-- Fee charged on top of amount, existing Transactions were free
+ALTER TABLE transaction
+    ADD COLUMN IF NOT EXISTS fee decimal NOT NULL default 0;
+
+CREATE INDEX IF NOT EXISTS transaction_user_id_type_created_time_idx ON transaction (user_id, type, created_time);
+
+-- Wallet's own accounts, i.e. "revenue" collects fees charged on Transactions
+CREATE TABLE IF NOT EXISTS house_account (
+    name text NOT NULL,
+    currency text NOT NULL,
+    balance decimal NOT NULL default 0,
+    created_time timestamp NOT NULL default now(),
+    updated_time timestamp,
+
+    PRIMARY KEY (name, currency),
+    CONSTRAINT house_account_balance_non_negative CHECK (balance >= 0)
+);
+
+INSERT INTO house_account (name, currency, balance) VALUES ('revenue', 'IDR', 0) ON CONFLICT DO NOTHING;
@@ -0,0 +1,8 @@
+ALTER TABLE transaction
+    DROP COLUMN IF EXISTS actor_id;
+
+DROP TABLE IF EXISTS user_role;
+
+DROP TABLE IF EXISTS role_permission;
+
+DROP TABLE IF EXISTS role;
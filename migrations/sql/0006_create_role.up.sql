@@ -0,0 +1,41 @@
+-- Roles grant permissions on top of get_profile and perform_transaction, which every User has
+CREATE TABLE IF NOT EXISTS role (
+    name text PRIMARY KEY,
+    created_time timestamp NOT NULL default now()
+);
+
+CREATE TABLE IF NOT EXISTS role_permission (
+    role text NOT NULL,
+    permission text NOT NULL,
+
+    PRIMARY KEY (role, permission),
+    CONSTRAINT fk_role_permission_role FOREIGN KEY (role) REFERENCES role(name) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS user_role (
+    user_id integer NOT NULL,
+    role text NOT NULL,
+    created_time timestamp NOT NULL default now(),
+
+    PRIMARY KEY (user_id, role),
+    CONSTRAINT fk_user_role_user_id FOREIGN KEY (user_id) REFERENCES "user"(id) ON DELETE CASCADE,
+    CONSTRAINT fk_user_role_role FOREIGN KEY (role) REFERENCES role(name) ON DELETE CASCADE
+);
+
+INSERT INTO role (name) VALUES ('support'), ('operator'), ('admin') ON CONFLICT DO NOTHING;
+
+INSERT INTO role_permission (role, permission) VALUES
+    ('support', 'user:read_any'),
+    ('operator', 'user:read_any'),
+    ('operator', 'user:freeze'),
+    ('operator', 'kyc:review'),
+    ('admin', 'user:read_any'),
+    ('admin', 'user:freeze'),
+    ('admin', 'kyc:review'),
+    ('admin', 'transaction:reverse'),
+    ('admin', 'balance:adjust')
+ON CONFLICT DO NOTHING;
+
+-- Admin who made the Transaction on the User's behalf, i.e. a balance adjustment
+ALTER TABLE transaction
+    ADD COLUMN IF NOT EXISTS actor_id integer CONSTRAINT fk_transaction_actor_id REFERENCES "user"(id);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS user_status_change;
+
+ALTER TABLE "user"
+    DROP COLUMN IF EXISTS status;
@@ -0,0 +1,19 @@
+-- Frozen Users can log in and read but not send, Closed Users can not log in and hold no balance
+ALTER TABLE "user"
+    ADD COLUMN IF NOT EXISTS status text NOT NULL DEFAULT 'Active' CONSTRAINT user_status_valid CHECK (status IN ('Active', 'Frozen', 'Closed'));
+
+-- Audit trail of every status transition, rows are never updated nor deleted
+CREATE TABLE IF NOT EXISTS user_status_change (
+    id serial PRIMARY KEY,
+    user_id integer NOT NULL,
+    actor_id integer NOT NULL,
+    from_status text NOT NULL,
+    to_status text NOT NULL,
+    reason text NOT NULL,
+    created_time timestamp NOT NULL default now(),
+
+    CONSTRAINT fk_user_status_change_user_id FOREIGN KEY (user_id) REFERENCES "user"(id),
+    CONSTRAINT fk_user_status_change_actor_id FOREIGN KEY (actor_id) REFERENCES "user"(id)
+);
+
+CREATE INDEX IF NOT EXISTS user_status_change_user_id_created_time_idx ON user_status_change (user_id, created_time);
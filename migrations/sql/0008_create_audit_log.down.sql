@@ -0,0 +1,7 @@
+DELETE FROM role_permission WHERE permission = 'audit:read';
+
+DELETE FROM role WHERE name = 'auditor';
+
+DROP TABLE IF EXISTS audit_log;
+
+DROP FUNCTION IF EXISTS audit_log_append_only();
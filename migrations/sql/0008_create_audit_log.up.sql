@@ -0,0 +1,42 @@
+-- Append-only log of security-sensitive actions, every entry is hash-chained to the previous one so tampering is detectable.
+-- User and actor are not foreign keys, entries must outlive the Users they mention.
+CREATE TABLE IF NOT EXISTS audit_log (
+    id bigserial PRIMARY KEY,
+    action text NOT NULL,
+    user_id integer,
+    actor_id integer,
+    ip text NOT NULL DEFAULT '',
+    user_agent text NOT NULL DEFAULT '',
+    details text NOT NULL DEFAULT '{}',
+    created_time timestamptz NOT NULL,
+    prev_hash text NOT NULL,
+    hash text NOT NULL,
+
+    CONSTRAINT audit_log_hash_unique UNIQUE (hash)
+);
+
+CREATE INDEX IF NOT EXISTS audit_log_user_id_idx ON audit_log (user_id);
+CREATE INDEX IF NOT EXISTS audit_log_actor_id_idx ON audit_log (actor_id);
+CREATE INDEX IF NOT EXISTS audit_log_action_created_time_idx ON audit_log (action, created_time);
+
+-- Rejects any change to written entries, the hash chain still detects changes made by a superuser disabling the triggers
+CREATE OR REPLACE FUNCTION audit_log_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only, % is not allowed', TG_OP;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS audit_log_no_update_delete ON audit_log;
+CREATE TRIGGER audit_log_no_update_delete BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW EXECUTE FUNCTION audit_log_append_only();
+
+DROP TRIGGER IF EXISTS audit_log_no_truncate ON audit_log;
+CREATE TRIGGER audit_log_no_truncate BEFORE TRUNCATE ON audit_log
+    FOR EACH STATEMENT EXECUTE FUNCTION audit_log_append_only();
+
+INSERT INTO role (name) VALUES ('auditor') ON CONFLICT DO NOTHING;
+
+INSERT INTO role_permission (role, permission) VALUES
+    ('auditor', 'audit:read'),
+    ('admin', 'audit:read')
+ON CONFLICT DO NOTHING;
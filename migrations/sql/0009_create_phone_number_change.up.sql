@@ -0,0 +1,15 @@
+-- Phone number changes waiting for the OTP sent to the new number, the phone number of "user" is only changed once verified
+CREATE TABLE IF NOT EXISTS phone_number_change (
+    id UUID PRIMARY KEY,
+    user_id integer NOT NULL,
+    phone_number text NOT NULL,
+    otp_hash text NOT NULL,
+    attempts integer NOT NULL DEFAULT 0,
+    expiry_time timestamp NOT NULL,
+    verified_time timestamp,
+    created_time timestamp NOT NULL default now(),
+
+    CONSTRAINT fk_phone_number_change_user_id FOREIGN KEY (user_id) REFERENCES "user"(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS phone_number_change_user_id_created_time_idx ON phone_number_change (user_id, created_time);
@@ -0,0 +1,2 @@
+ALTER TABLE "user"
+    DROP CONSTRAINT IF EXISTS user_phone_number_e164;
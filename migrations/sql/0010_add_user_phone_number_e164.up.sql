@@ -0,0 +1,6 @@
+-- Phone numbers are normalized to E.164 by the app, i.e. +628123456789.
+-- NOT VALID only checks new and updated rows, existing rows are normalized by `main phone migrate`, which then validates the constraint.
+ALTER TABLE "user"
+    DROP CONSTRAINT IF EXISTS user_phone_number_e164;
+ALTER TABLE "user"
+    ADD CONSTRAINT user_phone_number_e164 CHECK (phone_number ~ '^\+[1-9][0-9]{6,14}$') NOT VALID;
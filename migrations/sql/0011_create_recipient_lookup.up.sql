@@ -0,0 +1,15 @@
+-- Recipients looked up by phone number before a transfer, the id is the confirmation token returned to the sender.
+-- recipient_id is NULL if no User has the phone number, such lookups still count towards the rate limit of the sender.
+CREATE TABLE IF NOT EXISTS recipient_lookup (
+    id UUID PRIMARY KEY,
+    user_id integer NOT NULL,
+    recipient_id integer,
+    phone_number text NOT NULL,
+    expiry_time timestamp NOT NULL,
+    created_time timestamp NOT NULL default now(),
+
+    CONSTRAINT fk_recipient_lookup_user_id FOREIGN KEY (user_id) REFERENCES "user"(id) ON DELETE CASCADE,
+    CONSTRAINT fk_recipient_lookup_recipient_id FOREIGN KEY (recipient_id) REFERENCES "user"(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS recipient_lookup_user_id_created_time_idx ON recipient_lookup (user_id, created_time);
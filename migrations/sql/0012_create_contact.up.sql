@@ -0,0 +1,14 @@
+-- Recipients saved by a User to transfer to again, at most once per recipient
+CREATE TABLE IF NOT EXISTS contact (
+    id SERIAL PRIMARY KEY,
+    user_id integer NOT NULL,
+    recipient_id integer NOT NULL,
+    nickname text NOT NULL,
+    created_time timestamp NOT NULL default now(),
+    updated_time timestamp,
+
+    CONSTRAINT contact_user_id_recipient_id_key UNIQUE (user_id, recipient_id),
+    CONSTRAINT fk_contact_user_id FOREIGN KEY (user_id) REFERENCES "user"(id) ON DELETE CASCADE,
+    CONSTRAINT fk_contact_recipient_id FOREIGN KEY (recipient_id) REFERENCES "user"(id) ON DELETE CASCADE
+);
+
@@ -0,0 +1,5 @@
+ALTER TABLE transaction
+    DROP COLUMN IF EXISTS pocket_id,
+    DROP COLUMN IF EXISTS source_pocket_id;
+
+DROP TABLE IF EXISTS pocket;
@@ -0,0 +1,21 @@
+-- Money set aside by a User in IDR, out of "user".balance which only holds the money available to spend
+CREATE TABLE IF NOT EXISTS pocket (
+    id SERIAL PRIMARY KEY,
+    user_id integer NOT NULL,
+    name text NOT NULL,
+    balance decimal NOT NULL default 0,
+    target_amount decimal,
+    target_date date,
+    created_time timestamp NOT NULL default now(),
+    updated_time timestamp,
+
+    CONSTRAINT pocket_user_id_name_key UNIQUE (user_id, name),
+    CONSTRAINT pocket_balance_non_negative CHECK (balance >= 0),
+    CONSTRAINT fk_pocket_user_id FOREIGN KEY (user_id) REFERENCES "user"(id) ON DELETE CASCADE
+);
+
+-- PocketTransfer moves money from source_pocket_id to pocket_id, NULL being the main balance.
+-- Not a foreign key, so that emptied pockets can be deleted while their Transactions are kept.
+ALTER TABLE transaction
+    ADD COLUMN IF NOT EXISTS source_pocket_id integer,
+    ADD COLUMN IF NOT EXISTS pocket_id integer;
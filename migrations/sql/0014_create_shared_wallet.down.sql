@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS transaction_shared_wallet_id_created_time_idx;
+
+ALTER TABLE transaction
+    DROP COLUMN IF EXISTS shared_wallet_id;
+
+DROP TABLE IF EXISTS shared_wallet_member;
+DROP TABLE IF EXISTS shared_wallet;
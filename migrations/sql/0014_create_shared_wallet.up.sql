@@ -0,0 +1,35 @@
+-- IDR balance owned by a User, deposited into and spent by its members according to their role
+CREATE TABLE IF NOT EXISTS shared_wallet (
+    id SERIAL PRIMARY KEY,
+    owner_id integer NOT NULL,
+    name text NOT NULL,
+    balance decimal NOT NULL default 0,
+    created_time timestamp NOT NULL default now(),
+    updated_time timestamp,
+
+    CONSTRAINT shared_wallet_balance_non_negative CHECK (balance >= 0),
+    CONSTRAINT fk_shared_wallet_owner_id FOREIGN KEY (owner_id) REFERENCES "user"(id)
+);
+
+-- Limits are in IDR and only apply to Spenders, NULL being no limit
+CREATE TABLE IF NOT EXISTS shared_wallet_member (
+    shared_wallet_id integer NOT NULL,
+    user_id integer NOT NULL,
+    role text NOT NULL CONSTRAINT shared_wallet_member_role_valid CHECK (role IN ('Owner', 'Spender', 'Viewer')),
+    transaction_limit decimal,
+    monthly_limit decimal,
+    created_time timestamp NOT NULL default now(),
+    updated_time timestamp,
+
+    PRIMARY KEY (shared_wallet_id, user_id),
+    CONSTRAINT fk_shared_wallet_member_shared_wallet_id FOREIGN KEY (shared_wallet_id) REFERENCES shared_wallet(id) ON DELETE CASCADE,
+    CONSTRAINT fk_shared_wallet_member_user_id FOREIGN KEY (user_id) REFERENCES "user"(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS shared_wallet_member_user_id_idx ON shared_wallet_member (user_id);
+
+-- TransferOut from or SharedWalletDeposit into a shared wallet, user_id being the member acting on it
+ALTER TABLE transaction
+    ADD COLUMN IF NOT EXISTS shared_wallet_id integer CONSTRAINT fk_transaction_shared_wallet_id REFERENCES shared_wallet(id);
+
+CREATE INDEX IF NOT EXISTS transaction_shared_wallet_id_created_time_idx ON transaction (shared_wallet_id, created_time);
@@ -0,0 +1,3 @@
+-- Every User has its own hash chain, entries without a User are chained together, see InsertAuditLog.
+-- Looks up the last entry of a chain without scanning the entries of other Users.
+CREATE INDEX IF NOT EXISTS audit_log_chain_idx ON audit_log ((COALESCE(user_id, 0)), id);
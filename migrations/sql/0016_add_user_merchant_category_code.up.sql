@@ -0,0 +1,4 @@
+-- ISO 18245 merchant category code of a User verified as a merchant, NULL for personal wallets.
+-- Only set once the merchant is verified, QR payments to a merchant are Payments charged the merchant fee, see CreateUserQRPayment.
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS merchant_category_code text
+    CONSTRAINT user_merchant_category_code_check CHECK (merchant_category_code ~ '^[0-9]{4}$');
@@ -12,28 +12,77 @@ type TransactionType string
 const (
 	TransactionTypeTransferOut TransactionType = "TransferOut"
 	TransactionTypeTopUp       TransactionType = "TopUp"
+	TransactionTypePayment     TransactionType = "Payment" // Payment to a merchant, i.e. by scanning QR
+	TransactionTypeWithdrawal  TransactionType = "Withdrawal"
+	TransactionTypeConvert     TransactionType = "Convert" // Exchange between User's own balances of different currencies
+
+	// Move between User's main IDR balance and its Pockets, see Transaction.PocketID
+	TransactionTypePocketTransfer TransactionType = "PocketTransfer"
+
+	// Move from a member's IDR balance into a SharedWallet, see Transaction.SharedWalletID
+	TransactionTypeSharedWalletDeposit TransactionType = "SharedWalletDeposit"
+
+	// Balance corrected by an admin, see Transaction.ActorID
+	TransactionTypeAdjustmentCredit TransactionType = "AdjustmentCredit"
+	TransactionTypeAdjustmentDebit  TransactionType = "AdjustmentDebit"
+)
+
+type Currency string
+
+const (
+	CurrencyIDR Currency = "IDR" // Home currency, stored in user.balance
+	CurrencySGD Currency = "SGD"
+	CurrencyUSD Currency = "USD"
 )
 
+var SupportedCurrencies = []Currency{CurrencyIDR, CurrencySGD, CurrencyUSD}
+
+type Balance struct {
+	Currency Currency `db:"currency"`
+	Amount   float32  `db:"balance"`
+}
+
 type TransactionStatus string
 
 const (
 	TransactionStatusSuccessful TransactionStatus = "Successful"
 	TransactionStatusFailed     TransactionStatus = "Failed"
+	TransactionStatusPending    TransactionStatus = "Pending" // Waiting for an external party, i.e. disbursement gateway
+)
+
+type UserStatus string
+
+const (
+	UserStatusActive UserStatus = "Active"
+	UserStatusFrozen UserStatus = "Frozen" // Can log in and read, but not send
+	UserStatusClosed UserStatus = "Closed" // Can not log in, balance was zero or swept when closed
 )
 
 type User struct {
 	ID          int64      `db:"id"`
 	FullName    string     `db:"full_name"`
 	PhoneNumber string     `db:"phone_number"`
-	Balance     float32    `db:"balance"`
+	Balance     float32    `db:"balance"` // Balance in CurrencyIDR available to spend, Pockets excluded
 	Password    string     `db:"password"`
+	Status      UserStatus `db:"status"`
 	CreatedTime time.Time  `db:"created_time"`
 	UpdatedTime *time.Time `db:"updated_time"`
+
+	// MerchantCategoryCode is only set once User is verified as a merchant, empty for personal wallets
+	MerchantCategoryCode string `db:"merchant_category_code"`
+
+	Balances []Balance // Balance per currency, including CurrencyIDR
+	Pockets  []Pocket
 }
 
 type UserFilter struct {
-	UserID      int64  `db:"user_id"`
-	PhoneNumber string `db:"phone_number"`
+	UserID          int64     `db:"user_id"`
+	PhoneNumber     string    `db:"phone_number"`
+	FullName        string    `db:"full_name"` // Case-insensitive substring, "%" and "_" are matched literally
+	CreatedTimeFrom time.Time `db:"created_time"`
+	CreatedTimeTo   time.Time `db:"created_time"` // Exclusive
+	Limit           int       // No limit if zero
+	Offset          int
 }
 
 type Transaction struct {
@@ -46,7 +95,220 @@ type Transaction struct {
 	UpdatedTime *time.Time        `json:"updated_time,omitempty" db:"updated_time"` // Pointer to handle NULL values
 	Status      TransactionStatus `json:"status" db:"status"`
 	Description string            `json:"description" db:"description"`
+	Currency    Currency          `json:"currency" db:"currency"`
+	Fee         float32           `json:"fee" db:"fee"` // Charged on top of Amount, see fees package
 	Password    string
+
+	// TransferOut specific fields, alternatives to RecipientID resolved into it before the Transaction is performed
+	RecipientPhoneNumber string    `json:"-"`
+	RecipientToken       uuid.UUID `json:"-"` // ID of a RecipientLookup of the User
+
+	// Convert specific fields
+	FXQuoteID         uuid.UUID `json:"fx_quote_id,omitempty" db:"fx_quote_id"`
+	ConvertedCurrency Currency  `json:"converted_currency,omitempty" db:"converted_currency"`
+	ConvertedAmount   float32   `json:"converted_amount,omitempty" db:"converted_amount"`
+
+	// PocketTransfer specific fields, moving Amount from SourcePocketID to PocketID where zero is User's main balance
+	SourcePocketID int64 `json:"source_pocket_id,omitempty" db:"source_pocket_id"`
+	PocketID       int64 `json:"pocket_id,omitempty" db:"pocket_id"`
+
+	// TransferOut from or SharedWalletDeposit into a SharedWallet, UserID being the member acting on it. Zero for User's own balance.
+	SharedWalletID int64 `json:"shared_wallet_id,omitempty" db:"shared_wallet_id"`
+
+	// Withdrawal specific fields
+	BankAccountID         int64  `json:"bank_account_id,omitempty" db:"bank_account_id"`
+	DisbursementReference string `json:"disbursement_reference,omitempty" db:"disbursement_reference"`
+
+	ActorID int64 `json:"actor_id,omitempty" db:"actor_id"` // Admin who made the Transaction on the User's behalf, zero if the User did
+}
+
+type TransactionFilter struct {
+	UserID          int64             `db:"user_id"`
+	RecipientID     int64             `db:"recipient_id"`
+	ParticipantID   int64             // Either UserID or RecipientID, i.e. every Transaction sent or received by a User
+	SharedWalletID  int64             `db:"shared_wallet_id"`
+	PersonalOnly    bool              // Excludes Transactions made out of a SharedWallet
+	Type            TransactionType   `db:"type"`
+	Status          TransactionStatus `db:"status"`
+	CreatedTimeFrom time.Time         `db:"created_time"`
+	Limit           int               // No limit if zero, only used by GetTransactions
+	Offset          int
+}
+
+type UpdateTransactionRequest struct {
+	ID                    uuid.UUID
+	Status                TransactionStatus
+	DisbursementReference string
+	Description           string
+}
+
+type BankAccount struct {
+	ID            int64     `db:"id"`
+	UserID        int64     `db:"user_id"`
+	BankCode      string    `db:"bank_code"`
+	AccountNumber string    `db:"account_number"`
+	AccountName   string    `db:"account_name"`
+	CreatedTime   time.Time `db:"created_time"`
+}
+
+type BankAccountFilter struct {
+	BankAccountID int64 `db:"id"`
+	UserID        int64 `db:"user_id"`
+}
+
+type DisbursementStatus string
+
+const (
+	DisbursementStatusSuccessful DisbursementStatus = "Successful"
+	DisbursementStatusFailed     DisbursementStatus = "Failed"
+)
+
+// DisbursementResult is the final outcome of a Withdrawal reported by the disbursement gateway.
+type DisbursementResult struct {
+	TransactionID uuid.UUID
+	Reference     string
+	Status        DisbursementStatus
+	FailureReason string
+}
+
+type TopUpIntentStatus string
+
+const (
+	TopUpIntentStatusPending TopUpIntentStatus = "Pending"
+	TopUpIntentStatusPaid    TopUpIntentStatus = "Paid"
+	TopUpIntentStatusExpired TopUpIntentStatus = "Expired" // Never stored, Pending intents past ExpiryTime are reported as Expired
+)
+
+// TopUpIntent is a request to fund User's wallet by paying into a virtual account issued by the payment gateway.
+type TopUpIntent struct {
+	ID                   uuid.UUID         `db:"id"`
+	UserID               int64             `db:"user_id"`
+	Amount               float32           `db:"amount"`
+	BankCode             string            `db:"bank_code"`
+	VirtualAccountNumber string            `db:"virtual_account_number"`
+	Status               TopUpIntentStatus `db:"status"`
+	ExpiryTime           time.Time         `db:"expiry_time"`
+	PaymentReference     string            `db:"payment_reference"`
+	TransactionID        uuid.UUID         `db:"transaction_id"`
+	CreatedTime          time.Time         `db:"created_time"`
+	UpdatedTime          *time.Time        `db:"updated_time"`
+}
+
+// PhoneNumberMigration is the result of normalizing the phone numbers of existing Users into E.164
+type PhoneNumberMigration struct {
+	Users               int                         // Users read
+	Normalized          []PhoneNumberMigrationEntry // Changed, or to be changed by a dry run
+	Invalid             []PhoneNumberMigrationEntry // Not a valid phone number of an allowed country, to be fixed manually
+	Conflicts           []PhoneNumberMigrationEntry // Normalized phone number is registered to another User, to be fixed manually
+	ConstraintValidated bool                        // Every phone number is in E.164 and the DB now enforces it
+}
+
+type PhoneNumberMigrationEntry struct {
+	UserID int64
+	From   string
+	To     string // Empty if Invalid
+	Reason string
+}
+
+// PhoneNumberChange is a request of User to change its phone number, applied once the OTP sent to the new number is confirmed.
+// Only the hash of the OTP is kept.
+type PhoneNumberChange struct {
+	ID           uuid.UUID  `db:"id"`
+	UserID       int64      `db:"user_id"`
+	PhoneNumber  string     `db:"phone_number"` // New phone number
+	OTPHash      string     `db:"otp_hash"`
+	Attempts     int        `db:"attempts"` // Wrong OTPs confirmed so far
+	ExpiryTime   time.Time  `db:"expiry_time"`
+	VerifiedTime *time.Time `db:"verified_time"`
+	CreatedTime  time.Time  `db:"created_time"`
+}
+
+// RecipientLookup is a lookup of the Recipient of a transfer by phone number, its ID confirms the Recipient until ExpiryTime.
+// Lookups are kept, including those of unknown phone numbers, to rate-limit them per User.
+type RecipientLookup struct {
+	ID          uuid.UUID `db:"id"`
+	UserID      int64     `db:"user_id"`
+	RecipientID int64     `db:"recipient_id"` // Zero if no User has the phone number
+	PhoneNumber string    `db:"phone_number"`
+	ExpiryTime  time.Time `db:"expiry_time"`
+	CreatedTime time.Time `db:"created_time"`
+
+	RecipientName string `db:"-"` // Masked full name of the Recipient, i.e. "Jo** Do*"
+}
+
+// Contact is a Recipient saved by User to transfer to again.
+// RecipientName and RecipientStatus are the current ones of the Recipient, not those when the Contact was saved.
+type Contact struct {
+	ID              int64      `db:"id"`
+	UserID          int64      `db:"user_id"`
+	RecipientID     int64      `db:"recipient_id"`
+	Nickname        string     `db:"nickname"`
+	RecipientName   string     `db:"full_name"` // Masked like RecipientLookup.RecipientName once returned by the usecase
+	RecipientStatus UserStatus `db:"status"`
+	CreatedTime     time.Time  `db:"created_time"`
+	UpdatedTime     *time.Time `db:"updated_time"`
+}
+
+// ContactRequest saves a Contact, its Recipient is requested by only one of RecipientID, RecipientPhoneNumber and RecipientToken, like a TransferOut
+type ContactRequest struct {
+	UserID               int64
+	RecipientID          int64
+	RecipientPhoneNumber string
+	RecipientToken       uuid.UUID
+	Nickname             string
+}
+
+type ContactFilter struct {
+	ContactID int64 `db:"id"`
+	UserID    int64 `db:"user_id"`
+}
+
+type UpdateContactRequest struct {
+	ID       int64
+	UserID   int64
+	Nickname string
+}
+
+// ContactSuggestion is a Recipient of recent TransferOut of User who is not a Contact yet
+type ContactSuggestion struct {
+	RecipientID      int64     `db:"recipient_id"`
+	RecipientName    string    `db:"full_name"` // Masked like RecipientLookup.RecipientName once returned by the usecase
+	TransferCount    int       `db:"transfer_count"`
+	LastTransferTime time.Time `db:"last_transfer_time"`
+	Score            float64   `db:"score"` // Sum of the weight of each transfer, halved every ContactSuggestionFilter.HalfLife
+}
+
+type ContactSuggestionFilter struct {
+	UserID          int64
+	CreatedTimeFrom time.Time     // Only TransferOut since then are considered
+	HalfLife        time.Duration // Time after which a transfer weighs half as much
+	Limit           int
+}
+
+type UpdatePhoneNumberChangeRequest struct {
+	ID           uuid.UUID
+	Attempts     int
+	VerifiedTime time.Time // Unchanged if zero
+}
+
+type TopUpIntentFilter struct {
+	TopUpIntentID uuid.UUID `db:"id"`
+	UserID        int64     `db:"user_id"`
+}
+
+type UpdateTopUpIntentRequest struct {
+	ID               uuid.UUID
+	Status           TopUpIntentStatus
+	PaymentReference string
+	TransactionID    uuid.UUID
+}
+
+// TopUpPayment is a payment into a virtual account reported by the payment gateway.
+type TopUpPayment struct {
+	VirtualAccountNumber string
+	Amount               float32
+	PaymentReference     string // Unique per payment, used to detect duplicate callbacks
+	PaidTime             time.Time
 }
 
 type UpdateBalanceType string
@@ -56,12 +318,216 @@ const (
 	UpdateBalanceDecrement UpdateBalanceType = "Decrement"
 )
 
+// UpdateUserRequest updates User's balance, status or profile. Empty fields are unchanged.
+// Status and profile fields can not be combined with a non-IDR Balance, which is stored outside of "user".
 type UpdateUserRequest struct {
-	UserID  int64
-	Balance UpdateBalanceRequest
+	UserID      int64
+	Balance     UpdateBalanceRequest
+	Status      UserStatus
+	FullName    string
+	PhoneNumber string // Only once verified with an OTP sent to it, see PhoneNumberChange
 }
 
 type UpdateBalanceRequest struct {
-	Amount float32
-	Type   UpdateBalanceType
+	Amount   float32
+	Type     UpdateBalanceType
+	Currency Currency // Empty means CurrencyIDR
+}
+
+// Pocket is money set aside by User in CurrencyIDR, out of User's main balance so it can not be spent until moved back with a PocketTransfer
+type Pocket struct {
+	ID           int64      `db:"id"`
+	UserID       int64      `db:"user_id"`
+	Name         string     `db:"name"`
+	Balance      float32    `db:"balance"`
+	TargetAmount float32    `db:"target_amount"` // Zero if no target
+	TargetDate   *time.Time `db:"target_date"`   // Date only, nil if no target
+	CreatedTime  time.Time  `db:"created_time"`
+	UpdatedTime  *time.Time `db:"updated_time"`
+}
+
+type PocketFilter struct {
+	PocketID int64 `db:"id"`
+	UserID   int64 `db:"user_id"`
+}
+
+type SharedWalletRole string
+
+const (
+	SharedWalletRoleOwner   SharedWalletRole = "Owner"   // Created the SharedWallet, manages its members and is not limited
+	SharedWalletRoleSpender SharedWalletRole = "Spender" // Transfers out of the SharedWallet within its limits
+	SharedWalletRoleViewer  SharedWalletRole = "Viewer"  // Only views the SharedWallet and its activity
+)
+
+// SharedWallet is an IDR balance owned by a User, that its members deposit into and transfer out of according to their role
+type SharedWallet struct {
+	ID          int64      `db:"id"`
+	OwnerID     int64      `db:"owner_id"`
+	Name        string     `db:"name"`
+	Balance     float32    `db:"balance"`
+	CreatedTime time.Time  `db:"created_time"`
+	UpdatedTime *time.Time `db:"updated_time"`
+
+	Member SharedWalletMember // Membership of the User the SharedWallet is retrieved for
+}
+
+// SharedWalletFilter retrieves the SharedWallets UserID is a member of
+type SharedWalletFilter struct {
+	SharedWalletID int64 `db:"id"`
+	UserID         int64 `db:"user_id"`
+}
+
+// SharedWalletMember is a User allowed to act on a SharedWallet. Limits are in CurrencyIDR and only apply to SharedWalletRoleSpender.
+type SharedWalletMember struct {
+	SharedWalletID   int64            `db:"shared_wallet_id"`
+	UserID           int64            `db:"user_id"`
+	Role             SharedWalletRole `db:"role"`
+	TransactionLimit float32          `db:"transaction_limit"` // Maximum amount of a TransferOut, zero if no limit
+	MonthlyLimit     float32          `db:"monthly_limit"`     // Maximum amount of TransferOut in a calendar month, zero if no limit
+	MonthlySpent     float32          // Amount of successful TransferOut of the month, only set by the usecase
+	FullName         string           `db:"full_name"` // Masked like RecipientLookup.RecipientName once returned by the usecase
+	Status           UserStatus       `db:"status"`
+	CreatedTime      time.Time        `db:"created_time"`
+	UpdatedTime      *time.Time       `db:"updated_time"`
+}
+
+type SharedWalletMemberFilter struct {
+	SharedWalletID int64 `db:"shared_wallet_id"`
+	UserID         int64 `db:"user_id"`
+}
+
+// SharedWalletMemberRequest adds a member to a SharedWallet on behalf of its owner, the member is requested by only one of
+// PhoneNumber and Token like the Recipient of a TransferOut
+type SharedWalletMemberRequest struct {
+	SharedWalletID   int64
+	OwnerID          int64
+	PhoneNumber      string
+	Token            uuid.UUID // ID of a RecipientLookup of the owner
+	Role             SharedWalletRole
+	TransactionLimit float32
+	MonthlyLimit     float32
+}
+
+// UserStatusChange is a transition of User's status made by an admin, kept as an audit trail
+type UserStatusChange struct {
+	ID          int64      `db:"id"`
+	UserID      int64      `db:"user_id"`
+	ActorID     int64      `db:"actor_id"` // Admin making the change
+	FromStatus  UserStatus `db:"from_status"`
+	ToStatus    UserStatus `db:"to_status"`
+	Reason      string     `db:"reason"`
+	CreatedTime time.Time  `db:"created_time"`
+}
+
+type AuditAction string
+
+const (
+	AuditActionLogin             AuditAction = "user.login"
+	AuditActionBalanceUpdate     AuditAction = "balance.update" // Every balance mutation made by UpdateUser and UpdatePocketBalance
+	AuditActionBalanceAdjustment AuditAction = "admin.balance_adjustment"
+	AuditActionUserStatusChange  AuditAction = "admin.user_status_change"
+	AuditActionProfileUpdate     AuditAction = "user.profile_update"
+	AuditActionPhoneNumberChange AuditAction = "user.phone_number_change"
+)
+
+// AuditActor is who performs an action and from where, carried by the context of the request
+type AuditActor struct {
+	UserID    int64 // Zero if not authenticated, i.e. logging in
+	IP        string
+	UserAgent string
+}
+
+// AuditLogEntry is an append-only record of a security-sensitive action.
+// Hash covers every other field but ID, including PrevHash, the Hash of the previous entry, which chains entries together.
+type AuditLogEntry struct {
+	ID          int64             `db:"id"`
+	Action      AuditAction       `db:"action"`
+	UserID      int64             `db:"user_id"`  // User affected by the action, zero if unknown
+	ActorID     int64             `db:"actor_id"` // User performing the action, zero if unauthenticated or the system
+	IP          string            `db:"ip"`
+	UserAgent   string            `db:"user_agent"`
+	Details     map[string]string `db:"details"`
+	CreatedTime time.Time         `db:"created_time"`
+	PrevHash    string            `db:"prev_hash"` // Empty for the first entry
+	Hash        string            `db:"hash"`
+}
+
+type AuditLogFilter struct {
+	UserID          int64       `db:"user_id"`
+	ActorID         int64       `db:"actor_id"`
+	Action          AuditAction `db:"action"`
+	CreatedTimeFrom time.Time   `db:"created_time"`
+	CreatedTimeTo   time.Time   `db:"created_time"`
+	AfterID         int64       `db:"id"`
+	Limit           int         // No limit if zero
+	Offset          int
+}
+
+// AuditLogVerification is the result of walking the hash chain of the audit log
+type AuditLogVerification struct {
+	Entries  int64  // Entries verified, up to the broken one if any
+	LastHash string // Hash of the last valid entry of every User's chain, compare with a copy kept elsewhere to detect truncation
+	BrokenID int64  // ID of the first entry breaking the chain, zero if the chain is intact
+	Reason   string // Why BrokenID breaks the chain
+}
+
+// BalanceAdjustment corrects a User's balance by Amount, credited if positive and debited if negative.
+// Reason is mandatory and kept as the Transaction description.
+type BalanceAdjustment struct {
+	UserID   int64
+	ActorID  int64 // Admin making the adjustment
+	Amount   float32
+	Currency Currency // Empty means CurrencyIDR
+	Reason   string
+}
+
+// UpdateHouseAccountRequest moves money in or out of a house account, i.e. fees collected into fees.HouseRevenueAccount
+type UpdateHouseAccountRequest struct {
+	Account string
+	Balance UpdateBalanceRequest
+}
+
+type FXQuoteRequest struct {
+	UserID         int64
+	SourceCurrency Currency
+	TargetCurrency Currency
+	SourceAmount   float32
+}
+
+// FXQuote locks the rate to Convert SourceAmount into TargetAmount until ExpiryTime.
+// Fee is charged in SourceCurrency and already deducted before conversion.
+type FXQuote struct {
+	ID             uuid.UUID  `db:"id"`
+	UserID         int64      `db:"user_id"`
+	SourceCurrency Currency   `db:"source_currency"`
+	TargetCurrency Currency   `db:"target_currency"`
+	SourceAmount   float32    `db:"source_amount"`
+	Fee            float32    `db:"fee"`
+	Rate           float64    `db:"rate"`
+	TargetAmount   float32    `db:"target_amount"`
+	ExpiryTime     time.Time  `db:"expiry_time"`
+	TransactionID  uuid.UUID  `db:"transaction_id"` // Set once the quote is used by a Convert Transaction
+	CreatedTime    time.Time  `db:"created_time"`
+	UpdatedTime    *time.Time `db:"updated_time"`
+}
+
+type UpdateFXQuoteRequest struct {
+	ID            uuid.UUID
+	TransactionID uuid.UUID
+}
+
+type QRRequest struct {
+	UserID               int64
+	Amount               float32 // Generates a dynamic QR if > 0, otherwise a static QR
+	MerchantName         string
+	MerchantCategoryCode string
+	Description          string
+}
+
+type QRPayment struct {
+	UserID      int64
+	Payload     string
+	Amount      float32
+	Description string
+	Password    string
 }
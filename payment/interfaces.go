@@ -0,0 +1,38 @@
+// This file contains the interfaces for the payment gateway.
+// The payment gateway issues virtual accounts that Users pay into to top-up their wallet.
+// For testing purpose we will generate mock implementations of these
+// interfaces using mockgen. See the Makefile for more information.
+package payment
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Gateway issues virtual accounts for top-up.
+// Payments into a virtual account are reported later through the signed callback endpoint.
+type Gateway interface {
+	CreateVirtualAccount(ctx context.Context, request VirtualAccountRequest) (virtualAccount VirtualAccount, err error)
+}
+
+type VirtualAccountRequest struct {
+	ExternalID uuid.UUID // ID of the top-up intent, used as idempotency key by the gateway
+	Name       string    // Shown to the payer by the bank
+	Amount     float32   // Virtual account only accepts this exact amount
+	ExpiryTime time.Time
+}
+
+type VirtualAccount struct {
+	BankCode string
+	Number   string
+}
+
+// Callback is the payload the gateway sends to the callback endpoint when a virtual account is paid.
+type Callback struct {
+	VirtualAccountNumber string    `json:"virtual_account_number"`
+	Amount               float32   `json:"amount"`
+	PaymentReference     string    `json:"payment_reference"`
+	PaidTime             time.Time `json:"paid_time"`
+}
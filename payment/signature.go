@@ -0,0 +1,33 @@
+package payment
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// Sign computes the callback signature, i.e. hex encoded HMAC-SHA256 of the raw request body.
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify validates the callback signature in constant time.
+// The signature must be computed over the exact bytes received, so verify before decoding the body.
+func Verify(secret, body []byte, signature string) bool {
+	if len(secret) == 0 {
+		return false
+	}
+
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+
+	return hmac.Equal(mac.Sum(nil), expected)
+}
@@ -0,0 +1,70 @@
+package payment
+
+import "testing"
+
+func TestVerify(t *testing.T) {
+	var (
+		secret = []byte("secret")
+		body   = []byte(`{"virtual_account_number":"8808000000001234","amount":100000,"payment_reference":"PAY-1"}`)
+	)
+
+	tests := []struct {
+		name      string
+		secret    []byte
+		body      []byte
+		signature string
+		want      bool
+	}{
+		{
+			name:      "success",
+			secret:    secret,
+			body:      body,
+			signature: Sign(secret, body),
+			want:      true,
+		},
+		{
+			name:      "fail-tampered-body",
+			secret:    secret,
+			body:      []byte(`{"virtual_account_number":"8808000000001234","amount":900000,"payment_reference":"PAY-1"}`),
+			signature: Sign(secret, body),
+			want:      false,
+		},
+		{
+			name:      "fail-wrong-secret",
+			secret:    secret,
+			body:      body,
+			signature: Sign([]byte("guess"), body),
+			want:      false,
+		},
+		{
+			name:      "fail-not-hex",
+			secret:    secret,
+			body:      body,
+			signature: "not-a-signature",
+			want:      false,
+		},
+		{
+			name:      "fail-secret-not-configured",
+			secret:    nil,
+			body:      body,
+			signature: Sign(nil, body),
+			want:      false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := Verify(test.secret, test.body, test.signature); got != test.want {
+				t.Errorf("Verify() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSign(t *testing.T) {
+	// echo -n 'The quick brown fox jumps over the lazy dog' | openssl dgst -sha256 -hmac key
+	got := Sign([]byte("key"), []byte("The quick brown fox jumps over the lazy dog"))
+	if want := "f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8"; got != want {
+		t.Errorf("Sign() = %v, want %v", got, want)
+	}
+}
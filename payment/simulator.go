@@ -0,0 +1,97 @@
+package payment
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/WalletService/tracing"
+	"github.com/google/uuid"
+)
+
+const (
+	SignatureHeader = "X-Signature"
+
+	simulatorBankCode      = "BCA"
+	simulatorCompanyPrefix = "8808" // Company code the bank assigns to the wallet, prepended to every virtual account
+	simulatorNumberDigits  = 12
+)
+
+// Simulator is a local stand-in for the payment gateway.
+// It issues virtual accounts without calling any bank, and Pay sends the same signed callback
+// a real gateway sends when the payer transfers into the virtual account.
+type Simulator struct {
+	CallbackURL string
+	Secret      []byte
+	Client      *http.Client
+}
+
+func NewSimulator(callbackURL string, secret []byte) *Simulator {
+	return &Simulator{
+		CallbackURL: callbackURL,
+		Secret:      secret,
+		Client:      &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (s *Simulator) CreateVirtualAccount(ctx context.Context, request VirtualAccountRequest) (virtualAccount VirtualAccount, err error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1e12))
+	if err != nil {
+		return VirtualAccount{}, err
+	}
+
+	return VirtualAccount{
+		BankCode: simulatorBankCode,
+		Number:   fmt.Sprintf("%s%0*d", simulatorCompanyPrefix, simulatorNumberDigits, n),
+	}, nil
+}
+
+// Pay simulates the payer transferring amount into the virtual account.
+// PaymentReference is generated when empty, reuse a reference to simulate a duplicate callback.
+func (s *Simulator) Pay(ctx context.Context, callback Callback) (err error) {
+	if callback.PaymentReference == "" {
+		callback.PaymentReference = "SIM-" + uuid.New().String()
+	}
+	if callback.PaidTime.IsZero() {
+		callback.PaidTime = time.Now()
+	}
+
+	body, err := json.Marshal(callback)
+	if err != nil {
+		return err
+	}
+
+	ctx, span := tracing.Default().Start(ctx, "POST callback", tracing.SpanKindClient,
+		tracing.String("http.request.method", http.MethodPost),
+		tracing.String("payment.reference", callback.PaymentReference),
+	)
+	defer func() { span.End(err) }()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, s.CallbackURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(SignatureHeader, Sign(s.Secret, body))
+	// The wallet continues the trace of the payment
+	tracing.Inject(ctx, request.Header)
+
+	response, err := s.Client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode/100 != 2 {
+		responseBody, _ := io.ReadAll(response.Body)
+		return fmt.Errorf("callback rejected with status %d: %s", response.StatusCode, bytes.TrimSpace(responseBody))
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package payment
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestSimulator_Pay(t *testing.T) {
+	var received Callback
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if !Verify([]byte("secret"), body, r.Header.Get(SignatureHeader)) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		json.Unmarshal(body, &received)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	simulator := NewSimulator(server.URL, []byte("secret"))
+
+	virtualAccount, err := simulator.CreateVirtualAccount(context.Background(), VirtualAccountRequest{Amount: 100000})
+	if err != nil {
+		t.Fatalf("Simulator.CreateVirtualAccount() err = %v", err)
+	}
+	if len(virtualAccount.Number) != 16 || !strings.HasPrefix(virtualAccount.Number, simulatorCompanyPrefix) {
+		t.Errorf("Simulator.CreateVirtualAccount() number = %v", virtualAccount.Number)
+	}
+
+	if err := simulator.Pay(context.Background(), Callback{VirtualAccountNumber: virtualAccount.Number, Amount: 100000}); err != nil {
+		t.Fatalf("Simulator.Pay() err = %v", err)
+	}
+	if received.VirtualAccountNumber != virtualAccount.Number || received.Amount != 100000 || received.PaymentReference == "" {
+		t.Errorf("Simulator.Pay() callback = %+v", received)
+	}
+
+	// Callback signed with a different secret is rejected by the receiver
+	simulator.Secret = []byte("guess")
+	if err := simulator.Pay(context.Background(), Callback{VirtualAccountNumber: virtualAccount.Number, Amount: 100000}); err == nil {
+		t.Errorf("Simulator.Pay() should fail when the callback is rejected")
+	}
+}
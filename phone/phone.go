@@ -0,0 +1,150 @@
+// Package phone normalizes phone numbers typed by Users into E.164, i.e. "0812-3456-789" into "+628123456789".
+package phone
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	ErrInvalidCharacters   = errors.New("phone number should only contain numbers, spaces, dashes and a leading +")
+	ErrCountryNotSupported = errors.New("phone number country is not supported")
+	ErrInvalidNumber       = errors.New("phone number is not a valid mobile number")
+)
+
+// Country is the numbering metadata of a country, only mobile numbers are valid as OTPs are sent by SMS
+type Country struct {
+	Code        string // ISO 3166-1 alpha-2, i.e. "ID"
+	CallingCode string // Without "+", i.e. "62"
+	TrunkPrefix string // Dialed before national numbers, i.e. "0" of "0812...", empty if there is none
+	// Mobile matches the national significant number of mobile numbers, without the calling code nor trunk prefix
+	Mobile *regexp.Regexp
+}
+
+// Countries is the numbering metadata of every supported country, by Code.
+// Mobile patterns follow the metadata of libphonenumber.
+var Countries = map[string]Country{
+	"ID": {Code: "ID", CallingCode: "62", TrunkPrefix: "0", Mobile: regexp.MustCompile(`^8[1-9][0-9]{6,10}$`)},
+	"SG": {Code: "SG", CallingCode: "65", Mobile: regexp.MustCompile(`^(?:8[1-9]|9[0-8])[0-9]{6}$`)},
+	"MY": {Code: "MY", CallingCode: "60", TrunkPrefix: "0", Mobile: regexp.MustCompile(`^1(?:1[0-9]{8}|[02-9][0-9]{7})$`)},
+	"PH": {Code: "PH", CallingCode: "63", TrunkPrefix: "0", Mobile: regexp.MustCompile(`^9[0-9]{9}$`)},
+	"TH": {Code: "TH", CallingCode: "66", TrunkPrefix: "0", Mobile: regexp.MustCompile(`^[689][0-9]{8}$`)},
+	"VN": {Code: "VN", CallingCode: "84", TrunkPrefix: "0", Mobile: regexp.MustCompile(`^[35789][0-9]{8}$`)},
+	"AU": {Code: "AU", CallingCode: "61", TrunkPrefix: "0", Mobile: regexp.MustCompile(`^4[0-9]{8}$`)},
+	"GB": {Code: "GB", CallingCode: "44", TrunkPrefix: "0", Mobile: regexp.MustCompile(`^7[0-9]{9}$`)},
+	"US": {Code: "US", CallingCode: "1", TrunkPrefix: "1", Mobile: regexp.MustCompile(`^[2-9][0-9]{2}[2-9][0-9]{6}$`)},
+}
+
+// DefaultParser only accepts Indonesian numbers, which can be typed in local format
+var DefaultParser = &Parser{
+	home:    Countries["ID"],
+	allowed: map[string]bool{"ID": true},
+}
+
+// Parser normalizes phone numbers of an allowlist of countries into E.164.
+// Numbers without calling code are numbers of its home country.
+type Parser struct {
+	home    Country
+	allowed map[string]bool // By Code
+}
+
+// NewParser returns a Parser accepting numbers of countries, by Code, and treating numbers without calling code as numbers of home.
+// home is always accepted.
+func NewParser(home string, countries []string) (*Parser, error) {
+	p := &Parser{allowed: map[string]bool{}}
+
+	var ok bool
+	if p.home, ok = Countries[strings.ToUpper(home)]; !ok {
+		return nil, fmt.Errorf("unknown home country %q, supported countries are %s", home, supportedCodes())
+	}
+	p.allowed[p.home.Code] = true
+
+	for _, code := range countries {
+		country, ok := Countries[strings.ToUpper(strings.TrimSpace(code))]
+		if !ok {
+			return nil, fmt.Errorf("unknown country %q, supported countries are %s", code, supportedCodes())
+		}
+		p.allowed[country.Code] = true
+	}
+
+	return p, nil
+}
+
+// Normalize returns input in E.164, i.e. "+628123456789", accepting:
+//   - international numbers, i.e. "+62 812-3456-789" or "0062 812 3456 789"
+//   - numbers of the home country with its trunk prefix, i.e. "0812 3456 789", or its calling code without "+", i.e. "628123456789"
+//
+// Spaces, dashes, dots and parentheses are ignored.
+func (p *Parser) Normalize(input string) (string, error) {
+	number := strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '.', '(', ')':
+			return -1
+		}
+		return r
+	}, input)
+
+	international := false
+	if rest, ok := strings.CutPrefix(number, "+"); ok {
+		number, international = rest, true
+	} else if rest, ok := strings.CutPrefix(number, "00"); ok {
+		number, international = rest, true
+	}
+
+	if number == "" {
+		return "", ErrInvalidNumber
+	}
+	for _, c := range number {
+		if c < '0' || c > '9' {
+			return "", ErrInvalidCharacters
+		}
+	}
+
+	country := p.home
+	nationalNumber := number
+	if international {
+		var ok bool
+		if country, nationalNumber, ok = splitCallingCode(number); !ok {
+			return "", ErrCountryNotSupported
+		}
+	} else if rest, ok := strings.CutPrefix(number, country.CallingCode); ok && country.Mobile.MatchString(rest) {
+		nationalNumber = rest
+	}
+
+	if !p.allowed[country.Code] {
+		return "", ErrCountryNotSupported
+	}
+
+	// Local numbers start with the trunk prefix, which is often typed after the calling code too, i.e. "+62 0812..."
+	if country.TrunkPrefix != "" && !country.Mobile.MatchString(nationalNumber) {
+		nationalNumber = strings.TrimPrefix(nationalNumber, country.TrunkPrefix)
+	}
+	if !country.Mobile.MatchString(nationalNumber) {
+		return "", ErrInvalidNumber
+	}
+
+	return "+" + country.CallingCode + nationalNumber, nil
+}
+
+// splitCallingCode returns the Country of the calling code number starts with, and the rest of number.
+// Calling codes are prefix-free, so at most one Country matches.
+func splitCallingCode(number string) (Country, string, bool) {
+	for _, country := range Countries {
+		if rest, ok := strings.CutPrefix(number, country.CallingCode); ok {
+			return country, rest, true
+		}
+	}
+	return Country{}, "", false
+}
+
+func supportedCodes() string {
+	codes := make([]string, 0, len(Countries))
+	for code := range Countries {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return strings.Join(codes, ", ")
+}
@@ -0,0 +1,89 @@
+package phone
+
+import (
+	"testing"
+)
+
+func TestParser_Normalize(t *testing.T) {
+	parser, err := NewParser("ID", []string{"SG", "us"})
+	if err != nil {
+		t.Fatalf("NewParser() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr error
+	}{
+		{name: "e164", input: "+628123456789", want: "+628123456789"},
+		{name: "local", input: "08123456789", want: "+628123456789"},
+		{name: "local-dashes", input: "0812-3456-789", want: "+628123456789"},
+		{name: "calling-code-without-plus", input: "628123456789", want: "+628123456789"},
+		{name: "international-spaces-dashes", input: " +62 812-3456-7890 ", want: "+6281234567890"},
+		{name: "international-prefix", input: "0062 812 3456 789", want: "+628123456789"},
+		{name: "trunk-prefix-after-calling-code", input: "+62 0812 3456 789", want: "+628123456789"},
+		{name: "national-significant-number", input: "8123456789", want: "+628123456789"},
+		{name: "longest-mobile", input: "+62812345678901", want: "+62812345678901"},
+		{name: "allowed-country", input: "+65 9123 4567", want: "+6591234567"},
+		{name: "allowed-country-parentheses", input: "+1 (415) 555-2671", want: "+14155552671"},
+		{name: "fail-empty", input: "", wantErr: ErrInvalidNumber},
+		{name: "fail-plus-only", input: "+", wantErr: ErrInvalidNumber},
+		{name: "fail-letters", input: "0812345678a", wantErr: ErrInvalidCharacters},
+		{name: "fail-plus-inside", input: "62+8123456789", wantErr: ErrInvalidCharacters},
+		{name: "fail-too-short", input: "+62812345", wantErr: ErrInvalidNumber},
+		{name: "fail-too-long", input: "+628123456789012", wantErr: ErrInvalidNumber},
+		{name: "fail-landline", input: "021 5551234", wantErr: ErrInvalidNumber},
+		{name: "fail-not-allowed-country", input: "+60 12-345 6789", wantErr: ErrCountryNotSupported},
+		{name: "fail-unknown-calling-code", input: "+999 1234567", wantErr: ErrCountryNotSupported},
+		{name: "fail-invalid-for-country", input: "+65 6123 4567", wantErr: ErrInvalidNumber},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, gotErr := parser.Normalize(test.input)
+			if got != test.want {
+				t.Errorf("Parser.Normalize() got = %v, want %v", got, test.want)
+			}
+			if gotErr != test.wantErr {
+				t.Errorf("Parser.Normalize() gotErr = %v, wantErr %v", gotErr, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewParser(t *testing.T) {
+	tests := []struct {
+		name      string
+		home      string
+		countries []string
+		wantErr   bool
+	}{
+		{name: "home-only", home: "ID"},
+		{name: "lowercase", home: "id", countries: []string{" sg"}},
+		{name: "fail-unknown-home", home: "XX", wantErr: true},
+		{name: "fail-unknown-country", home: "ID", countries: []string{"SG", "XX"}, wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := NewParser(test.home, test.countries); (err != nil) != test.wantErr {
+				t.Errorf("NewParser() error = %v, wantErr %v", err, test.wantErr)
+			}
+		})
+	}
+}
+
+// TestCountries_CallingCodes verifies calling codes are prefix-free, as splitCallingCode relies on it
+func TestCountries_CallingCodes(t *testing.T) {
+	for code, country := range Countries {
+		if country.Code != code {
+			t.Errorf("Countries[%s].Code = %s", code, country.Code)
+		}
+		for otherCode, other := range Countries {
+			if otherCode != code && len(other.CallingCode) >= len(country.CallingCode) && other.CallingCode[:len(country.CallingCode)] == country.CallingCode {
+				t.Errorf("calling code %s of %s is a prefix of %s of %s", country.CallingCode, code, other.CallingCode, otherCode)
+			}
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package qr
+
+import "fmt"
+
+// crc16CCITT computes the CRC-16/CCITT-FALSE checksum (polynomial 0x1021, initial value 0xFFFF)
+// used by EMVCo merchant-presented QR codes.
+func crc16CCITT(data []byte) uint16 {
+	crc := uint16(0xFFFF)
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+// checksum returns the 4 uppercase hex characters of the CRC for the given payload.
+// The payload is expected to already end with the CRC ID and length, i.e. "6304".
+func checksum(payload string) string {
+	return fmt.Sprintf("%04X", crc16CCITT([]byte(payload)))
+}
@@ -0,0 +1,315 @@
+// Package qr encodes and decodes EMVCo merchant-presented QR payloads, i.e. QRIS.
+// A payload is a sequence of ID-length-value data objects terminated by a CRC16-CCITT checksum.
+//
+// References
+// 1. https://www.emvco.com/specifications/emv-qr-code-specification-for-payment-systems-emv-qrcps-merchant-presented-mode/
+package qr
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Root data object IDs
+const (
+	idPayloadFormatIndicator   = "00"
+	idPointOfInitiation        = "01"
+	idMerchantAccountFirst     = 26
+	idMerchantAccountLast      = 51
+	idMerchantCategoryCode     = "52"
+	idTransactionCurrency      = "53"
+	idTransactionAmount        = "54"
+	idCountryCode              = "58"
+	idMerchantName             = "59"
+	idMerchantCity             = "60"
+	idPostalCode               = "61"
+	idAdditionalDataField      = "62"
+	idCRC                      = "63"
+	payloadFormatIndicatorV1   = "01"
+	crcDataObjectHeader        = idCRC + "04"
+	crcDataObjectHeaderLength  = len(crcDataObjectHeader)
+	crcDataObjectLength        = crcDataObjectHeaderLength + 4
+	maxMerchantNameLength      = 25
+	maxMerchantCityLength      = 15
+	defaultTransactionCurrency = "360" // ISO 4217 numeric code for IDR
+	defaultCountryCode         = "ID"
+)
+
+// Merchant account information template IDs
+const (
+	idMerchantAccountGloballyUniqueID = "00"
+	idMerchantAccountPAN              = "01"
+	idMerchantAccountMerchantID       = "02"
+	idMerchantAccountCriteria         = "03"
+)
+
+// Additional data field template IDs
+const (
+	idAdditionalBillNumber     = "01"
+	idAdditionalReferenceLabel = "05"
+	idAdditionalTerminalLabel  = "07"
+	idAdditionalPurpose        = "08"
+)
+
+var (
+	ErrInvalidChecksum = errors.New("qr: invalid checksum")
+	ErrMalformed       = errors.New("qr: malformed payload")
+)
+
+type PointOfInitiation string
+
+const (
+	// PointOfInitiationStatic is used when the same QR is shown for every transaction and the payer enters the amount.
+	PointOfInitiationStatic PointOfInitiation = "11"
+	// PointOfInitiationDynamic is used when a new QR is shown for every transaction and includes the amount.
+	PointOfInitiationDynamic PointOfInitiation = "12"
+)
+
+type MerchantAccount struct {
+	ID               string // 26 to 51
+	GloballyUniqueID string // Reverse domain name of the acquirer, i.e. ID.CO.QRIS.WWW
+	PAN              string
+	MerchantID       string
+	Criteria         string
+}
+
+type AdditionalData struct {
+	BillNumber     string
+	ReferenceLabel string
+	TerminalLabel  string
+	Purpose        string
+}
+
+type Payload struct {
+	PointOfInitiation    PointOfInitiation
+	MerchantAccounts     []MerchantAccount
+	MerchantCategoryCode string
+	TransactionCurrency  string
+	TransactionAmount    float32 // Only set for dynamic payloads
+	CountryCode          string
+	MerchantName         string
+	MerchantCity         string
+	PostalCode           string
+	AdditionalData       AdditionalData
+}
+
+// IsDynamic returns whether the payload carries a transaction amount.
+func (p Payload) IsDynamic() bool {
+	return p.PointOfInitiation == PointOfInitiationDynamic
+}
+
+// MerchantAccount returns the merchant account information with the given globally unique ID.
+func (p Payload) MerchantAccount(globallyUniqueID string) (MerchantAccount, bool) {
+	for _, account := range p.MerchantAccounts {
+		if account.GloballyUniqueID == globallyUniqueID {
+			return account, true
+		}
+	}
+	return MerchantAccount{}, false
+}
+
+// Encode validates the payload and serializes it, including the trailing CRC.
+func Encode(p Payload) (string, error) {
+	if err := validate(p); err != nil {
+		return "", err
+	}
+
+	if p.TransactionCurrency == "" {
+		p.TransactionCurrency = defaultTransactionCurrency
+	}
+	if p.CountryCode == "" {
+		p.CountryCode = defaultCountryCode
+	}
+
+	tlvs := []tlv{
+		{ID: idPayloadFormatIndicator, Value: payloadFormatIndicatorV1},
+		{ID: idPointOfInitiation, Value: string(p.PointOfInitiation)},
+	}
+
+	for _, account := range p.MerchantAccounts {
+		value, err := encodeTLVs([]tlv{
+			{ID: idMerchantAccountGloballyUniqueID, Value: account.GloballyUniqueID},
+			{ID: idMerchantAccountPAN, Value: account.PAN},
+			{ID: idMerchantAccountMerchantID, Value: account.MerchantID},
+			{ID: idMerchantAccountCriteria, Value: account.Criteria},
+		})
+		if err != nil {
+			return "", err
+		}
+		tlvs = append(tlvs, tlv{ID: account.ID, Value: value})
+	}
+
+	additionalData, err := encodeTLVs([]tlv{
+		{ID: idAdditionalBillNumber, Value: p.AdditionalData.BillNumber},
+		{ID: idAdditionalReferenceLabel, Value: p.AdditionalData.ReferenceLabel},
+		{ID: idAdditionalTerminalLabel, Value: p.AdditionalData.TerminalLabel},
+		{ID: idAdditionalPurpose, Value: p.AdditionalData.Purpose},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var amount string
+	if p.IsDynamic() {
+		amount = formatAmount(p.TransactionAmount)
+	}
+
+	tlvs = append(tlvs,
+		tlv{ID: idMerchantCategoryCode, Value: p.MerchantCategoryCode},
+		tlv{ID: idTransactionCurrency, Value: p.TransactionCurrency},
+		tlv{ID: idTransactionAmount, Value: amount},
+		tlv{ID: idCountryCode, Value: p.CountryCode},
+		tlv{ID: idMerchantName, Value: p.MerchantName},
+		tlv{ID: idMerchantCity, Value: p.MerchantCity},
+		tlv{ID: idPostalCode, Value: p.PostalCode},
+		tlv{ID: idAdditionalDataField, Value: additionalData},
+	)
+
+	payload, err := encodeTLVs(tlvs)
+	if err != nil {
+		return "", err
+	}
+
+	payload += crcDataObjectHeader
+	return payload + checksum(payload), nil
+}
+
+// Parse verifies the CRC of a scanned payload and decodes it.
+// Data objects that are not used by the wallet, i.e. tip indicator or language template, are ignored.
+func Parse(input string) (p Payload, err error) {
+	input = strings.TrimSpace(input)
+
+	if len(input) < crcDataObjectLength || input[len(input)-crcDataObjectLength:len(input)-4] != crcDataObjectHeader {
+		return p, fmt.Errorf("%w: missing CRC", ErrMalformed)
+	}
+
+	if !strings.EqualFold(checksum(input[:len(input)-4]), input[len(input)-4:]) {
+		return p, ErrInvalidChecksum
+	}
+
+	tlvs, err := decodeTLVs(input[:len(input)-crcDataObjectLength])
+	if err != nil {
+		return p, fmt.Errorf("%w: %v", ErrMalformed, err)
+	}
+
+	var payloadFormatIndicator, amount string
+	for _, t := range tlvs {
+		switch t.ID {
+		case idPayloadFormatIndicator:
+			payloadFormatIndicator = t.Value
+		case idPointOfInitiation:
+			p.PointOfInitiation = PointOfInitiation(t.Value)
+		case idMerchantCategoryCode:
+			p.MerchantCategoryCode = t.Value
+		case idTransactionCurrency:
+			p.TransactionCurrency = t.Value
+		case idTransactionAmount:
+			amount = t.Value
+		case idCountryCode:
+			p.CountryCode = t.Value
+		case idMerchantName:
+			p.MerchantName = t.Value
+		case idMerchantCity:
+			p.MerchantCity = t.Value
+		case idPostalCode:
+			p.PostalCode = t.Value
+		case idAdditionalDataField:
+			fields, err := decodeTLVMap(t.Value)
+			if err != nil {
+				return Payload{}, fmt.Errorf("%w: additional data field: %v", ErrMalformed, err)
+			}
+			p.AdditionalData = AdditionalData{
+				BillNumber:     fields[idAdditionalBillNumber],
+				ReferenceLabel: fields[idAdditionalReferenceLabel],
+				TerminalLabel:  fields[idAdditionalTerminalLabel],
+				Purpose:        fields[idAdditionalPurpose],
+			}
+		default:
+			if id, err := strconv.Atoi(t.ID); err == nil && id >= idMerchantAccountFirst && id <= idMerchantAccountLast {
+				fields, err := decodeTLVMap(t.Value)
+				if err != nil {
+					return Payload{}, fmt.Errorf("%w: merchant account %s: %v", ErrMalformed, t.ID, err)
+				}
+				p.MerchantAccounts = append(p.MerchantAccounts, MerchantAccount{
+					ID:               t.ID,
+					GloballyUniqueID: fields[idMerchantAccountGloballyUniqueID],
+					PAN:              fields[idMerchantAccountPAN],
+					MerchantID:       fields[idMerchantAccountMerchantID],
+					Criteria:         fields[idMerchantAccountCriteria],
+				})
+			}
+		}
+	}
+
+	if payloadFormatIndicator != payloadFormatIndicatorV1 {
+		return Payload{}, fmt.Errorf("%w: unsupported payload format indicator %q", ErrMalformed, payloadFormatIndicator)
+	}
+
+	if amount != "" {
+		parsedAmount, err := strconv.ParseFloat(amount, 32)
+		if err != nil || parsedAmount <= 0 {
+			return Payload{}, fmt.Errorf("%w: invalid transaction amount %q", ErrMalformed, amount)
+		}
+		p.TransactionAmount = float32(parsedAmount)
+	}
+
+	// Point of initiation is optional in the specification, so we infer it from the presence of amount
+	if p.PointOfInitiation == "" {
+		p.PointOfInitiation = PointOfInitiationStatic
+		if amount != "" {
+			p.PointOfInitiation = PointOfInitiationDynamic
+		}
+	}
+
+	if p.IsDynamic() && amount == "" {
+		return Payload{}, fmt.Errorf("%w: dynamic payload is missing transaction amount", ErrMalformed)
+	}
+
+	return p, nil
+}
+
+func validate(p Payload) error {
+	switch p.PointOfInitiation {
+	case PointOfInitiationStatic:
+		if p.TransactionAmount != 0 {
+			return errors.New("qr: static payload should not have transaction amount")
+		}
+	case PointOfInitiationDynamic:
+		if p.TransactionAmount <= 0 {
+			return errors.New("qr: dynamic payload should have transaction amount > 0")
+		}
+	default:
+		return fmt.Errorf("qr: unknown point of initiation %q", p.PointOfInitiation)
+	}
+
+	if len(p.MerchantAccounts) == 0 {
+		return errors.New("qr: at least one merchant account is required")
+	}
+	for _, account := range p.MerchantAccounts {
+		if id, err := strconv.Atoi(account.ID); err != nil || len(account.ID) != 2 || id < idMerchantAccountFirst || id > idMerchantAccountLast {
+			return fmt.Errorf("qr: merchant account ID should be between %d and %d", idMerchantAccountFirst, idMerchantAccountLast)
+		}
+		if account.GloballyUniqueID == "" {
+			return errors.New("qr: merchant account globally unique ID is required")
+		}
+	}
+
+	if len(p.MerchantCategoryCode) != 4 {
+		return errors.New("qr: merchant category code should be 4 digits")
+	}
+	if p.MerchantName == "" || len([]rune(p.MerchantName)) > maxMerchantNameLength {
+		return fmt.Errorf("qr: merchant name should be 1 to %d characters", maxMerchantNameLength)
+	}
+	if p.MerchantCity == "" || len([]rune(p.MerchantCity)) > maxMerchantCityLength {
+		return fmt.Errorf("qr: merchant city should be 1 to %d characters", maxMerchantCityLength)
+	}
+
+	return nil
+}
+
+// formatAmount formats amount without trailing zeros, i.e. 10000 or 23.72, as recommended by EMVCo.
+func formatAmount(amount float32) string {
+	return strconv.FormatFloat(float64(amount), 'f', -1, 32)
+}
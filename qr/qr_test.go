@@ -0,0 +1,235 @@
+package qr
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+const (
+	// Sample payload from the EMVCo merchant-presented mode specification
+	emvcoSamplePayload = "00020101021229300012D156000000000510A93FO3230Q31280012D15600000001030812345678520441115802CN5914BEST TRANSPORT6007BEIJING64200002ZH0104最佳运输0202北京540523.7253031565502016233030412340603***0708A60086670902ME91320016A0112233449988770708123456786304A13A"
+
+	walletStaticPayload  = "00020101021126620023ID.CO.WALLETSERVICE.WWW01199360099900000000001020110303UMI5204482953033605802ID5905name16007JAKARTA63047E85"
+	walletDynamicPayload = "00020101021226620023ID.CO.WALLETSERVICE.WWW01199360099900000000001020110303UMI52045812530336054062500005802ID5912Warung Makan6007JAKARTA61051219062280507INV-0010813Traktir Makan63041EC8"
+)
+
+var (
+	walletMerchantAccount = MerchantAccount{
+		ID:               "26",
+		GloballyUniqueID: "ID.CO.WALLETSERVICE.WWW",
+		PAN:              "9360099900000000001",
+		MerchantID:       "1",
+		Criteria:         "UMI",
+	}
+
+	walletStatic = Payload{
+		PointOfInitiation:    PointOfInitiationStatic,
+		MerchantAccounts:     []MerchantAccount{walletMerchantAccount},
+		MerchantCategoryCode: "4829",
+		TransactionCurrency:  "360",
+		CountryCode:          "ID",
+		MerchantName:         "name1",
+		MerchantCity:         "JAKARTA",
+	}
+
+	walletDynamic = Payload{
+		PointOfInitiation:    PointOfInitiationDynamic,
+		MerchantAccounts:     []MerchantAccount{walletMerchantAccount},
+		MerchantCategoryCode: "5812",
+		TransactionCurrency:  "360",
+		TransactionAmount:    250000,
+		CountryCode:          "ID",
+		MerchantName:         "Warung Makan",
+		MerchantCity:         "JAKARTA",
+		PostalCode:           "12190",
+		AdditionalData: AdditionalData{
+			ReferenceLabel: "INV-001",
+			Purpose:        "Traktir Makan",
+		},
+	}
+)
+
+func Test_crc16CCITT(t *testing.T) {
+	// Standard check value of CRC-16/CCITT-FALSE
+	if got := crc16CCITT([]byte("123456789")); got != 0x29B1 {
+		t.Errorf("crc16CCITT() = %04X, want 29B1", got)
+	}
+}
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       Payload
+		wantPayload string
+		wantErr     bool
+	}{
+		{
+			name:        "success-static",
+			input:       walletStatic,
+			wantPayload: walletStaticPayload,
+		},
+		{
+			name:        "success-dynamic",
+			input:       walletDynamic,
+			wantPayload: walletDynamicPayload,
+		},
+		{
+			name: "fail-static-with-amount",
+			input: func() Payload {
+				p := walletStatic
+				p.TransactionAmount = 1000
+				return p
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "fail-dynamic-without-amount",
+			input: func() Payload {
+				p := walletDynamic
+				p.TransactionAmount = 0
+				return p
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "fail-missing-merchant-account",
+			input: func() Payload {
+				p := walletStatic
+				p.MerchantAccounts = nil
+				return p
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "fail-merchant-name-too-long",
+			input: func() Payload {
+				p := walletStatic
+				p.MerchantName = "A Very Long Merchant Name That Overflows"
+				return p
+			}(),
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gotPayload, gotErr := Encode(test.input)
+			if gotPayload != test.wantPayload {
+				t.Errorf("qr.Encode() gotPayload = %v, wantPayload %v", gotPayload, test.wantPayload)
+			}
+			if (gotErr != nil) != test.wantErr {
+				t.Errorf("qr.Encode() gotErr = %v, wantErr %v", gotErr, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		wantPayload Payload
+		wantErr     error
+	}{
+		{
+			name:  "success-emvco-sample",
+			input: emvcoSamplePayload,
+			wantPayload: Payload{
+				PointOfInitiation: PointOfInitiationDynamic,
+				MerchantAccounts: []MerchantAccount{
+					{ID: "29", GloballyUniqueID: "D15600000000"},
+					{ID: "31", GloballyUniqueID: "D15600000001", Criteria: "12345678"},
+				},
+				MerchantCategoryCode: "4111",
+				TransactionCurrency:  "156",
+				TransactionAmount:    23.72,
+				CountryCode:          "CN",
+				MerchantName:         "BEST TRANSPORT",
+				MerchantCity:         "BEIJING",
+				AdditionalData: AdditionalData{
+					TerminalLabel: "A6008667",
+				},
+			},
+		},
+		{
+			name:        "success-static",
+			input:       walletStaticPayload,
+			wantPayload: walletStatic,
+		},
+		{
+			name:        "success-dynamic",
+			input:       walletDynamicPayload,
+			wantPayload: walletDynamic,
+		},
+		{
+			name:        "success-lowercase-crc",
+			input:       walletStaticPayload[:len(walletStaticPayload)-4] + "7e85",
+			wantPayload: walletStatic,
+		},
+		{
+			name:    "fail-tampered-amount",
+			input:   "00020101021226620023ID.CO.WALLETSERVICE.WWW01199360099900000000001020110303UMI52045812530336054069500005802ID5912Warung Makan6007JAKARTA61051219062280507INV-0010813Traktir Makan63041EC8",
+			wantErr: ErrInvalidChecksum,
+		},
+		{
+			name:    "fail-missing-crc",
+			input:   "000201010211",
+			wantErr: ErrMalformed,
+		},
+		{
+			name: "fail-truncated-data-object",
+			input: func() string {
+				payload := "00020101021" + crcDataObjectHeader
+				return payload + checksum(payload)
+			}(),
+			wantErr: ErrMalformed,
+		},
+		{
+			name: "fail-invalid-length",
+			input: func() string {
+				payload := "0002010102115999" + crcDataObjectHeader
+				return payload + checksum(payload)
+			}(),
+			wantErr: ErrMalformed,
+		},
+		{
+			name: "fail-dynamic-without-amount",
+			input: func() string {
+				payload := "0002010102125802ID" + crcDataObjectHeader
+				return payload + checksum(payload)
+			}(),
+			wantErr: ErrMalformed,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gotPayload, gotErr := Parse(test.input)
+			if !reflect.DeepEqual(gotPayload, test.wantPayload) {
+				t.Errorf("qr.Parse() gotPayload = %+v, wantPayload %+v", gotPayload, test.wantPayload)
+			}
+			if !errors.Is(gotErr, test.wantErr) {
+				t.Errorf("qr.Parse() gotErr = %v, wantErr %v", gotErr, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestEncodeParseRoundTrip(t *testing.T) {
+	for _, input := range []Payload{walletStatic, walletDynamic} {
+		payload, err := Encode(input)
+		if err != nil {
+			t.Fatalf("qr.Encode() err = %v", err)
+		}
+
+		got, err := Parse(payload)
+		if err != nil {
+			t.Fatalf("qr.Parse() err = %v", err)
+		}
+
+		if !reflect.DeepEqual(got, input) {
+			t.Errorf("qr.Parse(qr.Encode()) = %+v, want %+v", got, input)
+		}
+	}
+}
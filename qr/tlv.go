@@ -0,0 +1,78 @@
+package qr
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// tlv is a single EMVCo data object: a 2-digit ID, a 2-digit length and a value.
+// Lengths are counted in characters (not bytes) as mandated by the EMVCo specification.
+type tlv struct {
+	ID    string
+	Value string
+}
+
+func (t tlv) String() string {
+	return fmt.Sprintf("%s%02d%s", t.ID, len([]rune(t.Value)), t.Value)
+}
+
+// encodeTLVs concatenates data objects, skipping the ones with empty value.
+func encodeTLVs(tlvs []tlv) (string, error) {
+	var sb strings.Builder
+	for _, t := range tlvs {
+		if t.Value == "" {
+			continue
+		}
+		if len([]rune(t.Value)) > 99 {
+			return "", fmt.Errorf("value of ID %s is longer than 99 characters", t.ID)
+		}
+		sb.WriteString(t.String())
+	}
+	return sb.String(), nil
+}
+
+// decodeTLVs splits input into data objects, keeping the order they appear in.
+func decodeTLVs(input string) ([]tlv, error) {
+	var (
+		runes  = []rune(input)
+		result []tlv
+	)
+
+	for i := 0; i < len(runes); {
+		if i+4 > len(runes) {
+			return nil, errors.New("truncated data object header")
+		}
+
+		id := string(runes[i : i+2])
+		length, err := strconv.Atoi(string(runes[i+2 : i+4]))
+		if err != nil || length < 0 {
+			return nil, fmt.Errorf("invalid length for ID %s", id)
+		}
+		i += 4
+
+		if i+length > len(runes) {
+			return nil, fmt.Errorf("value of ID %s exceeds payload length", id)
+		}
+
+		result = append(result, tlv{ID: id, Value: string(runes[i : i+length])})
+		i += length
+	}
+
+	return result, nil
+}
+
+// decodeTLVMap decodes a nested template into a map keyed by ID.
+func decodeTLVMap(input string) (map[string]string, error) {
+	tlvs, err := decodeTLVs(input)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string, len(tlvs))
+	for _, t := range tlvs {
+		result[t.ID] = t.Value
+	}
+	return result, nil
+}
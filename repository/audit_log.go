@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/WalletService/model"
+)
+
+// auditLogLockID identifies the Postgres advisory locks serializing appends to the audit log, along with the User of the chain, see queryLockAuditLog
+const auditLogLockID int32 = 4242044
+
+type auditActorContextKey struct{}
+
+// ContextWithAuditActor returns a copy of ctx carrying who performs the request, recorded by InsertAuditLog
+func ContextWithAuditActor(ctx context.Context, actor model.AuditActor) context.Context {
+	return context.WithValue(ctx, auditActorContextKey{}, actor)
+}
+
+// AuditActorFromContext returns who performs the request carried by ctx, empty if not a request, i.e. a disbursement result
+func AuditActorFromContext(ctx context.Context) model.AuditActor {
+	actor, _ := ctx.Value(auditActorContextKey{}).(model.AuditActor)
+	return actor
+}
+
+// HashAuditLogEntry returns the SHA-256 of every field of the entry but ID and Hash, in hex.
+// PrevHash is included, so changing, removing or reordering an entry breaks the chain of its User from that entry on.
+func HashAuditLogEntry(entry model.AuditLogEntry) string {
+	// Struct fields and map keys are marshalled in a fixed order, so the content is the same when read back
+	content, _ := json.Marshal(struct {
+		PrevHash    string            `json:"prev_hash"`
+		Action      model.AuditAction `json:"action"`
+		UserID      int64             `json:"user_id"`
+		ActorID     int64             `json:"actor_id"`
+		IP          string            `json:"ip"`
+		UserAgent   string            `json:"user_agent"`
+		Details     map[string]string `json:"details"`
+		CreatedTime string            `json:"created_time"`
+	}{
+		PrevHash:    entry.PrevHash,
+		Action:      entry.Action,
+		UserID:      entry.UserID,
+		ActorID:     entry.ActorID,
+		IP:          entry.IP,
+		UserAgent:   entry.UserAgent,
+		Details:     auditLogDetails(entry.Details),
+		CreatedTime: entry.CreatedTime.UTC().Format(time.RFC3339Nano),
+	})
+
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// HashAuditLogHeads returns the SHA-256 of the last entry hash of every User's chain in hex, empty if there is no entry.
+// Removing the trailing entries of any chain changes it, so it is compared with a copy kept elsewhere to detect truncation.
+func HashAuditLogHeads(heads map[int64]string) string {
+	if len(heads) == 0 {
+		return ""
+	}
+
+	userIDs := make([]int64, 0, len(heads))
+	for userID := range heads {
+		userIDs = append(userIDs, userID)
+	}
+	sort.Slice(userIDs, func(i, j int) bool { return userIDs[i] < userIDs[j] })
+
+	hash := sha256.New()
+	for _, userID := range userIDs {
+		fmt.Fprintf(hash, "%d:%s\n", userID, heads[userID])
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// auditLogDetails returns details as stored, no details are stored as an empty object
+func auditLogDetails(details map[string]string) map[string]string {
+	if details == nil {
+		return map[string]string{}
+	}
+	return details
+}
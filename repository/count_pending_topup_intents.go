@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/WalletService/model"
+)
+
+// CountPendingTopUpIntents counts the TopUpIntents of User that can still be paid at the given time
+func (r *Repository) CountPendingTopUpIntents(ctx context.Context, userID int64, now time.Time) (count int, err error) {
+	err = r.executor(ctx).QueryRowContext(ctx, queryCountPendingTopUpIntents, userID, model.TopUpIntentStatusPending, now).Scan(&count)
+
+	return
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// CountPhoneNumberChanges counts the phone number changes requested by User since the given time, verified or not
+func (r *Repository) CountPhoneNumberChanges(ctx context.Context, userID int64, since time.Time) (count int, err error) {
+	err = r.executor(ctx).QueryRowContext(ctx, queryCountPhoneNumberChanges, userID, since).Scan(&count)
+
+	return
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// CountRecipientLookups counts the lookups made by User since the given time, including those of unknown phone numbers
+func (r *Repository) CountRecipientLookups(ctx context.Context, userID int64, since time.Time) (count int, err error) {
+	err = r.executor(ctx).QueryRowContext(ctx, queryCountRecipientLookups, userID, since).Scan(&count)
+
+	return
+}
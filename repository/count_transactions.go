@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/WalletService/model"
+)
+
+func (r *Repository) CountTransactions(ctx context.Context, request model.TransactionFilter) (count int, err error) {
+	query, params := buildQueryCountTransactions(request)
+
+	err = r.executor(ctx).QueryRowContext(ctx, query, params...).Scan(&count)
+
+	return
+}
+
+func buildQueryCountTransactions(in model.TransactionFilter) (string, []interface{}) {
+	return whereTransactions(queryCountTransactions, in)
+}
+
+// whereTransactions appends the conditions of TransactionFilter to query, shared by CountTransactions, SumTransactions and GetTransactions
+func whereTransactions(query string, in model.TransactionFilter) (string, []interface{}) {
+	var (
+		params []interface{}
+		offset int = 0
+	)
+
+	if in.UserID != 0 {
+		query += fmt.Sprintf(whereTransactionUserID, offset+1)
+		params = append(params, in.UserID)
+		offset++
+	}
+
+	if in.RecipientID != 0 {
+		query += fmt.Sprintf(whereTransactionRecipientID, offset+1)
+		params = append(params, in.RecipientID)
+		offset++
+	}
+
+	if in.ParticipantID != 0 {
+		query += fmt.Sprintf(whereTransactionParticipantID, offset+1)
+		params = append(params, in.ParticipantID)
+		offset++
+	}
+
+	if in.SharedWalletID != 0 {
+		query += fmt.Sprintf(whereTransactionSharedWalletID, offset+1)
+		params = append(params, in.SharedWalletID)
+		offset++
+	}
+
+	if in.PersonalOnly {
+		query += whereTransactionPersonal
+	}
+
+	if in.Type != "" {
+		query += fmt.Sprintf(whereTransactionType, offset+1)
+		params = append(params, in.Type)
+		offset++
+	}
+
+	if in.Status != "" {
+		query += fmt.Sprintf(whereTransactionStatus, offset+1)
+		params = append(params, in.Status)
+		offset++
+	}
+
+	if !in.CreatedTimeFrom.IsZero() {
+		query += fmt.Sprintf(whereTransactionCreatedTimeFrom, offset+1)
+		params = append(params, in.CreatedTimeFrom)
+		offset++
+	}
+
+	return query, params
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/WalletService/model"
+)
+
+func Test_whereTransactions(t *testing.T) {
+	createdTimeFrom := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		input      model.TransactionFilter
+		wantQuery  string
+		wantParams []interface{}
+	}{
+		{
+			name:       "no-filter",
+			input:      model.TransactionFilter{},
+			wantQuery:  queryCountTransactions,
+			wantParams: nil,
+		},
+		{
+			name:       "user-and-type",
+			input:      model.TransactionFilter{UserID: 123, Type: model.TransactionTypeTransferOut},
+			wantQuery:  queryCountTransactions + " AND user_id = $1 AND type = $2",
+			wantParams: []interface{}{int64(123), model.TransactionTypeTransferOut},
+		},
+		{
+			name:       "participant-sent-or-received",
+			input:      model.TransactionFilter{ParticipantID: 123, Status: model.TransactionStatusSuccessful, CreatedTimeFrom: createdTimeFrom},
+			wantQuery:  queryCountTransactions + " AND (user_id = $1 OR recipient_id = $1) AND status = $2 AND created_time >= $3",
+			wantParams: []interface{}{int64(123), model.TransactionStatusSuccessful, createdTimeFrom},
+		},
+		{
+			name:       "user-personal-only",
+			input:      model.TransactionFilter{UserID: 123, PersonalOnly: true, Type: model.TransactionTypeTransferOut},
+			wantQuery:  queryCountTransactions + " AND user_id = $1 AND shared_wallet_id IS NULL AND type = $2",
+			wantParams: []interface{}{int64(123), model.TransactionTypeTransferOut},
+		},
+		{
+			name:       "shared-wallet",
+			input:      model.TransactionFilter{SharedWalletID: 77, Type: model.TransactionTypeTransferOut},
+			wantQuery:  queryCountTransactions + " AND shared_wallet_id = $1 AND type = $2",
+			wantParams: []interface{}{int64(77), model.TransactionTypeTransferOut},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gotQuery, gotParams := whereTransactions(queryCountTransactions, test.input)
+			if gotQuery != test.wantQuery {
+				t.Errorf("whereTransactions() query = %v, wantQuery %v", gotQuery, test.wantQuery)
+			}
+			if !reflect.DeepEqual(gotParams, test.wantParams) {
+				t.Errorf("whereTransactions() params = %v, wantParams %v", gotParams, test.wantParams)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+)
+
+func (r *Repository) DeleteContact(ctx context.Context, userID, contactID int64) error {
+	result, err := r.executor(ctx).ExecContext(ctx, queryDeleteContact, contactID, userID)
+	if err != nil {
+		return err
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	// No rows deleted means contact does not exist or belongs to another User
+	if affectedRows == 0 {
+		return ErrContactNotFound
+	}
+
+	return nil
+}
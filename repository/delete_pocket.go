@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+)
+
+func (r *Repository) DeletePocket(ctx context.Context, userID, pocketID int64) error {
+	result, err := r.executor(ctx).ExecContext(ctx, queryDeletePocket, pocketID, userID)
+	if err != nil {
+		return err
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	// No rows deleted means pocket does not exist or belongs to another User
+	if affectedRows == 0 {
+		return ErrPocketNotFound
+	}
+
+	return nil
+}
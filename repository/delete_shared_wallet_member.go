@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+)
+
+func (r *Repository) DeleteSharedWalletMember(ctx context.Context, sharedWalletID, userID int64) error {
+	result, err := r.executor(ctx).ExecContext(ctx, queryDeleteSharedWalletMember, sharedWalletID, userID)
+	if err != nil {
+		return err
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	// No rows deleted means User is not a member of the shared wallet
+	if affectedRows == 0 {
+		return ErrSharedWalletMemberNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/WalletService/model"
+)
+
+// GetAuditLog lists audit log entries matching the filter, in the order they were appended
+func (r *Repository) GetAuditLog(ctx context.Context, request model.AuditLogFilter) (entries []model.AuditLogEntry, err error) {
+	query, params := buildQueryGetAuditLog(request)
+
+	rows, err := r.executor(ctx).QueryContext(ctx, query, params...)
+	if err != nil {
+		return []model.AuditLogEntry{}, err
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			entry   model.AuditLogEntry
+			details string
+		)
+
+		if err := rows.Scan(
+			&entry.ID,
+			&entry.Action,
+			&entry.UserID,
+			&entry.ActorID,
+			&entry.IP,
+			&entry.UserAgent,
+			&details,
+			&entry.CreatedTime,
+			&entry.PrevHash,
+			&entry.Hash,
+		); err != nil {
+			return []model.AuditLogEntry{}, err
+		}
+
+		// Details that can not be read back are left empty, the entry then fails verification
+		json.Unmarshal([]byte(details), &entry.Details)
+
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
+
+func buildQueryGetAuditLog(in model.AuditLogFilter) (string, []interface{}) {
+	var (
+		query  = querySelectAuditLog
+		params []interface{}
+		offset int = 0
+	)
+
+	if in.UserID != 0 {
+		query += fmt.Sprintf(whereAuditLogUserID, offset+1)
+		params = append(params, in.UserID)
+		offset++
+	}
+
+	if in.ActorID != 0 {
+		query += fmt.Sprintf(whereAuditLogActorID, offset+1)
+		params = append(params, in.ActorID)
+		offset++
+	}
+
+	if in.Action != "" {
+		query += fmt.Sprintf(whereAuditLogAction, offset+1)
+		params = append(params, in.Action)
+		offset++
+	}
+
+	if !in.CreatedTimeFrom.IsZero() {
+		query += fmt.Sprintf(whereAuditLogCreatedFrom, offset+1)
+		params = append(params, in.CreatedTimeFrom)
+		offset++
+	}
+
+	if !in.CreatedTimeTo.IsZero() {
+		query += fmt.Sprintf(whereAuditLogCreatedTo, offset+1)
+		params = append(params, in.CreatedTimeTo)
+		offset++
+	}
+
+	if in.AfterID != 0 {
+		query += fmt.Sprintf(whereAuditLogAfterID, offset+1)
+		params = append(params, in.AfterID)
+		offset++
+	}
+
+	query += orderAuditLogByID
+	return paginate(query, params, in.Limit, in.Offset)
+}
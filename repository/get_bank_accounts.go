@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/WalletService/model"
+)
+
+func (r *Repository) GetBankAccounts(ctx context.Context, request model.BankAccountFilter) (bankAccounts []model.BankAccount, err error) {
+	query, params := buildQueryGetBankAccounts(request)
+
+	rows, err := r.executor(ctx).QueryContext(ctx, query, params...)
+	if err != nil {
+		return []model.BankAccount{}, err
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		bankAccount := model.BankAccount{}
+
+		if err := rows.Scan(
+			&bankAccount.ID,
+			&bankAccount.UserID,
+			&bankAccount.BankCode,
+			&bankAccount.AccountNumber,
+			&bankAccount.AccountName,
+			&bankAccount.CreatedTime,
+		); err != nil {
+			return []model.BankAccount{}, err
+		}
+
+		bankAccounts = append(bankAccounts, bankAccount)
+	}
+
+	return bankAccounts, rows.Err()
+}
+
+func buildQueryGetBankAccounts(in model.BankAccountFilter) (string, []interface{}) {
+	var (
+		query  string = querySelectBankAccounts
+		params []interface{}
+		offset int = 0
+	)
+
+	if in.BankAccountID != 0 {
+		query += fmt.Sprintf(whereBankAccountID, offset+1)
+		params = append(params, in.BankAccountID)
+		offset++
+	}
+
+	if in.UserID != 0 {
+		query += fmt.Sprintf(whereBankAccountUserID, offset+1)
+		params = append(params, in.UserID)
+		offset++
+	}
+
+	query += orderBankAccountsByIDDesc
+
+	return query, params
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/WalletService/model"
+)
+
+func (r *Repository) GetContactSuggestions(ctx context.Context, request model.ContactSuggestionFilter) (suggestions []model.ContactSuggestion, err error) {
+	rows, err := r.executor(ctx).QueryContext(
+		ctx,
+		querySelectContactSuggestions,
+		request.UserID,
+		time.Now(),
+		request.HalfLife.Seconds(),
+		request.CreatedTimeFrom,
+		model.TransactionTypeTransferOut,
+		model.TransactionStatusSuccessful,
+		model.UserStatusClosed,
+		request.Limit,
+	)
+	if err != nil {
+		return []model.ContactSuggestion{}, err
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		suggestion := model.ContactSuggestion{}
+
+		if err := rows.Scan(
+			&suggestion.RecipientID,
+			&suggestion.RecipientName,
+			&suggestion.TransferCount,
+			&suggestion.LastTransferTime,
+			&suggestion.Score,
+		); err != nil {
+			return []model.ContactSuggestion{}, err
+		}
+
+		suggestions = append(suggestions, suggestion)
+	}
+
+	return suggestions, rows.Err()
+}
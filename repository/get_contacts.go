@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/WalletService/model"
+)
+
+func (r *Repository) GetContacts(ctx context.Context, request model.ContactFilter) (contacts []model.Contact, err error) {
+	query, params := buildQueryGetContacts(request)
+
+	rows, err := r.executor(ctx).QueryContext(ctx, query, params...)
+	if err != nil {
+		return []model.Contact{}, err
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		contact := model.Contact{}
+
+		if err := rows.Scan(
+			&contact.ID,
+			&contact.UserID,
+			&contact.RecipientID,
+			&contact.Nickname,
+			&contact.RecipientName,
+			&contact.RecipientStatus,
+			&contact.CreatedTime,
+			&contact.UpdatedTime,
+		); err != nil {
+			return []model.Contact{}, err
+		}
+
+		contacts = append(contacts, contact)
+	}
+
+	return contacts, rows.Err()
+}
+
+func buildQueryGetContacts(in model.ContactFilter) (string, []interface{}) {
+	var (
+		query  string = querySelectContacts
+		params []interface{}
+		offset int = 0
+	)
+
+	if in.ContactID != 0 {
+		query += fmt.Sprintf(whereContactID, offset+1)
+		params = append(params, in.ContactID)
+		offset++
+	}
+
+	if in.UserID != 0 {
+		query += fmt.Sprintf(whereContactUserID, offset+1)
+		params = append(params, in.UserID)
+		offset++
+	}
+
+	query += orderContactsByNickname
+
+	return query, params
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/WalletService/model"
+)
+
+func (r *Repository) GetPockets(ctx context.Context, request model.PocketFilter) (pockets []model.Pocket, err error) {
+	query, params := buildQueryGetPockets(request)
+
+	rows, err := r.executor(ctx).QueryContext(ctx, query, params...)
+	if err != nil {
+		return []model.Pocket{}, err
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		pocket := model.Pocket{}
+
+		if err := rows.Scan(
+			&pocket.ID,
+			&pocket.UserID,
+			&pocket.Name,
+			&pocket.Balance,
+			&pocket.TargetAmount,
+			&pocket.TargetDate,
+			&pocket.CreatedTime,
+			&pocket.UpdatedTime,
+		); err != nil {
+			return []model.Pocket{}, err
+		}
+
+		pockets = append(pockets, pocket)
+	}
+
+	return pockets, rows.Err()
+}
+
+func buildQueryGetPockets(in model.PocketFilter) (string, []interface{}) {
+	var (
+		query  string = querySelectPockets
+		params []interface{}
+		offset int = 0
+	)
+
+	if in.PocketID != 0 {
+		query += fmt.Sprintf(wherePocketID, offset+1)
+		params = append(params, in.PocketID)
+		offset++
+	}
+
+	if in.UserID != 0 {
+		query += fmt.Sprintf(wherePocketUserID, offset+1)
+		params = append(params, in.UserID)
+		offset++
+	}
+
+	query += orderPocketsByID
+
+	return query, params
+}
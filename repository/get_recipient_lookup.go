@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/WalletService/model"
+	"github.com/google/uuid"
+)
+
+var ErrRecipientLookupNotFound = errors.New("recipient lookup not found")
+
+func (r *Repository) GetRecipientLookup(ctx context.Context, lookupID uuid.UUID) (lookup model.RecipientLookup, err error) {
+	err = r.executor(ctx).QueryRowContext(ctx, queryGetRecipientLookup, lookupID).Scan(
+		&lookup.ID,
+		&lookup.UserID,
+		&lookup.RecipientID,
+		&lookup.PhoneNumber,
+		&lookup.ExpiryTime,
+		&lookup.CreatedTime,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return lookup, ErrRecipientLookupNotFound
+	}
+
+	return lookup, err
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/WalletService/model"
+)
+
+// GetSharedWalletMembers lists members in the order they were added, with their current name and status
+func (r *Repository) GetSharedWalletMembers(ctx context.Context, request model.SharedWalletMemberFilter) (members []model.SharedWalletMember, err error) {
+	query, params := buildQueryGetSharedWalletMembers(request)
+
+	rows, err := r.executor(ctx).QueryContext(ctx, query, params...)
+	if err != nil {
+		return []model.SharedWalletMember{}, err
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		member := model.SharedWalletMember{}
+
+		if err := rows.Scan(
+			&member.SharedWalletID,
+			&member.UserID,
+			&member.Role,
+			&member.TransactionLimit,
+			&member.MonthlyLimit,
+			&member.FullName,
+			&member.Status,
+			&member.CreatedTime,
+			&member.UpdatedTime,
+		); err != nil {
+			return []model.SharedWalletMember{}, err
+		}
+
+		members = append(members, member)
+	}
+
+	return members, rows.Err()
+}
+
+func buildQueryGetSharedWalletMembers(in model.SharedWalletMemberFilter) (string, []interface{}) {
+	var (
+		query  string = querySelectSharedWalletMembers
+		params []interface{}
+		offset int = 0
+	)
+
+	if in.SharedWalletID != 0 {
+		query += fmt.Sprintf(whereSharedWalletMemberWalletID, offset+1)
+		params = append(params, in.SharedWalletID)
+		offset++
+	}
+
+	if in.UserID != 0 {
+		query += fmt.Sprintf(whereSharedWalletMemberUserID, offset+1)
+		params = append(params, in.UserID)
+		offset++
+	}
+
+	query += orderSharedWalletMembersByCreation
+
+	return query, params
+}
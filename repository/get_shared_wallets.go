@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/WalletService/model"
+)
+
+// GetSharedWallets lists the SharedWallets User is a member of, with User's membership
+func (r *Repository) GetSharedWallets(ctx context.Context, request model.SharedWalletFilter) (wallets []model.SharedWallet, err error) {
+	query, params := buildQueryGetSharedWallets(request)
+
+	rows, err := r.executor(ctx).QueryContext(ctx, query, params...)
+	if err != nil {
+		return []model.SharedWallet{}, err
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		wallet := model.SharedWallet{}
+
+		if err := rows.Scan(
+			&wallet.ID,
+			&wallet.OwnerID,
+			&wallet.Name,
+			&wallet.Balance,
+			&wallet.CreatedTime,
+			&wallet.UpdatedTime,
+			&wallet.Member.SharedWalletID,
+			&wallet.Member.UserID,
+			&wallet.Member.Role,
+			&wallet.Member.TransactionLimit,
+			&wallet.Member.MonthlyLimit,
+			&wallet.Member.CreatedTime,
+			&wallet.Member.UpdatedTime,
+		); err != nil {
+			return []model.SharedWallet{}, err
+		}
+
+		wallets = append(wallets, wallet)
+	}
+
+	return wallets, rows.Err()
+}
+
+func buildQueryGetSharedWallets(in model.SharedWalletFilter) (string, []interface{}) {
+	var (
+		query  string        = querySelectSharedWallets
+		params []interface{} = []interface{}{in.UserID}
+		offset int           = 1
+	)
+
+	if in.SharedWalletID != 0 {
+		query += fmt.Sprintf(whereSharedWalletID, offset+1)
+		params = append(params, in.SharedWalletID)
+		offset++
+	}
+
+	query += orderSharedWalletsByID
+
+	return query, params
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/WalletService/model"
+	"github.com/google/uuid"
+)
+
+func (r *Repository) GetTopUpIntents(ctx context.Context, request model.TopUpIntentFilter) (intents []model.TopUpIntent, err error) {
+	query, params := buildQueryGetTopUpIntents(request)
+
+	rows, err := r.executor(ctx).QueryContext(ctx, query, params...)
+	if err != nil {
+		return []model.TopUpIntent{}, err
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		intent := model.TopUpIntent{}
+
+		if err := rows.Scan(
+			&intent.ID,
+			&intent.UserID,
+			&intent.Amount,
+			&intent.BankCode,
+			&intent.VirtualAccountNumber,
+			&intent.Status,
+			&intent.ExpiryTime,
+			&intent.PaymentReference,
+			&intent.TransactionID,
+			&intent.CreatedTime,
+			&intent.UpdatedTime,
+		); err != nil {
+			return []model.TopUpIntent{}, err
+		}
+
+		intents = append(intents, intent)
+	}
+
+	return intents, rows.Err()
+}
+
+func buildQueryGetTopUpIntents(in model.TopUpIntentFilter) (string, []interface{}) {
+	var (
+		query  string = querySelectTopUpIntents
+		params []interface{}
+		offset int = 0
+	)
+
+	if in.TopUpIntentID != uuid.Nil {
+		query += fmt.Sprintf(whereTopUpIntentID, offset+1)
+		params = append(params, in.TopUpIntentID)
+		offset++
+	}
+
+	if in.UserID != 0 {
+		query += fmt.Sprintf(whereTopUpIntentUserID, offset+1)
+		params = append(params, in.UserID)
+		offset++
+	}
+
+	query += orderTopUpIntentsByTime
+
+	return query, params
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/WalletService/model"
+)
+
+// GetTransactions lists Transactions matching the filter, most recent first
+func (r *Repository) GetTransactions(ctx context.Context, request model.TransactionFilter) (transactions []model.Transaction, err error) {
+	query, params := buildQueryGetTransactions(request)
+
+	rows, err := r.executor(ctx).QueryContext(ctx, query, params...)
+	if err != nil {
+		return []model.Transaction{}, err
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		transaction := model.Transaction{}
+
+		if err := rows.Scan(
+			&transaction.ID,
+			&transaction.UserID,
+			&transaction.Amount,
+			&transaction.Type,
+			&transaction.RecipientID,
+			&transaction.Status,
+			&transaction.Description,
+			&transaction.CreatedTime,
+			&transaction.UpdatedTime,
+			&transaction.BankAccountID,
+			&transaction.DisbursementReference,
+			&transaction.Currency,
+			&transaction.Fee,
+			&transaction.ActorID,
+			&transaction.SourcePocketID,
+			&transaction.PocketID,
+			&transaction.SharedWalletID,
+		); err != nil {
+			return []model.Transaction{}, err
+		}
+
+		transactions = append(transactions, transaction)
+	}
+
+	return transactions, rows.Err()
+}
+
+func buildQueryGetTransactions(in model.TransactionFilter) (string, []interface{}) {
+	query, params := whereTransactions(querySelectTransactions, in)
+
+	query += orderTransactionsByTimeDesc
+	return paginate(query, params, in.Limit, in.Offset)
+}
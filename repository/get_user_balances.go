@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/WalletService/model"
+)
+
+// GetUserBalances returns User's balances other than IDR, see "user".balance for IDR balance
+func (r *Repository) GetUserBalances(ctx context.Context, userID int64) (balances []model.Balance, err error) {
+	rows, err := r.executor(ctx).QueryContext(ctx, querySelectUserBalances, userID)
+	if err != nil {
+		return []model.Balance{}, err
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		balance := model.Balance{}
+
+		if err := rows.Scan(
+			&balance.Currency,
+			&balance.Amount,
+		); err != nil {
+			return []model.Balance{}, err
+		}
+
+		balances = append(balances, balance)
+	}
+
+	return balances, rows.Err()
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+)
+
+// GetUserPermissions lists the permissions granted by every role of User, a User without roles has none
+func (r *Repository) GetUserPermissions(ctx context.Context, userID int64) (permissions []string, err error) {
+	rows, err := r.executor(ctx).QueryContext(ctx, querySelectUserPermissions, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var permission string
+		if err := rows.Scan(&permission); err != nil {
+			return nil, err
+		}
+
+		permissions = append(permissions, permission)
+	}
+
+	return permissions, rows.Err()
+}
@@ -5,10 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/WalletService/model"
 )
 
+var ErrUserNotFound = errors.New("user not found")
+
 func (r *Repository) GetUser(ctx context.Context, userID int64) (user model.User, err error) {
 	users, err := r.GetUsers(ctx, model.UserFilter{UserID: userID})
 	if err != nil {
@@ -16,7 +19,7 @@ func (r *Repository) GetUser(ctx context.Context, userID int64) (user model.User
 	}
 
 	if len(users) == 0 {
-		return user, errors.New("user not found")
+		return user, ErrUserNotFound
 	}
 
 	return users[0], nil
@@ -28,7 +31,7 @@ func (r *Repository) GetUsers(ctx context.Context, request model.UserFilter) (us
 		return []model.User{}, err
 	}
 
-	rows, err := r.exec.QueryContext(ctx, query, params...)
+	rows, err := r.executor(ctx).QueryContext(ctx, query, params...)
 	if err != nil {
 		return []model.User{}, err
 	}
@@ -43,8 +46,10 @@ func (r *Repository) GetUsers(ctx context.Context, request model.UserFilter) (us
 			&user.PhoneNumber,
 			&user.Balance,
 			&user.Password,
+			&user.Status,
 			&user.CreatedTime,
 			&user.UpdatedTime,
+			&user.MerchantCategoryCode,
 		); err != nil {
 			return []model.User{}, err
 		}
@@ -80,5 +85,49 @@ func buildQueryGetUsers(in model.UserFilter) (string, []interface{}, error) {
 		offset++
 	}
 
+	if in.FullName != "" {
+		query += fmt.Sprintf(whereUserFullName, offset+1)
+		params = append(params, escapeLike(in.FullName))
+		offset++
+	}
+
+	if !in.CreatedTimeFrom.IsZero() {
+		query += fmt.Sprintf(whereUserCreatedFrom, offset+1)
+		params = append(params, in.CreatedTimeFrom)
+		offset++
+	}
+
+	if !in.CreatedTimeTo.IsZero() {
+		query += fmt.Sprintf(whereUserCreatedTo, offset+1)
+		params = append(params, in.CreatedTimeTo)
+		offset++
+	}
+
+	query += orderUsersByID
+	query, params = paginate(query, params, in.Limit, in.Offset)
+
 	return query, params, nil
 }
+
+// likeEscaper escapes the wildcards of a LIKE pattern with the backslash declared by its ESCAPE clause
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLike returns the input matched literally inside a LIKE pattern, so a search of "50%" does not match every name starting with "50"
+func escapeLike(input string) string {
+	return likeEscaper.Replace(input)
+}
+
+// paginate appends LIMIT and OFFSET to a query ending with its ORDER BY, limit of zero means no limit
+func paginate(query string, params []interface{}, limit, offset int) (string, []interface{}) {
+	if limit > 0 {
+		params = append(params, limit)
+		query += fmt.Sprintf(limitF, len(params))
+	}
+
+	if offset > 0 {
+		params = append(params, offset)
+		query += fmt.Sprintf(offsetF, len(params))
+	}
+
+	return query, params
+}
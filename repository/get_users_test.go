@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/WalletService/model"
+)
+
+func Test_buildQueryGetUsers(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      model.UserFilter
+		wantQuery  string
+		wantParams []interface{}
+	}{
+		{
+			name:       "no-filter",
+			input:      model.UserFilter{},
+			wantQuery:  querySelectUsers + " ORDER BY id",
+			wantParams: nil,
+		},
+		{
+			name:       "full-name",
+			input:      model.UserFilter{FullName: "Budi"},
+			wantQuery:  querySelectUsers + " AND full_name ILIKE '%' || $1 || '%' ESCAPE '\\' ORDER BY id",
+			wantParams: []interface{}{"Budi"},
+		},
+		{
+			name:       "full-name-wildcards-escaped",
+			input:      model.UserFilter{FullName: `50%_off\`},
+			wantQuery:  querySelectUsers + " AND full_name ILIKE '%' || $1 || '%' ESCAPE '\\' ORDER BY id",
+			wantParams: []interface{}{`50\%\_off\\`},
+		},
+		{
+			name:       "phone-number-full-name-and-pagination",
+			input:      model.UserFilter{PhoneNumber: "+6281234567890", FullName: "_", Limit: 10, Offset: 20},
+			wantQuery:  querySelectUsers + " AND phone_number = $1 AND full_name ILIKE '%' || $2 || '%' ESCAPE '\\' ORDER BY id LIMIT $3 OFFSET $4",
+			wantParams: []interface{}{"+6281234567890", `\_`, 10, 20},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gotQuery, gotParams, err := buildQueryGetUsers(test.input)
+			if err != nil {
+				t.Fatalf("buildQueryGetUsers() error = %v", err)
+			}
+			if gotQuery != test.wantQuery {
+				t.Errorf("buildQueryGetUsers() query = %v, wantQuery %v", gotQuery, test.wantQuery)
+			}
+			if !reflect.DeepEqual(gotParams, test.wantParams) {
+				t.Errorf("buildQueryGetUsers() params = %v, wantParams %v", gotParams, test.wantParams)
+			}
+		})
+	}
+}
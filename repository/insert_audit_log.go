@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/WalletService/model"
+)
+
+// InsertAuditLog appends the entry to the audit log, chained to the last entry of the same User.
+// Every User has its own chain, so only the DB txs auditing the same User wait for each other, entries without a User share one chain.
+// Balance mutations lock their Users in ID order before auditing them, so two DB txs never wait for each other's chain.
+// It runs within the DB tx carried by ctx, so the entry is only kept if the action is committed, or in its own DB tx otherwise.
+// Actor, IP and user agent are taken from ctx, see ContextWithAuditActor, unless set on the entry.
+func (r *Repository) InsertAuditLog(ctx context.Context, entry model.AuditLogEntry) (inserted model.AuditLogEntry, err error) {
+	tx, ok := TxFromContext(ctx)
+	if !ok {
+		if tx, err = r.db.BeginTx(ctx, nil); err != nil {
+			return model.AuditLogEntry{}, err
+		}
+		defer func() {
+			if err != nil {
+				tx.Rollback()
+				return
+			}
+			err = tx.Commit()
+		}()
+	}
+
+	actor := AuditActorFromContext(ctx)
+	if entry.ActorID == 0 {
+		entry.ActorID = actor.UserID
+	}
+	if entry.IP == "" {
+		entry.IP = actor.IP
+	}
+	if entry.UserAgent == "" {
+		entry.UserAgent = actor.UserAgent
+	}
+	entry.Details = auditLogDetails(entry.Details)
+	// Postgres keeps microseconds, the hash must be computed on the time read back
+	entry.CreatedTime = time.Now().UTC().Truncate(time.Microsecond)
+
+	// Held until the DB tx ends, the last entry of User can not change until this one is committed or rolled back
+	if _, err = tx.ExecContext(ctx, queryLockAuditLog, auditLogLockID, entry.UserID); err != nil {
+		return model.AuditLogEntry{}, err
+	}
+
+	// First entry of User has no previous hash
+	if err = tx.QueryRowContext(ctx, querySelectLastAuditLogHash, entry.UserID).Scan(&entry.PrevHash); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return model.AuditLogEntry{}, err
+	}
+
+	details, err := json.Marshal(entry.Details)
+	if err != nil {
+		return model.AuditLogEntry{}, err
+	}
+	entry.Hash = HashAuditLogEntry(entry)
+
+	if err = tx.QueryRowContext(ctx, queryInsertAuditLog,
+		entry.Action,
+		entry.UserID,
+		entry.ActorID,
+		entry.IP,
+		entry.UserAgent,
+		string(details),
+		entry.CreatedTime,
+		entry.PrevHash,
+		entry.Hash,
+	).Scan(&entry.ID); err != nil {
+		return model.AuditLogEntry{}, err
+	}
+
+	return entry, nil
+}
+
+// auditBalanceUpdate appends a balance mutation made by UpdateUser to the audit log, within the same DB tx as the mutation
+func (r *Repository) auditBalanceUpdate(ctx context.Context, in model.UpdateUserRequest) error {
+	currency := in.Balance.Currency
+	if currency == "" {
+		currency = model.CurrencyIDR
+	}
+
+	_, err := r.InsertAuditLog(ctx, model.AuditLogEntry{
+		Action: model.AuditActionBalanceUpdate,
+		UserID: in.UserID,
+		Details: map[string]string{
+			"type":     string(in.Balance.Type),
+			"amount":   strconv.FormatFloat(float64(in.Balance.Amount), 'f', -1, 32),
+			"currency": string(currency),
+		},
+	})
+	return err
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/WalletService/model"
+)
+
+func (r *Repository) InsertBankAccount(ctx context.Context, bankAccount model.BankAccount) (bankAccountID int64, err error) {
+	err = r.executor(ctx).QueryRowContext(
+		ctx,
+		queryInsertBankAccount,
+		bankAccount.UserID,
+		bankAccount.BankCode,
+		bankAccount.AccountNumber,
+		bankAccount.AccountName,
+		time.Now(),
+	).Scan(&bankAccountID)
+
+	return
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/WalletService/model"
+)
+
+// InsertContact violates the unique constraint if the Recipient is already a Contact of User, see utils.IsUniqueConstraintViolation
+func (r *Repository) InsertContact(ctx context.Context, contact model.Contact) (contactID int64, err error) {
+	err = r.executor(ctx).QueryRowContext(
+		ctx,
+		queryInsertContact,
+		contact.UserID,
+		contact.RecipientID,
+		contact.Nickname,
+		time.Now(),
+	).Scan(&contactID)
+
+	return
+}
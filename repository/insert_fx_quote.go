@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/WalletService/model"
+)
+
+func (r *Repository) InsertFXQuote(ctx context.Context, quote model.FXQuote) (err error) {
+	_, err = r.executor(ctx).ExecContext(
+		ctx,
+		queryInsertFXQuote,
+		quote.ID,
+		quote.UserID,
+		quote.SourceCurrency,
+		quote.TargetCurrency,
+		quote.SourceAmount,
+		quote.Fee,
+		quote.Rate,
+		quote.TargetAmount,
+		quote.ExpiryTime,
+		time.Now(),
+	)
+
+	return err
+}
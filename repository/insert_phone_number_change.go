@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/WalletService/model"
+)
+
+// InsertPhoneNumberChange inserts the change with its ID and attempts as is, it is pending until verified
+func (r *Repository) InsertPhoneNumberChange(ctx context.Context, change model.PhoneNumberChange) (err error) {
+	_, err = r.executor(ctx).ExecContext(
+		ctx,
+		queryInsertPhoneNumberChange,
+		change.ID,
+		change.UserID,
+		change.PhoneNumber,
+		change.OTPHash,
+		change.Attempts,
+		change.ExpiryTime,
+		time.Now(),
+	)
+
+	return err
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/WalletService/model"
+)
+
+// InsertPocket inserts an empty Pocket, violating the unique constraint if User has another Pocket with the name, see utils.IsUniqueConstraintViolation
+func (r *Repository) InsertPocket(ctx context.Context, pocket model.Pocket) (pocketID int64, err error) {
+	err = r.executor(ctx).QueryRowContext(
+		ctx,
+		queryInsertPocket,
+		pocket.UserID,
+		pocket.Name,
+		sql.NullFloat64{Float64: float64(pocket.TargetAmount), Valid: pocket.TargetAmount != 0},
+		pocket.TargetDate,
+		time.Now(),
+	).Scan(&pocketID)
+
+	return
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/WalletService/model"
+)
+
+// InsertRecipientLookup inserts the lookup with its ID as is, recipient_id is NULL if the phone number is not registered
+func (r *Repository) InsertRecipientLookup(ctx context.Context, lookup model.RecipientLookup) (err error) {
+	_, err = r.executor(ctx).ExecContext(
+		ctx,
+		queryInsertRecipientLookup,
+		lookup.ID,
+		lookup.UserID,
+		sql.NullInt64{Int64: lookup.RecipientID, Valid: lookup.RecipientID != 0},
+		lookup.PhoneNumber,
+		lookup.ExpiryTime,
+		time.Now(),
+	)
+
+	return err
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/WalletService/model"
+)
+
+// InsertSharedWallet inserts an empty SharedWallet, its owner is added as a member with InsertSharedWalletMember
+func (r *Repository) InsertSharedWallet(ctx context.Context, wallet model.SharedWallet) (sharedWalletID int64, err error) {
+	err = r.executor(ctx).QueryRowContext(
+		ctx,
+		queryInsertSharedWallet,
+		wallet.OwnerID,
+		wallet.Name,
+		time.Now(),
+	).Scan(&sharedWalletID)
+
+	return
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/WalletService/model"
+)
+
+// InsertSharedWalletMember violates the unique constraint if User is already a member of the SharedWallet, see utils.IsUniqueConstraintViolation
+func (r *Repository) InsertSharedWalletMember(ctx context.Context, member model.SharedWalletMember) error {
+	_, err := r.executor(ctx).ExecContext(
+		ctx,
+		queryInsertSharedWalletMember,
+		member.SharedWalletID,
+		member.UserID,
+		member.Role,
+		sql.NullFloat64{Float64: float64(member.TransactionLimit), Valid: member.TransactionLimit != 0},
+		sql.NullFloat64{Float64: float64(member.MonthlyLimit), Valid: member.MonthlyLimit != 0},
+		time.Now(),
+	)
+
+	return err
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/WalletService/model"
+)
+
+// InsertTopUpIntent inserts the intent with its ID as is, since the ID is already sent to the payment gateway
+func (r *Repository) InsertTopUpIntent(ctx context.Context, intent model.TopUpIntent) (err error) {
+	_, err = r.executor(ctx).ExecContext(
+		ctx,
+		queryInsertTopUpIntent,
+		intent.ID,
+		intent.UserID,
+		intent.Amount,
+		intent.BankCode,
+		intent.VirtualAccountNumber,
+		intent.Status,
+		intent.ExpiryTime,
+		time.Now(),
+	)
+
+	return err
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	"github.com/WalletService/model"
@@ -23,9 +24,28 @@ func (r *Repository) InsertTransaction(ctx context.Context, transaction model.Tr
 		transaction.Status,
 		transaction.Description,
 		time.Now(),
+		sql.NullInt64{Int64: transaction.BankAccountID, Valid: transaction.BankAccountID != 0},
+		sql.NullString{String: transaction.DisbursementReference, Valid: transaction.DisbursementReference != ""},
+		transactionCurrency(transaction.Currency),
+		uuid.NullUUID{UUID: transaction.FXQuoteID, Valid: transaction.FXQuoteID != uuid.Nil},
+		sql.NullString{String: string(transaction.ConvertedCurrency), Valid: transaction.ConvertedCurrency != ""},
+		sql.NullFloat64{Float64: float64(transaction.ConvertedAmount), Valid: transaction.ConvertedCurrency != ""},
+		transaction.Fee,
+		sql.NullInt64{Int64: transaction.ActorID, Valid: transaction.ActorID != 0},
+		sql.NullInt64{Int64: transaction.SourcePocketID, Valid: transaction.SourcePocketID != 0},
+		sql.NullInt64{Int64: transaction.PocketID, Valid: transaction.PocketID != 0},
+		sql.NullInt64{Int64: transaction.SharedWalletID, Valid: transaction.SharedWalletID != 0},
 	)
 
-	err = r.exec.QueryRowContext(ctx, queryInsertTransaction, params...).Scan(&transactionID)
+	err = r.executor(ctx).QueryRowContext(ctx, queryInsertTransaction, params...).Scan(&transactionID)
 
 	return
 }
+
+// transactionCurrency defaults to IDR for Transactions created before multi-currency
+func transactionCurrency(currency model.Currency) model.Currency {
+	if currency == "" {
+		return model.CurrencyIDR
+	}
+	return currency
+}
@@ -11,11 +11,11 @@ import (
 )
 
 const (
-	saltCost = 12
+	defaultPasswordHashCost = 12
 )
 
 func (r *Repository) InsertUser(ctx context.Context, user model.User) (userID int64, err error) {
-	hashedPasswordBytes, err := bcrypt.GenerateFromPassword([]byte(user.Password), saltCost)
+	hashedPasswordBytes, err := bcrypt.GenerateFromPassword([]byte(user.Password), r.passwordHashCost)
 	if err != nil {
 		return userID, err
 	}
@@ -24,7 +24,7 @@ func (r *Repository) InsertUser(ctx context.Context, user model.User) (userID in
 
 	query, params := buildQueryInsertUsers([]model.User{user})
 
-	err = r.exec.QueryRowContext(ctx, query, params...).Scan(&userID)
+	err = r.executor(ctx).QueryRowContext(ctx, query, params...).Scan(&userID)
 
 	return
 }
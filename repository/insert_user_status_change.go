@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/WalletService/model"
+)
+
+// InsertUserStatusChange records a transition of User's status in the audit trail
+func (r *Repository) InsertUserStatusChange(ctx context.Context, change model.UserStatusChange) (changeID int64, err error) {
+	err = r.executor(ctx).QueryRowContext(ctx, queryInsertUserStatusChange,
+		change.UserID,
+		change.ActorID,
+		change.FromStatus,
+		change.ToStatus,
+		change.Reason,
+		time.Now(),
+	).Scan(&changeID)
+
+	return
+}
@@ -7,6 +7,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/WalletService/model"
 	"github.com/google/uuid"
@@ -16,9 +17,57 @@ type RepositoryInterface interface {
 	InsertUser(ctx context.Context, user model.User) (userID int64, err error)
 	GetUsers(ctx context.Context, request model.UserFilter) (users []model.User, err error)
 	GetUser(ctx context.Context, userID int64) (user model.User, err error)
+	GetUserPermissions(ctx context.Context, userID int64) (permissions []string, err error)
 	InsertTransaction(ctx context.Context, transaction model.Transaction) (transactionID uuid.UUID, err error)
 	UpdateUser(ctx context.Context, request model.UpdateUserRequest) error
+	InsertUserStatusChange(ctx context.Context, change model.UserStatusChange) (changeID int64, err error)
 	LockUser(ctx context.Context, userID int64) error
+	LockUsers(ctx context.Context, userIDs []int64) (users []model.User, err error)
+	LockTransaction(ctx context.Context, transactionID uuid.UUID) (transaction model.Transaction, err error)
+	UpdateTransaction(ctx context.Context, request model.UpdateTransactionRequest) error
+	CountTransactions(ctx context.Context, request model.TransactionFilter) (count int, err error)
+	SumTransactions(ctx context.Context, request model.TransactionFilter) (sum float32, err error)
+	GetTransactions(ctx context.Context, request model.TransactionFilter) (transactions []model.Transaction, err error)
+	UpdateHouseAccount(ctx context.Context, request model.UpdateHouseAccountRequest) error
+	InsertBankAccount(ctx context.Context, bankAccount model.BankAccount) (bankAccountID int64, err error)
+	GetBankAccounts(ctx context.Context, request model.BankAccountFilter) (bankAccounts []model.BankAccount, err error)
+	InsertTopUpIntent(ctx context.Context, intent model.TopUpIntent) (err error)
+	GetTopUpIntents(ctx context.Context, request model.TopUpIntentFilter) (intents []model.TopUpIntent, err error)
+	LockTopUpIntent(ctx context.Context, virtualAccountNumber string) (intent model.TopUpIntent, err error)
+	UpdateTopUpIntent(ctx context.Context, request model.UpdateTopUpIntentRequest) error
+	CountPendingTopUpIntents(ctx context.Context, userID int64, now time.Time) (count int, err error)
+	GetUserBalances(ctx context.Context, userID int64) (balances []model.Balance, err error)
+	InsertFXQuote(ctx context.Context, quote model.FXQuote) (err error)
+	LockFXQuote(ctx context.Context, quoteID uuid.UUID) (quote model.FXQuote, err error)
+	UpdateFXQuote(ctx context.Context, request model.UpdateFXQuoteRequest) error
+	InsertPhoneNumberChange(ctx context.Context, change model.PhoneNumberChange) (err error)
+	LockPhoneNumberChange(ctx context.Context, userID int64) (change model.PhoneNumberChange, err error)
+	UpdatePhoneNumberChange(ctx context.Context, request model.UpdatePhoneNumberChangeRequest) error
+	CountPhoneNumberChanges(ctx context.Context, userID int64, since time.Time) (count int, err error)
+	ValidatePhoneNumberConstraint(ctx context.Context) error
+	InsertRecipientLookup(ctx context.Context, lookup model.RecipientLookup) (err error)
+	GetRecipientLookup(ctx context.Context, lookupID uuid.UUID) (lookup model.RecipientLookup, err error)
+	CountRecipientLookups(ctx context.Context, userID int64, since time.Time) (count int, err error)
+	InsertContact(ctx context.Context, contact model.Contact) (contactID int64, err error)
+	GetContacts(ctx context.Context, request model.ContactFilter) (contacts []model.Contact, err error)
+	UpdateContact(ctx context.Context, request model.UpdateContactRequest) error
+	DeleteContact(ctx context.Context, userID, contactID int64) error
+	GetContactSuggestions(ctx context.Context, request model.ContactSuggestionFilter) (suggestions []model.ContactSuggestion, err error)
+	InsertPocket(ctx context.Context, pocket model.Pocket) (pocketID int64, err error)
+	GetPockets(ctx context.Context, request model.PocketFilter) (pockets []model.Pocket, err error)
+	UpdatePocket(ctx context.Context, pocket model.Pocket) error
+	UpdatePocketBalance(ctx context.Context, userID, pocketID int64, balance model.UpdateBalanceRequest) error
+	DeletePocket(ctx context.Context, userID, pocketID int64) error
+	InsertSharedWallet(ctx context.Context, wallet model.SharedWallet) (sharedWalletID int64, err error)
+	GetSharedWallets(ctx context.Context, request model.SharedWalletFilter) (wallets []model.SharedWallet, err error)
+	LockSharedWallet(ctx context.Context, sharedWalletID int64) (wallet model.SharedWallet, err error)
+	UpdateSharedWalletBalance(ctx context.Context, sharedWalletID, memberID int64, balance model.UpdateBalanceRequest) error
+	InsertSharedWalletMember(ctx context.Context, member model.SharedWalletMember) error
+	GetSharedWalletMembers(ctx context.Context, request model.SharedWalletMemberFilter) (members []model.SharedWalletMember, err error)
+	UpdateSharedWalletMember(ctx context.Context, member model.SharedWalletMember) error
+	DeleteSharedWalletMember(ctx context.Context, sharedWalletID, userID int64) error
+	InsertAuditLog(ctx context.Context, entry model.AuditLogEntry) (inserted model.AuditLogEntry, err error)
+	GetAuditLog(ctx context.Context, request model.AuditLogFilter) (entries []model.AuditLogEntry, err error)
 	DbTxnRepoInterface // to enable using db txn
 }
 
@@ -30,7 +79,6 @@ type Executor interface {
 
 type DbTxnRepoInterface interface {
 	GetSqlDb() (SqlDbInterface, error)
-	SetExecutor(executor Executor)
 }
 
 type SqlDbInterface interface {
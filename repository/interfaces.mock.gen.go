@@ -8,6 +8,7 @@ import (
 	context "context"
 	sql "database/sql"
 	reflect "reflect"
+	time "time"
 
 	model "github.com/WalletService/model"
 	gomock "github.com/golang/mock/gomock"
@@ -37,6 +38,228 @@ func (m *MockRepositoryInterface) EXPECT() *MockRepositoryInterfaceMockRecorder
 	return m.recorder
 }
 
+// CountPendingTopUpIntents mocks base method.
+func (m *MockRepositoryInterface) CountPendingTopUpIntents(ctx context.Context, userID int64, now time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPendingTopUpIntents", ctx, userID, now)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPendingTopUpIntents indicates an expected call of CountPendingTopUpIntents.
+func (mr *MockRepositoryInterfaceMockRecorder) CountPendingTopUpIntents(ctx, userID, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPendingTopUpIntents", reflect.TypeOf((*MockRepositoryInterface)(nil).CountPendingTopUpIntents), ctx, userID, now)
+}
+
+// CountPhoneNumberChanges mocks base method.
+func (m *MockRepositoryInterface) CountPhoneNumberChanges(ctx context.Context, userID int64, since time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPhoneNumberChanges", ctx, userID, since)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPhoneNumberChanges indicates an expected call of CountPhoneNumberChanges.
+func (mr *MockRepositoryInterfaceMockRecorder) CountPhoneNumberChanges(ctx, userID, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPhoneNumberChanges", reflect.TypeOf((*MockRepositoryInterface)(nil).CountPhoneNumberChanges), ctx, userID, since)
+}
+
+// CountRecipientLookups mocks base method.
+func (m *MockRepositoryInterface) CountRecipientLookups(ctx context.Context, userID int64, since time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountRecipientLookups", ctx, userID, since)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountRecipientLookups indicates an expected call of CountRecipientLookups.
+func (mr *MockRepositoryInterfaceMockRecorder) CountRecipientLookups(ctx, userID, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountRecipientLookups", reflect.TypeOf((*MockRepositoryInterface)(nil).CountRecipientLookups), ctx, userID, since)
+}
+
+// CountTransactions mocks base method.
+func (m *MockRepositoryInterface) CountTransactions(ctx context.Context, request model.TransactionFilter) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountTransactions", ctx, request)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountTransactions indicates an expected call of CountTransactions.
+func (mr *MockRepositoryInterfaceMockRecorder) CountTransactions(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTransactions", reflect.TypeOf((*MockRepositoryInterface)(nil).CountTransactions), ctx, request)
+}
+
+// DeleteContact mocks base method.
+func (m *MockRepositoryInterface) DeleteContact(ctx context.Context, userID, contactID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteContact", ctx, userID, contactID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteContact indicates an expected call of DeleteContact.
+func (mr *MockRepositoryInterfaceMockRecorder) DeleteContact(ctx, userID, contactID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteContact", reflect.TypeOf((*MockRepositoryInterface)(nil).DeleteContact), ctx, userID, contactID)
+}
+
+// DeletePocket mocks base method.
+func (m *MockRepositoryInterface) DeletePocket(ctx context.Context, userID, pocketID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePocket", ctx, userID, pocketID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePocket indicates an expected call of DeletePocket.
+func (mr *MockRepositoryInterfaceMockRecorder) DeletePocket(ctx, userID, pocketID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePocket", reflect.TypeOf((*MockRepositoryInterface)(nil).DeletePocket), ctx, userID, pocketID)
+}
+
+// DeleteSharedWalletMember mocks base method.
+func (m *MockRepositoryInterface) DeleteSharedWalletMember(ctx context.Context, sharedWalletID, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSharedWalletMember", ctx, sharedWalletID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSharedWalletMember indicates an expected call of DeleteSharedWalletMember.
+func (mr *MockRepositoryInterfaceMockRecorder) DeleteSharedWalletMember(ctx, sharedWalletID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSharedWalletMember", reflect.TypeOf((*MockRepositoryInterface)(nil).DeleteSharedWalletMember), ctx, sharedWalletID, userID)
+}
+
+// GetAuditLog mocks base method.
+func (m *MockRepositoryInterface) GetAuditLog(ctx context.Context, request model.AuditLogFilter) ([]model.AuditLogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditLog", ctx, request)
+	ret0, _ := ret[0].([]model.AuditLogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditLog indicates an expected call of GetAuditLog.
+func (mr *MockRepositoryInterfaceMockRecorder) GetAuditLog(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditLog", reflect.TypeOf((*MockRepositoryInterface)(nil).GetAuditLog), ctx, request)
+}
+
+// GetBankAccounts mocks base method.
+func (m *MockRepositoryInterface) GetBankAccounts(ctx context.Context, request model.BankAccountFilter) ([]model.BankAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBankAccounts", ctx, request)
+	ret0, _ := ret[0].([]model.BankAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBankAccounts indicates an expected call of GetBankAccounts.
+func (mr *MockRepositoryInterfaceMockRecorder) GetBankAccounts(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBankAccounts", reflect.TypeOf((*MockRepositoryInterface)(nil).GetBankAccounts), ctx, request)
+}
+
+// GetContactSuggestions mocks base method.
+func (m *MockRepositoryInterface) GetContactSuggestions(ctx context.Context, request model.ContactSuggestionFilter) ([]model.ContactSuggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContactSuggestions", ctx, request)
+	ret0, _ := ret[0].([]model.ContactSuggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContactSuggestions indicates an expected call of GetContactSuggestions.
+func (mr *MockRepositoryInterfaceMockRecorder) GetContactSuggestions(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContactSuggestions", reflect.TypeOf((*MockRepositoryInterface)(nil).GetContactSuggestions), ctx, request)
+}
+
+// GetContacts mocks base method.
+func (m *MockRepositoryInterface) GetContacts(ctx context.Context, request model.ContactFilter) ([]model.Contact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContacts", ctx, request)
+	ret0, _ := ret[0].([]model.Contact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContacts indicates an expected call of GetContacts.
+func (mr *MockRepositoryInterfaceMockRecorder) GetContacts(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContacts", reflect.TypeOf((*MockRepositoryInterface)(nil).GetContacts), ctx, request)
+}
+
+// GetPockets mocks base method.
+func (m *MockRepositoryInterface) GetPockets(ctx context.Context, request model.PocketFilter) ([]model.Pocket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPockets", ctx, request)
+	ret0, _ := ret[0].([]model.Pocket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPockets indicates an expected call of GetPockets.
+func (mr *MockRepositoryInterfaceMockRecorder) GetPockets(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPockets", reflect.TypeOf((*MockRepositoryInterface)(nil).GetPockets), ctx, request)
+}
+
+// GetRecipientLookup mocks base method.
+func (m *MockRepositoryInterface) GetRecipientLookup(ctx context.Context, lookupID uuid.UUID) (model.RecipientLookup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecipientLookup", ctx, lookupID)
+	ret0, _ := ret[0].(model.RecipientLookup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecipientLookup indicates an expected call of GetRecipientLookup.
+func (mr *MockRepositoryInterfaceMockRecorder) GetRecipientLookup(ctx, lookupID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecipientLookup", reflect.TypeOf((*MockRepositoryInterface)(nil).GetRecipientLookup), ctx, lookupID)
+}
+
+// GetSharedWalletMembers mocks base method.
+func (m *MockRepositoryInterface) GetSharedWalletMembers(ctx context.Context, request model.SharedWalletMemberFilter) ([]model.SharedWalletMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSharedWalletMembers", ctx, request)
+	ret0, _ := ret[0].([]model.SharedWalletMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSharedWalletMembers indicates an expected call of GetSharedWalletMembers.
+func (mr *MockRepositoryInterfaceMockRecorder) GetSharedWalletMembers(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharedWalletMembers", reflect.TypeOf((*MockRepositoryInterface)(nil).GetSharedWalletMembers), ctx, request)
+}
+
+// GetSharedWallets mocks base method.
+func (m *MockRepositoryInterface) GetSharedWallets(ctx context.Context, request model.SharedWalletFilter) ([]model.SharedWallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSharedWallets", ctx, request)
+	ret0, _ := ret[0].([]model.SharedWallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSharedWallets indicates an expected call of GetSharedWallets.
+func (mr *MockRepositoryInterfaceMockRecorder) GetSharedWallets(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharedWallets", reflect.TypeOf((*MockRepositoryInterface)(nil).GetSharedWallets), ctx, request)
+}
+
 // GetSqlDb mocks base method.
 func (m *MockRepositoryInterface) GetSqlDb() (SqlDbInterface, error) {
 	m.ctrl.T.Helper()
@@ -52,6 +275,36 @@ func (mr *MockRepositoryInterfaceMockRecorder) GetSqlDb() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSqlDb", reflect.TypeOf((*MockRepositoryInterface)(nil).GetSqlDb))
 }
 
+// GetTopUpIntents mocks base method.
+func (m *MockRepositoryInterface) GetTopUpIntents(ctx context.Context, request model.TopUpIntentFilter) ([]model.TopUpIntent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTopUpIntents", ctx, request)
+	ret0, _ := ret[0].([]model.TopUpIntent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTopUpIntents indicates an expected call of GetTopUpIntents.
+func (mr *MockRepositoryInterfaceMockRecorder) GetTopUpIntents(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopUpIntents", reflect.TypeOf((*MockRepositoryInterface)(nil).GetTopUpIntents), ctx, request)
+}
+
+// GetTransactions mocks base method.
+func (m *MockRepositoryInterface) GetTransactions(ctx context.Context, request model.TransactionFilter) ([]model.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactions", ctx, request)
+	ret0, _ := ret[0].([]model.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactions indicates an expected call of GetTransactions.
+func (mr *MockRepositoryInterfaceMockRecorder) GetTransactions(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactions", reflect.TypeOf((*MockRepositoryInterface)(nil).GetTransactions), ctx, request)
+}
+
 // GetUser mocks base method.
 func (m *MockRepositoryInterface) GetUser(ctx context.Context, userID int64) (model.User, error) {
 	m.ctrl.T.Helper()
@@ -67,6 +320,36 @@ func (mr *MockRepositoryInterfaceMockRecorder) GetUser(ctx, userID interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockRepositoryInterface)(nil).GetUser), ctx, userID)
 }
 
+// GetUserBalances mocks base method.
+func (m *MockRepositoryInterface) GetUserBalances(ctx context.Context, userID int64) ([]model.Balance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserBalances", ctx, userID)
+	ret0, _ := ret[0].([]model.Balance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserBalances indicates an expected call of GetUserBalances.
+func (mr *MockRepositoryInterfaceMockRecorder) GetUserBalances(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserBalances", reflect.TypeOf((*MockRepositoryInterface)(nil).GetUserBalances), ctx, userID)
+}
+
+// GetUserPermissions mocks base method.
+func (m *MockRepositoryInterface) GetUserPermissions(ctx context.Context, userID int64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserPermissions", ctx, userID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserPermissions indicates an expected call of GetUserPermissions.
+func (mr *MockRepositoryInterfaceMockRecorder) GetUserPermissions(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPermissions", reflect.TypeOf((*MockRepositoryInterface)(nil).GetUserPermissions), ctx, userID)
+}
+
 // GetUsers mocks base method.
 func (m *MockRepositoryInterface) GetUsers(ctx context.Context, request model.UserFilter) ([]model.User, error) {
 	m.ctrl.T.Helper()
@@ -82,6 +365,151 @@ func (mr *MockRepositoryInterfaceMockRecorder) GetUsers(ctx, request interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockRepositoryInterface)(nil).GetUsers), ctx, request)
 }
 
+// InsertAuditLog mocks base method.
+func (m *MockRepositoryInterface) InsertAuditLog(ctx context.Context, entry model.AuditLogEntry) (model.AuditLogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAuditLog", ctx, entry)
+	ret0, _ := ret[0].(model.AuditLogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertAuditLog indicates an expected call of InsertAuditLog.
+func (mr *MockRepositoryInterfaceMockRecorder) InsertAuditLog(ctx, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAuditLog", reflect.TypeOf((*MockRepositoryInterface)(nil).InsertAuditLog), ctx, entry)
+}
+
+// InsertBankAccount mocks base method.
+func (m *MockRepositoryInterface) InsertBankAccount(ctx context.Context, bankAccount model.BankAccount) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertBankAccount", ctx, bankAccount)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertBankAccount indicates an expected call of InsertBankAccount.
+func (mr *MockRepositoryInterfaceMockRecorder) InsertBankAccount(ctx, bankAccount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertBankAccount", reflect.TypeOf((*MockRepositoryInterface)(nil).InsertBankAccount), ctx, bankAccount)
+}
+
+// InsertContact mocks base method.
+func (m *MockRepositoryInterface) InsertContact(ctx context.Context, contact model.Contact) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertContact", ctx, contact)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertContact indicates an expected call of InsertContact.
+func (mr *MockRepositoryInterfaceMockRecorder) InsertContact(ctx, contact interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertContact", reflect.TypeOf((*MockRepositoryInterface)(nil).InsertContact), ctx, contact)
+}
+
+// InsertFXQuote mocks base method.
+func (m *MockRepositoryInterface) InsertFXQuote(ctx context.Context, quote model.FXQuote) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertFXQuote", ctx, quote)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertFXQuote indicates an expected call of InsertFXQuote.
+func (mr *MockRepositoryInterfaceMockRecorder) InsertFXQuote(ctx, quote interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertFXQuote", reflect.TypeOf((*MockRepositoryInterface)(nil).InsertFXQuote), ctx, quote)
+}
+
+// InsertPhoneNumberChange mocks base method.
+func (m *MockRepositoryInterface) InsertPhoneNumberChange(ctx context.Context, change model.PhoneNumberChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertPhoneNumberChange", ctx, change)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertPhoneNumberChange indicates an expected call of InsertPhoneNumberChange.
+func (mr *MockRepositoryInterfaceMockRecorder) InsertPhoneNumberChange(ctx, change interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertPhoneNumberChange", reflect.TypeOf((*MockRepositoryInterface)(nil).InsertPhoneNumberChange), ctx, change)
+}
+
+// InsertPocket mocks base method.
+func (m *MockRepositoryInterface) InsertPocket(ctx context.Context, pocket model.Pocket) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertPocket", ctx, pocket)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertPocket indicates an expected call of InsertPocket.
+func (mr *MockRepositoryInterfaceMockRecorder) InsertPocket(ctx, pocket interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertPocket", reflect.TypeOf((*MockRepositoryInterface)(nil).InsertPocket), ctx, pocket)
+}
+
+// InsertRecipientLookup mocks base method.
+func (m *MockRepositoryInterface) InsertRecipientLookup(ctx context.Context, lookup model.RecipientLookup) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertRecipientLookup", ctx, lookup)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertRecipientLookup indicates an expected call of InsertRecipientLookup.
+func (mr *MockRepositoryInterfaceMockRecorder) InsertRecipientLookup(ctx, lookup interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertRecipientLookup", reflect.TypeOf((*MockRepositoryInterface)(nil).InsertRecipientLookup), ctx, lookup)
+}
+
+// InsertSharedWallet mocks base method.
+func (m *MockRepositoryInterface) InsertSharedWallet(ctx context.Context, wallet model.SharedWallet) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertSharedWallet", ctx, wallet)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertSharedWallet indicates an expected call of InsertSharedWallet.
+func (mr *MockRepositoryInterfaceMockRecorder) InsertSharedWallet(ctx, wallet interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertSharedWallet", reflect.TypeOf((*MockRepositoryInterface)(nil).InsertSharedWallet), ctx, wallet)
+}
+
+// InsertSharedWalletMember mocks base method.
+func (m *MockRepositoryInterface) InsertSharedWalletMember(ctx context.Context, member model.SharedWalletMember) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertSharedWalletMember", ctx, member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertSharedWalletMember indicates an expected call of InsertSharedWalletMember.
+func (mr *MockRepositoryInterfaceMockRecorder) InsertSharedWalletMember(ctx, member interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertSharedWalletMember", reflect.TypeOf((*MockRepositoryInterface)(nil).InsertSharedWalletMember), ctx, member)
+}
+
+// InsertTopUpIntent mocks base method.
+func (m *MockRepositoryInterface) InsertTopUpIntent(ctx context.Context, intent model.TopUpIntent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertTopUpIntent", ctx, intent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertTopUpIntent indicates an expected call of InsertTopUpIntent.
+func (mr *MockRepositoryInterfaceMockRecorder) InsertTopUpIntent(ctx, intent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertTopUpIntent", reflect.TypeOf((*MockRepositoryInterface)(nil).InsertTopUpIntent), ctx, intent)
+}
+
 // InsertTransaction mocks base method.
 func (m *MockRepositoryInterface) InsertTransaction(ctx context.Context, transaction model.Transaction) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -112,6 +540,96 @@ func (mr *MockRepositoryInterfaceMockRecorder) InsertUser(ctx, user interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertUser", reflect.TypeOf((*MockRepositoryInterface)(nil).InsertUser), ctx, user)
 }
 
+// InsertUserStatusChange mocks base method.
+func (m *MockRepositoryInterface) InsertUserStatusChange(ctx context.Context, change model.UserStatusChange) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertUserStatusChange", ctx, change)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertUserStatusChange indicates an expected call of InsertUserStatusChange.
+func (mr *MockRepositoryInterfaceMockRecorder) InsertUserStatusChange(ctx, change interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertUserStatusChange", reflect.TypeOf((*MockRepositoryInterface)(nil).InsertUserStatusChange), ctx, change)
+}
+
+// LockFXQuote mocks base method.
+func (m *MockRepositoryInterface) LockFXQuote(ctx context.Context, quoteID uuid.UUID) (model.FXQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockFXQuote", ctx, quoteID)
+	ret0, _ := ret[0].(model.FXQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockFXQuote indicates an expected call of LockFXQuote.
+func (mr *MockRepositoryInterfaceMockRecorder) LockFXQuote(ctx, quoteID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockFXQuote", reflect.TypeOf((*MockRepositoryInterface)(nil).LockFXQuote), ctx, quoteID)
+}
+
+// LockPhoneNumberChange mocks base method.
+func (m *MockRepositoryInterface) LockPhoneNumberChange(ctx context.Context, userID int64) (model.PhoneNumberChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockPhoneNumberChange", ctx, userID)
+	ret0, _ := ret[0].(model.PhoneNumberChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockPhoneNumberChange indicates an expected call of LockPhoneNumberChange.
+func (mr *MockRepositoryInterfaceMockRecorder) LockPhoneNumberChange(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockPhoneNumberChange", reflect.TypeOf((*MockRepositoryInterface)(nil).LockPhoneNumberChange), ctx, userID)
+}
+
+// LockSharedWallet mocks base method.
+func (m *MockRepositoryInterface) LockSharedWallet(ctx context.Context, sharedWalletID int64) (model.SharedWallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockSharedWallet", ctx, sharedWalletID)
+	ret0, _ := ret[0].(model.SharedWallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockSharedWallet indicates an expected call of LockSharedWallet.
+func (mr *MockRepositoryInterfaceMockRecorder) LockSharedWallet(ctx, sharedWalletID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockSharedWallet", reflect.TypeOf((*MockRepositoryInterface)(nil).LockSharedWallet), ctx, sharedWalletID)
+}
+
+// LockTopUpIntent mocks base method.
+func (m *MockRepositoryInterface) LockTopUpIntent(ctx context.Context, virtualAccountNumber string) (model.TopUpIntent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockTopUpIntent", ctx, virtualAccountNumber)
+	ret0, _ := ret[0].(model.TopUpIntent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockTopUpIntent indicates an expected call of LockTopUpIntent.
+func (mr *MockRepositoryInterfaceMockRecorder) LockTopUpIntent(ctx, virtualAccountNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockTopUpIntent", reflect.TypeOf((*MockRepositoryInterface)(nil).LockTopUpIntent), ctx, virtualAccountNumber)
+}
+
+// LockTransaction mocks base method.
+func (m *MockRepositoryInterface) LockTransaction(ctx context.Context, transactionID uuid.UUID) (model.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockTransaction", ctx, transactionID)
+	ret0, _ := ret[0].(model.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockTransaction indicates an expected call of LockTransaction.
+func (mr *MockRepositoryInterfaceMockRecorder) LockTransaction(ctx, transactionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockTransaction", reflect.TypeOf((*MockRepositoryInterface)(nil).LockTransaction), ctx, transactionID)
+}
+
 // LockUser mocks base method.
 func (m *MockRepositoryInterface) LockUser(ctx context.Context, userID int64) error {
 	m.ctrl.T.Helper()
@@ -126,16 +644,174 @@ func (mr *MockRepositoryInterfaceMockRecorder) LockUser(ctx, userID interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockUser", reflect.TypeOf((*MockRepositoryInterface)(nil).LockUser), ctx, userID)
 }
 
-// SetExecutor mocks base method.
-func (m *MockRepositoryInterface) SetExecutor(executor Executor) {
+// LockUsers mocks base method.
+func (m *MockRepositoryInterface) LockUsers(ctx context.Context, userIDs []int64) ([]model.User, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "SetExecutor", executor)
+	ret := m.ctrl.Call(m, "LockUsers", ctx, userIDs)
+	ret0, _ := ret[0].([]model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// SetExecutor indicates an expected call of SetExecutor.
-func (mr *MockRepositoryInterfaceMockRecorder) SetExecutor(executor interface{}) *gomock.Call {
+// LockUsers indicates an expected call of LockUsers.
+func (mr *MockRepositoryInterfaceMockRecorder) LockUsers(ctx, userIDs interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetExecutor", reflect.TypeOf((*MockRepositoryInterface)(nil).SetExecutor), executor)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockUsers", reflect.TypeOf((*MockRepositoryInterface)(nil).LockUsers), ctx, userIDs)
+}
+
+// SumTransactions mocks base method.
+func (m *MockRepositoryInterface) SumTransactions(ctx context.Context, request model.TransactionFilter) (float32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumTransactions", ctx, request)
+	ret0, _ := ret[0].(float32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumTransactions indicates an expected call of SumTransactions.
+func (mr *MockRepositoryInterfaceMockRecorder) SumTransactions(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumTransactions", reflect.TypeOf((*MockRepositoryInterface)(nil).SumTransactions), ctx, request)
+}
+
+// UpdateContact mocks base method.
+func (m *MockRepositoryInterface) UpdateContact(ctx context.Context, request model.UpdateContactRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateContact", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateContact indicates an expected call of UpdateContact.
+func (mr *MockRepositoryInterfaceMockRecorder) UpdateContact(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateContact", reflect.TypeOf((*MockRepositoryInterface)(nil).UpdateContact), ctx, request)
+}
+
+// UpdateFXQuote mocks base method.
+func (m *MockRepositoryInterface) UpdateFXQuote(ctx context.Context, request model.UpdateFXQuoteRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFXQuote", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFXQuote indicates an expected call of UpdateFXQuote.
+func (mr *MockRepositoryInterfaceMockRecorder) UpdateFXQuote(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFXQuote", reflect.TypeOf((*MockRepositoryInterface)(nil).UpdateFXQuote), ctx, request)
+}
+
+// UpdateHouseAccount mocks base method.
+func (m *MockRepositoryInterface) UpdateHouseAccount(ctx context.Context, request model.UpdateHouseAccountRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHouseAccount", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateHouseAccount indicates an expected call of UpdateHouseAccount.
+func (mr *MockRepositoryInterfaceMockRecorder) UpdateHouseAccount(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHouseAccount", reflect.TypeOf((*MockRepositoryInterface)(nil).UpdateHouseAccount), ctx, request)
+}
+
+// UpdatePhoneNumberChange mocks base method.
+func (m *MockRepositoryInterface) UpdatePhoneNumberChange(ctx context.Context, request model.UpdatePhoneNumberChangeRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePhoneNumberChange", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePhoneNumberChange indicates an expected call of UpdatePhoneNumberChange.
+func (mr *MockRepositoryInterfaceMockRecorder) UpdatePhoneNumberChange(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePhoneNumberChange", reflect.TypeOf((*MockRepositoryInterface)(nil).UpdatePhoneNumberChange), ctx, request)
+}
+
+// UpdatePocket mocks base method.
+func (m *MockRepositoryInterface) UpdatePocket(ctx context.Context, pocket model.Pocket) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePocket", ctx, pocket)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePocket indicates an expected call of UpdatePocket.
+func (mr *MockRepositoryInterfaceMockRecorder) UpdatePocket(ctx, pocket interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePocket", reflect.TypeOf((*MockRepositoryInterface)(nil).UpdatePocket), ctx, pocket)
+}
+
+// UpdatePocketBalance mocks base method.
+func (m *MockRepositoryInterface) UpdatePocketBalance(ctx context.Context, userID, pocketID int64, balance model.UpdateBalanceRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePocketBalance", ctx, userID, pocketID, balance)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePocketBalance indicates an expected call of UpdatePocketBalance.
+func (mr *MockRepositoryInterfaceMockRecorder) UpdatePocketBalance(ctx, userID, pocketID, balance interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePocketBalance", reflect.TypeOf((*MockRepositoryInterface)(nil).UpdatePocketBalance), ctx, userID, pocketID, balance)
+}
+
+// UpdateSharedWalletBalance mocks base method.
+func (m *MockRepositoryInterface) UpdateSharedWalletBalance(ctx context.Context, sharedWalletID, memberID int64, balance model.UpdateBalanceRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSharedWalletBalance", ctx, sharedWalletID, memberID, balance)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSharedWalletBalance indicates an expected call of UpdateSharedWalletBalance.
+func (mr *MockRepositoryInterfaceMockRecorder) UpdateSharedWalletBalance(ctx, sharedWalletID, memberID, balance interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSharedWalletBalance", reflect.TypeOf((*MockRepositoryInterface)(nil).UpdateSharedWalletBalance), ctx, sharedWalletID, memberID, balance)
+}
+
+// UpdateSharedWalletMember mocks base method.
+func (m *MockRepositoryInterface) UpdateSharedWalletMember(ctx context.Context, member model.SharedWalletMember) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSharedWalletMember", ctx, member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSharedWalletMember indicates an expected call of UpdateSharedWalletMember.
+func (mr *MockRepositoryInterfaceMockRecorder) UpdateSharedWalletMember(ctx, member interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSharedWalletMember", reflect.TypeOf((*MockRepositoryInterface)(nil).UpdateSharedWalletMember), ctx, member)
+}
+
+// UpdateTopUpIntent mocks base method.
+func (m *MockRepositoryInterface) UpdateTopUpIntent(ctx context.Context, request model.UpdateTopUpIntentRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTopUpIntent", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTopUpIntent indicates an expected call of UpdateTopUpIntent.
+func (mr *MockRepositoryInterfaceMockRecorder) UpdateTopUpIntent(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTopUpIntent", reflect.TypeOf((*MockRepositoryInterface)(nil).UpdateTopUpIntent), ctx, request)
+}
+
+// UpdateTransaction mocks base method.
+func (m *MockRepositoryInterface) UpdateTransaction(ctx context.Context, request model.UpdateTransactionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTransaction", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTransaction indicates an expected call of UpdateTransaction.
+func (mr *MockRepositoryInterfaceMockRecorder) UpdateTransaction(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransaction", reflect.TypeOf((*MockRepositoryInterface)(nil).UpdateTransaction), ctx, request)
 }
 
 // UpdateUser mocks base method.
@@ -152,6 +828,20 @@ func (mr *MockRepositoryInterfaceMockRecorder) UpdateUser(ctx, request interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockRepositoryInterface)(nil).UpdateUser), ctx, request)
 }
 
+// ValidatePhoneNumberConstraint mocks base method.
+func (m *MockRepositoryInterface) ValidatePhoneNumberConstraint(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidatePhoneNumberConstraint", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidatePhoneNumberConstraint indicates an expected call of ValidatePhoneNumberConstraint.
+func (mr *MockRepositoryInterfaceMockRecorder) ValidatePhoneNumberConstraint(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatePhoneNumberConstraint", reflect.TypeOf((*MockRepositoryInterface)(nil).ValidatePhoneNumberConstraint), ctx)
+}
+
 // MockExecutor is a mock of Executor interface.
 type MockExecutor struct {
 	ctrl     *gomock.Controller
@@ -272,18 +962,6 @@ func (mr *MockDbTxnRepoInterfaceMockRecorder) GetSqlDb() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSqlDb", reflect.TypeOf((*MockDbTxnRepoInterface)(nil).GetSqlDb))
 }
 
-// SetExecutor mocks base method.
-func (m *MockDbTxnRepoInterface) SetExecutor(executor Executor) {
-	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "SetExecutor", executor)
-}
-
-// SetExecutor indicates an expected call of SetExecutor.
-func (mr *MockDbTxnRepoInterfaceMockRecorder) SetExecutor(executor interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetExecutor", reflect.TypeOf((*MockDbTxnRepoInterface)(nil).SetExecutor), executor)
-}
-
 // MockSqlDbInterface is a mock of SqlDbInterface interface.
 type MockSqlDbInterface struct {
 	ctrl     *gomock.Controller
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/WalletService/model"
+	"github.com/google/uuid"
+)
+
+// LockFXQuote selects row for FXQuote and locks it using FOR UPDATE
+func (r *Repository) LockFXQuote(ctx context.Context, quoteID uuid.UUID) (quote model.FXQuote, err error) {
+	err = r.executor(ctx).QueryRowContext(ctx, queryLockFXQuote, quoteID).Scan(
+		&quote.ID,
+		&quote.UserID,
+		&quote.SourceCurrency,
+		&quote.TargetCurrency,
+		&quote.SourceAmount,
+		&quote.Fee,
+		&quote.Rate,
+		&quote.TargetAmount,
+		&quote.ExpiryTime,
+		&quote.TransactionID,
+		&quote.CreatedTime,
+		&quote.UpdatedTime,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return quote, errors.New("fx quote not found")
+	}
+
+	return quote, err
+}
@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/WalletService/model"
+)
+
+var ErrPhoneNumberChangeNotFound = errors.New("phone number change not found")
+
+// LockPhoneNumberChange selects row for the latest PhoneNumberChange of User and locks it using FOR UPDATE
+func (r *Repository) LockPhoneNumberChange(ctx context.Context, userID int64) (change model.PhoneNumberChange, err error) {
+	err = r.executor(ctx).QueryRowContext(ctx, queryLockPhoneNumberChange, userID).Scan(
+		&change.ID,
+		&change.UserID,
+		&change.PhoneNumber,
+		&change.OTPHash,
+		&change.Attempts,
+		&change.ExpiryTime,
+		&change.VerifiedTime,
+		&change.CreatedTime,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return change, ErrPhoneNumberChangeNotFound
+	}
+
+	return change, err
+}
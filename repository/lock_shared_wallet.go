@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/WalletService/model"
+)
+
+var ErrSharedWalletNotFound = errors.New("shared wallet not found")
+
+// LockSharedWallet selects row for SharedWallet and locks it using FOR UPDATE.
+// Users acting on the SharedWallet are expected to be locked by LockUsers first, so that transfers can not deadlock each other.
+func (r *Repository) LockSharedWallet(ctx context.Context, sharedWalletID int64) (wallet model.SharedWallet, err error) {
+	err = r.executor(ctx).QueryRowContext(ctx, queryLockSharedWallet, sharedWalletID).Scan(
+		&wallet.ID,
+		&wallet.OwnerID,
+		&wallet.Name,
+		&wallet.Balance,
+		&wallet.CreatedTime,
+		&wallet.UpdatedTime,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return wallet, ErrSharedWalletNotFound
+	}
+
+	return wallet, err
+}
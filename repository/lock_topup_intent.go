@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/WalletService/model"
+)
+
+var ErrTopUpIntentNotFound = errors.New("top-up intent not found")
+
+// LockTopUpIntent selects row for TopUpIntent by its virtual account number and locks it using FOR UPDATE
+func (r *Repository) LockTopUpIntent(ctx context.Context, virtualAccountNumber string) (intent model.TopUpIntent, err error) {
+	err = r.executor(ctx).QueryRowContext(ctx, queryLockTopUpIntent, virtualAccountNumber).Scan(
+		&intent.ID,
+		&intent.UserID,
+		&intent.Amount,
+		&intent.BankCode,
+		&intent.VirtualAccountNumber,
+		&intent.Status,
+		&intent.ExpiryTime,
+		&intent.PaymentReference,
+		&intent.TransactionID,
+		&intent.CreatedTime,
+		&intent.UpdatedTime,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return intent, ErrTopUpIntentNotFound
+	}
+
+	return intent, err
+}
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/WalletService/model"
+	"github.com/google/uuid"
+)
+
+// LockTransaction selects row for Transaction and locks it using FOR UPDATE
+func (r *Repository) LockTransaction(ctx context.Context, transactionID uuid.UUID) (transaction model.Transaction, err error) {
+	err = r.executor(ctx).QueryRowContext(ctx, queryLockTransaction, transactionID).Scan(
+		&transaction.ID,
+		&transaction.UserID,
+		&transaction.Amount,
+		&transaction.Type,
+		&transaction.RecipientID,
+		&transaction.Status,
+		&transaction.Description,
+		&transaction.CreatedTime,
+		&transaction.UpdatedTime,
+		&transaction.BankAccountID,
+		&transaction.DisbursementReference,
+		&transaction.Currency,
+		&transaction.Fee,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return transaction, errors.New("transaction not found")
+	}
+
+	return transaction, err
+}
@@ -15,7 +15,7 @@ func (r *Repository) LockUser(ctx context.Context, userID int64) (err error) {
 		userID,
 	)
 
-	rows, err := r.exec.QueryContext(ctx, queryLockUser, params...)
+	rows, err := r.executor(ctx).QueryContext(ctx, queryLockUser, params...)
 	if err != nil {
 		return err
 	}
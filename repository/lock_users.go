@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/WalletService/model"
+)
+
+// LockUsers selects rows for Users and locks them using FOR UPDATE in a single query.
+// Rows are locked in ID order, so concurrent transactions locking the same Users can not deadlock each other.
+// Returned Users only have ID, IDR Balance and Status, read under the lock.
+func (r *Repository) LockUsers(ctx context.Context, userIDs []int64) (users []model.User, err error) {
+	var (
+		placeholders []string
+		params       []interface{}
+	)
+
+	for i, userID := range userIDs {
+		placeholders = append(placeholders, fmt.Sprintf("$%d", i+1))
+		params = append(params, userID)
+	}
+
+	rows, err := r.executor(ctx).QueryContext(ctx, fmt.Sprintf(queryLockUsersF, strings.Join(placeholders, ", ")), params...)
+	if err != nil {
+		return []model.User{}, err
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		user := model.User{}
+
+		if err := rows.Scan(
+			&user.ID,
+			&user.Balance,
+			&user.Status,
+		); err != nil {
+			return []model.User{}, err
+		}
+
+		users = append(users, user)
+	}
+
+	return users, rows.Err()
+}
@@ -7,22 +7,214 @@ var (
 )
 
 var (
-	querySelectUsers     = "SELECT id, full_name, phone_number, balance, password, created_time, updated_time FROM \"user\" WHERE true"
+	querySelectUsers     = "SELECT id, full_name, phone_number, balance, password, status, created_time, updated_time, COALESCE(merchant_category_code, '') FROM \"user\" WHERE true"
 	whereUserPhoneNumber = " AND phone_number = $%d"
 	whereUserID          = " AND id = $%d"
+	whereUserFullName    = " AND full_name ILIKE '%%' || $%d || '%%' ESCAPE '\\'"
+	whereUserCreatedFrom = " AND created_time >= $%d"
+	whereUserCreatedTo   = " AND created_time < $%d"
+	orderUsersByID       = " ORDER BY id"
+	limitF               = " LIMIT $%d"
+	offsetF              = " OFFSET $%d"
+)
+
+var (
+	queryInsertUserStatusChange = "INSERT INTO user_status_change(user_id, actor_id, from_status, to_status, reason, created_time) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id"
+)
+
+var (
+	querySelectUserPermissions = "SELECT DISTINCT role_permission.permission FROM user_role JOIN role_permission ON role_permission.role = user_role.role WHERE user_role.user_id = $1 ORDER BY role_permission.permission"
 )
 
 var (
 	queryUpdateUserF      = "UPDATE \"user\" SET %s WHERE TRUE"
 	incrementUserBalanceF = "balance = balance + $%d"
 	decrementUserBalanceF = "balance = balance - $%d"
+	setUserStatusF        = "status = $%d"
+	setUserFullNameF      = "full_name = $%d"
+	setUserPhoneNumberF   = "phone_number = $%d"
 	setUserUpdatedTimeF   = "updated_time = $%d"
 )
 
 var (
-	queryInsertTransaction = "INSERT INTO transaction(id, user_id, amount, type, recipient_id, status, description, created_time) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id"
+	queryInsertPhoneNumberChange = "INSERT INTO phone_number_change(id, user_id, phone_number, otp_hash, attempts, expiry_time, created_time) VALUES ($1, $2, $3, $4, $5, $6, $7)"
+	// Only the latest change can be verified, requesting another change replaces the previous one
+	queryLockPhoneNumberChange   = "SELECT id, user_id, phone_number, otp_hash, attempts, expiry_time, verified_time, created_time FROM phone_number_change WHERE user_id = $1 ORDER BY created_time DESC LIMIT 1 FOR UPDATE"
+	queryUpdatePhoneNumberChange = "UPDATE phone_number_change SET attempts = $1, verified_time = COALESCE($2, verified_time) WHERE id = $3"
+	queryCountPhoneNumberChanges = "SELECT COUNT(*) FROM phone_number_change WHERE user_id = $1 AND created_time >= $2"
+
+	// Checks the rows added before the constraint, see migrations/sql/0010_add_user_phone_number_e164.up.sql
+	queryValidateUserPhoneNumberE164 = "ALTER TABLE \"user\" VALIDATE CONSTRAINT user_phone_number_e164"
+)
+
+var (
+	queryInsertRecipientLookup = "INSERT INTO recipient_lookup(id, user_id, recipient_id, phone_number, expiry_time, created_time) VALUES ($1, $2, $3, $4, $5, $6)"
+	queryGetRecipientLookup    = "SELECT id, user_id, COALESCE(recipient_id, 0), phone_number, expiry_time, created_time FROM recipient_lookup WHERE id = $1"
+	queryCountRecipientLookups = "SELECT COUNT(*) FROM recipient_lookup WHERE user_id = $1 AND created_time >= $2"
+)
+
+var (
+	queryInsertContact      = "INSERT INTO contact(user_id, recipient_id, nickname, created_time) VALUES ($1, $2, $3, $4) RETURNING id"
+	querySelectContacts     = "SELECT c.id, c.user_id, c.recipient_id, c.nickname, u.full_name, u.status, c.created_time, c.updated_time FROM contact c JOIN \"user\" u ON u.id = c.recipient_id WHERE true"
+	whereContactID          = " AND c.id = $%d"
+	whereContactUserID      = " AND c.user_id = $%d"
+	orderContactsByNickname = " ORDER BY lower(c.nickname), c.id"
+	queryUpdateContact      = "UPDATE contact SET nickname = $1, updated_time = $2 WHERE id = $3 AND user_id = $4"
+	queryDeleteContact      = "DELETE FROM contact WHERE id = $1 AND user_id = $2"
+
+	// Recipients of successful TransferOut since $4 who are neither closed nor a Contact yet.
+	// Each transfer weighs 1 when made at $2, halving every $3 seconds, so both frequent and recent Recipients rank first.
+	querySelectContactSuggestions = "SELECT t.recipient_id, u.full_name, COUNT(*) AS transfer_count, MAX(t.created_time) AS last_transfer_time, " +
+		"SUM(POWER(0.5, EXTRACT(EPOCH FROM ($2::timestamp - t.created_time)) / $3::float8)) AS score " +
+		"FROM transaction t JOIN \"user\" u ON u.id = t.recipient_id " +
+		"WHERE t.user_id = $1 AND t.created_time >= $4 AND t.type = $5 AND t.status = $6 AND t.recipient_id <> t.user_id AND u.status <> $7 " +
+		"AND NOT EXISTS (SELECT 1 FROM contact c WHERE c.user_id = t.user_id AND c.recipient_id = t.recipient_id) " +
+		"GROUP BY t.recipient_id, u.full_name ORDER BY score DESC, last_transfer_time DESC LIMIT $8"
+)
+
+var (
+	queryInsertPocket           = "INSERT INTO pocket(user_id, name, target_amount, target_date, created_time) VALUES ($1, $2, $3, $4, $5) RETURNING id"
+	querySelectPockets          = "SELECT id, user_id, name, balance, COALESCE(target_amount, 0), target_date, created_time, updated_time FROM pocket WHERE true"
+	wherePocketID               = " AND id = $%d"
+	wherePocketUserID           = " AND user_id = $%d"
+	orderPocketsByID            = " ORDER BY id"
+	queryUpdatePocket           = "UPDATE pocket SET name = $1, target_amount = $2, target_date = $3, updated_time = $4 WHERE id = $5 AND user_id = $6"
+	queryIncrementPocketBalance = "UPDATE pocket SET balance = balance + $1, updated_time = $2 WHERE id = $3 AND user_id = $4"
+	queryDecrementPocketBalance = "UPDATE pocket SET balance = balance - $1, updated_time = $2 WHERE id = $3 AND user_id = $4"
+	queryDeletePocket           = "DELETE FROM pocket WHERE id = $1 AND user_id = $2"
+)
+
+var (
+	queryInsertSharedWallet = "INSERT INTO shared_wallet(owner_id, name, created_time) VALUES ($1, $2, $3) RETURNING id"
+	// SharedWallets with the membership of a User, see SharedWalletFilter
+	querySelectSharedWallets = "SELECT w.id, w.owner_id, w.name, w.balance, w.created_time, w.updated_time, m.shared_wallet_id, m.user_id, m.role, " +
+		"COALESCE(m.transaction_limit, 0), COALESCE(m.monthly_limit, 0), m.created_time, m.updated_time " +
+		"FROM shared_wallet w JOIN shared_wallet_member m ON m.shared_wallet_id = w.id WHERE m.user_id = $1"
+	whereSharedWalletID                = " AND w.id = $%d"
+	orderSharedWalletsByID             = " ORDER BY w.id"
+	queryLockSharedWallet              = "SELECT id, owner_id, name, balance, created_time, updated_time FROM shared_wallet WHERE id = $1 FOR UPDATE"
+	queryIncrementSharedWalletBalance  = "UPDATE shared_wallet SET balance = balance + $1, updated_time = $2 WHERE id = $3"
+	queryDecrementSharedWalletBalance  = "UPDATE shared_wallet SET balance = balance - $1, updated_time = $2 WHERE id = $3"
+	queryInsertSharedWalletMember      = "INSERT INTO shared_wallet_member(shared_wallet_id, user_id, role, transaction_limit, monthly_limit, created_time) VALUES ($1, $2, $3, $4, $5, $6)"
+	querySelectSharedWalletMembers     = "SELECT m.shared_wallet_id, m.user_id, m.role, COALESCE(m.transaction_limit, 0), COALESCE(m.monthly_limit, 0), u.full_name, u.status, m.created_time, m.updated_time FROM shared_wallet_member m JOIN \"user\" u ON u.id = m.user_id WHERE true"
+	whereSharedWalletMemberWalletID    = " AND m.shared_wallet_id = $%d"
+	whereSharedWalletMemberUserID      = " AND m.user_id = $%d"
+	orderSharedWalletMembersByCreation = " ORDER BY m.created_time, m.user_id"
+	queryUpdateSharedWalletMember      = "UPDATE shared_wallet_member SET role = $1, transaction_limit = $2, monthly_limit = $3, updated_time = $4 WHERE shared_wallet_id = $5 AND user_id = $6"
+	queryDeleteSharedWalletMember      = "DELETE FROM shared_wallet_member WHERE shared_wallet_id = $1 AND user_id = $2"
+)
+
+var (
+	querySelectUserBalances = "SELECT currency, balance FROM user_balance WHERE user_id = $1 ORDER BY currency"
+)
+
+var (
+	// Balance row is created on the first increment of a currency
+	queryIncrementUserBalance = "INSERT INTO user_balance(user_id, currency, balance, created_time) VALUES ($1, $2, $3, $4) ON CONFLICT (user_id, currency) DO UPDATE SET balance = user_balance.balance + EXCLUDED.balance, updated_time = EXCLUDED.created_time"
+	queryDecrementUserBalance = "UPDATE user_balance SET balance = balance - $3, updated_time = $4 WHERE user_id = $1 AND currency = $2"
+)
+
+var (
+	queryInsertFXQuote = "INSERT INTO fx_quote(id, user_id, source_currency, target_currency, source_amount, fee, rate, target_amount, expiry_time, created_time) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)"
+	queryLockFXQuote   = "SELECT id, user_id, source_currency, target_currency, source_amount, fee, rate, target_amount, expiry_time, COALESCE(transaction_id, '00000000-0000-0000-0000-000000000000'), created_time, updated_time FROM fx_quote WHERE id = $1 FOR UPDATE"
+	queryUpdateFXQuote = "UPDATE fx_quote SET transaction_id = $1, updated_time = $2 WHERE id = $3"
+)
+
+var (
+	// House account row is created on the first fee collected in a currency
+	queryIncrementHouseAccount = "INSERT INTO house_account(name, currency, balance, created_time) VALUES ($1, $2, $3, $4) ON CONFLICT (name, currency) DO UPDATE SET balance = house_account.balance + EXCLUDED.balance, updated_time = EXCLUDED.created_time"
+	queryDecrementHouseAccount = "UPDATE house_account SET balance = balance - $3, updated_time = $4 WHERE name = $1 AND currency = $2"
+)
+
+var (
+	queryInsertTransaction = "INSERT INTO transaction(id, user_id, amount, type, recipient_id, status, description, created_time, bank_account_id, disbursement_reference, currency, fx_quote_id, converted_currency, converted_amount, fee, actor_id, source_pocket_id, pocket_id, shared_wallet_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19) RETURNING id"
+)
+
+var (
+	queryLockTransaction = "SELECT id, user_id, amount, type, recipient_id, status, COALESCE(description, ''), created_time, updated_time, COALESCE(bank_account_id, 0), COALESCE(disbursement_reference, ''), currency, fee FROM transaction WHERE id = $1 FOR UPDATE"
+)
+
+var (
+	queryCountTransactions          = "SELECT COUNT(*) FROM transaction WHERE true"
+	querySumTransactions            = "SELECT COALESCE(SUM(amount), 0) FROM transaction WHERE true"
+	querySelectTransactions         = "SELECT id, user_id, amount, type, recipient_id, status, COALESCE(description, ''), created_time, updated_time, COALESCE(bank_account_id, 0), COALESCE(disbursement_reference, ''), currency, fee, COALESCE(actor_id, 0), COALESCE(source_pocket_id, 0), COALESCE(pocket_id, 0), COALESCE(shared_wallet_id, 0) FROM transaction WHERE true"
+	whereTransactionUserID          = " AND user_id = $%d"
+	whereTransactionRecipientID     = " AND recipient_id = $%d"
+	whereTransactionParticipantID   = " AND (user_id = $%[1]d OR recipient_id = $%[1]d)"
+	whereTransactionSharedWalletID  = " AND shared_wallet_id = $%d"
+	whereTransactionPersonal        = " AND shared_wallet_id IS NULL"
+	whereTransactionType            = " AND type = $%d"
+	whereTransactionStatus          = " AND status = $%d"
+	whereTransactionCreatedTimeFrom = " AND created_time >= $%d"
+	orderTransactionsByTimeDesc     = " ORDER BY created_time DESC, id"
+)
+
+var (
+	queryUpdateTransactionF        = "UPDATE transaction SET %s WHERE id = $%d"
+	setTransactionStatusF          = "status = $%d"
+	setTransactionDisbursementRefF = "disbursement_reference = $%d"
+	setTransactionDescriptionF     = "description = $%d"
+	setTransactionUpdatedTimeF     = "updated_time = $%d"
+)
+
+var (
+	queryInsertBankAccount = "INSERT INTO bank_account(user_id, bank_code, account_number, account_name, created_time) VALUES ($1, $2, $3, $4, $5) RETURNING id"
+)
+
+var (
+	querySelectBankAccounts   = "SELECT id, user_id, bank_code, account_number, account_name, created_time FROM bank_account WHERE true"
+	whereBankAccountID        = " AND id = $%d"
+	whereBankAccountUserID    = " AND user_id = $%d"
+	orderBankAccountsByIDDesc = " ORDER BY id DESC"
+)
+
+var (
+	queryInsertTopUpIntent = "INSERT INTO topup_intent(id, user_id, amount, bank_code, virtual_account_number, status, expiry_time, created_time) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)"
+)
+
+var (
+	querySelectTopUpIntents = "SELECT id, user_id, amount, bank_code, virtual_account_number, status, expiry_time, COALESCE(payment_reference, ''), COALESCE(transaction_id, '00000000-0000-0000-0000-000000000000'), created_time, updated_time FROM topup_intent WHERE true"
+	whereTopUpIntentID      = " AND id = $%d"
+	whereTopUpIntentUserID  = " AND user_id = $%d"
+	orderTopUpIntentsByTime = " ORDER BY created_time DESC"
+)
+
+var (
+	queryLockTopUpIntent = "SELECT id, user_id, amount, bank_code, virtual_account_number, status, expiry_time, COALESCE(payment_reference, ''), COALESCE(transaction_id, '00000000-0000-0000-0000-000000000000'), created_time, updated_time FROM topup_intent WHERE virtual_account_number = $1 FOR UPDATE"
+)
+
+var (
+	queryCountPendingTopUpIntents = "SELECT COUNT(*) FROM topup_intent WHERE user_id = $1 AND status = $2 AND expiry_time > $3"
+)
+
+var (
+	queryUpdateTopUpIntentF         = "UPDATE topup_intent SET %s WHERE id = $%d"
+	setTopUpIntentStatusF           = "status = $%d"
+	setTopUpIntentPaymentReferenceF = "payment_reference = $%d"
+	setTopUpIntentTransactionIDF    = "transaction_id = $%d"
+	setTopUpIntentUpdatedTimeF      = "updated_time = $%d"
+)
+
+var (
+	// Appends to the chain of a User are serialized by a tx-level advisory lock keyed by the User, so every entry is chained
+	// to the entry of the same User committed before it
+	queryLockAuditLog           = "SELECT pg_advisory_xact_lock($1, $2)"
+	querySelectLastAuditLogHash = "SELECT hash FROM audit_log WHERE COALESCE(user_id, 0) = $1 ORDER BY id DESC LIMIT 1"
+	queryInsertAuditLog         = "INSERT INTO audit_log(action, user_id, actor_id, ip, user_agent, details, created_time, prev_hash, hash) VALUES ($1, NULLIF($2::integer, 0), NULLIF($3::integer, 0), $4, $5, $6, $7, $8, $9) RETURNING id"
+)
+
+var (
+	querySelectAuditLog      = "SELECT id, action, COALESCE(user_id, 0), COALESCE(actor_id, 0), ip, user_agent, details, created_time, prev_hash, hash FROM audit_log WHERE true"
+	whereAuditLogUserID      = " AND user_id = $%d"
+	whereAuditLogActorID     = " AND actor_id = $%d"
+	whereAuditLogAction      = " AND action = $%d"
+	whereAuditLogCreatedFrom = " AND created_time >= $%d"
+	whereAuditLogCreatedTo   = " AND created_time < $%d"
+	whereAuditLogAfterID     = " AND id > $%d"
+	orderAuditLogByID        = " ORDER BY id"
 )
 
 var (
-	queryLockUser = "SELECT balance from \"user\" WHERE id = $1 FOR UPDATE"
+	queryLockUser   = "SELECT balance from \"user\" WHERE id = $1 FOR UPDATE"
+	queryLockUsersF = "SELECT id, balance, status FROM \"user\" WHERE id IN (%s) ORDER BY id FOR UPDATE"
 )
@@ -2,46 +2,99 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
-	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
 )
 
+// Repository is shared by all requests and never mutated after construction.
+// Queries run within a DB tx when the tx is carried by the context, see ContextWithTx.
 type Repository struct {
-	exec Executor
+	db SqlDbInterface
+
+	// passwordHashCost is the bcrypt cost of hashing User's password
+	passwordHashCost int
 }
 
 type NewRepositoryOptions struct {
 	Dsn string
+	DB  *sql.DB // Used instead of opening Dsn if set
+
+	PasswordHashCost int // defaultPasswordHashCost if not set
 }
 
-func NewRepository(dbUrl string) *Repository {
-	db, err := sql.Open("postgres", dbUrl)
-	if err != nil {
-		panic(err)
+func NewRepository(opts NewRepositoryOptions) *Repository {
+	db := opts.DB
+	if db == nil {
+		var err error
+		if db, err = sql.Open("postgres", opts.Dsn); err != nil {
+			panic(err)
+		}
 	}
 
-	sqlDb := &SqlDb{
-		db: db,
+	passwordHashCost := opts.PasswordHashCost
+	if passwordHashCost == 0 {
+		passwordHashCost = defaultPasswordHashCost
 	}
 
 	return &Repository{
-		exec: sqlDb,
+		db: &SqlDb{
+			db: db,
+		},
+		passwordHashCost: passwordHashCost,
 	}
 }
 
+// NewRepositoryWithDB creates a Repository on an already opened *sql.DB
+func NewRepositoryWithDB(db *sql.DB) *Repository {
+	return NewRepository(NewRepositoryOptions{DB: db})
+}
+
 func (r *Repository) GetSqlDb() (SqlDbInterface, error) {
-	var (
-		sqlDb *SqlDb
-		ok    bool
-	)
+	return r.db, nil
+}
+
+// WaitForTransactions blocks until every DB transaction in flight is committed or rolled back, or ctx is done.
+// It is used on shutdown so transfers in progress complete before the *sql.DB is closed.
+func (r *Repository) WaitForTransactions(ctx context.Context) error {
+	sqlDb, ok := r.db.(*SqlDb)
+	if !ok {
+		return nil
+	}
 
-	if sqlDb, ok = r.exec.(*SqlDb); !ok {
-		return nil, errors.New("exec is not a sqlDb")
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+
+	for atomic.LoadInt64(&sqlDb.inFlight) > 0 {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return fmt.Errorf("%d DB transactions still in flight: %w", atomic.LoadInt64(&sqlDb.inFlight), ctx.Err())
+		}
 	}
 
-	return sqlDb, nil
+	return nil
+}
+
+type txContextKey struct{}
+
+// ContextWithTx returns a copy of ctx carrying the DB tx, Repository methods called with it run within the tx
+func ContextWithTx(ctx context.Context, tx SqlTxInterface) context.Context {
+	return context.WithValue(ctx, txContextKey{}, tx)
 }
 
-func (r *Repository) SetExecutor(exec Executor) {
-	r.exec = exec
+// TxFromContext returns the DB tx carried by ctx, if any
+func TxFromContext(ctx context.Context) (tx SqlTxInterface, ok bool) {
+	tx, ok = ctx.Value(txContextKey{}).(SqlTxInterface)
+	return tx, ok
+}
+
+// executor returns the DB tx carried by ctx, or the shared *sql.DB otherwise
+func (r *Repository) executor(ctx context.Context) Executor {
+	if tx, ok := TxFromContext(ctx); ok {
+		return tx
+	}
+	return r.db
 }
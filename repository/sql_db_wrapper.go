@@ -4,28 +4,89 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/WalletService/logging"
+	"github.com/WalletService/metrics"
+	"github.com/WalletService/tracing"
 )
 
 type SqlDb struct {
 	db *sql.DB
+
+	// inFlight counts DB transactions begun and not yet committed nor rolled back, see WaitForTransactions
+	inFlight int64
 }
 
-func (r *SqlDb) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+func (r *SqlDb) ExecContext(ctx context.Context, query string, args ...interface{}) (result sql.Result, err error) {
+	ctx, end := startQuery(ctx, "exec", false, query)
+	defer func() { end(err) }()
 	return r.db.ExecContext(ctx, query, args...)
 }
 
-func (r *SqlDb) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+func (r *SqlDb) QueryContext(ctx context.Context, query string, args ...interface{}) (rows *sql.Rows, err error) {
+	ctx, end := startQuery(ctx, "query", false, query)
+	defer func() { end(err) }()
 	return r.db.QueryContext(ctx, query, args...)
 }
 
-func (r *SqlDb) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+func (r *SqlDb) QueryRowContext(ctx context.Context, query string, args ...interface{}) (row *sql.Row) {
+	ctx, end := startQuery(ctx, "query_row", false, query)
+	defer func() { end(row.Err()) }()
 	return r.db.QueryRowContext(ctx, query, args...)
 }
 
 func (r *SqlDb) BeginTx(ctx context.Context, opts *sql.TxOptions) (SqlTxInterface, error) {
+	atomic.AddInt64(&r.inFlight, 1)
 	tx, err := r.db.BeginTx(ctx, opts)
 	if err != nil {
+		atomic.AddInt64(&r.inFlight, -1)
 		return nil, err
 	}
-	return &SqlTx{tx: tx}, nil
+	return &SqlTx{tx: tx, done: func() { atomic.AddInt64(&r.inFlight, -1) }}, nil
+}
+
+// startQuery starts the span of a query, the returned end func ends it with the error of the query,
+// records its latency and logs it at debug level with the request. Args are never recorded, they may hold User data.
+func startQuery(ctx context.Context, method string, inTx bool, query string) (context.Context, func(err error)) {
+	query = strings.Join(strings.Fields(query), " ")
+	start := time.Now()
+
+	ctx, span := tracing.Start(ctx, "db."+method,
+		tracing.String("db.system", "postgresql"),
+		tracing.String("db.statement", query),
+		tracing.Bool("db.in_tx", inTx),
+	)
+
+	return ctx, func(err error) {
+		// No rows is an expected outcome of a query, not a failure
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil
+		}
+		span.End(err)
+
+		duration := time.Since(start)
+		metrics.DBQueryDuration.Observe(duration.Seconds(), method, strconv.FormatBool(inTx))
+
+		logger := logging.FromContext(ctx)
+		if !logger.Enabled(ctx, slog.LevelDebug) {
+			return
+		}
+
+		attrs := []slog.Attr{
+			slog.String("method", method),
+			slog.Bool("in_tx", inTx),
+			slog.String("query", query),
+			slog.Duration("duration", duration),
+		}
+		if err != nil {
+			attrs = append(attrs, slog.Any("error", err))
+		}
+		logger.LogAttrs(ctx, slog.LevelDebug, "db query", attrs...)
+	}
 }
@@ -4,28 +4,47 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"sync"
 )
 
 type SqlTx struct {
 	tx *sql.Tx
+
+	// done is called once the tx is committed or rolled back, whichever comes first
+	done     func()
+	doneOnce sync.Once
 }
 
 func (r *SqlTx) Commit() error {
+	defer r.finish()
 	return r.tx.Commit()
 }
 
 func (r *SqlTx) Rollback() error {
+	defer r.finish()
 	return r.tx.Rollback()
 }
 
-func (r *SqlTx) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+func (r *SqlTx) finish() {
+	if r.done != nil {
+		r.doneOnce.Do(r.done)
+	}
+}
+
+func (r *SqlTx) ExecContext(ctx context.Context, query string, args ...interface{}) (result sql.Result, err error) {
+	ctx, end := startQuery(ctx, "exec", true, query)
+	defer func() { end(err) }()
 	return r.tx.ExecContext(ctx, query, args...)
 }
 
-func (r *SqlTx) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+func (r *SqlTx) QueryContext(ctx context.Context, query string, args ...interface{}) (rows *sql.Rows, err error) {
+	ctx, end := startQuery(ctx, "query", true, query)
+	defer func() { end(err) }()
 	return r.tx.QueryContext(ctx, query, args...)
 }
 
-func (r *SqlTx) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+func (r *SqlTx) QueryRowContext(ctx context.Context, query string, args ...interface{}) (row *sql.Row) {
+	ctx, end := startQuery(ctx, "query_row", true, query)
+	defer func() { end(row.Err()) }()
 	return r.tx.QueryRowContext(ctx, query, args...)
 }
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/WalletService/model"
+)
+
+// SumTransactions returns the total amount of Transactions matching the filter, zero if there are none
+func (r *Repository) SumTransactions(ctx context.Context, request model.TransactionFilter) (sum float32, err error) {
+	query, params := whereTransactions(querySumTransactions, request)
+
+	err = r.executor(ctx).QueryRowContext(ctx, query, params...).Scan(&sum)
+
+	return
+}
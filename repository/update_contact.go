@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/WalletService/model"
+)
+
+var ErrContactNotFound = errors.New("contact not found")
+
+func (r *Repository) UpdateContact(ctx context.Context, in model.UpdateContactRequest) error {
+	result, err := r.executor(ctx).ExecContext(ctx, queryUpdateContact, in.Nickname, time.Now(), in.ID, in.UserID)
+	if err != nil {
+		return err
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	// No rows updated means contact does not exist or belongs to another User
+	if affectedRows == 0 {
+		return ErrContactNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/WalletService/model"
+)
+
+func (r *Repository) UpdateFXQuote(ctx context.Context, in model.UpdateFXQuoteRequest) error {
+	result, err := r.executor(ctx).ExecContext(ctx, queryUpdateFXQuote, in.TransactionID, time.Now(), in.ID)
+	if err != nil {
+		return err
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	// No rows updated means fx quote does not exist
+	if affectedRows == 0 {
+		return errors.New("fx quote not found")
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/WalletService/model"
+)
+
+func (r *Repository) UpdateHouseAccount(ctx context.Context, in model.UpdateHouseAccountRequest) error {
+	var query string
+	switch in.Balance.Type {
+	case model.UpdateBalanceIncrement:
+		query = queryIncrementHouseAccount
+	case model.UpdateBalanceDecrement:
+		query = queryDecrementHouseAccount
+	default:
+		return errors.New("unknow balance update type")
+	}
+
+	result, err := r.executor(ctx).ExecContext(ctx, query, in.Account, transactionCurrency(in.Balance.Currency), in.Balance.Amount, time.Now())
+	if err != nil {
+		return err
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	// No rows updated means the house account never held the currency
+	if affectedRows == 0 {
+		return errors.New("house account balance not enough")
+	}
+
+	return nil
+}
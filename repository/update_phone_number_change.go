@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/WalletService/model"
+)
+
+func (r *Repository) UpdatePhoneNumberChange(ctx context.Context, in model.UpdatePhoneNumberChangeRequest) error {
+	var verifiedTime interface{}
+	if !in.VerifiedTime.IsZero() {
+		verifiedTime = in.VerifiedTime
+	}
+
+	result, err := r.executor(ctx).ExecContext(ctx, queryUpdatePhoneNumberChange, in.Attempts, verifiedTime, in.ID)
+	if err != nil {
+		return err
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	// No rows updated means phone number change does not exist
+	if affectedRows == 0 {
+		return ErrPhoneNumberChangeNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/WalletService/model"
+)
+
+var ErrPocketNotFound = errors.New("pocket not found")
+
+// UpdatePocket replaces the name and target of the Pocket, its balance is only changed by UpdatePocketBalance
+func (r *Repository) UpdatePocket(ctx context.Context, pocket model.Pocket) error {
+	result, err := r.executor(ctx).ExecContext(
+		ctx,
+		queryUpdatePocket,
+		pocket.Name,
+		sql.NullFloat64{Float64: float64(pocket.TargetAmount), Valid: pocket.TargetAmount != 0},
+		pocket.TargetDate,
+		time.Now(),
+		pocket.ID,
+		pocket.UserID,
+	)
+	if err != nil {
+		return err
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	// No rows updated means pocket does not exist or belongs to another User
+	if affectedRows == 0 {
+		return ErrPocketNotFound
+	}
+
+	return nil
+}
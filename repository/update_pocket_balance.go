@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/WalletService/model"
+)
+
+// UpdatePocketBalance increments or decrements the balance of User's Pocket, which is only held in CurrencyIDR.
+// The mutation is appended to the audit log like the ones made by UpdateUser.
+func (r *Repository) UpdatePocketBalance(ctx context.Context, userID, pocketID int64, balance model.UpdateBalanceRequest) error {
+	if balance.Currency != "" && balance.Currency != model.CurrencyIDR {
+		return errors.New("pocket balance can only be in IDR")
+	}
+
+	var query string
+	switch balance.Type {
+	case model.UpdateBalanceIncrement:
+		query = queryIncrementPocketBalance
+	case model.UpdateBalanceDecrement:
+		query = queryDecrementPocketBalance
+	default:
+		return errors.New("unknow balance update type")
+	}
+
+	result, err := r.executor(ctx).ExecContext(ctx, query, balance.Amount, time.Now(), pocketID, userID)
+	if err != nil {
+		return err
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	// No rows updated means pocket does not exist or belongs to another User
+	if affectedRows == 0 {
+		return ErrPocketNotFound
+	}
+
+	_, err = r.InsertAuditLog(ctx, model.AuditLogEntry{
+		Action: model.AuditActionBalanceUpdate,
+		UserID: userID,
+		Details: map[string]string{
+			"type":      string(balance.Type),
+			"amount":    strconv.FormatFloat(float64(balance.Amount), 'f', -1, 32),
+			"currency":  string(model.CurrencyIDR),
+			"pocket_id": strconv.FormatInt(pocketID, 10),
+		},
+	})
+	return err
+}
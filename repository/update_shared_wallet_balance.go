@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/WalletService/model"
+)
+
+// UpdateSharedWalletBalance increments or decrements the balance of the SharedWallet, which is only held in CurrencyIDR.
+// The mutation is appended to the audit log for the member making it, like the ones made by UpdateUser.
+func (r *Repository) UpdateSharedWalletBalance(ctx context.Context, sharedWalletID, memberID int64, balance model.UpdateBalanceRequest) error {
+	if balance.Currency != "" && balance.Currency != model.CurrencyIDR {
+		return errors.New("shared wallet balance can only be in IDR")
+	}
+
+	var query string
+	switch balance.Type {
+	case model.UpdateBalanceIncrement:
+		query = queryIncrementSharedWalletBalance
+	case model.UpdateBalanceDecrement:
+		query = queryDecrementSharedWalletBalance
+	default:
+		return errors.New("unknow balance update type")
+	}
+
+	result, err := r.executor(ctx).ExecContext(ctx, query, balance.Amount, time.Now(), sharedWalletID)
+	if err != nil {
+		return err
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affectedRows == 0 {
+		return ErrSharedWalletNotFound
+	}
+
+	_, err = r.InsertAuditLog(ctx, model.AuditLogEntry{
+		Action: model.AuditActionBalanceUpdate,
+		UserID: memberID,
+		Details: map[string]string{
+			"type":             string(balance.Type),
+			"amount":           strconv.FormatFloat(float64(balance.Amount), 'f', -1, 32),
+			"currency":         string(model.CurrencyIDR),
+			"shared_wallet_id": strconv.FormatInt(sharedWalletID, 10),
+		},
+	})
+	return err
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/WalletService/model"
+)
+
+var ErrSharedWalletMemberNotFound = errors.New("shared wallet member not found")
+
+// UpdateSharedWalletMember replaces the role and limits of the member
+func (r *Repository) UpdateSharedWalletMember(ctx context.Context, member model.SharedWalletMember) error {
+	result, err := r.executor(ctx).ExecContext(
+		ctx,
+		queryUpdateSharedWalletMember,
+		member.Role,
+		sql.NullFloat64{Float64: float64(member.TransactionLimit), Valid: member.TransactionLimit != 0},
+		sql.NullFloat64{Float64: float64(member.MonthlyLimit), Valid: member.MonthlyLimit != 0},
+		time.Now(),
+		member.SharedWalletID,
+		member.UserID,
+	)
+	if err != nil {
+		return err
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	// No rows updated means User is not a member of the shared wallet
+	if affectedRows == 0 {
+		return ErrSharedWalletMemberNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/WalletService/model"
+	"github.com/google/uuid"
+)
+
+func (r *Repository) UpdateTopUpIntent(ctx context.Context, in model.UpdateTopUpIntentRequest) error {
+	var (
+		setFields []string
+		params    []interface{}
+		offset    int = 0
+	)
+
+	if in.Status != "" {
+		setFields = append(setFields, fmt.Sprintf(setTopUpIntentStatusF, offset+1))
+		params = append(params, in.Status)
+		offset++
+	}
+
+	if in.PaymentReference != "" {
+		setFields = append(setFields, fmt.Sprintf(setTopUpIntentPaymentReferenceF, offset+1))
+		params = append(params, in.PaymentReference)
+		offset++
+	}
+
+	if in.TransactionID != uuid.Nil {
+		setFields = append(setFields, fmt.Sprintf(setTopUpIntentTransactionIDF, offset+1))
+		params = append(params, in.TransactionID)
+		offset++
+	}
+
+	setFields = append(setFields, fmt.Sprintf(setTopUpIntentUpdatedTimeF, offset+1))
+	params = append(params, time.Now())
+	offset++
+
+	query := fmt.Sprintf(queryUpdateTopUpIntentF, strings.Join(setFields, ","), offset+1)
+	params = append(params, in.ID)
+
+	result, err := r.executor(ctx).ExecContext(ctx, query, params...)
+	if err != nil {
+		return err
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	// No rows updated means top-up intent does not exist
+	if affectedRows == 0 {
+		return ErrTopUpIntentNotFound
+	}
+
+	return nil
+}
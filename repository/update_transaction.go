@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/WalletService/model"
+)
+
+func (r *Repository) UpdateTransaction(ctx context.Context, in model.UpdateTransactionRequest) error {
+	var (
+		setFields []string
+		params    []interface{}
+		offset    int = 0
+	)
+
+	if in.Status != "" {
+		setFields = append(setFields, fmt.Sprintf(setTransactionStatusF, offset+1))
+		params = append(params, in.Status)
+		offset++
+	}
+
+	if in.DisbursementReference != "" {
+		setFields = append(setFields, fmt.Sprintf(setTransactionDisbursementRefF, offset+1))
+		params = append(params, in.DisbursementReference)
+		offset++
+	}
+
+	if in.Description != "" {
+		setFields = append(setFields, fmt.Sprintf(setTransactionDescriptionF, offset+1))
+		params = append(params, in.Description)
+		offset++
+	}
+
+	setFields = append(setFields, fmt.Sprintf(setTransactionUpdatedTimeF, offset+1))
+	params = append(params, time.Now())
+	offset++
+
+	query := fmt.Sprintf(queryUpdateTransactionF, strings.Join(setFields, ","), offset+1)
+	params = append(params, in.ID)
+
+	result, err := r.executor(ctx).ExecContext(ctx, query, params...)
+	if err != nil {
+		return err
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	// No rows updated means transaction does not exist
+	if affectedRows == 0 {
+		return errors.New("transaction not found")
+	}
+
+	return nil
+}
@@ -19,6 +19,14 @@ func (r *Repository) UpdateUser(ctx context.Context, in model.UpdateUserRequest)
 		offset    int = 0
 	)
 
+	// Only IDR balance is stored in "user", other currencies are in user_balance
+	if in.Balance.Currency != "" && in.Balance.Currency != model.CurrencyIDR {
+		if in.Status != "" || in.FullName != "" || in.PhoneNumber != "" {
+			return errors.New("status and profile can not be updated with a non-IDR balance")
+		}
+		return r.updateUserBalance(ctx, in)
+	}
+
 	if in.Balance != (model.UpdateBalanceRequest{}) {
 		switch in.Balance.Type {
 		case model.UpdateBalanceIncrement:
@@ -36,6 +44,25 @@ func (r *Repository) UpdateUser(ctx context.Context, in model.UpdateUserRequest)
 		offset++
 	}
 
+	if in.Status != "" {
+		setFields = append(setFields, fmt.Sprintf(setUserStatusF, offset+1))
+		params = append(params, in.Status)
+		offset++
+	}
+
+	if in.FullName != "" {
+		setFields = append(setFields, fmt.Sprintf(setUserFullNameF, offset+1))
+		params = append(params, in.FullName)
+		offset++
+	}
+
+	// Violates the unique constraint if another User has the phone number, see utils.IsUniqueConstraintViolation
+	if in.PhoneNumber != "" {
+		setFields = append(setFields, fmt.Sprintf(setUserPhoneNumberF, offset+1))
+		params = append(params, in.PhoneNumber)
+		offset++
+	}
+
 	setFields = append(setFields, fmt.Sprintf(setUserUpdatedTimeF, offset+1))
 	params = append(
 		params,
@@ -56,7 +83,7 @@ func (r *Repository) UpdateUser(ctx context.Context, in model.UpdateUserRequest)
 		result sql.Result
 		err    error
 	)
-	if result, err = r.exec.ExecContext(ctx, query, params...); err != nil {
+	if result, err = r.executor(ctx).ExecContext(ctx, query, params...); err != nil {
 		return err
 	}
 
@@ -68,8 +95,41 @@ func (r *Repository) UpdateUser(ctx context.Context, in model.UpdateUserRequest)
 
 	// No rows updated means user does not exist
 	if affectedRows == 0 {
-		return errors.New("user not found")
+		return ErrUserNotFound
+	}
+
+	if in.Balance != (model.UpdateBalanceRequest{}) {
+		return r.auditBalanceUpdate(ctx, in)
 	}
 
 	return nil
 }
+
+func (r *Repository) updateUserBalance(ctx context.Context, in model.UpdateUserRequest) error {
+	var query string
+	switch in.Balance.Type {
+	case model.UpdateBalanceIncrement:
+		query = queryIncrementUserBalance
+	case model.UpdateBalanceDecrement:
+		query = queryDecrementUserBalance
+	default:
+		return errors.New("unknow balance update type")
+	}
+
+	result, err := r.executor(ctx).ExecContext(ctx, query, in.UserID, in.Balance.Currency, in.Balance.Amount, time.Now())
+	if err != nil {
+		return err
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	// No rows updated means User never held the currency
+	if affectedRows == 0 {
+		return errors.New("balance not enough")
+	}
+
+	return r.auditBalanceUpdate(ctx, in)
+}
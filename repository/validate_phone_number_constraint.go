@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+)
+
+// ValidatePhoneNumberConstraint checks every phone number of "user" is in E.164, so the constraint also holds for rows added before it.
+// It fails if a phone number is not.
+func (r *Repository) ValidatePhoneNumberConstraint(ctx context.Context) error {
+	_, err := r.executor(ctx).ExecContext(ctx, queryValidateUserPhoneNumberE164)
+	return err
+}
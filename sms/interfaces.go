@@ -0,0 +1,15 @@
+// This file contains the interfaces for the SMS sender.
+// The SMS sender delivers one-time passwords and security notifications to a User's phone number.
+// For testing purpose we will generate mock implementations of these
+// interfaces using mockgen. See the Makefile for more information.
+package sms
+
+import (
+	"context"
+)
+
+// Sender delivers a text message to a phone number in E.164 format, i.e. +628123456789.
+// A nil error only means the provider accepted the message, delivery is not confirmed.
+type Sender interface {
+	Send(ctx context.Context, phoneNumber, message string) error
+}
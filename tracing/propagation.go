@@ -0,0 +1,72 @@
+package tracing
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// TraceparentHeader carries the SpanContext across processes, see https://www.w3.org/TR/trace-context/
+const TraceparentHeader = "traceparent"
+
+const sampledFlag = 0x01
+
+// Traceparent formats spanContext as the value of the traceparent header
+func (sc SpanContext) Traceparent() string {
+	flags := 0
+	if sc.Sampled {
+		flags = sampledFlag
+	}
+	return fmt.Sprintf("00-%s-%s-%02x", sc.TraceID, sc.SpanID, flags)
+}
+
+// ParseTraceparent parses the value of the traceparent header, ok is false if it is invalid
+func ParseTraceparent(traceparent string) (spanContext SpanContext, ok bool) {
+	parts := strings.Split(strings.TrimSpace(traceparent), "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || parts[0] == "ff" {
+		return SpanContext{}, false
+	}
+	// Version 00 has exactly 4 parts, later versions may append more
+	if parts[0] == "00" && len(parts) != 4 {
+		return SpanContext{}, false
+	}
+
+	if !decodeHex(parts[1], spanContext.TraceID[:]) || !decodeHex(parts[2], spanContext.SpanID[:]) {
+		return SpanContext{}, false
+	}
+
+	var flags [1]byte
+	if !decodeHex(parts[3], flags[:]) {
+		return SpanContext{}, false
+	}
+	spanContext.Sampled = flags[0]&sampledFlag != 0
+
+	return spanContext, spanContext.IsValid()
+}
+
+// decodeHex decodes lower case hex s into dst, which s must fill exactly
+func decodeHex(s string, dst []byte) bool {
+	if len(s) != hex.EncodedLen(len(dst)) || strings.ToLower(s) != s {
+		return false
+	}
+	_, err := hex.Decode(dst, []byte(s))
+	return err == nil
+}
+
+// Inject sets the traceparent header to the span carried by ctx, so the receiver continues the trace
+func Inject(ctx context.Context, header http.Header) {
+	if spanContext := SpanContextFromContext(ctx); spanContext.IsValid() {
+		header.Set(TraceparentHeader, spanContext.Traceparent())
+	}
+}
+
+// Extract returns a copy of ctx continuing the trace of a valid traceparent header, otherwise ctx
+func Extract(ctx context.Context, header http.Header) context.Context {
+	spanContext, ok := ParseTraceparent(header.Get(TraceparentHeader))
+	if !ok {
+		return ctx
+	}
+	return ContextWithRemoteSpanContext(ctx, spanContext)
+}
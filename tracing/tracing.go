@@ -0,0 +1,252 @@
+// Package tracing records spans of work, i.e. an HTTP request, a usecase method or a DB query,
+// and exports them to a collector. Spans are threaded through context, each span started from a context
+// becomes a child of the span carried by it, or of the remote span propagated with the W3C traceparent header.
+// The model follows OpenTelemetry, so spans can be exported with OTLP to any OpenTelemetry collector.
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/WalletService/logging"
+)
+
+type TraceID [16]byte
+
+func (id TraceID) String() string { return hex.EncodeToString(id[:]) }
+func (id TraceID) IsValid() bool  { return id != TraceID{} }
+
+type SpanID [8]byte
+
+func (id SpanID) String() string { return hex.EncodeToString(id[:]) }
+func (id SpanID) IsValid() bool  { return id != SpanID{} }
+
+// SpanContext identifies a span across process boundaries, see Inject and Extract
+type SpanContext struct {
+	TraceID TraceID
+	SpanID  SpanID
+	Sampled bool // Spans of unsampled traces are not exported
+}
+
+func (sc SpanContext) IsValid() bool {
+	return sc.TraceID.IsValid() && sc.SpanID.IsValid()
+}
+
+type SpanKind int
+
+// Values are the OTLP span kinds
+const (
+	SpanKindInternal SpanKind = 1
+	SpanKindServer   SpanKind = 2
+	SpanKindClient   SpanKind = 3
+)
+
+type StatusCode int
+
+// Values are the OTLP status codes
+const (
+	StatusUnset StatusCode = 0
+	StatusOK    StatusCode = 1
+	StatusError StatusCode = 2
+)
+
+type Attribute struct {
+	Key   string
+	Value any // string, int64, float64 or bool
+}
+
+func String(key, value string) Attribute          { return Attribute{Key: key, Value: value} }
+func Int64(key string, value int64) Attribute     { return Attribute{Key: key, Value: value} }
+func Float64(key string, value float64) Attribute { return Attribute{Key: key, Value: value} }
+func Bool(key string, value bool) Attribute       { return Attribute{Key: key, Value: value} }
+
+// SpanData is an ended span as exported
+type SpanData struct {
+	Name          string
+	SpanContext   SpanContext
+	ParentSpanID  SpanID // Invalid for the root span of a trace
+	Kind          SpanKind
+	StartTime     time.Time
+	EndTime       time.Time
+	Attributes    []Attribute
+	StatusCode    StatusCode
+	StatusMessage string
+}
+
+// Exporter sends ended spans to where they are stored, i.e. a collector
+type Exporter interface {
+	ExportSpans(ctx context.Context, spans []SpanData) error
+	// Shutdown exports spans still buffered, no span is exported after it returns
+	Shutdown(ctx context.Context) error
+}
+
+// Tracer starts spans and exports them once ended
+type Tracer struct {
+	exporter Exporter // Spans are not exported if nil
+}
+
+func NewTracer(exporter Exporter) *Tracer {
+	return &Tracer{exporter: exporter}
+}
+
+var defaultTracer atomic.Pointer[Tracer]
+
+func init() {
+	defaultTracer.Store(NewTracer(nil))
+}
+
+// SetDefault sets the Tracer used by Start, which exports nothing until set
+func SetDefault(t *Tracer) {
+	defaultTracer.Store(t)
+}
+
+func Default() *Tracer {
+	return defaultTracer.Load()
+}
+
+// Start starts an internal span with the default Tracer, see Tracer.Start
+func Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, *Span) {
+	return Default().Start(ctx, name, SpanKindInternal, attrs...)
+}
+
+// Start starts a span as a child of the span carried by ctx, or of the remote span extracted into ctx,
+// otherwise as the root of a new trace. The returned context carries the new span.
+func (t *Tracer) Start(ctx context.Context, name string, kind SpanKind, attrs ...Attribute) (context.Context, *Span) {
+	parent := SpanContextFromContext(ctx)
+
+	spanContext := SpanContext{
+		TraceID: parent.TraceID,
+		SpanID:  newSpanID(),
+		Sampled: parent.Sampled,
+	}
+	if !parent.IsValid() {
+		spanContext.TraceID = newTraceID()
+		spanContext.Sampled = true
+	}
+
+	span := &Span{
+		tracer: t,
+		data: SpanData{
+			Name:         name,
+			SpanContext:  spanContext,
+			ParentSpanID: parent.SpanID,
+			Kind:         kind,
+			StartTime:    time.Now(),
+			Attributes:   attrs,
+		},
+	}
+
+	return context.WithValue(ctx, spanKey, span), span
+}
+
+// Shutdown exports spans still buffered by the exporter
+func (t *Tracer) Shutdown(ctx context.Context) error {
+	if t.exporter == nil {
+		return nil
+	}
+	return t.exporter.Shutdown(ctx)
+}
+
+// Span is a unit of work in a trace, it is exported once ended
+type Span struct {
+	tracer *Tracer
+
+	mu    sync.Mutex
+	data  SpanData
+	ended bool
+}
+
+func (s *Span) SpanContext() SpanContext {
+	return s.data.SpanContext
+}
+
+// SetName renames the span, i.e. once the route of a request is known
+func (s *Span) SetName(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data.Name = name
+}
+
+func (s *Span) SetAttributes(attrs ...Attribute) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data.Attributes = append(s.data.Attributes, attrs...)
+}
+
+// SetError marks the span as failed with err, a nil err leaves its status unchanged
+func (s *Span) SetError(err error) {
+	if err == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data.StatusCode = StatusError
+	s.data.StatusMessage = logging.Redact(err.Error())
+}
+
+// End ends the span, marked as failed if err is not nil, and exports it. Only the first End has effect.
+func (s *Span) End(err error) {
+	s.SetError(err)
+
+	s.mu.Lock()
+	if s.ended {
+		s.mu.Unlock()
+		return
+	}
+	s.ended = true
+	s.data.EndTime = time.Now()
+	data := s.data
+	s.mu.Unlock()
+
+	if s.tracer.exporter == nil || !data.SpanContext.Sampled {
+		return
+	}
+	// Exporting must not fail the work the span recorded, exporters report their own errors
+	_ = s.tracer.exporter.ExportSpans(context.Background(), []SpanData{data})
+}
+
+type contextKey int
+
+const (
+	spanKey contextKey = iota
+	remoteSpanContextKey
+)
+
+// SpanFromContext returns the span carried by ctx, nil if there is none
+func SpanFromContext(ctx context.Context) *Span {
+	span, _ := ctx.Value(spanKey).(*Span)
+	return span
+}
+
+// SpanContextFromContext returns the SpanContext of the span carried by ctx, or of the remote span extracted into ctx
+func SpanContextFromContext(ctx context.Context) SpanContext {
+	if span := SpanFromContext(ctx); span != nil {
+		return span.SpanContext()
+	}
+	spanContext, _ := ctx.Value(remoteSpanContextKey).(SpanContext)
+	return spanContext
+}
+
+// ContextWithRemoteSpanContext returns a copy of ctx whose spans are children of the remote span identified by spanContext
+func ContextWithRemoteSpanContext(ctx context.Context, spanContext SpanContext) context.Context {
+	return context.WithValue(ctx, remoteSpanContextKey, spanContext)
+}
+
+func newTraceID() (id TraceID) {
+	for !id.IsValid() {
+		_, _ = rand.Read(id[:])
+	}
+	return id
+}
+
+func newSpanID() (id SpanID) {
+	for !id.IsValid() {
+		_, _ = rand.Read(id[:])
+	}
+	return id
+}
@@ -0,0 +1,211 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestParseTraceparent(t *testing.T) {
+	tests := []struct {
+		name        string
+		traceparent string
+		wantOK      bool
+		wantSampled bool
+	}{
+		{
+			name:        "sampled",
+			traceparent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			wantOK:      true,
+			wantSampled: true,
+		},
+		{
+			name:        "not-sampled",
+			traceparent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00",
+			wantOK:      true,
+		},
+		{
+			name:        "future-version-with-more-fields",
+			traceparent: "01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+			wantOK:      true,
+			wantSampled: true,
+		},
+		{
+			name:        "zero-trace-id",
+			traceparent: "00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		},
+		{
+			name:        "upper-case",
+			traceparent: "00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01",
+		},
+		{
+			name:        "invalid-version",
+			traceparent: "ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		},
+		{
+			name:        "short-span-id",
+			traceparent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa-01",
+		},
+		{
+			name: "empty",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			spanContext, ok := ParseTraceparent(test.traceparent)
+			if ok != test.wantOK {
+				t.Fatalf("ParseTraceparent() ok = %v, want %v", ok, test.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if spanContext.Sampled != test.wantSampled {
+				t.Errorf("ParseTraceparent() sampled = %v, want %v", spanContext.Sampled, test.wantSampled)
+			}
+			if spanContext.TraceID.String() != "4bf92f3577b34da6a3ce929d0e0e4736" || spanContext.SpanID.String() != "00f067aa0ba902b7" {
+				t.Errorf("ParseTraceparent() = %v", spanContext)
+			}
+		})
+	}
+}
+
+func TestInjectExtract(t *testing.T) {
+	tracer := NewTracer(nil)
+	_, span := tracer.Start(context.Background(), "client", SpanKindClient)
+
+	header := http.Header{}
+	Inject(context.WithValue(context.Background(), spanKey, span), header)
+	if got, want := header.Get(TraceparentHeader), span.SpanContext().Traceparent(); got != want {
+		t.Fatalf("Inject() traceparent = %v, want %v", got, want)
+	}
+
+	ctx := Extract(context.Background(), header)
+	_, child := tracer.Start(ctx, "server", SpanKindServer)
+	if child.SpanContext().TraceID != span.SpanContext().TraceID || child.data.ParentSpanID != span.SpanContext().SpanID {
+		t.Errorf("span started from Extract() is not a child of the injected span")
+	}
+}
+
+func TestTracer_Start(t *testing.T) {
+	exporter := NewInMemoryExporter()
+	tracer := NewTracer(exporter)
+
+	ctx, root := tracer.Start(context.Background(), "root", SpanKindServer)
+	childCtx, child := tracer.Start(ctx, "child", SpanKindInternal, String("key", "value"))
+	_, grandchild := tracer.Start(childCtx, "grandchild", SpanKindInternal)
+
+	grandchild.End(errors.New("user +6281122334455 not found"))
+	child.End(nil)
+	root.End(nil)
+	root.End(errors.New("ended twice"))
+
+	spans := exporter.Spans()
+	if len(spans) != 3 {
+		t.Fatalf("exported %d spans, want 3", len(spans))
+	}
+
+	byName := map[string]SpanData{}
+	for _, span := range spans {
+		byName[span.Name] = span
+		if span.SpanContext.TraceID != root.SpanContext().TraceID {
+			t.Errorf("span %s is not in the trace of root", span.Name)
+		}
+	}
+
+	if byName["root"].ParentSpanID.IsValid() || byName["root"].StatusCode != StatusUnset {
+		t.Errorf("root = %+v, want no parent and unset status", byName["root"])
+	}
+	if byName["child"].ParentSpanID != byName["root"].SpanContext.SpanID {
+		t.Errorf("child parent = %v, want root", byName["child"].ParentSpanID)
+	}
+	if byName["grandchild"].ParentSpanID != byName["child"].SpanContext.SpanID {
+		t.Errorf("grandchild parent = %v, want child", byName["grandchild"].ParentSpanID)
+	}
+	if got := byName["grandchild"]; got.StatusCode != StatusError || strings.Contains(got.StatusMessage, "+6281122334455") {
+		t.Errorf("grandchild status = %v %q, want redacted error", got.StatusCode, got.StatusMessage)
+	}
+}
+
+func TestTracer_Start_NotSampled(t *testing.T) {
+	exporter := NewInMemoryExporter()
+	tracer := NewTracer(exporter)
+
+	spanContext, _ := ParseTraceparent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00")
+	_, span := tracer.Start(ContextWithRemoteSpanContext(context.Background(), spanContext), "server", SpanKindServer)
+	span.End(nil)
+
+	if spans := exporter.Spans(); len(spans) != 0 {
+		t.Errorf("exported %d spans of an unsampled trace, want 0", len(spans))
+	}
+}
+
+func TestStdoutExporter(t *testing.T) {
+	var buf bytes.Buffer
+	tracer := NewTracer(NewStdoutExporter(&buf))
+
+	_, span := tracer.Start(context.Background(), "GetUser", SpanKindServer, Int64("http.response.status_code", 200))
+	span.End(nil)
+
+	line := map[string]any{}
+	if err := json.Unmarshal(buf.Bytes(), &line); err != nil {
+		t.Fatalf("span line is not JSON: %v, %s", err, buf.String())
+	}
+	if line["name"] != "GetUser" || line["kind"] != "server" || line["trace_id"] != span.SpanContext().TraceID.String() {
+		t.Errorf("span line = %s", buf.String())
+	}
+}
+
+func TestOTLPExporter(t *testing.T) {
+	var body []byte
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/traces" || r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("request = %s %s", r.URL.Path, r.Header.Get("Content-Type"))
+		}
+		body, _ = io.ReadAll(r.Body)
+	}))
+	defer collector.Close()
+
+	exporter := NewOTLPExporter(collector.URL+"/v1/traces", "wallet-service")
+	tracer := NewTracer(exporter)
+
+	ctx, parent := tracer.Start(context.Background(), "CreateUserTransaction", SpanKindServer)
+	_, child := tracer.Start(ctx, "db.exec", SpanKindInternal, Int64("rows", 1), Bool("db.in_tx", true))
+	child.End(errors.New("deadlock detected"))
+	parent.End(nil)
+
+	// Shutdown sends the spans still queued
+	if err := exporter.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() error = %v", err)
+	}
+
+	var request otlpRequest
+	if err := json.Unmarshal(body, &request); err != nil {
+		t.Fatalf("collector received invalid JSON: %v, %s", err, body)
+	}
+
+	resource := request.ResourceSpans[0]
+	if value := resource.Resource.Attributes[0].Value["stringValue"]; value != "wallet-service" {
+		t.Errorf("service.name = %v, want wallet-service", value)
+	}
+
+	spans := resource.ScopeSpans[0].Spans
+	if len(spans) != 2 {
+		t.Fatalf("collector received %d spans, want 2", len(spans))
+	}
+	if spans[0].Name != "db.exec" || spans[0].ParentSpanID != spans[1].SpanID || spans[0].Status.Code != StatusError {
+		t.Errorf("child span = %+v", spans[0])
+	}
+	if spans[0].Attributes[0].Value["intValue"] != "1" || spans[0].Attributes[1].Value["boolValue"] != true {
+		t.Errorf("child attributes = %+v", spans[0].Attributes)
+	}
+	if spans[1].Kind != SpanKindServer || spans[1].ParentSpanID != "" {
+		t.Errorf("parent span = %+v", spans[1])
+	}
+}
@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/WalletService/model"
+	"github.com/WalletService/tracing"
+	"github.com/WalletService/utils"
+)
+
+var (
+	ErrAdjustmentReasonRequired = errors.New("reason is required to adjust balance")
+	ErrAdjustmentOwnBalance     = errors.New("cannot adjust own balance")
+)
+
+// GetUserPermissions returns the permissions granted to User by its roles, on top of the permissions every User has
+func (uc *Usecase) GetUserPermissions(ctx context.Context, userID int64) (permissions []utils.JWTPermission, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.GetUserPermissions")
+	defer func() { span.End(err) }()
+
+	granted, err := uc.Repository.GetUserPermissions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, permission := range granted {
+		permissions = append(permissions, utils.JWTPermission(permission))
+	}
+
+	return permissions, nil
+}
+
+// GetUserTransactions lists the Transactions of any User, most recent first
+func (uc *Usecase) GetUserTransactions(ctx context.Context, request model.TransactionFilter) (transactions []model.Transaction, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.GetUserTransactions", tracing.Int64("user_id", request.ParticipantID))
+	defer func() { span.End(err) }()
+
+	// Validate User exists, so an unknown User is not mistaken for one without Transactions
+	if _, err := uc.Repository.GetUser(ctx, request.ParticipantID); err != nil {
+		return nil, err
+	}
+
+	return uc.Repository.GetTransactions(ctx, request)
+}
+
+// AdjustUserBalance corrects User's balance on behalf of an admin, recorded as an AdjustmentCredit or AdjustmentDebit Transaction.
+// The reason is kept as the Transaction description, and the admin as its actor.
+func (uc *Usecase) AdjustUserBalance(ctx context.Context, adjustment model.BalanceAdjustment) (newTransaction model.Transaction, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.AdjustUserBalance",
+		tracing.Int64("user_id", adjustment.UserID),
+		tracing.Int64("actor_id", adjustment.ActorID),
+	)
+	defer func() { span.End(err) }()
+
+	if adjustment.Amount == 0 {
+		return model.Transaction{}, errors.New("invalid amount")
+	}
+	if adjustment.Reason == "" {
+		return model.Transaction{}, ErrAdjustmentReasonRequired
+	}
+	if adjustment.UserID == adjustment.ActorID {
+		return model.Transaction{}, ErrAdjustmentOwnBalance
+	}
+
+	// Adjustment is in IDR unless requested otherwise
+	if adjustment.Currency == "" {
+		adjustment.Currency = model.CurrencyIDR
+	}
+	if !isSupportedCurrency(adjustment.Currency) {
+		return model.Transaction{}, errors.New("unsupported currency")
+	}
+
+	newTransaction = model.Transaction{
+		UserID:      adjustment.UserID,
+		Amount:      adjustment.Amount,
+		Type:        model.TransactionTypeAdjustmentCredit,
+		RecipientID: adjustment.UserID,
+		Description: adjustment.Reason,
+		Currency:    adjustment.Currency,
+		ActorID:     adjustment.ActorID,
+	}
+	balanceUpdate := model.UpdateBalanceIncrement
+	if adjustment.Amount < 0 {
+		newTransaction.Amount = -adjustment.Amount
+		newTransaction.Type = model.TransactionTypeAdjustmentDebit
+		balanceUpdate = model.UpdateBalanceDecrement
+	}
+
+	if err = utils.WithDbTxRetry(ctx, uc.Repository, func(ctx context.Context) error {
+		// 1. Lock User data to prevent race condition
+		lockedUsers, err := uc.lockUsers(ctx, adjustment.UserID)
+		if err != nil {
+			return err
+		}
+
+		// 2. Validate User is not closed and debit does not make User's balance negative
+		if lockedUsers[adjustment.UserID].Status == model.UserStatusClosed {
+			return ErrUserClosed
+		}
+		if balanceUpdate == model.UpdateBalanceDecrement {
+			balance, err := uc.lockedBalanceOf(ctx, lockedUsers[adjustment.UserID], adjustment.Currency)
+			if err != nil {
+				return err
+			}
+			if balance < newTransaction.Amount {
+				return errors.New("balance not enough")
+			}
+		}
+
+		// 3. Update User's balance
+		if err := uc.Repository.UpdateUser(ctx, model.UpdateUserRequest{
+			UserID: adjustment.UserID,
+			Balance: model.UpdateBalanceRequest{
+				Amount:   newTransaction.Amount,
+				Type:     balanceUpdate,
+				Currency: adjustment.Currency,
+			},
+		}); err != nil {
+			return err
+		}
+
+		// 4. Insert a Successful Transaction record
+		newTransaction.Status = model.TransactionStatusSuccessful
+		if newTransaction.ID, err = uc.Repository.InsertTransaction(ctx, newTransaction); err != nil {
+			return err
+		}
+
+		// 5. Record the admin action in the audit log
+		_, err = uc.Repository.InsertAuditLog(ctx, model.AuditLogEntry{
+			Action:  model.AuditActionBalanceAdjustment,
+			UserID:  adjustment.UserID,
+			ActorID: adjustment.ActorID,
+			Details: map[string]string{
+				"transaction_id": newTransaction.ID.String(),
+				"type":           string(newTransaction.Type),
+				"amount":         strconv.FormatFloat(float64(newTransaction.Amount), 'f', -1, 32),
+				"currency":       string(newTransaction.Currency),
+				"reason":         adjustment.Reason,
+			},
+		})
+		return err
+	}); err != nil {
+		recordTransaction(newTransaction, model.TransactionStatusFailed)
+		auditTransaction(ctx, newTransaction, model.TransactionStatusFailed, err)
+		return model.Transaction{}, err
+	}
+
+	recordTransaction(newTransaction, newTransaction.Status)
+	auditTransaction(ctx, newTransaction, newTransaction.Status, nil)
+
+	return newTransaction, nil
+}
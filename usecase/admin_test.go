@@ -0,0 +1,234 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/WalletService/model"
+	"github.com/WalletService/repository"
+	"github.com/WalletService/utils"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+)
+
+func TestGetUserPermissions(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockRepository := repository.NewMockRepositoryInterface(controller)
+	mockRepository.EXPECT().GetUserPermissions(gomock.Any(), int64(1)).Return([]string{"balance:adjust", "user:read_any"}, nil)
+
+	usecase := &Usecase{Repository: mockRepository}
+
+	gotPermissions, err := usecase.GetUserPermissions(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("usecase.GetUserPermissions() error = %v", err)
+	}
+
+	wantPermissions := []utils.JWTPermission{utils.JWTPermissionAdjustBalance, utils.JWTPermissionReadAnyUser}
+	if !reflect.DeepEqual(gotPermissions, wantPermissions) {
+		t.Errorf("usecase.GetUserPermissions() = %v, want %v", gotPermissions, wantPermissions)
+	}
+}
+
+func TestAdjustUserBalance(t *testing.T) {
+	transactionID := convertToUUID("3d6e668f-ad02-40ff-8540-90c1528a7c88")
+
+	mockDbTx := func(ctrl *gomock.Controller, m *repository.MockRepositoryInterface, commit bool) {
+		sqlDb := repository.NewMockSqlDbInterface(ctrl)
+		sqlTx := repository.NewMockSqlTxInterface(ctrl)
+
+		m.EXPECT().GetSqlDb().Return(sqlDb, nil).Times(1)
+		sqlDb.EXPECT().BeginTx(gomock.Any(), gomock.Nil()).Return(sqlTx, nil).Times(1)
+		if commit {
+			sqlTx.EXPECT().Commit().Return(nil).Times(1)
+		} else {
+			sqlTx.EXPECT().Rollback().Return(nil).Times(1)
+		}
+	}
+
+	tests := []struct {
+		name              string
+		input             model.BalanceAdjustment
+		mockRepository    func(controller *gomock.Controller) *repository.MockRepositoryInterface
+		wantTransactionID uuid.UUID
+		wantErr           error
+	}{
+		{
+			name: "success-credit",
+			input: model.BalanceAdjustment{
+				UserID:  1234,
+				ActorID: 1,
+				Amount:  5000,
+				Reason:  "Goodwill credit",
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockDbTx(ctrl, m, true)
+
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234}).Return([]model.User{{ID: 1234, Balance: 0}}, nil).Times(1)
+				m.EXPECT().UpdateUser(gomock.Any(), model.UpdateUserRequest{
+					UserID: 1234,
+					Balance: model.UpdateBalanceRequest{
+						Amount:   5000,
+						Type:     model.UpdateBalanceIncrement,
+						Currency: model.CurrencyIDR,
+					},
+				}).Return(nil).Times(1)
+				m.EXPECT().InsertTransaction(gomock.Any(), model.Transaction{
+					UserID:      1234,
+					Amount:      5000,
+					Type:        model.TransactionTypeAdjustmentCredit,
+					RecipientID: 1234,
+					Status:      model.TransactionStatusSuccessful,
+					Description: "Goodwill credit",
+					Currency:    model.CurrencyIDR,
+					ActorID:     1,
+				}).Return(transactionID, nil).Times(1)
+				m.EXPECT().InsertAuditLog(gomock.Any(), model.AuditLogEntry{
+					Action:  model.AuditActionBalanceAdjustment,
+					UserID:  1234,
+					ActorID: 1,
+					Details: map[string]string{
+						"transaction_id": transactionID.String(),
+						"type":           string(model.TransactionTypeAdjustmentCredit),
+						"amount":         "5000",
+						"currency":       "IDR",
+						"reason":         "Goodwill credit",
+					},
+				}).Return(model.AuditLogEntry{ID: 1}, nil).Times(1)
+
+				return m
+			},
+			wantTransactionID: transactionID,
+		},
+		{
+			name: "success-debit",
+			input: model.BalanceAdjustment{
+				UserID:  1234,
+				ActorID: 1,
+				Amount:  -5000,
+				Reason:  "Refund credited twice",
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockDbTx(ctrl, m, true)
+
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234}).Return([]model.User{{ID: 1234, Balance: 5000}}, nil).Times(1)
+				m.EXPECT().UpdateUser(gomock.Any(), model.UpdateUserRequest{
+					UserID: 1234,
+					Balance: model.UpdateBalanceRequest{
+						Amount:   5000,
+						Type:     model.UpdateBalanceDecrement,
+						Currency: model.CurrencyIDR,
+					},
+				}).Return(nil).Times(1)
+				m.EXPECT().InsertTransaction(gomock.Any(), model.Transaction{
+					UserID:      1234,
+					Amount:      5000,
+					Type:        model.TransactionTypeAdjustmentDebit,
+					RecipientID: 1234,
+					Status:      model.TransactionStatusSuccessful,
+					Description: "Refund credited twice",
+					Currency:    model.CurrencyIDR,
+					ActorID:     1,
+				}).Return(transactionID, nil).Times(1)
+				m.EXPECT().InsertAuditLog(gomock.Any(), model.AuditLogEntry{
+					Action:  model.AuditActionBalanceAdjustment,
+					UserID:  1234,
+					ActorID: 1,
+					Details: map[string]string{
+						"transaction_id": transactionID.String(),
+						"type":           string(model.TransactionTypeAdjustmentDebit),
+						"amount":         "5000",
+						"currency":       "IDR",
+						"reason":         "Refund credited twice",
+					},
+				}).Return(model.AuditLogEntry{ID: 1}, nil).Times(1)
+
+				return m
+			},
+			wantTransactionID: transactionID,
+		},
+		{
+			name: "fail-debit-balance-not-enough",
+			input: model.BalanceAdjustment{
+				UserID:  1234,
+				ActorID: 1,
+				Amount:  -5000,
+				Reason:  "Refund credited twice",
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockDbTx(ctrl, m, false)
+
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234}).Return([]model.User{{ID: 1234, Balance: 4999}}, nil).Times(1)
+
+				return m
+			},
+			wantErr: errors.New("balance not enough"),
+		},
+		{
+			name: "fail-user-not-found",
+			input: model.BalanceAdjustment{
+				UserID:  1234,
+				ActorID: 1,
+				Amount:  5000,
+				Reason:  "Goodwill credit",
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockDbTx(ctrl, m, false)
+
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234}).Return(nil, nil).Times(1)
+
+				return m
+			},
+			wantErr: repository.ErrUserNotFound,
+		},
+		{
+			name: "fail-reason-required",
+			input: model.BalanceAdjustment{
+				UserID:  1234,
+				ActorID: 1,
+				Amount:  5000,
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				return repository.NewMockRepositoryInterface(ctrl)
+			},
+			wantErr: ErrAdjustmentReasonRequired,
+		},
+		{
+			name: "fail-own-balance",
+			input: model.BalanceAdjustment{
+				UserID:  1,
+				ActorID: 1,
+				Amount:  5000,
+				Reason:  "Goodwill credit",
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				return repository.NewMockRepositoryInterface(ctrl)
+			},
+			wantErr: ErrAdjustmentOwnBalance,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			usecase := &Usecase{
+				Repository: test.mockRepository(controller),
+			}
+
+			gotTransaction, gotErr := usecase.AdjustUserBalance(context.Background(), test.input)
+			if gotTransaction.ID != test.wantTransactionID {
+				t.Errorf("usecase.AdjustUserBalance() gotTransactionID = %v, wantTransactionID %v", gotTransaction.ID, test.wantTransactionID)
+				return
+			}
+			if test.wantErr == nil && gotErr != nil || test.wantErr != nil && (gotErr == nil || gotErr.Error() != test.wantErr.Error()) {
+				t.Errorf("usecase.AdjustUserBalance() gotErr = %v, wantErr %v", gotErr, test.wantErr)
+			}
+		})
+	}
+}
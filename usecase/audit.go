@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/WalletService/logging"
+	"github.com/WalletService/model"
+	"github.com/google/uuid"
+)
+
+// auditTransaction logs the outcome of a Transaction reaching status, or failing with err, with every detail needed to reconcile it
+func auditTransaction(ctx context.Context, transaction model.Transaction, status model.TransactionStatus, err error) {
+	attrs := []slog.Attr{
+		slog.String("audit", "transaction"),
+		slog.Int64("user_id", transaction.UserID),
+		slog.String("type", string(transaction.Type)),
+		slog.String("status", string(status)),
+		slog.Float64("amount", float64(transaction.Amount)),
+		slog.Float64("fee", float64(transaction.Fee)),
+		slog.String("currency", string(transaction.Currency)),
+	}
+
+	// Failed Transactions are not always persisted, so they may not have an ID
+	if transaction.ID != uuid.Nil {
+		attrs = append(attrs, slog.String("transaction_id", transaction.ID.String()))
+	}
+	if transaction.RecipientID != 0 {
+		attrs = append(attrs, slog.Int64("recipient_id", transaction.RecipientID))
+	}
+	if transaction.BankAccountID != 0 {
+		attrs = append(attrs, slog.Int64("bank_account_id", transaction.BankAccountID))
+	}
+	if transaction.DisbursementReference != "" {
+		attrs = append(attrs, slog.String("disbursement_reference", transaction.DisbursementReference))
+	}
+	if transaction.ActorID != 0 {
+		attrs = append(attrs, slog.Int64("actor_id", transaction.ActorID))
+	}
+	if transaction.ConvertedCurrency != "" {
+		attrs = append(attrs,
+			slog.String("converted_currency", string(transaction.ConvertedCurrency)),
+			slog.Float64("converted_amount", float64(transaction.ConvertedAmount)),
+		)
+	}
+
+	level := slog.LevelInfo
+	if err != nil {
+		level = slog.LevelWarn
+		attrs = append(attrs, slog.Any("error", err))
+	}
+
+	logging.FromContext(ctx).LogAttrs(ctx, level, "transaction", attrs...)
+}
+
+// auditUserStatusChange logs a transition of User's status, along with the admin making it and why
+func auditUserStatusChange(ctx context.Context, change model.UserStatusChange) {
+	logging.FromContext(ctx).LogAttrs(ctx, slog.LevelInfo, "user status change",
+		slog.String("audit", "user_status"),
+		slog.Int64("user_id", change.UserID),
+		slog.Int64("actor_id", change.ActorID),
+		slog.String("from_status", string(change.FromStatus)),
+		slog.String("to_status", string(change.ToStatus)),
+		slog.String("reason", change.Reason),
+	)
+}
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/WalletService/model"
+	"github.com/WalletService/repository"
+	"github.com/WalletService/tracing"
+)
+
+// auditLogVerifyBatchSize is the number of entries VerifyAuditLog reads at once when not set
+const auditLogVerifyBatchSize = 1000
+
+// GetAuditLog lists audit log entries for auditors, in the order they were appended
+func (uc *Usecase) GetAuditLog(ctx context.Context, filter model.AuditLogFilter) (entries []model.AuditLogEntry, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.GetAuditLog")
+	defer func() { span.End(err) }()
+
+	return uc.Repository.GetAuditLog(ctx, filter)
+}
+
+// VerifyAuditLog walks the hash chain of every User in the whole audit log, batchSize entries at a time, and stops at the first entry breaking it.
+// An entry breaks the chain if it does not point to the hash of the previous entry of its User, or its content does not match its own hash.
+func (uc *Usecase) VerifyAuditLog(ctx context.Context, batchSize int) (verification model.AuditLogVerification, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.VerifyAuditLog")
+	defer func() { span.End(err) }()
+
+	// Hash of the last valid entry of every User, entries without a User are chained under zero
+	heads := map[int64]string{}
+	defer func() { verification.LastHash = repository.HashAuditLogHeads(heads) }()
+
+	if batchSize <= 0 {
+		batchSize = auditLogVerifyBatchSize
+	}
+
+	var afterID int64
+	for {
+		entries, err := uc.Repository.GetAuditLog(ctx, model.AuditLogFilter{AfterID: afterID, Limit: batchSize})
+		if err != nil {
+			return verification, err
+		}
+
+		for _, entry := range entries {
+			if entry.PrevHash != heads[entry.UserID] {
+				verification.BrokenID = entry.ID
+				verification.Reason = fmt.Sprintf("previous hash %q does not match hash %q of the previous entry of user %d", entry.PrevHash, heads[entry.UserID], entry.UserID)
+				return verification, nil
+			}
+			if hash := repository.HashAuditLogEntry(entry); hash != entry.Hash {
+				verification.BrokenID = entry.ID
+				verification.Reason = fmt.Sprintf("hash %q does not match content hashed to %q", entry.Hash, hash)
+				return verification, nil
+			}
+
+			verification.Entries++
+			heads[entry.UserID] = entry.Hash
+			afterID = entry.ID
+		}
+
+		if len(entries) < batchSize {
+			return verification, nil
+		}
+	}
+}
+
+// auditLogin appends a login attempt to the audit log, userID is zero if no User has the phone number.
+// Only a successful login is made by User, a failed attempt has no actor.
+func (uc *Usecase) auditLogin(ctx context.Context, userID int64, loginErr error) error {
+	entry := model.AuditLogEntry{
+		Action:  model.AuditActionLogin,
+		UserID:  userID,
+		ActorID: userID,
+		Details: map[string]string{"result": "success"},
+	}
+	if loginErr != nil {
+		entry.ActorID = 0
+		entry.Details = map[string]string{"result": "failure", "error": loginErr.Error()}
+	}
+
+	// Recorded even if the request is cancelled, a failed attempt must not go unrecorded
+	_, err := uc.Repository.InsertAuditLog(context.WithoutCancel(ctx), entry)
+	return err
+}
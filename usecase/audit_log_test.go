@@ -0,0 +1,220 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/WalletService/model"
+	"github.com/WalletService/repository"
+	gomock "github.com/golang/mock/gomock"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// newAuditLogChain returns an entry of each User, chained to the previous entry of the same User as InsertAuditLog appends them
+func newAuditLogChain(userIDs ...int64) []model.AuditLogEntry {
+	entries := make([]model.AuditLogEntry, len(userIDs))
+	heads := map[int64]string{}
+	for i, userID := range userIDs {
+		entries[i] = model.AuditLogEntry{
+			ID:          int64(i + 1),
+			Action:      model.AuditActionBalanceUpdate,
+			UserID:      userID,
+			Details:     map[string]string{"type": "Increment", "amount": "5000", "currency": "IDR"},
+			CreatedTime: time.Date(2024, 1, 1, 0, 0, i, 0, time.UTC),
+			PrevHash:    heads[userID],
+		}
+		entries[i].Hash = repository.HashAuditLogEntry(entries[i])
+		heads[userID] = entries[i].Hash
+	}
+	return entries
+}
+
+func TestVerifyAuditLog(t *testing.T) {
+	tests := []struct {
+		name             string
+		entries          func() []model.AuditLogEntry
+		wantVerification func(entries []model.AuditLogEntry) model.AuditLogVerification
+	}{
+		{
+			name:    "success-intact-chain",
+			entries: func() []model.AuditLogEntry { return newAuditLogChain(1234, 1234, 1234, 1234, 1234) },
+			wantVerification: func(entries []model.AuditLogEntry) model.AuditLogVerification {
+				return model.AuditLogVerification{Entries: 5, LastHash: repository.HashAuditLogHeads(map[int64]string{1234: entries[4].Hash})}
+			},
+		},
+		{
+			name:    "success-interleaved-user-chains",
+			entries: func() []model.AuditLogEntry { return newAuditLogChain(1234, 6789, 1234, 0, 6789) },
+			wantVerification: func(entries []model.AuditLogEntry) model.AuditLogVerification {
+				return model.AuditLogVerification{Entries: 5, LastHash: repository.HashAuditLogHeads(map[int64]string{
+					0:    entries[3].Hash,
+					1234: entries[2].Hash,
+					6789: entries[4].Hash,
+				})}
+			},
+		},
+		{
+			name:    "success-empty",
+			entries: func() []model.AuditLogEntry { return nil },
+			wantVerification: func(entries []model.AuditLogEntry) model.AuditLogVerification {
+				return model.AuditLogVerification{}
+			},
+		},
+		{
+			name: "fail-content-changed",
+			entries: func() []model.AuditLogEntry {
+				entries := newAuditLogChain(1234, 1234, 1234, 1234, 1234)
+				entries[2].Details = map[string]string{"type": "Increment", "amount": "50000", "currency": "IDR"}
+				return entries
+			},
+			wantVerification: func(entries []model.AuditLogEntry) model.AuditLogVerification {
+				return model.AuditLogVerification{Entries: 2, LastHash: repository.HashAuditLogHeads(map[int64]string{1234: entries[1].Hash}), BrokenID: 3}
+			},
+		},
+		{
+			name: "fail-entry-removed",
+			entries: func() []model.AuditLogEntry {
+				entries := newAuditLogChain(1234, 6789, 1234, 1234, 6789)
+				return append(entries[:2], entries[3:]...)
+			},
+			wantVerification: func(entries []model.AuditLogEntry) model.AuditLogVerification {
+				return model.AuditLogVerification{Entries: 2, LastHash: repository.HashAuditLogHeads(map[int64]string{1234: entries[0].Hash, 6789: entries[1].Hash}), BrokenID: 4}
+			},
+		},
+		{
+			name: "fail-entry-rehashed",
+			entries: func() []model.AuditLogEntry {
+				// Rehashing a changed entry breaks the link of the next one
+				entries := newAuditLogChain(1234, 1234, 1234, 1234, 1234)
+				entries[1].Details = map[string]string{"type": "Decrement", "amount": "5000", "currency": "IDR"}
+				entries[1].Hash = repository.HashAuditLogEntry(entries[1])
+				return entries
+			},
+			wantVerification: func(entries []model.AuditLogEntry) model.AuditLogVerification {
+				return model.AuditLogVerification{Entries: 2, LastHash: repository.HashAuditLogHeads(map[int64]string{1234: entries[1].Hash}), BrokenID: 3}
+			},
+		},
+		{
+			name: "fail-entry-moved-to-other-user",
+			entries: func() []model.AuditLogEntry {
+				// Entry is still chained to the previous entry of its original User
+				entries := newAuditLogChain(1234, 1234, 1234)
+				entries[1].UserID = 6789
+				entries[1].Hash = repository.HashAuditLogEntry(entries[1])
+				return entries
+			},
+			wantVerification: func(entries []model.AuditLogEntry) model.AuditLogVerification {
+				return model.AuditLogVerification{Entries: 1, LastHash: repository.HashAuditLogHeads(map[int64]string{1234: entries[0].Hash}), BrokenID: 2}
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			mockRepository := repository.NewMockRepositoryInterface(controller)
+
+			// Entries are read 2 at a time to walk several batches
+			entries := test.entries()
+			mockRepository.EXPECT().GetAuditLog(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, filter model.AuditLogFilter) ([]model.AuditLogEntry, error) {
+				var batch []model.AuditLogEntry
+				for _, entry := range entries {
+					if entry.ID > filter.AfterID && len(batch) < filter.Limit {
+						batch = append(batch, entry)
+					}
+				}
+				return batch, nil
+			}).MinTimes(1)
+
+			usecase := &Usecase{Repository: mockRepository}
+
+			gotVerification, err := usecase.VerifyAuditLog(context.Background(), 2)
+			if err != nil {
+				t.Fatalf("usecase.VerifyAuditLog() error = %v", err)
+			}
+
+			wantVerification := test.wantVerification(entries)
+			if gotVerification.Entries != wantVerification.Entries || gotVerification.LastHash != wantVerification.LastHash || gotVerification.BrokenID != wantVerification.BrokenID {
+				t.Errorf("usecase.VerifyAuditLog() = %+v, want %+v", gotVerification, wantVerification)
+			}
+			if wantVerification.BrokenID != 0 && gotVerification.Reason == "" {
+				t.Errorf("usecase.VerifyAuditLog() should give the reason entry %d breaks the chain", gotVerification.BrokenID)
+			}
+		})
+	}
+}
+
+func TestUserLogin_AuditLog(t *testing.T) {
+	password, _ := bcrypt.GenerateFromPassword([]byte("Admin1234!"), bcrypt.MinCost)
+
+	tests := []struct {
+		name           string
+		inputPassword  string
+		mockRepository func(controller *gomock.Controller) *repository.MockRepositoryInterface
+		wantUserID     int64
+		wantErr        bool
+	}{
+		{
+			name:          "success-recorded",
+			inputPassword: "Admin1234!",
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				m.EXPECT().GetUsers(gomock.Any(), gomock.Any()).Return([]model.User{{ID: 1234, Password: string(password)}}, nil).Times(1)
+				m.EXPECT().InsertAuditLog(gomock.Any(), model.AuditLogEntry{
+					Action:  model.AuditActionLogin,
+					UserID:  1234,
+					ActorID: 1234,
+					Details: map[string]string{"result": "success"},
+				}).Return(model.AuditLogEntry{ID: 1}, nil).Times(1)
+				return m
+			},
+			wantUserID: 1234,
+		},
+		{
+			name:          "fail-invalid-password-recorded",
+			inputPassword: "Wrong1234!",
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				m.EXPECT().GetUsers(gomock.Any(), gomock.Any()).Return([]model.User{{ID: 1234, Password: string(password)}}, nil).Times(1)
+				m.EXPECT().InsertAuditLog(gomock.Any(), model.AuditLogEntry{
+					Action:  model.AuditActionLogin,
+					UserID:  1234,
+					Details: map[string]string{"result": "failure", "error": "invalid password"},
+				}).Return(model.AuditLogEntry{ID: 1}, nil).Times(1)
+				return m
+			},
+			wantErr: true,
+		},
+		{
+			name:          "fail-not-recorded",
+			inputPassword: "Admin1234!",
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				m.EXPECT().GetUsers(gomock.Any(), gomock.Any()).Return([]model.User{{ID: 1234, Password: string(password)}}, nil).Times(1)
+				m.EXPECT().InsertAuditLog(gomock.Any(), gomock.Any()).Return(model.AuditLogEntry{}, errors.New("error-insert-audit-log")).Times(1)
+				return m
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			usecase := &Usecase{
+				Repository: test.mockRepository(controller),
+			}
+
+			gotUserID, gotErr := usecase.UserLogin(context.Background(), "+628123456789", test.inputPassword)
+			if gotUserID != test.wantUserID {
+				t.Errorf("usecase.UserLogin() gotUserID = %v, wantUserID %v", gotUserID, test.wantUserID)
+			}
+			if (gotErr != nil) != test.wantErr {
+				t.Errorf("usecase.UserLogin() gotErr = %v, wantErr %v", gotErr, test.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,433 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/WalletService/model"
+	"github.com/WalletService/repository"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// recordingDriver is a database/sql driver that records which DB tx each statement ran in.
+// It lets the stress test run the real repository and utils.WithDbTx code path without a database.
+type recordingDriver struct {
+	passwordHash string
+
+	// beforeStatement is called before a statement runs in a tx, the statement fails if it returns an error
+	beforeStatement func(ctx context.Context, query string) error
+
+	mu     sync.Mutex
+	nextTx int
+	txs    map[int][]recordedStatement // Statements by tx ID, 0 is outside of any tx
+	status map[int]string              // Final status of each tx, committed or rolled back
+	locks  map[string]int              // tx ID holding each tx-level advisory lock, released when the tx ends like Postgres does
+}
+
+type recordedStatement struct {
+	query string
+	args  []driver.NamedValue
+}
+
+func (d *recordingDriver) Open(name string) (driver.Conn, error) {
+	return &recordingConn{driver: d}, nil
+}
+
+func (d *recordingDriver) record(txID int, query string, args []driver.NamedValue) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.txs[txID] = append(d.txs[txID], recordedStatement{query: query, args: args})
+}
+
+// recordingConn is only used by one goroutine at a time, as guaranteed by database/sql
+type recordingConn struct {
+	driver *recordingDriver
+	txID   int
+}
+
+func (c *recordingConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("prepared statements are not supported")
+}
+
+func (c *recordingConn) Close() error { return nil }
+
+func (c *recordingConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *recordingConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	c.driver.mu.Lock()
+	defer c.driver.mu.Unlock()
+
+	c.driver.nextTx++
+	c.txID = c.driver.nextTx
+	return &recordingTx{conn: c}, nil
+}
+
+func (c *recordingConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if err := c.before(ctx, query); err != nil {
+		return nil, err
+	}
+	if strings.HasPrefix(query, "SELECT pg_advisory_xact_lock") {
+		if err := c.driver.lock(ctx, c.txID, fmt.Sprint(args)); err != nil {
+			return nil, err
+		}
+	}
+	c.driver.record(c.txID, query, args)
+	return driver.RowsAffected(1), nil
+}
+
+// lock waits until no other tx holds the advisory lock identified by key, then holds it for txID
+func (d *recordingDriver) lock(ctx context.Context, txID int, key string) error {
+	for {
+		d.mu.Lock()
+		if holder, ok := d.locks[key]; !ok || holder == txID {
+			d.locks[key] = txID
+			d.mu.Unlock()
+			return nil
+		}
+		d.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Millisecond):
+		}
+	}
+}
+
+func (c *recordingConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if err := c.before(ctx, query); err != nil {
+		return nil, err
+	}
+	c.driver.record(c.txID, query, args)
+
+	switch {
+	case strings.HasPrefix(query, "SELECT id, full_name"):
+		userID, _ := args[0].Value.(string)
+		id := int64(0)
+		fmt.Sscan(userID, &id)
+		return &recordingRows{
+			columns: []string{"id", "full_name", "phone_number", "balance", "password", "status", "created_time", "updated_time", "merchant_category_code"},
+			values:  [][]driver.Value{{id, "name", "+628123456789", float64(1000000), c.driver.passwordHash, "Active", time.Now(), nil, ""}},
+		}, nil
+	case strings.HasPrefix(query, "SELECT id, balance"):
+		rows := &recordingRows{columns: []string{"id", "balance", "status"}}
+		for _, arg := range args {
+			rows.values = append(rows.values, []driver.Value{arg.Value, float64(1000000), "Active"})
+		}
+		return rows, nil
+	case strings.HasPrefix(query, "INSERT INTO transaction"):
+		return &recordingRows{columns: []string{"id"}, values: [][]driver.Value{{uuid.New().String()}}}, nil
+	case strings.HasPrefix(query, "SELECT hash FROM audit_log"):
+		return &recordingRows{columns: []string{"hash"}}, nil
+	case strings.HasPrefix(query, "INSERT INTO audit_log"):
+		return &recordingRows{columns: []string{"id"}, values: [][]driver.Value{{int64(1)}}}, nil
+	default:
+		return nil, fmt.Errorf("unexpected query: %s", query)
+	}
+}
+
+func (c *recordingConn) before(ctx context.Context, query string) error {
+	if c.txID == 0 || c.driver.beforeStatement == nil {
+		return nil
+	}
+	return c.driver.beforeStatement(ctx, query)
+}
+
+type recordingTx struct {
+	conn *recordingConn
+}
+
+func (tx *recordingTx) Commit() error   { return tx.end("committed") }
+func (tx *recordingTx) Rollback() error { return tx.end("rolled back") }
+
+func (tx *recordingTx) end(status string) error {
+	tx.conn.driver.mu.Lock()
+	defer tx.conn.driver.mu.Unlock()
+
+	if _, ok := tx.conn.driver.status[tx.conn.txID]; ok {
+		return sql.ErrTxDone
+	}
+	tx.conn.driver.status[tx.conn.txID] = status
+	for key, holder := range tx.conn.driver.locks {
+		if holder == tx.conn.txID {
+			delete(tx.conn.driver.locks, key)
+		}
+	}
+	tx.conn.txID = 0
+	return nil
+}
+
+type recordingRows struct {
+	columns []string
+	values  [][]driver.Value
+}
+
+func (r *recordingRows) Columns() []string { return r.columns }
+func (r *recordingRows) Close() error      { return nil }
+
+func (r *recordingRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
+
+func newRecordingDB(t *testing.T, recorder *recordingDriver) *sql.DB {
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("Admin1234!"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recorder.passwordHash = string(passwordHash)
+	recorder.txs = map[int][]recordedStatement{}
+	recorder.status = map[int]string{}
+	recorder.locks = map[string]int{}
+
+	driverName := fmt.Sprintf("recording-%s", t.Name())
+	sql.Register(driverName, recorder)
+
+	db, err := sql.Open(driverName, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	return db
+}
+
+// TestConcurrentTransfersAreIsolated fires parallel transfers through the shared Repository.
+// Each DB tx must only contain the statements of its own transfer. Run with -race to also detect data races.
+func TestConcurrentTransfersAreIsolated(t *testing.T) {
+	const transfers = 50
+
+	recorder := &recordingDriver{}
+	db := newRecordingDB(t, recorder)
+	db.SetMaxOpenConns(transfers / 2)
+
+	usecase := NewUsecase(NewUsecaseOptions{
+		Repository: repository.NewRepositoryWithDB(db),
+	})
+
+	var wg sync.WaitGroup
+	errs := make(chan error, transfers)
+	for i := 0; i < transfers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			// Every transfer is between its own pair of Users, i.e. 1 -> 2, 3 -> 4
+			senderID, recipientID := int64(2*i+1), int64(2*i+2)
+			_, err := usecase.CreateUserTransaction(context.Background(), model.Transaction{
+				UserID:      senderID,
+				RecipientID: recipientID,
+				Amount:      1000,
+				Type:        model.TransactionTypeTransferOut,
+				Password:    "Admin1234!",
+			})
+			errs <- err
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("CreateUserTransaction() err = %v", err)
+		}
+	}
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+
+	if len(recorder.status) != transfers {
+		t.Fatalf("got %d DB txs, want %d", len(recorder.status), transfers)
+	}
+
+	for txID, statements := range recorder.txs {
+		if txID == 0 {
+			// Only Users are read outside of DB tx, the rest must run within the transfer's tx
+			for _, statement := range statements {
+				if !strings.HasPrefix(statement.query, "SELECT id, full_name") {
+					t.Errorf("statement ran outside of DB tx: %s", statement.query)
+				}
+			}
+			continue
+		}
+
+		if recorder.status[txID] != "committed" {
+			t.Errorf("tx %d is %s, want committed", txID, recorder.status[txID])
+		}
+
+		// LockUsers, UpdateUser x2 each appended to the audit log with 3 statements, and InsertTransaction
+		if len(statements) != 10 {
+			t.Errorf("tx %d has %d statements, want 10", txID, len(statements))
+		}
+
+		userIDs := map[int64]bool{}
+		for _, statement := range statements {
+			// Audit log statements are checked by the count above, and its locks by TestAuditLogChainsDoNotBlockOtherUsers
+			if strings.Contains(statement.query, "audit_log") || strings.Contains(statement.query, "pg_advisory_xact_lock") {
+				continue
+			}
+			for _, arg := range statement.args {
+				if id, ok := arg.Value.(int64); ok {
+					userIDs[id] = true
+				}
+			}
+		}
+
+		var senderID int64
+		for id := range userIDs {
+			if id%2 == 1 {
+				senderID = id
+			}
+		}
+		if len(userIDs) != 2 || !userIDs[senderID] || !userIDs[senderID+1] {
+			t.Errorf("tx %d touched Users %v, want a single sender and recipient pair", txID, userIDs)
+		}
+	}
+}
+
+// TestCancelledTransferRollsBack cancels the request context while the transfer's DB tx is running.
+// The DB tx must be rolled back and the Failed Transaction must still be recorded.
+func TestCancelledTransferRollsBack(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	recorder := &recordingDriver{
+		// Client disconnects right after the balances are locked
+		beforeStatement: func(stmtCtx context.Context, query string) error {
+			if strings.HasPrefix(query, "UPDATE") {
+				cancel()
+				<-stmtCtx.Done()
+				return stmtCtx.Err()
+			}
+			return nil
+		},
+	}
+	db := newRecordingDB(t, recorder)
+
+	usecase := NewUsecase(NewUsecaseOptions{
+		Repository: repository.NewRepositoryWithDB(db),
+	})
+
+	_, err := usecase.CreateUserTransaction(ctx, model.Transaction{
+		UserID:      1,
+		RecipientID: 2,
+		Amount:      1000,
+		Type:        model.TransactionTypeTransferOut,
+		Password:    "Admin1234!",
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("CreateUserTransaction() err = %v, want %v", err, context.Canceled)
+	}
+
+	// database/sql rolls back the tx in the background once ctx is cancelled
+	deadline := time.Now().Add(time.Second)
+	for {
+		recorder.mu.Lock()
+		status := recorder.status[1]
+		recorder.mu.Unlock()
+
+		if status != "" || time.Now().After(deadline) {
+			if status != "rolled back" {
+				t.Fatalf("tx is %q, want rolled back", status)
+			}
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+
+	for _, statement := range recorder.txs[1] {
+		if strings.HasPrefix(statement.query, "UPDATE") || strings.HasPrefix(statement.query, "INSERT") {
+			t.Errorf("tx should not have written anything, got: %s", statement.query)
+		}
+	}
+
+	failedRecorded := false
+	for _, statement := range recorder.txs[0] {
+		if strings.HasPrefix(statement.query, "INSERT INTO transaction") {
+			failedRecorded = statement.args[5].Value == string(model.TransactionStatusFailed)
+		}
+	}
+	if !failedRecorded {
+		t.Errorf("Failed Transaction should be recorded outside of the cancelled tx")
+	}
+}
+
+// TestAuditLogChainsDoNotBlockOtherUsers holds the audit log chains of a transfer's Users until its DB tx ends.
+// Transfers between other Users must complete meanwhile, while a transfer to one of its Users waits for its chain.
+func TestAuditLogChainsDoNotBlockOtherUsers(t *testing.T) {
+	type holdKey struct{}
+
+	held, release := make(chan struct{}), make(chan struct{})
+	recorder := &recordingDriver{
+		// Transfer carrying holdKey stops once both its balance updates are audited, keeping their chains locked
+		beforeStatement: func(ctx context.Context, query string) error {
+			if ctx.Value(holdKey{}) != nil && strings.HasPrefix(query, "INSERT INTO transaction") {
+				close(held)
+				<-release
+			}
+			return nil
+		},
+	}
+	db := newRecordingDB(t, recorder)
+
+	usecase := NewUsecase(NewUsecaseOptions{
+		Repository: repository.NewRepositoryWithDB(db),
+	})
+	transfer := func(ctx context.Context, senderID, recipientID int64) error {
+		_, err := usecase.CreateUserTransaction(ctx, model.Transaction{
+			UserID:      senderID,
+			RecipientID: recipientID,
+			Amount:      1000,
+			Type:        model.TransactionTypeTransferOut,
+			Password:    "Admin1234!",
+		})
+		return err
+	}
+
+	heldErr := make(chan error, 1)
+	go func() { heldErr <- transfer(context.WithValue(context.Background(), holdKey{}, true), 1, 2) }()
+	<-held
+
+	// Unrelated Users are not serialized behind the held transfer
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := transfer(ctx, 3, 4); err != nil {
+		t.Fatalf("transfer between other Users err = %v, should not wait for the held transfer", err)
+	}
+
+	// Recipient of the held transfer waits for its chain until the held transfer ends
+	relatedErr := make(chan error, 1)
+	go func() { relatedErr <- transfer(context.Background(), 5, 2) }()
+	select {
+	case err := <-relatedErr:
+		t.Fatalf("transfer to a User of the held transfer completed with err = %v, should wait for its chain", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	if err := <-heldErr; err != nil {
+		t.Errorf("held transfer err = %v", err)
+	}
+	if err := <-relatedErr; err != nil {
+		t.Errorf("transfer to a User of the held transfer err = %v", err)
+	}
+}
@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/WalletService/model"
+	"github.com/WalletService/repository"
+	"github.com/WalletService/tracing"
+)
+
+const (
+	// Contact suggestions are ranked by the TransferOut of the period, each weighing half as much every half-life
+	contactSuggestionPeriod   = 90 * 24 * time.Hour
+	contactSuggestionHalfLife = 14 * 24 * time.Hour
+
+	defaultContactSuggestionLimit = 5
+)
+
+var (
+	ErrContactSelf = errors.New("can not add yourself as a contact")
+)
+
+// AddContact saves the Recipient as a Contact of User. Like a TransferOut, the Recipient is requested with the token of a lookup or its phone number,
+// which is looked up and so rate limited. A Recipient ID is only accepted for a Recipient User has already transferred to, so that saving
+// Contacts can not be used to enumerate accounts either.
+func (uc *Usecase) AddContact(ctx context.Context, request model.ContactRequest) (contact model.Contact, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.AddContact", tracing.Int64("user_id", request.UserID))
+	defer func() { span.End(err) }()
+
+	recipientID := request.RecipientID
+	if recipientID != 0 {
+		transfers, err := uc.Repository.CountTransactions(ctx, model.TransactionFilter{
+			UserID:      request.UserID,
+			RecipientID: recipientID,
+			Type:        model.TransactionTypeTransferOut,
+			Status:      model.TransactionStatusSuccessful,
+		})
+		if err != nil {
+			return model.Contact{}, err
+		}
+		if transfers == 0 {
+			return model.Contact{}, ErrRecipientNotFound
+		}
+	} else {
+		recipientID, err = uc.resolveRecipient(ctx, model.Transaction{
+			UserID:               request.UserID,
+			RecipientPhoneNumber: request.RecipientPhoneNumber,
+			RecipientToken:       request.RecipientToken,
+		})
+		if err != nil {
+			return model.Contact{}, err
+		}
+		if recipientID == 0 {
+			return model.Contact{}, errors.New("recipient is required")
+		}
+	}
+
+	if recipientID == request.UserID {
+		return model.Contact{}, ErrContactSelf
+	}
+
+	// Closed accounts can never receive, so they can not be saved either
+	recipient, err := uc.Repository.GetUser(ctx, recipientID)
+	if errors.Is(err, repository.ErrUserNotFound) {
+		return model.Contact{}, ErrRecipientNotFound
+	} else if err != nil {
+		return model.Contact{}, err
+	}
+	if recipient.Status == model.UserStatusClosed {
+		return model.Contact{}, ErrRecipientNotFound
+	}
+
+	// Violates the unique constraint if the Recipient is already a Contact, see utils.IsUniqueConstraintViolation
+	contactID, err := uc.Repository.InsertContact(ctx, model.Contact{
+		UserID:      request.UserID,
+		RecipientID: recipientID,
+		Nickname:    request.Nickname,
+	})
+	if err != nil {
+		return model.Contact{}, err
+	}
+
+	return uc.getContact(ctx, request.UserID, contactID)
+}
+
+// GetUserContacts returns the Contacts of User by nickname, with the current masked name and status of each Recipient
+func (uc *Usecase) GetUserContacts(ctx context.Context, userID int64) (contacts []model.Contact, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.GetUserContacts", tracing.Int64("user_id", userID))
+	defer func() { span.End(err) }()
+
+	contacts, err = uc.Repository.GetContacts(ctx, model.ContactFilter{UserID: userID})
+	if err != nil {
+		return []model.Contact{}, err
+	}
+
+	for i := range contacts {
+		contacts[i].RecipientName = maskName(contacts[i].RecipientName)
+	}
+
+	return contacts, nil
+}
+
+func (uc *Usecase) UpdateContact(ctx context.Context, request model.UpdateContactRequest) (contact model.Contact, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.UpdateContact", tracing.Int64("user_id", request.UserID))
+	defer func() { span.End(err) }()
+
+	if err = uc.Repository.UpdateContact(ctx, request); err != nil {
+		return model.Contact{}, err
+	}
+
+	return uc.getContact(ctx, request.UserID, request.ID)
+}
+
+func (uc *Usecase) DeleteContact(ctx context.Context, userID, contactID int64) (err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.DeleteContact", tracing.Int64("user_id", userID))
+	defer func() { span.End(err) }()
+
+	return uc.Repository.DeleteContact(ctx, userID, contactID)
+}
+
+// GetContactSuggestions returns the Recipients of recent successful TransferOut of User who are not Contacts yet, both frequent and recent
+// Recipients first. defaultContactSuggestionLimit are returned if limit is not set.
+func (uc *Usecase) GetContactSuggestions(ctx context.Context, userID int64, limit int) (suggestions []model.ContactSuggestion, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.GetContactSuggestions", tracing.Int64("user_id", userID))
+	defer func() { span.End(err) }()
+
+	if limit <= 0 {
+		limit = defaultContactSuggestionLimit
+	}
+
+	suggestions, err = uc.Repository.GetContactSuggestions(ctx, model.ContactSuggestionFilter{
+		UserID:          userID,
+		CreatedTimeFrom: time.Now().Add(-contactSuggestionPeriod),
+		HalfLife:        contactSuggestionHalfLife,
+		Limit:           limit,
+	})
+	if err != nil {
+		return []model.ContactSuggestion{}, err
+	}
+
+	for i := range suggestions {
+		suggestions[i].RecipientName = maskName(suggestions[i].RecipientName)
+	}
+
+	return suggestions, nil
+}
+
+// getContact returns the Contact of User with its Recipient's masked name, repository.ErrContactNotFound if User has no such Contact
+func (uc *Usecase) getContact(ctx context.Context, userID, contactID int64) (model.Contact, error) {
+	contacts, err := uc.Repository.GetContacts(ctx, model.ContactFilter{ContactID: contactID, UserID: userID})
+	if err != nil {
+		return model.Contact{}, err
+	}
+	if len(contacts) == 0 {
+		return model.Contact{}, repository.ErrContactNotFound
+	}
+
+	contact := contacts[0]
+	contact.RecipientName = maskName(contact.RecipientName)
+	return contact, nil
+}
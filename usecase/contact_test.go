@@ -0,0 +1,268 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/WalletService/model"
+	"github.com/WalletService/repository"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+func TestAddContact(t *testing.T) {
+	var (
+		errorDb     = errors.New("db error")
+		token       = uuid.New()
+		createdTime = time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+
+		transfersTo = func(m *repository.MockRepositoryInterface, recipientID int64, count int) {
+			m.EXPECT().CountTransactions(gomock.Any(), model.TransactionFilter{
+				UserID:      1234,
+				RecipientID: recipientID,
+				Type:        model.TransactionTypeTransferOut,
+				Status:      model.TransactionStatusSuccessful,
+			}).Return(count, nil).Times(1)
+		}
+		insertContact = func(m *repository.MockRepositoryInterface, err error) {
+			m.EXPECT().GetUser(gomock.Any(), int64(6789)).Return(model.User{ID: 6789, FullName: "John Doe", Status: model.UserStatusActive}, nil).Times(1)
+			m.EXPECT().InsertContact(gomock.Any(), model.Contact{UserID: 1234, RecipientID: 6789, Nickname: "Johnny"}).Return(int64(11), err).Times(1)
+			if err != nil {
+				return
+			}
+			m.EXPECT().GetContacts(gomock.Any(), model.ContactFilter{ContactID: 11, UserID: 1234}).Return([]model.Contact{
+				{ID: 11, UserID: 1234, RecipientID: 6789, Nickname: "Johnny", RecipientName: "John Doe", RecipientStatus: model.UserStatusActive, CreatedTime: createdTime},
+			}, nil).Times(1)
+		}
+
+		contact = model.Contact{
+			ID: 11, UserID: 1234, RecipientID: 6789, Nickname: "Johnny", RecipientName: "Jo** Do*", RecipientStatus: model.UserStatusActive, CreatedTime: createdTime,
+		}
+	)
+
+	tests := []struct {
+		name           string
+		input          model.ContactRequest
+		mockRepository func(controller *gomock.Controller) *repository.MockRepositoryInterface
+		wantContact    model.Contact
+		wantErr        error
+	}{
+		{
+			name:  "success-recipient-id-transferred-to",
+			input: model.ContactRequest{UserID: 1234, RecipientID: 6789, Nickname: "Johnny"},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				transfersTo(m, 6789, 2)
+				insertContact(m, nil)
+				return m
+			},
+			wantContact: contact,
+		},
+		{
+			name:  "success-token",
+			input: model.ContactRequest{UserID: 1234, RecipientToken: token, Nickname: "Johnny"},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				m.EXPECT().GetRecipientLookup(gomock.Any(), token).Return(model.RecipientLookup{
+					ID: token, UserID: 1234, RecipientID: 6789, ExpiryTime: time.Now().Add(time.Minute),
+				}, nil).Times(1)
+				insertContact(m, nil)
+				return m
+			},
+			wantContact: contact,
+		},
+		{
+			name:  "failed-recipient-id-never-transferred-to",
+			input: model.ContactRequest{UserID: 1234, RecipientID: 6789, Nickname: "Johnny"},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				transfersTo(m, 6789, 0)
+				return m
+			},
+			wantErr: ErrRecipientNotFound,
+		},
+		{
+			name:  "failed-token-expired",
+			input: model.ContactRequest{UserID: 1234, RecipientToken: token, Nickname: "Johnny"},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				m.EXPECT().GetRecipientLookup(gomock.Any(), token).Return(model.RecipientLookup{
+					ID: token, UserID: 1234, RecipientID: 6789, ExpiryTime: time.Now().Add(-time.Minute),
+				}, nil).Times(1)
+				return m
+			},
+			wantErr: ErrRecipientTokenExpired,
+		},
+		{
+			name:  "failed-self",
+			input: model.ContactRequest{UserID: 1234, RecipientToken: token, Nickname: "Me"},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				m.EXPECT().GetRecipientLookup(gomock.Any(), token).Return(model.RecipientLookup{
+					ID: token, UserID: 1234, RecipientID: 1234, ExpiryTime: time.Now().Add(time.Minute),
+				}, nil).Times(1)
+				return m
+			},
+			wantErr: ErrContactSelf,
+		},
+		{
+			name:  "failed-recipient-closed",
+			input: model.ContactRequest{UserID: 1234, RecipientID: 6789, Nickname: "Johnny"},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				transfersTo(m, 6789, 1)
+				m.EXPECT().GetUser(gomock.Any(), int64(6789)).Return(model.User{ID: 6789, Status: model.UserStatusClosed}, nil).Times(1)
+				return m
+			},
+			wantErr: ErrRecipientNotFound,
+		},
+		{
+			name:  "failed-already-a-contact",
+			input: model.ContactRequest{UserID: 1234, RecipientID: 6789, Nickname: "Johnny"},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				transfersTo(m, 6789, 1)
+				insertContact(m, &pq.Error{Code: "23505"})
+				return m
+			},
+			wantErr: &pq.Error{Code: "23505"},
+		},
+		{
+			name:  "failed-repo-call",
+			input: model.ContactRequest{UserID: 1234, RecipientID: 6789, Nickname: "Johnny"},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				m.EXPECT().CountTransactions(gomock.Any(), gomock.Any()).Return(0, errorDb).Times(1)
+				return m
+			},
+			wantErr: errorDb,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			usecase := &Usecase{Repository: test.mockRepository(controller)}
+
+			gotContact, gotErr := usecase.AddContact(context.Background(), test.input)
+			if !reflect.DeepEqual(gotContact, test.wantContact) {
+				t.Errorf("usecase.AddContact() gotContact = %+v, wantContact %+v", gotContact, test.wantContact)
+			}
+			if !reflect.DeepEqual(gotErr, test.wantErr) && !errors.Is(gotErr, test.wantErr) {
+				t.Errorf("usecase.AddContact() gotErr = %v, wantErr %v", gotErr, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestUpdateContact(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockRepository func(controller *gomock.Controller) *repository.MockRepositoryInterface
+		wantContact    model.Contact
+		wantErr        error
+	}{
+		{
+			name: "success",
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				m.EXPECT().UpdateContact(gomock.Any(), model.UpdateContactRequest{ID: 11, UserID: 1234, Nickname: "Dad"}).Return(nil).Times(1)
+				m.EXPECT().GetContacts(gomock.Any(), model.ContactFilter{ContactID: 11, UserID: 1234}).Return([]model.Contact{
+					{ID: 11, UserID: 1234, RecipientID: 6789, Nickname: "Dad", RecipientName: "John Doe", RecipientStatus: model.UserStatusClosed},
+				}, nil).Times(1)
+				return m
+			},
+			wantContact: model.Contact{ID: 11, UserID: 1234, RecipientID: 6789, Nickname: "Dad", RecipientName: "Jo** Do*", RecipientStatus: model.UserStatusClosed},
+		},
+		{
+			name: "failed-not-found",
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				m.EXPECT().UpdateContact(gomock.Any(), gomock.Any()).Return(repository.ErrContactNotFound).Times(1)
+				return m
+			},
+			wantErr: repository.ErrContactNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			usecase := &Usecase{Repository: test.mockRepository(controller)}
+
+			gotContact, gotErr := usecase.UpdateContact(context.Background(), model.UpdateContactRequest{ID: 11, UserID: 1234, Nickname: "Dad"})
+			if !reflect.DeepEqual(gotContact, test.wantContact) {
+				t.Errorf("usecase.UpdateContact() gotContact = %+v, wantContact %+v", gotContact, test.wantContact)
+			}
+			if !errors.Is(gotErr, test.wantErr) {
+				t.Errorf("usecase.UpdateContact() gotErr = %v, wantErr %v", gotErr, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetContactSuggestions(t *testing.T) {
+	lastTransferTime := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		limit           int
+		mockRepository  func(t *testing.T, controller *gomock.Controller) *repository.MockRepositoryInterface
+		wantSuggestions []model.ContactSuggestion
+		wantErr         bool
+	}{
+		{
+			name:  "success-default-limit",
+			limit: 0,
+			mockRepository: func(t *testing.T, ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				m.EXPECT().GetContactSuggestions(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, filter model.ContactSuggestionFilter) ([]model.ContactSuggestion, error) {
+					if filter.UserID != 1234 || filter.Limit != defaultContactSuggestionLimit || filter.HalfLife != contactSuggestionHalfLife ||
+						time.Since(filter.CreatedTimeFrom) < contactSuggestionPeriod {
+						t.Errorf("GetContactSuggestions() filter = %+v, should rank the TransferOut of the period", filter)
+					}
+					return []model.ContactSuggestion{
+						{RecipientID: 6789, RecipientName: "John Doe", TransferCount: 3, LastTransferTime: lastTransferTime, Score: 2.5},
+					}, nil
+				}).Times(1)
+				return m
+			},
+			wantSuggestions: []model.ContactSuggestion{
+				{RecipientID: 6789, RecipientName: "Jo** Do*", TransferCount: 3, LastTransferTime: lastTransferTime, Score: 2.5},
+			},
+		},
+		{
+			name:  "failed-repo-call",
+			limit: 10,
+			mockRepository: func(t *testing.T, ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				m.EXPECT().GetContactSuggestions(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error")).Times(1)
+				return m
+			},
+			wantSuggestions: []model.ContactSuggestion{},
+			wantErr:         true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			usecase := &Usecase{Repository: test.mockRepository(t, controller)}
+
+			gotSuggestions, gotErr := usecase.GetContactSuggestions(context.Background(), 1234, test.limit)
+			if !reflect.DeepEqual(gotSuggestions, test.wantSuggestions) {
+				t.Errorf("usecase.GetContactSuggestions() gotSuggestions = %+v, wantSuggestions %+v", gotSuggestions, test.wantSuggestions)
+			}
+			if (gotErr != nil) != test.wantErr {
+				t.Errorf("usecase.GetContactSuggestions() gotErr = %v, wantErr %v", gotErr, test.wantErr)
+			}
+		})
+	}
+}
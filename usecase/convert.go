@@ -0,0 +1,165 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/WalletService/model"
+	"github.com/WalletService/tracing"
+	"github.com/WalletService/utils"
+	"github.com/google/uuid"
+)
+
+// CreateUserFXQuote quotes converting between User's balances. The quote is locked until its ExpiryTime,
+// and is used by a Convert Transaction to get exactly the quoted amount regardless of later rate changes.
+func (uc *Usecase) CreateUserFXQuote(ctx context.Context, request model.FXQuoteRequest) (quote model.FXQuote, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.CreateUserFXQuote")
+	defer func() { span.End(err) }()
+
+	if uc.FXQuoter == nil {
+		return model.FXQuote{}, errors.New("currency conversion is not available")
+	}
+
+	if request.SourceAmount <= 0 {
+		return model.FXQuote{}, errors.New("invalid amount")
+	}
+
+	if !isSupportedCurrency(request.SourceCurrency) || !isSupportedCurrency(request.TargetCurrency) {
+		return model.FXQuote{}, errors.New("unsupported currency")
+	}
+
+	if request.SourceCurrency == request.TargetCurrency {
+		return model.FXQuote{}, errors.New("cannot convert to the same currency")
+	}
+
+	quote, err = uc.FXQuoter.Quote(ctx, request)
+	if err != nil {
+		return model.FXQuote{}, err
+	}
+
+	if err := uc.Repository.InsertFXQuote(ctx, quote); err != nil {
+		return model.FXQuote{}, err
+	}
+
+	return quote, nil
+}
+
+// performConvert exchanges User's balance in transaction.Currency into the target currency of the quote.
+func (uc *Usecase) performConvert(ctx context.Context, user model.User, transaction model.Transaction) (newTransaction model.Transaction, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.performConvert")
+	defer func() { span.End(err) }()
+
+	// Validate requested password matches with User's password
+	if err := uc.verifyTransactionPassword(user, transaction.Password); err != nil {
+		return model.Transaction{}, err
+	}
+
+	// Validate User has balance to cover transaction
+	if balanceOf(user, transaction.Currency) < transaction.Amount {
+		return model.Transaction{}, errors.New("balance not enough")
+	}
+
+	// Validate a quote is requested, rate is only guaranteed for a locked quote
+	if transaction.FXQuoteID == uuid.Nil {
+		return model.Transaction{}, errors.New("must specify fx quote for Convert")
+	}
+
+	// Convert moves money between User's own balances
+	transaction.RecipientID = user.ID
+	transaction.Password = ""
+
+	// Perform the following in a single DB transaction to ensure atomicity of all Convert operations
+	if err = utils.WithDbTxRetry(ctx, uc.Repository, func(ctx context.Context) error {
+		// 1. Lock the quote so that it can only be used once
+		quote, err := uc.Repository.LockFXQuote(ctx, transaction.FXQuoteID)
+		if err != nil {
+			return err
+		}
+
+		if quote.UserID != user.ID {
+			return errors.New("fx quote not found")
+		}
+
+		if quote.TransactionID != uuid.Nil {
+			return errors.New("fx quote is already used")
+		}
+
+		if time.Now().After(quote.ExpiryTime) {
+			return errors.New("fx quote is expired")
+		}
+
+		if quote.SourceCurrency != transaction.Currency || quote.SourceAmount != transaction.Amount {
+			return errors.New("transaction does not match fx quote")
+		}
+
+		// 2. Lock User data to prevent race condition
+		lockedUsers, err := uc.lockUsers(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+
+		// 3. Validate User can send like a TransferOut, it may have been frozen or closed since retrieved
+		if err := canSend(lockedUsers[user.ID]); err != nil {
+			return err
+		}
+
+		// 4. Validate User's balance again now that it can not change, it may have changed since User was retrieved
+		balance, err := uc.lockedBalanceOf(ctx, lockedUsers[user.ID], quote.SourceCurrency)
+		if err != nil {
+			return err
+		}
+		if balance < quote.SourceAmount {
+			return errors.New("balance not enough")
+		}
+
+		// 5. Subtract User's balance in source currency, fee included
+		if err := uc.Repository.UpdateUser(ctx, model.UpdateUserRequest{
+			UserID: user.ID,
+			Balance: model.UpdateBalanceRequest{
+				Amount:   quote.SourceAmount,
+				Type:     model.UpdateBalanceDecrement,
+				Currency: quote.SourceCurrency,
+			},
+		}); err != nil {
+			return err
+		}
+
+		// 6. Increment User's balance in target currency
+		if err := uc.Repository.UpdateUser(ctx, model.UpdateUserRequest{
+			UserID: user.ID,
+			Balance: model.UpdateBalanceRequest{
+				Amount:   quote.TargetAmount,
+				Type:     model.UpdateBalanceIncrement,
+				Currency: quote.TargetCurrency,
+			},
+		}); err != nil {
+			return err
+		}
+
+		// 7. Insert a Successful Transaction record
+		transaction.Status = model.TransactionStatusSuccessful
+		transaction.ConvertedCurrency = quote.TargetCurrency
+		transaction.ConvertedAmount = quote.TargetAmount
+		if transaction.ID, err = uc.Repository.InsertTransaction(ctx, transaction); err != nil {
+			return err
+		}
+
+		// 8. Mark the quote as used by the Transaction
+		return uc.Repository.UpdateFXQuote(ctx, model.UpdateFXQuoteRequest{
+			ID:            quote.ID,
+			TransactionID: transaction.ID,
+		})
+	}); err != nil {
+		// Insert a Failed transaction record if failed, even if the request is cancelled
+		transaction.Status = model.TransactionStatusFailed
+		transaction.ConvertedCurrency = ""
+		transaction.ConvertedAmount = 0
+
+		uc.Repository.InsertTransaction(context.WithoutCancel(ctx), transaction)
+
+		return model.Transaction{}, err
+	}
+
+	return transaction, nil
+}
@@ -0,0 +1,437 @@
+package usecase
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/WalletService/fx"
+	"github.com/WalletService/model"
+	"github.com/WalletService/repository"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+)
+
+func TestCreateUserFXQuote(t *testing.T) {
+	provider, _ := fx.NewInMemoryProvider(map[string]float64{"USD/IDR": 15500})
+
+	tests := []struct {
+		name             string
+		input            model.FXQuoteRequest
+		mockRepository   func(controller *gomock.Controller) *repository.MockRepositoryInterface
+		wantTargetAmount float32
+		wantErr          bool
+	}{
+		{
+			name: "success",
+			input: model.FXQuoteRequest{
+				UserID:         1234,
+				SourceCurrency: model.CurrencyUSD,
+				TargetCurrency: model.CurrencyIDR,
+				SourceAmount:   10,
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				m.EXPECT().InsertFXQuote(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				return m
+			},
+			wantTargetAmount: 155000,
+			wantErr:          false,
+		},
+		{
+			name: "fail-same-currency",
+			input: model.FXQuoteRequest{
+				UserID:         1234,
+				SourceCurrency: model.CurrencyIDR,
+				TargetCurrency: model.CurrencyIDR,
+				SourceAmount:   10000,
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				return repository.NewMockRepositoryInterface(ctrl)
+			},
+			wantTargetAmount: 0,
+			wantErr:          true,
+		},
+		{
+			name: "fail-unsupported-currency",
+			input: model.FXQuoteRequest{
+				UserID:         1234,
+				SourceCurrency: "EUR",
+				TargetCurrency: model.CurrencyIDR,
+				SourceAmount:   10,
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				return repository.NewMockRepositoryInterface(ctrl)
+			},
+			wantTargetAmount: 0,
+			wantErr:          true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			usecase := &Usecase{
+				Repository: test.mockRepository(controller),
+				FXQuoter:   fx.NewQuoter(provider, fx.Pricing{QuoteTTL: time.Minute}),
+			}
+
+			gotQuote, gotErr := usecase.CreateUserFXQuote(context.Background(), test.input)
+			if gotQuote.TargetAmount != test.wantTargetAmount {
+				t.Errorf("usecase.CreateUserFXQuote() gotTargetAmount = %v, wantTargetAmount %v", gotQuote.TargetAmount, test.wantTargetAmount)
+				return
+			}
+			if (gotErr != nil) != test.wantErr {
+				t.Errorf("usecase.CreateUserFXQuote() gotErr = %v, wantErr %v", gotErr, test.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func Test_performConvert(t *testing.T) {
+	var (
+		quoteID       = convertToUUID("5a0d7d2e-3c3f-4a59-8f0e-8f6b0f3f2a10")
+		transactionID = convertToUUID("3d6e668f-ad02-40ff-8540-90c1528a7c88")
+
+		user = model.User{
+			ID:       1234,
+			Password: "$2a$12$35ELZtgOq3iFR6awq.jsDuV5Dr.0XU5k7iUQuShfeLTWRHGFr//fq",
+			Balance:  0,
+			Balances: []model.Balance{
+				{Currency: model.CurrencyIDR, Amount: 0},
+				{Currency: model.CurrencyUSD, Amount: 100},
+			},
+		}
+
+		convert = model.Transaction{
+			UserID:    1234,
+			Amount:    10,
+			Type:      model.TransactionTypeConvert,
+			Currency:  model.CurrencyUSD,
+			FXQuoteID: quoteID,
+			Password:  "Admin1234!",
+		}
+
+		quote = model.FXQuote{
+			ID:             quoteID,
+			UserID:         1234,
+			SourceCurrency: model.CurrencyUSD,
+			TargetCurrency: model.CurrencyIDR,
+			SourceAmount:   10,
+			Fee:            0.5,
+			Rate:           15422.5,
+			TargetAmount:   146513,
+			ExpiryTime:     time.Now().Add(time.Minute),
+		}
+	)
+
+	mockDbTx := func(ctrl *gomock.Controller, m *repository.MockRepositoryInterface, commit bool) {
+		sqlDb := repository.NewMockSqlDbInterface(ctrl)
+		sqlTx := repository.NewMockSqlTxInterface(ctrl)
+
+		m.EXPECT().GetSqlDb().Return(sqlDb, nil).Times(1)
+		sqlDb.EXPECT().BeginTx(gomock.Any(), gomock.Nil()).Return(sqlTx, nil).Times(1)
+		if commit {
+			sqlTx.EXPECT().Commit().Return(nil).Times(1)
+		} else {
+			sqlTx.EXPECT().Rollback().Return(nil).Times(1)
+		}
+	}
+
+	tests := []struct {
+		name             string
+		inputTransaction model.Transaction
+		mockRepository   func(controller *gomock.Controller) *repository.MockRepositoryInterface
+		wantTransaction  model.Transaction
+		wantErr          bool
+	}{
+		{
+			name:             "success",
+			inputTransaction: convert,
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockDbTx(ctrl, m, true)
+
+				m.EXPECT().LockFXQuote(gomock.Any(), quoteID).Return(quote, nil).Times(1)
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234}).Return([]model.User{{ID: 1234}}, nil).Times(1)
+				m.EXPECT().GetUserBalances(gomock.Any(), int64(1234)).Return([]model.Balance{
+					{Currency: model.CurrencyUSD, Amount: 100},
+				}, nil).Times(1)
+				m.EXPECT().UpdateUser(gomock.Any(), model.UpdateUserRequest{
+					UserID: 1234,
+					Balance: model.UpdateBalanceRequest{
+						Amount:   10,
+						Type:     model.UpdateBalanceDecrement,
+						Currency: model.CurrencyUSD,
+					},
+				}).Return(nil).Times(1)
+				m.EXPECT().UpdateUser(gomock.Any(), model.UpdateUserRequest{
+					UserID: 1234,
+					Balance: model.UpdateBalanceRequest{
+						Amount:   146513,
+						Type:     model.UpdateBalanceIncrement,
+						Currency: model.CurrencyIDR,
+					},
+				}).Return(nil).Times(1)
+				m.EXPECT().InsertTransaction(gomock.Any(), model.Transaction{
+					UserID:            1234,
+					Amount:            10,
+					Type:              model.TransactionTypeConvert,
+					RecipientID:       1234,
+					Status:            model.TransactionStatusSuccessful,
+					Currency:          model.CurrencyUSD,
+					FXQuoteID:         quoteID,
+					ConvertedCurrency: model.CurrencyIDR,
+					ConvertedAmount:   146513,
+				}).Return(transactionID, nil).Times(1)
+				m.EXPECT().UpdateFXQuote(gomock.Any(), model.UpdateFXQuoteRequest{
+					ID:            quoteID,
+					TransactionID: transactionID,
+				}).Return(nil).Times(1)
+
+				return m
+			},
+			wantTransaction: model.Transaction{
+				ID:                transactionID,
+				UserID:            1234,
+				Amount:            10,
+				Type:              model.TransactionTypeConvert,
+				RecipientID:       1234,
+				Status:            model.TransactionStatusSuccessful,
+				Currency:          model.CurrencyUSD,
+				FXQuoteID:         quoteID,
+				ConvertedCurrency: model.CurrencyIDR,
+				ConvertedAmount:   146513,
+			},
+			wantErr: false,
+		},
+		{
+			name:             "fail-quote-expired",
+			inputTransaction: convert,
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockDbTx(ctrl, m, false)
+
+				expiredQuote := quote
+				expiredQuote.ExpiryTime = time.Now().Add(-time.Second)
+				m.EXPECT().LockFXQuote(gomock.Any(), quoteID).Return(expiredQuote, nil).Times(1)
+				m.EXPECT().InsertTransaction(gomock.Any(), gomock.Any()).Return(uuid.Nil, nil).Times(1)
+
+				return m
+			},
+			wantTransaction: model.Transaction{},
+			wantErr:         true,
+		},
+		{
+			name:             "fail-quote-already-used",
+			inputTransaction: convert,
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockDbTx(ctrl, m, false)
+
+				usedQuote := quote
+				usedQuote.TransactionID = transactionID
+				m.EXPECT().LockFXQuote(gomock.Any(), quoteID).Return(usedQuote, nil).Times(1)
+				m.EXPECT().InsertTransaction(gomock.Any(), gomock.Any()).Return(uuid.Nil, nil).Times(1)
+
+				return m
+			},
+			wantTransaction: model.Transaction{},
+			wantErr:         true,
+		},
+		{
+			name: "fail-amount-does-not-match-quote",
+			inputTransaction: func() model.Transaction {
+				transaction := convert
+				transaction.Amount = 20
+				return transaction
+			}(),
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockDbTx(ctrl, m, false)
+
+				m.EXPECT().LockFXQuote(gomock.Any(), quoteID).Return(quote, nil).Times(1)
+				m.EXPECT().InsertTransaction(gomock.Any(), gomock.Any()).Return(uuid.Nil, nil).Times(1)
+
+				return m
+			},
+			wantTransaction: model.Transaction{},
+			wantErr:         true,
+		},
+		{
+			name:             "fail-user-frozen",
+			inputTransaction: convert,
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockDbTx(ctrl, m, false)
+
+				m.EXPECT().LockFXQuote(gomock.Any(), quoteID).Return(quote, nil).Times(1)
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234}).Return([]model.User{{ID: 1234, Status: model.UserStatusFrozen}}, nil).Times(1)
+				m.EXPECT().InsertTransaction(gomock.Any(), model.Transaction{
+					UserID:      1234,
+					Amount:      10,
+					Type:        model.TransactionTypeConvert,
+					RecipientID: 1234,
+					Status:      model.TransactionStatusFailed,
+					Currency:    model.CurrencyUSD,
+					FXQuoteID:   quoteID,
+				}).Return(uuid.Nil, nil).Times(1)
+
+				return m
+			},
+			wantTransaction: model.Transaction{},
+			wantErr:         true,
+		},
+		{
+			name: "fail-balance-not-enough",
+			inputTransaction: func() model.Transaction {
+				transaction := convert
+				transaction.Amount = 1000
+				return transaction
+			}(),
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				return repository.NewMockRepositoryInterface(ctrl)
+			},
+			wantTransaction: model.Transaction{},
+			wantErr:         true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			usecase := &Usecase{
+				Repository: test.mockRepository(controller),
+			}
+
+			gotTransaction, gotErr := usecase.performConvert(context.Background(), user, test.inputTransaction)
+			if !reflect.DeepEqual(gotTransaction, test.wantTransaction) {
+				t.Errorf("usecase.performConvert() gotTransaction = %v, wantTransaction %v", gotTransaction, test.wantTransaction)
+				return
+			}
+			if (gotErr != nil) != test.wantErr {
+				t.Errorf("usecase.performConvert() gotErr = %v, wantErr %v", gotErr, test.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestCreateUserTransaction_Currency(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          model.Transaction
+		mockRepository func(controller *gomock.Controller) *repository.MockRepositoryInterface
+		wantErr        bool
+	}{
+		{
+			name: "fail-transfer-out-not-enough-balance-in-currency",
+			input: model.Transaction{
+				UserID:      1234,
+				Amount:      50,
+				Type:        model.TransactionTypeTransferOut,
+				Currency:    model.CurrencySGD,
+				RecipientID: 6789,
+				Password:    "Admin1234!",
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+
+				// IDR balance is plenty, but SGD balance is not enough
+				m.EXPECT().GetUser(gomock.Any(), int64(1234)).Return(model.User{
+					ID:       1234,
+					Password: "$2a$12$35ELZtgOq3iFR6awq.jsDuV5Dr.0XU5k7iUQuShfeLTWRHGFr//fq",
+					Balance:  1000000,
+				}, nil).Times(1)
+				m.EXPECT().GetUserBalances(gomock.Any(), int64(1234)).Return([]model.Balance{
+					{Currency: model.CurrencySGD, Amount: 10},
+				}, nil).Times(1)
+
+				return m
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail-withdrawal-only-supports-idr",
+			input: model.Transaction{
+				UserID:        1234,
+				Amount:        50,
+				Type:          model.TransactionTypeWithdrawal,
+				Currency:      model.CurrencyUSD,
+				BankAccountID: 7,
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				return repository.NewMockRepositoryInterface(ctrl)
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail-unsupported-currency",
+			input: model.Transaction{
+				UserID:   1234,
+				Amount:   50,
+				Type:     model.TransactionTypeTransferOut,
+				Currency: "EUR",
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				return repository.NewMockRepositoryInterface(ctrl)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			usecase := &Usecase{
+				Repository: test.mockRepository(controller),
+			}
+
+			_, gotErr := usecase.CreateUserTransaction(context.Background(), test.input)
+			if (gotErr != nil) != test.wantErr {
+				t.Errorf("usecase.CreateUserTransaction() gotErr = %v, wantErr %v", gotErr, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetUser(t *testing.T) {
+	controller := gomock.NewController(t)
+
+	m := repository.NewMockRepositoryInterface(controller)
+	m.EXPECT().GetUser(gomock.Any(), int64(1234)).Return(model.User{ID: 1234, Balance: 50000}, nil).Times(1)
+	m.EXPECT().GetUserBalances(gomock.Any(), int64(1234)).Return([]model.Balance{
+		{Currency: model.CurrencySGD, Amount: 12.5},
+	}, nil).Times(1)
+	m.EXPECT().GetPockets(gomock.Any(), model.PocketFilter{UserID: 1234}).Return([]model.Pocket{
+		{ID: 1, UserID: 1234, Name: "Holiday", Balance: 20000, TargetAmount: 5000000},
+	}, nil).Times(1)
+
+	usecase := &Usecase{
+		Repository: m,
+	}
+
+	got, err := usecase.GetUser(context.Background(), 1234)
+	if err != nil {
+		t.Fatalf("usecase.GetUser() err = %v", err)
+	}
+
+	want := []model.Balance{
+		{Currency: model.CurrencyIDR, Amount: 50000},
+		{Currency: model.CurrencySGD, Amount: 12.5},
+	}
+	if !reflect.DeepEqual(got.Balances, want) {
+		t.Errorf("usecase.GetUser() balances = %v, want %v", got.Balances, want)
+	}
+
+	// Money set aside in Pockets is not part of the balance
+	wantPockets := []model.Pocket{{ID: 1, UserID: 1234, Name: "Holiday", Balance: 20000, TargetAmount: 5000000}}
+	if !reflect.DeepEqual(got.Pockets, wantPockets) {
+		t.Errorf("usecase.GetUser() pockets = %v, want %v", got.Pockets, wantPockets)
+	}
+}
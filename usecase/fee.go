@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/WalletService/fees"
+	"github.com/WalletService/model"
+	"github.com/WalletService/tracing"
+)
+
+// PreviewUserTransactionFee returns the fee that would be charged if User performs the Transaction now.
+func (uc *Usecase) PreviewUserTransactionFee(ctx context.Context, transaction model.Transaction) (fee float32, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.PreviewUserTransactionFee")
+	defer func() { span.End(err) }()
+
+	if transaction.Amount <= 0 {
+		return 0, errors.New("invalid amount")
+	}
+
+	if transaction.Currency == "" {
+		transaction.Currency = model.CurrencyIDR
+	}
+	if !isSupportedCurrency(transaction.Currency) {
+		return 0, errors.New("unsupported currency")
+	}
+
+	return uc.calculateFee(ctx, transaction)
+}
+
+// calculateFee returns the fee of the Transaction, Transactions this month are only counted when the fee is tiered.
+// The tier is counted per funding source: a SharedWallet's Transactions count towards the SharedWallet, never towards the member's own tier.
+func (uc *Usecase) calculateFee(ctx context.Context, transaction model.Transaction) (fee float32, err error) {
+	if uc.Fees == nil {
+		return 0, nil
+	}
+
+	request := fees.Request{
+		Type:     transaction.Type,
+		Currency: transaction.Currency,
+		Amount:   transaction.Amount,
+	}
+
+	if uc.Fees.IsTiered(transaction.Type, transaction.Currency) {
+		now := time.Now()
+		filter := model.TransactionFilter{
+			Type:            transaction.Type,
+			Status:          model.TransactionStatusSuccessful,
+			CreatedTimeFrom: time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location()),
+		}
+		if transaction.SharedWalletID != 0 {
+			filter.SharedWalletID = transaction.SharedWalletID
+		} else {
+			filter.UserID = transaction.UserID
+			filter.PersonalOnly = true
+		}
+		if request.MonthlyCount, err = uc.Repository.CountTransactions(ctx, filter); err != nil {
+			return 0, err
+		}
+	}
+
+	return uc.Fees.Calculate(request), nil
+}
+
+// collectFee posts the Transaction's fee to the house revenue account, it must run in the same DB transaction that charges User
+func (uc *Usecase) collectFee(ctx context.Context, transaction model.Transaction, updateType model.UpdateBalanceType) error {
+	if transaction.Fee == 0 {
+		return nil
+	}
+
+	return uc.Repository.UpdateHouseAccount(ctx, model.UpdateHouseAccountRequest{
+		Account: fees.HouseRevenueAccount,
+		Balance: model.UpdateBalanceRequest{
+			Amount:   transaction.Fee,
+			Type:     updateType,
+			Currency: transaction.Currency,
+		},
+	})
+}
@@ -0,0 +1,359 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/WalletService/fees"
+	"github.com/WalletService/model"
+	"github.com/WalletService/repository"
+	"github.com/golang/mock/gomock"
+)
+
+var testFeeSchedule = fees.Schedule{
+	Rules: []fees.Rule{
+		{Type: model.TransactionTypeWithdrawal, Tiers: []fees.Tier{{Flat: 2500}}},
+		{Type: model.TransactionTypePayment, Tiers: []fees.Tier{{Percent: 0.007}}},
+		{Type: model.TransactionTypeTransferOut, Tiers: []fees.Tier{{FromMonthlyCount: 0}, {FromMonthlyCount: 20, Flat: 1000}}},
+	},
+}
+
+func TestPreviewUserTransactionFee(t *testing.T) {
+	tests := []struct {
+		name             string
+		inputTransaction model.Transaction
+		mockRepository   func(controller *gomock.Controller) *repository.MockRepositoryInterface
+		wantFee          float32
+		wantErr          bool
+	}{
+		{
+			name: "success-flat-fee-without-counting",
+			inputTransaction: model.Transaction{
+				UserID: 1234,
+				Amount: 100000,
+				Type:   model.TransactionTypeWithdrawal,
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				return repository.NewMockRepositoryInterface(ctrl)
+			},
+			wantFee: 2500,
+		},
+		{
+			name: "success-percent-fee",
+			inputTransaction: model.Transaction{
+				UserID: 1234,
+				Amount: 100000,
+				Type:   model.TransactionTypePayment,
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				return repository.NewMockRepositoryInterface(ctrl)
+			},
+			wantFee: 700,
+		},
+		{
+			name: "success-free-transfer-within-monthly-quota",
+			inputTransaction: model.Transaction{
+				UserID: 1234,
+				Amount: 100000,
+				Type:   model.TransactionTypeTransferOut,
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				m.EXPECT().CountTransactions(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, filter model.TransactionFilter) (int, error) {
+					if filter.UserID != 1234 || filter.Type != model.TransactionTypeTransferOut || filter.Status != model.TransactionStatusSuccessful || filter.CreatedTimeFrom.Day() != 1 {
+						t.Errorf("CountTransactions() filter = %+v, should count this month's successful TransferOut", filter)
+					}
+					if !filter.PersonalOnly || filter.SharedWalletID != 0 {
+						t.Errorf("CountTransactions() filter = %+v, should exclude SharedWallet transfers from User's tier", filter)
+					}
+					return 19, nil
+				}).Times(1)
+				return m
+			},
+			wantFee: 0,
+		},
+		{
+			name: "success-shared-wallet-transfer-counted-per-shared-wallet",
+			inputTransaction: model.Transaction{
+				UserID:         1234,
+				SharedWalletID: 77,
+				Amount:         100000,
+				Type:           model.TransactionTypeTransferOut,
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				m.EXPECT().CountTransactions(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, filter model.TransactionFilter) (int, error) {
+					if filter.SharedWalletID != 77 || filter.UserID != 0 || filter.PersonalOnly || filter.Type != model.TransactionTypeTransferOut || filter.Status != model.TransactionStatusSuccessful {
+						t.Errorf("CountTransactions() filter = %+v, should count this month's successful TransferOut of the SharedWallet", filter)
+					}
+					return 20, nil
+				}).Times(1)
+				return m
+			},
+			wantFee: 1000,
+		},
+		{
+			name: "success-transfer-after-monthly-quota",
+			inputTransaction: model.Transaction{
+				UserID: 1234,
+				Amount: 100000,
+				Type:   model.TransactionTypeTransferOut,
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				m.EXPECT().CountTransactions(gomock.Any(), gomock.Any()).Return(20, nil).Times(1)
+				return m
+			},
+			wantFee: 1000,
+		},
+		{
+			name: "fail-count-transactions",
+			inputTransaction: model.Transaction{
+				UserID: 1234,
+				Amount: 100000,
+				Type:   model.TransactionTypeTransferOut,
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				m.EXPECT().CountTransactions(gomock.Any(), gomock.Any()).Return(0, errors.New("error-count")).Times(1)
+				return m
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail-invalid-amount",
+			inputTransaction: model.Transaction{
+				UserID: 1234,
+				Type:   model.TransactionTypeWithdrawal,
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				return repository.NewMockRepositoryInterface(ctrl)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			usecase := &Usecase{
+				Repository: test.mockRepository(controller),
+				Fees:       &testFeeSchedule,
+			}
+
+			gotFee, gotErr := usecase.PreviewUserTransactionFee(context.Background(), test.inputTransaction)
+			if (gotErr != nil) != test.wantErr {
+				t.Fatalf("usecase.PreviewUserTransactionFee() gotErr = %v, wantErr %v", gotErr, test.wantErr)
+			}
+			if gotFee != test.wantFee {
+				t.Errorf("usecase.PreviewUserTransactionFee() gotFee = %v, wantFee %v", gotFee, test.wantFee)
+			}
+		})
+	}
+}
+
+func Test_performTransferOut_Fee(t *testing.T) {
+	user := model.User{
+		ID:       1234,
+		Password: "$2a$12$35ELZtgOq3iFR6awq.jsDuV5Dr.0XU5k7iUQuShfeLTWRHGFr//fq",
+		Balance:  100700,
+	}
+
+	mockDbTx := func(ctrl *gomock.Controller, m *repository.MockRepositoryInterface, commit bool) {
+		sqlDb := repository.NewMockSqlDbInterface(ctrl)
+		sqlTx := repository.NewMockSqlTxInterface(ctrl)
+
+		m.EXPECT().GetSqlDb().Return(sqlDb, nil).Times(1)
+		sqlDb.EXPECT().BeginTx(gomock.Any(), gomock.Nil()).Return(sqlTx, nil).Times(1)
+		if commit {
+			sqlTx.EXPECT().Commit().Return(nil).Times(1)
+		} else {
+			sqlTx.EXPECT().Rollback().Return(nil).Times(1)
+		}
+	}
+
+	tests := []struct {
+		name             string
+		inputTransaction model.Transaction
+		mockRepository   func(controller *gomock.Controller) *repository.MockRepositoryInterface
+		wantTransaction  model.Transaction
+		wantErr          bool
+	}{
+		{
+			name: "success-fee-posted-to-house-account",
+			inputTransaction: model.Transaction{
+				UserID:      1234,
+				Amount:      100000,
+				RecipientID: 6789,
+				Type:        model.TransactionTypePayment,
+				Currency:    model.CurrencyIDR,
+				Password:    "Admin1234!",
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockDbTx(ctrl, m, true)
+
+				m.EXPECT().GetUser(gomock.Any(), int64(6789)).Return(model.User{ID: 6789}, nil).Times(1)
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234, 6789}).Return([]model.User{{ID: 1234, Balance: 100700}, {ID: 6789}}, nil).Times(1)
+				m.EXPECT().UpdateUser(gomock.Any(), model.UpdateUserRequest{
+					UserID:  1234,
+					Balance: model.UpdateBalanceRequest{Amount: 100700, Type: model.UpdateBalanceDecrement, Currency: model.CurrencyIDR},
+				}).Return(nil).Times(1)
+				m.EXPECT().UpdateUser(gomock.Any(), model.UpdateUserRequest{
+					UserID:  6789,
+					Balance: model.UpdateBalanceRequest{Amount: 100000, Type: model.UpdateBalanceIncrement, Currency: model.CurrencyIDR},
+				}).Return(nil).Times(1)
+				m.EXPECT().UpdateHouseAccount(gomock.Any(), model.UpdateHouseAccountRequest{
+					Account: fees.HouseRevenueAccount,
+					Balance: model.UpdateBalanceRequest{Amount: 700, Type: model.UpdateBalanceIncrement, Currency: model.CurrencyIDR},
+				}).Return(nil).Times(1)
+				m.EXPECT().InsertTransaction(gomock.Any(), model.Transaction{
+					UserID:      1234,
+					Amount:      100000,
+					RecipientID: 6789,
+					Type:        model.TransactionTypePayment,
+					Currency:    model.CurrencyIDR,
+					Fee:         700,
+					Status:      model.TransactionStatusSuccessful,
+				}).Return(convertToUUID("3d6e668f-ad02-40ff-8540-90c1528a7c88"), nil).Times(1)
+
+				return m
+			},
+			wantTransaction: model.Transaction{
+				ID:          convertToUUID("3d6e668f-ad02-40ff-8540-90c1528a7c88"),
+				UserID:      1234,
+				Amount:      100000,
+				RecipientID: 6789,
+				Type:        model.TransactionTypePayment,
+				Currency:    model.CurrencyIDR,
+				Fee:         700,
+				Status:      model.TransactionStatusSuccessful,
+			},
+		},
+		{
+			name: "success-tiered-fee-recalculated-under-lock",
+			inputTransaction: model.Transaction{
+				UserID:      1234,
+				Amount:      99000,
+				RecipientID: 6789,
+				Type:        model.TransactionTypeTransferOut,
+				Currency:    model.CurrencyIDR,
+				Password:    "Admin1234!",
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockDbTx(ctrl, m, true)
+
+				// A concurrent transfer of User ends the free quota between the first count and the lock
+				gomock.InOrder(
+					m.EXPECT().CountTransactions(gomock.Any(), gomock.Any()).Return(19, nil).Times(1),
+					m.EXPECT().CountTransactions(gomock.Any(), gomock.Any()).Return(20, nil).Times(1),
+				)
+				m.EXPECT().GetUser(gomock.Any(), int64(6789)).Return(model.User{ID: 6789}, nil).Times(1)
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234, 6789}).Return([]model.User{{ID: 1234, Balance: 100700}, {ID: 6789}}, nil).Times(1)
+				m.EXPECT().UpdateUser(gomock.Any(), model.UpdateUserRequest{
+					UserID:  1234,
+					Balance: model.UpdateBalanceRequest{Amount: 100000, Type: model.UpdateBalanceDecrement, Currency: model.CurrencyIDR},
+				}).Return(nil).Times(1)
+				m.EXPECT().UpdateUser(gomock.Any(), model.UpdateUserRequest{
+					UserID:  6789,
+					Balance: model.UpdateBalanceRequest{Amount: 99000, Type: model.UpdateBalanceIncrement, Currency: model.CurrencyIDR},
+				}).Return(nil).Times(1)
+				m.EXPECT().UpdateHouseAccount(gomock.Any(), model.UpdateHouseAccountRequest{
+					Account: fees.HouseRevenueAccount,
+					Balance: model.UpdateBalanceRequest{Amount: 1000, Type: model.UpdateBalanceIncrement, Currency: model.CurrencyIDR},
+				}).Return(nil).Times(1)
+				m.EXPECT().InsertTransaction(gomock.Any(), model.Transaction{
+					UserID:      1234,
+					Amount:      99000,
+					RecipientID: 6789,
+					Type:        model.TransactionTypeTransferOut,
+					Currency:    model.CurrencyIDR,
+					Fee:         1000,
+					Status:      model.TransactionStatusSuccessful,
+				}).Return(convertToUUID("3d6e668f-ad02-40ff-8540-90c1528a7c88"), nil).Times(1)
+
+				return m
+			},
+			wantTransaction: model.Transaction{
+				ID:          convertToUUID("3d6e668f-ad02-40ff-8540-90c1528a7c88"),
+				UserID:      1234,
+				Amount:      99000,
+				RecipientID: 6789,
+				Type:        model.TransactionTypeTransferOut,
+				Currency:    model.CurrencyIDR,
+				Fee:         1000,
+				Status:      model.TransactionStatusSuccessful,
+			},
+		},
+		{
+			name: "fail-balance-does-not-cover-fee",
+			inputTransaction: model.Transaction{
+				UserID:      1234,
+				Amount:      100001,
+				RecipientID: 6789,
+				Type:        model.TransactionTypePayment,
+				Currency:    model.CurrencyIDR,
+				Password:    "Admin1234!",
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				return repository.NewMockRepositoryInterface(ctrl)
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail-house-account-should-rollback",
+			inputTransaction: model.Transaction{
+				UserID:      1234,
+				Amount:      100000,
+				RecipientID: 6789,
+				Type:        model.TransactionTypePayment,
+				Currency:    model.CurrencyIDR,
+				Password:    "Admin1234!",
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockDbTx(ctrl, m, false)
+
+				m.EXPECT().GetUser(gomock.Any(), int64(6789)).Return(model.User{ID: 6789}, nil).Times(1)
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234, 6789}).Return([]model.User{{ID: 1234, Balance: 100700}, {ID: 6789}}, nil).Times(1)
+				m.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				m.EXPECT().UpdateHouseAccount(gomock.Any(), gomock.Any()).Return(errors.New("error-house-account")).Times(1)
+				m.EXPECT().InsertTransaction(gomock.Any(), model.Transaction{
+					UserID:      1234,
+					Amount:      100000,
+					RecipientID: 6789,
+					Type:        model.TransactionTypePayment,
+					Currency:    model.CurrencyIDR,
+					Fee:         700,
+					Status:      model.TransactionStatusFailed,
+				}).Return(convertToUUID("3d6e668f-ad02-40ff-8540-90c1528a7c88"), nil).Times(1)
+
+				return m
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			usecase := &Usecase{
+				Repository: test.mockRepository(controller),
+				Fees:       &testFeeSchedule,
+			}
+
+			gotTransaction, gotErr := usecase.performTransferOut(context.Background(), user, test.inputTransaction)
+			if (gotErr != nil) != test.wantErr {
+				t.Fatalf("usecase.performTransferOut() gotErr = %v, wantErr %v", gotErr, test.wantErr)
+			}
+			if !reflect.DeepEqual(gotTransaction, test.wantTransaction) {
+				t.Errorf("usecase.performTransferOut() gotTransaction = %+v, wantTransaction %+v", gotTransaction, test.wantTransaction)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 
 	"github.com/WalletService/model"
+	"github.com/WalletService/utils"
 	"github.com/google/uuid"
 )
 
@@ -16,5 +17,41 @@ type UsecaseInterface interface {
 	GetUser(ctx context.Context, userID int64) (user model.User, err error)
 	GetUsers(ctx context.Context, request model.UserFilter) (users []model.User, err error)
 	UserLogin(ctx context.Context, phoneNumber, password string) (userID int64, err error)
-	CreateUserTransaction(ctx context.Context, transaction model.Transaction) (newTransactionID uuid.UUID, err error)
+	CreateUserTransaction(ctx context.Context, transaction model.Transaction) (newTransaction model.Transaction, err error)
+	LookupRecipient(ctx context.Context, userID int64, phoneNumber string) (lookup model.RecipientLookup, err error)
+	AddContact(ctx context.Context, request model.ContactRequest) (contact model.Contact, err error)
+	GetUserContacts(ctx context.Context, userID int64) (contacts []model.Contact, err error)
+	UpdateContact(ctx context.Context, request model.UpdateContactRequest) (contact model.Contact, err error)
+	DeleteContact(ctx context.Context, userID, contactID int64) error
+	GetContactSuggestions(ctx context.Context, userID int64, limit int) (suggestions []model.ContactSuggestion, err error)
+	CreatePocket(ctx context.Context, pocket model.Pocket) (newPocket model.Pocket, err error)
+	UpdatePocket(ctx context.Context, pocket model.Pocket) (updatedPocket model.Pocket, err error)
+	DeletePocket(ctx context.Context, userID, pocketID int64) error
+	CreateSharedWallet(ctx context.Context, wallet model.SharedWallet) (newWallet model.SharedWallet, err error)
+	GetUserSharedWallets(ctx context.Context, userID int64) (wallets []model.SharedWallet, err error)
+	GetSharedWalletMembers(ctx context.Context, ownerID, sharedWalletID int64) (members []model.SharedWalletMember, err error)
+	AddSharedWalletMember(ctx context.Context, request model.SharedWalletMemberRequest) (member model.SharedWalletMember, err error)
+	UpdateSharedWalletMember(ctx context.Context, ownerID int64, member model.SharedWalletMember) (updatedMember model.SharedWalletMember, err error)
+	RemoveSharedWalletMember(ctx context.Context, ownerID, sharedWalletID, userID int64) error
+	GetSharedWalletTransactions(ctx context.Context, userID int64, request model.TransactionFilter) (transactions []model.Transaction, err error)
+	PreviewUserTransactionFee(ctx context.Context, transaction model.Transaction) (fee float32, err error)
+	GenerateUserQR(ctx context.Context, request model.QRRequest) (payload string, err error)
+	CreateUserQRPayment(ctx context.Context, payment model.QRPayment) (newTransaction model.Transaction, err error)
+	RegisterUserBankAccount(ctx context.Context, bankAccount model.BankAccount) (bankAccountID int64, err error)
+	GetUserBankAccounts(ctx context.Context, userID int64) (bankAccounts []model.BankAccount, err error)
+	CompleteWithdrawal(ctx context.Context, result model.DisbursementResult) error
+	CreateUserTopUpIntent(ctx context.Context, intent model.TopUpIntent) (newIntent model.TopUpIntent, err error)
+	GetUserTopUpIntent(ctx context.Context, userID int64, intentID uuid.UUID) (intent model.TopUpIntent, err error)
+	CompleteTopUp(ctx context.Context, topUpPayment model.TopUpPayment) (newTransaction model.Transaction, err error)
+	CreateUserFXQuote(ctx context.Context, request model.FXQuoteRequest) (quote model.FXQuote, err error)
+	GetUserPermissions(ctx context.Context, userID int64) (permissions []utils.JWTPermission, err error)
+	GetUserTransactions(ctx context.Context, request model.TransactionFilter) (transactions []model.Transaction, err error)
+	AdjustUserBalance(ctx context.Context, adjustment model.BalanceAdjustment) (newTransaction model.Transaction, err error)
+	ChangeUserStatus(ctx context.Context, request model.UserStatusChange) (change model.UserStatusChange, err error)
+	UpdateUserProfile(ctx context.Context, request model.UpdateUserRequest) (user model.User, err error)
+	RequestPhoneNumberChange(ctx context.Context, userID int64, phoneNumber, password string) (change model.PhoneNumberChange, err error)
+	ConfirmPhoneNumberChange(ctx context.Context, userID int64, otp string) (user model.User, err error)
+	MigratePhoneNumbers(ctx context.Context, batchSize int, dryRun bool) (migration model.PhoneNumberMigration, err error)
+	GetAuditLog(ctx context.Context, filter model.AuditLogFilter) (entries []model.AuditLogEntry, err error)
+	VerifyAuditLog(ctx context.Context, batchSize int) (verification model.AuditLogVerification, err error)
 }
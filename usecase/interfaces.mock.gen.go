@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	model "github.com/WalletService/model"
+	utils "github.com/WalletService/utils"
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
 )
@@ -36,11 +37,190 @@ func (m *MockUsecaseInterface) EXPECT() *MockUsecaseInterfaceMockRecorder {
 	return m.recorder
 }
 
+// AddContact mocks base method.
+func (m *MockUsecaseInterface) AddContact(ctx context.Context, request model.ContactRequest) (model.Contact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddContact", ctx, request)
+	ret0, _ := ret[0].(model.Contact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddContact indicates an expected call of AddContact.
+func (mr *MockUsecaseInterfaceMockRecorder) AddContact(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddContact", reflect.TypeOf((*MockUsecaseInterface)(nil).AddContact), ctx, request)
+}
+
+// AddSharedWalletMember mocks base method.
+func (m *MockUsecaseInterface) AddSharedWalletMember(ctx context.Context, request model.SharedWalletMemberRequest) (model.SharedWalletMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSharedWalletMember", ctx, request)
+	ret0, _ := ret[0].(model.SharedWalletMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddSharedWalletMember indicates an expected call of AddSharedWalletMember.
+func (mr *MockUsecaseInterfaceMockRecorder) AddSharedWalletMember(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSharedWalletMember", reflect.TypeOf((*MockUsecaseInterface)(nil).AddSharedWalletMember), ctx, request)
+}
+
+// AdjustUserBalance mocks base method.
+func (m *MockUsecaseInterface) AdjustUserBalance(ctx context.Context, adjustment model.BalanceAdjustment) (model.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdjustUserBalance", ctx, adjustment)
+	ret0, _ := ret[0].(model.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdjustUserBalance indicates an expected call of AdjustUserBalance.
+func (mr *MockUsecaseInterfaceMockRecorder) AdjustUserBalance(ctx, adjustment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdjustUserBalance", reflect.TypeOf((*MockUsecaseInterface)(nil).AdjustUserBalance), ctx, adjustment)
+}
+
+// ChangeUserStatus mocks base method.
+func (m *MockUsecaseInterface) ChangeUserStatus(ctx context.Context, request model.UserStatusChange) (model.UserStatusChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeUserStatus", ctx, request)
+	ret0, _ := ret[0].(model.UserStatusChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeUserStatus indicates an expected call of ChangeUserStatus.
+func (mr *MockUsecaseInterfaceMockRecorder) ChangeUserStatus(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeUserStatus", reflect.TypeOf((*MockUsecaseInterface)(nil).ChangeUserStatus), ctx, request)
+}
+
+// CompleteTopUp mocks base method.
+func (m *MockUsecaseInterface) CompleteTopUp(ctx context.Context, topUpPayment model.TopUpPayment) (model.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteTopUp", ctx, topUpPayment)
+	ret0, _ := ret[0].(model.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteTopUp indicates an expected call of CompleteTopUp.
+func (mr *MockUsecaseInterfaceMockRecorder) CompleteTopUp(ctx, topUpPayment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteTopUp", reflect.TypeOf((*MockUsecaseInterface)(nil).CompleteTopUp), ctx, topUpPayment)
+}
+
+// CompleteWithdrawal mocks base method.
+func (m *MockUsecaseInterface) CompleteWithdrawal(ctx context.Context, result model.DisbursementResult) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteWithdrawal", ctx, result)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteWithdrawal indicates an expected call of CompleteWithdrawal.
+func (mr *MockUsecaseInterfaceMockRecorder) CompleteWithdrawal(ctx, result interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteWithdrawal", reflect.TypeOf((*MockUsecaseInterface)(nil).CompleteWithdrawal), ctx, result)
+}
+
+// ConfirmPhoneNumberChange mocks base method.
+func (m *MockUsecaseInterface) ConfirmPhoneNumberChange(ctx context.Context, userID int64, otp string) (model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmPhoneNumberChange", ctx, userID, otp)
+	ret0, _ := ret[0].(model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmPhoneNumberChange indicates an expected call of ConfirmPhoneNumberChange.
+func (mr *MockUsecaseInterfaceMockRecorder) ConfirmPhoneNumberChange(ctx, userID, otp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmPhoneNumberChange", reflect.TypeOf((*MockUsecaseInterface)(nil).ConfirmPhoneNumberChange), ctx, userID, otp)
+}
+
+// CreatePocket mocks base method.
+func (m *MockUsecaseInterface) CreatePocket(ctx context.Context, pocket model.Pocket) (model.Pocket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePocket", ctx, pocket)
+	ret0, _ := ret[0].(model.Pocket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePocket indicates an expected call of CreatePocket.
+func (mr *MockUsecaseInterfaceMockRecorder) CreatePocket(ctx, pocket interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePocket", reflect.TypeOf((*MockUsecaseInterface)(nil).CreatePocket), ctx, pocket)
+}
+
+// CreateSharedWallet mocks base method.
+func (m *MockUsecaseInterface) CreateSharedWallet(ctx context.Context, wallet model.SharedWallet) (model.SharedWallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSharedWallet", ctx, wallet)
+	ret0, _ := ret[0].(model.SharedWallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSharedWallet indicates an expected call of CreateSharedWallet.
+func (mr *MockUsecaseInterfaceMockRecorder) CreateSharedWallet(ctx, wallet interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSharedWallet", reflect.TypeOf((*MockUsecaseInterface)(nil).CreateSharedWallet), ctx, wallet)
+}
+
+// CreateUserFXQuote mocks base method.
+func (m *MockUsecaseInterface) CreateUserFXQuote(ctx context.Context, request model.FXQuoteRequest) (model.FXQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserFXQuote", ctx, request)
+	ret0, _ := ret[0].(model.FXQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserFXQuote indicates an expected call of CreateUserFXQuote.
+func (mr *MockUsecaseInterfaceMockRecorder) CreateUserFXQuote(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserFXQuote", reflect.TypeOf((*MockUsecaseInterface)(nil).CreateUserFXQuote), ctx, request)
+}
+
+// CreateUserQRPayment mocks base method.
+func (m *MockUsecaseInterface) CreateUserQRPayment(ctx context.Context, payment model.QRPayment) (model.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserQRPayment", ctx, payment)
+	ret0, _ := ret[0].(model.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserQRPayment indicates an expected call of CreateUserQRPayment.
+func (mr *MockUsecaseInterfaceMockRecorder) CreateUserQRPayment(ctx, payment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserQRPayment", reflect.TypeOf((*MockUsecaseInterface)(nil).CreateUserQRPayment), ctx, payment)
+}
+
+// CreateUserTopUpIntent mocks base method.
+func (m *MockUsecaseInterface) CreateUserTopUpIntent(ctx context.Context, intent model.TopUpIntent) (model.TopUpIntent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserTopUpIntent", ctx, intent)
+	ret0, _ := ret[0].(model.TopUpIntent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserTopUpIntent indicates an expected call of CreateUserTopUpIntent.
+func (mr *MockUsecaseInterfaceMockRecorder) CreateUserTopUpIntent(ctx, intent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserTopUpIntent", reflect.TypeOf((*MockUsecaseInterface)(nil).CreateUserTopUpIntent), ctx, intent)
+}
+
 // CreateUserTransaction mocks base method.
-func (m *MockUsecaseInterface) CreateUserTransaction(ctx context.Context, transaction model.Transaction) (uuid.UUID, error) {
+func (m *MockUsecaseInterface) CreateUserTransaction(ctx context.Context, transaction model.Transaction) (model.Transaction, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateUserTransaction", ctx, transaction)
-	ret0, _ := ret[0].(uuid.UUID)
+	ret0, _ := ret[0].(model.Transaction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -51,6 +231,109 @@ func (mr *MockUsecaseInterfaceMockRecorder) CreateUserTransaction(ctx, transacti
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserTransaction", reflect.TypeOf((*MockUsecaseInterface)(nil).CreateUserTransaction), ctx, transaction)
 }
 
+// DeleteContact mocks base method.
+func (m *MockUsecaseInterface) DeleteContact(ctx context.Context, userID, contactID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteContact", ctx, userID, contactID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteContact indicates an expected call of DeleteContact.
+func (mr *MockUsecaseInterfaceMockRecorder) DeleteContact(ctx, userID, contactID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteContact", reflect.TypeOf((*MockUsecaseInterface)(nil).DeleteContact), ctx, userID, contactID)
+}
+
+// DeletePocket mocks base method.
+func (m *MockUsecaseInterface) DeletePocket(ctx context.Context, userID, pocketID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePocket", ctx, userID, pocketID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePocket indicates an expected call of DeletePocket.
+func (mr *MockUsecaseInterfaceMockRecorder) DeletePocket(ctx, userID, pocketID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePocket", reflect.TypeOf((*MockUsecaseInterface)(nil).DeletePocket), ctx, userID, pocketID)
+}
+
+// GenerateUserQR mocks base method.
+func (m *MockUsecaseInterface) GenerateUserQR(ctx context.Context, request model.QRRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateUserQR", ctx, request)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateUserQR indicates an expected call of GenerateUserQR.
+func (mr *MockUsecaseInterfaceMockRecorder) GenerateUserQR(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateUserQR", reflect.TypeOf((*MockUsecaseInterface)(nil).GenerateUserQR), ctx, request)
+}
+
+// GetAuditLog mocks base method.
+func (m *MockUsecaseInterface) GetAuditLog(ctx context.Context, filter model.AuditLogFilter) ([]model.AuditLogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditLog", ctx, filter)
+	ret0, _ := ret[0].([]model.AuditLogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditLog indicates an expected call of GetAuditLog.
+func (mr *MockUsecaseInterfaceMockRecorder) GetAuditLog(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditLog", reflect.TypeOf((*MockUsecaseInterface)(nil).GetAuditLog), ctx, filter)
+}
+
+// GetContactSuggestions mocks base method.
+func (m *MockUsecaseInterface) GetContactSuggestions(ctx context.Context, userID int64, limit int) ([]model.ContactSuggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContactSuggestions", ctx, userID, limit)
+	ret0, _ := ret[0].([]model.ContactSuggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContactSuggestions indicates an expected call of GetContactSuggestions.
+func (mr *MockUsecaseInterfaceMockRecorder) GetContactSuggestions(ctx, userID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContactSuggestions", reflect.TypeOf((*MockUsecaseInterface)(nil).GetContactSuggestions), ctx, userID, limit)
+}
+
+// GetSharedWalletMembers mocks base method.
+func (m *MockUsecaseInterface) GetSharedWalletMembers(ctx context.Context, ownerID, sharedWalletID int64) ([]model.SharedWalletMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSharedWalletMembers", ctx, ownerID, sharedWalletID)
+	ret0, _ := ret[0].([]model.SharedWalletMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSharedWalletMembers indicates an expected call of GetSharedWalletMembers.
+func (mr *MockUsecaseInterfaceMockRecorder) GetSharedWalletMembers(ctx, ownerID, sharedWalletID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharedWalletMembers", reflect.TypeOf((*MockUsecaseInterface)(nil).GetSharedWalletMembers), ctx, ownerID, sharedWalletID)
+}
+
+// GetSharedWalletTransactions mocks base method.
+func (m *MockUsecaseInterface) GetSharedWalletTransactions(ctx context.Context, userID int64, request model.TransactionFilter) ([]model.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSharedWalletTransactions", ctx, userID, request)
+	ret0, _ := ret[0].([]model.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSharedWalletTransactions indicates an expected call of GetSharedWalletTransactions.
+func (mr *MockUsecaseInterfaceMockRecorder) GetSharedWalletTransactions(ctx, userID, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharedWalletTransactions", reflect.TypeOf((*MockUsecaseInterface)(nil).GetSharedWalletTransactions), ctx, userID, request)
+}
+
 // GetUser mocks base method.
 func (m *MockUsecaseInterface) GetUser(ctx context.Context, userID int64) (model.User, error) {
 	m.ctrl.T.Helper()
@@ -66,6 +349,96 @@ func (mr *MockUsecaseInterfaceMockRecorder) GetUser(ctx, userID interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockUsecaseInterface)(nil).GetUser), ctx, userID)
 }
 
+// GetUserBankAccounts mocks base method.
+func (m *MockUsecaseInterface) GetUserBankAccounts(ctx context.Context, userID int64) ([]model.BankAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserBankAccounts", ctx, userID)
+	ret0, _ := ret[0].([]model.BankAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserBankAccounts indicates an expected call of GetUserBankAccounts.
+func (mr *MockUsecaseInterfaceMockRecorder) GetUserBankAccounts(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserBankAccounts", reflect.TypeOf((*MockUsecaseInterface)(nil).GetUserBankAccounts), ctx, userID)
+}
+
+// GetUserContacts mocks base method.
+func (m *MockUsecaseInterface) GetUserContacts(ctx context.Context, userID int64) ([]model.Contact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserContacts", ctx, userID)
+	ret0, _ := ret[0].([]model.Contact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserContacts indicates an expected call of GetUserContacts.
+func (mr *MockUsecaseInterfaceMockRecorder) GetUserContacts(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserContacts", reflect.TypeOf((*MockUsecaseInterface)(nil).GetUserContacts), ctx, userID)
+}
+
+// GetUserPermissions mocks base method.
+func (m *MockUsecaseInterface) GetUserPermissions(ctx context.Context, userID int64) ([]utils.JWTPermission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserPermissions", ctx, userID)
+	ret0, _ := ret[0].([]utils.JWTPermission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserPermissions indicates an expected call of GetUserPermissions.
+func (mr *MockUsecaseInterfaceMockRecorder) GetUserPermissions(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPermissions", reflect.TypeOf((*MockUsecaseInterface)(nil).GetUserPermissions), ctx, userID)
+}
+
+// GetUserSharedWallets mocks base method.
+func (m *MockUsecaseInterface) GetUserSharedWallets(ctx context.Context, userID int64) ([]model.SharedWallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserSharedWallets", ctx, userID)
+	ret0, _ := ret[0].([]model.SharedWallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserSharedWallets indicates an expected call of GetUserSharedWallets.
+func (mr *MockUsecaseInterfaceMockRecorder) GetUserSharedWallets(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSharedWallets", reflect.TypeOf((*MockUsecaseInterface)(nil).GetUserSharedWallets), ctx, userID)
+}
+
+// GetUserTopUpIntent mocks base method.
+func (m *MockUsecaseInterface) GetUserTopUpIntent(ctx context.Context, userID int64, intentID uuid.UUID) (model.TopUpIntent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserTopUpIntent", ctx, userID, intentID)
+	ret0, _ := ret[0].(model.TopUpIntent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserTopUpIntent indicates an expected call of GetUserTopUpIntent.
+func (mr *MockUsecaseInterfaceMockRecorder) GetUserTopUpIntent(ctx, userID, intentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTopUpIntent", reflect.TypeOf((*MockUsecaseInterface)(nil).GetUserTopUpIntent), ctx, userID, intentID)
+}
+
+// GetUserTransactions mocks base method.
+func (m *MockUsecaseInterface) GetUserTransactions(ctx context.Context, request model.TransactionFilter) ([]model.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserTransactions", ctx, request)
+	ret0, _ := ret[0].([]model.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserTransactions indicates an expected call of GetUserTransactions.
+func (mr *MockUsecaseInterfaceMockRecorder) GetUserTransactions(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTransactions", reflect.TypeOf((*MockUsecaseInterface)(nil).GetUserTransactions), ctx, request)
+}
+
 // GetUsers mocks base method.
 func (m *MockUsecaseInterface) GetUsers(ctx context.Context, request model.UserFilter) ([]model.User, error) {
 	m.ctrl.T.Helper()
@@ -81,6 +454,51 @@ func (mr *MockUsecaseInterfaceMockRecorder) GetUsers(ctx, request interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockUsecaseInterface)(nil).GetUsers), ctx, request)
 }
 
+// LookupRecipient mocks base method.
+func (m *MockUsecaseInterface) LookupRecipient(ctx context.Context, userID int64, phoneNumber string) (model.RecipientLookup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LookupRecipient", ctx, userID, phoneNumber)
+	ret0, _ := ret[0].(model.RecipientLookup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LookupRecipient indicates an expected call of LookupRecipient.
+func (mr *MockUsecaseInterfaceMockRecorder) LookupRecipient(ctx, userID, phoneNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupRecipient", reflect.TypeOf((*MockUsecaseInterface)(nil).LookupRecipient), ctx, userID, phoneNumber)
+}
+
+// MigratePhoneNumbers mocks base method.
+func (m *MockUsecaseInterface) MigratePhoneNumbers(ctx context.Context, batchSize int, dryRun bool) (model.PhoneNumberMigration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigratePhoneNumbers", ctx, batchSize, dryRun)
+	ret0, _ := ret[0].(model.PhoneNumberMigration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigratePhoneNumbers indicates an expected call of MigratePhoneNumbers.
+func (mr *MockUsecaseInterfaceMockRecorder) MigratePhoneNumbers(ctx, batchSize, dryRun interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigratePhoneNumbers", reflect.TypeOf((*MockUsecaseInterface)(nil).MigratePhoneNumbers), ctx, batchSize, dryRun)
+}
+
+// PreviewUserTransactionFee mocks base method.
+func (m *MockUsecaseInterface) PreviewUserTransactionFee(ctx context.Context, transaction model.Transaction) (float32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewUserTransactionFee", ctx, transaction)
+	ret0, _ := ret[0].(float32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewUserTransactionFee indicates an expected call of PreviewUserTransactionFee.
+func (mr *MockUsecaseInterfaceMockRecorder) PreviewUserTransactionFee(ctx, transaction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewUserTransactionFee", reflect.TypeOf((*MockUsecaseInterface)(nil).PreviewUserTransactionFee), ctx, transaction)
+}
+
 // RegisterUser mocks base method.
 func (m *MockUsecaseInterface) RegisterUser(ctx context.Context, user model.User) (int64, error) {
 	m.ctrl.T.Helper()
@@ -96,6 +514,110 @@ func (mr *MockUsecaseInterfaceMockRecorder) RegisterUser(ctx, user interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterUser", reflect.TypeOf((*MockUsecaseInterface)(nil).RegisterUser), ctx, user)
 }
 
+// RegisterUserBankAccount mocks base method.
+func (m *MockUsecaseInterface) RegisterUserBankAccount(ctx context.Context, bankAccount model.BankAccount) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterUserBankAccount", ctx, bankAccount)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterUserBankAccount indicates an expected call of RegisterUserBankAccount.
+func (mr *MockUsecaseInterfaceMockRecorder) RegisterUserBankAccount(ctx, bankAccount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterUserBankAccount", reflect.TypeOf((*MockUsecaseInterface)(nil).RegisterUserBankAccount), ctx, bankAccount)
+}
+
+// RemoveSharedWalletMember mocks base method.
+func (m *MockUsecaseInterface) RemoveSharedWalletMember(ctx context.Context, ownerID, sharedWalletID, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveSharedWalletMember", ctx, ownerID, sharedWalletID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveSharedWalletMember indicates an expected call of RemoveSharedWalletMember.
+func (mr *MockUsecaseInterfaceMockRecorder) RemoveSharedWalletMember(ctx, ownerID, sharedWalletID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSharedWalletMember", reflect.TypeOf((*MockUsecaseInterface)(nil).RemoveSharedWalletMember), ctx, ownerID, sharedWalletID, userID)
+}
+
+// RequestPhoneNumberChange mocks base method.
+func (m *MockUsecaseInterface) RequestPhoneNumberChange(ctx context.Context, userID int64, phoneNumber, password string) (model.PhoneNumberChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestPhoneNumberChange", ctx, userID, phoneNumber, password)
+	ret0, _ := ret[0].(model.PhoneNumberChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestPhoneNumberChange indicates an expected call of RequestPhoneNumberChange.
+func (mr *MockUsecaseInterfaceMockRecorder) RequestPhoneNumberChange(ctx, userID, phoneNumber, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPhoneNumberChange", reflect.TypeOf((*MockUsecaseInterface)(nil).RequestPhoneNumberChange), ctx, userID, phoneNumber, password)
+}
+
+// UpdateContact mocks base method.
+func (m *MockUsecaseInterface) UpdateContact(ctx context.Context, request model.UpdateContactRequest) (model.Contact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateContact", ctx, request)
+	ret0, _ := ret[0].(model.Contact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateContact indicates an expected call of UpdateContact.
+func (mr *MockUsecaseInterfaceMockRecorder) UpdateContact(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateContact", reflect.TypeOf((*MockUsecaseInterface)(nil).UpdateContact), ctx, request)
+}
+
+// UpdatePocket mocks base method.
+func (m *MockUsecaseInterface) UpdatePocket(ctx context.Context, pocket model.Pocket) (model.Pocket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePocket", ctx, pocket)
+	ret0, _ := ret[0].(model.Pocket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePocket indicates an expected call of UpdatePocket.
+func (mr *MockUsecaseInterfaceMockRecorder) UpdatePocket(ctx, pocket interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePocket", reflect.TypeOf((*MockUsecaseInterface)(nil).UpdatePocket), ctx, pocket)
+}
+
+// UpdateSharedWalletMember mocks base method.
+func (m *MockUsecaseInterface) UpdateSharedWalletMember(ctx context.Context, ownerID int64, member model.SharedWalletMember) (model.SharedWalletMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSharedWalletMember", ctx, ownerID, member)
+	ret0, _ := ret[0].(model.SharedWalletMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSharedWalletMember indicates an expected call of UpdateSharedWalletMember.
+func (mr *MockUsecaseInterfaceMockRecorder) UpdateSharedWalletMember(ctx, ownerID, member interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSharedWalletMember", reflect.TypeOf((*MockUsecaseInterface)(nil).UpdateSharedWalletMember), ctx, ownerID, member)
+}
+
+// UpdateUserProfile mocks base method.
+func (m *MockUsecaseInterface) UpdateUserProfile(ctx context.Context, request model.UpdateUserRequest) (model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserProfile", ctx, request)
+	ret0, _ := ret[0].(model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserProfile indicates an expected call of UpdateUserProfile.
+func (mr *MockUsecaseInterfaceMockRecorder) UpdateUserProfile(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserProfile", reflect.TypeOf((*MockUsecaseInterface)(nil).UpdateUserProfile), ctx, request)
+}
+
 // UserLogin mocks base method.
 func (m *MockUsecaseInterface) UserLogin(ctx context.Context, phoneNumber, password string) (int64, error) {
 	m.ctrl.T.Helper()
@@ -110,3 +632,18 @@ func (mr *MockUsecaseInterfaceMockRecorder) UserLogin(ctx, phoneNumber, password
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserLogin", reflect.TypeOf((*MockUsecaseInterface)(nil).UserLogin), ctx, phoneNumber, password)
 }
+
+// VerifyAuditLog mocks base method.
+func (m *MockUsecaseInterface) VerifyAuditLog(ctx context.Context, batchSize int) (model.AuditLogVerification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyAuditLog", ctx, batchSize)
+	ret0, _ := ret[0].(model.AuditLogVerification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyAuditLog indicates an expected call of VerifyAuditLog.
+func (mr *MockUsecaseInterfaceMockRecorder) VerifyAuditLog(ctx, batchSize interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyAuditLog", reflect.TypeOf((*MockUsecaseInterface)(nil).VerifyAuditLog), ctx, batchSize)
+}
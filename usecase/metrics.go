@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"github.com/WalletService/metrics"
+	"github.com/WalletService/model"
+)
+
+// recordTransaction counts a Transaction reaching status, and adds its amount to the volume once Successful
+func recordTransaction(transaction model.Transaction, status model.TransactionStatus) {
+	metrics.Transactions.Inc(string(transaction.Type), string(status))
+
+	if status == model.TransactionStatusSuccessful {
+		currency := transaction.Currency
+		if currency == "" {
+			currency = model.CurrencyIDR
+		}
+		metrics.TransactionVolume.Add(float64(transaction.Amount), string(transaction.Type), string(currency))
+	}
+}
+
+// recordLogin counts a login attempt by whether it succeeded
+func recordLogin(err error) {
+	if err != nil {
+		metrics.Logins.Inc(metrics.LoginFailure)
+		return
+	}
+	metrics.Logins.Inc(metrics.LoginSuccess)
+}
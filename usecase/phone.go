@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/WalletService/model"
+	"github.com/WalletService/tracing"
+	"github.com/WalletService/utils"
+)
+
+// phoneNumberMigrationBatchSize is the number of Users MigratePhoneNumbers reads at once when not set
+const phoneNumberMigrationBatchSize = 1000
+
+// MigratePhoneNumbers normalizes the phone numbers of Users registered before E.164 normalization, batchSize Users at a time.
+// Phone numbers which are invalid or whose normalized phone number is registered to another User are reported, to be fixed manually.
+// Once every phone number is in E.164, the DB enforces it for every row. A dry run only reports.
+func (uc *Usecase) MigratePhoneNumbers(ctx context.Context, batchSize int, dryRun bool) (migration model.PhoneNumberMigration, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.MigratePhoneNumbers")
+	defer func() { span.End(err) }()
+
+	if batchSize <= 0 {
+		batchSize = phoneNumberMigrationBatchSize
+	}
+	parser := uc.validationLimits().PhoneNumberParser()
+
+	// Users are ordered by ID, which changing their phone number does not change
+	for offset := 0; ; offset += batchSize {
+		users, err := uc.Repository.GetUsers(ctx, model.UserFilter{Limit: batchSize, Offset: offset})
+		if err != nil {
+			return migration, err
+		}
+
+		for _, user := range users {
+			migration.Users++
+
+			normalized, err := parser.Normalize(user.PhoneNumber)
+			if err != nil {
+				migration.Invalid = append(migration.Invalid, model.PhoneNumberMigrationEntry{UserID: user.ID, From: user.PhoneNumber, Reason: err.Error()})
+				continue
+			}
+			if normalized == user.PhoneNumber {
+				continue
+			}
+
+			entry := model.PhoneNumberMigrationEntry{UserID: user.ID, From: user.PhoneNumber, To: normalized}
+			if !dryRun {
+				err := uc.migratePhoneNumber(ctx, entry)
+				if utils.IsUniqueConstraintViolation(err) {
+					entry.Reason = "phone number is already registered to another user"
+					migration.Conflicts = append(migration.Conflicts, entry)
+					continue
+				} else if err != nil {
+					return migration, err
+				}
+			}
+			migration.Normalized = append(migration.Normalized, entry)
+		}
+
+		if len(users) < batchSize {
+			break
+		}
+	}
+
+	if dryRun || len(migration.Invalid) > 0 || len(migration.Conflicts) > 0 {
+		return migration, nil
+	}
+
+	if err := uc.Repository.ValidatePhoneNumberConstraint(ctx); err != nil {
+		return migration, err
+	}
+	migration.ConstraintValidated = true
+
+	return migration, nil
+}
+
+// migratePhoneNumber changes the phone number of a User to its E.164 form, recorded in the audit log like a change made by the User
+func (uc *Usecase) migratePhoneNumber(ctx context.Context, entry model.PhoneNumberMigrationEntry) error {
+	return utils.WithDbTx(ctx, uc.Repository, func(ctx context.Context) error {
+		// 1. Change User's phone number, violates the unique constraint if another User has the normalized phone number
+		if err := uc.Repository.UpdateUser(ctx, model.UpdateUserRequest{
+			UserID:      entry.UserID,
+			PhoneNumber: entry.To,
+		}); err != nil {
+			return err
+		}
+
+		// 2. Record the change in the audit log
+		_, err := uc.Repository.InsertAuditLog(ctx, model.AuditLogEntry{
+			Action: model.AuditActionPhoneNumberChange,
+			UserID: entry.UserID,
+			Details: map[string]string{
+				"from_phone_number": entry.From,
+				"to_phone_number":   entry.To,
+				"reason":            "e164_normalization",
+			},
+		})
+		return err
+	})
+}
@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/WalletService/model"
+	"github.com/WalletService/repository"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/lib/pq"
+)
+
+func TestMigratePhoneNumbers(t *testing.T) {
+	var (
+		users = []model.User{
+			{ID: 1, PhoneNumber: "+628123456789"},
+			{ID: 2, PhoneNumber: "+62 0812-1111-2222"},
+			{ID: 3, PhoneNumber: "+62215551234"},
+		}
+		normalized = model.PhoneNumberMigrationEntry{UserID: 2, From: "+62 0812-1111-2222", To: "+6281211112222"}
+		invalid    = model.PhoneNumberMigrationEntry{UserID: 3, From: "+62215551234", Reason: "phone number is not a valid mobile number"}
+	)
+
+	mockMigratePhoneNumber := func(ctrl *gomock.Controller, m *repository.MockRepositoryInterface, err error) {
+		mockProfileDbTx(ctrl, m, err == nil)
+
+		m.EXPECT().UpdateUser(gomock.Any(), model.UpdateUserRequest{UserID: 2, PhoneNumber: "+6281211112222"}).Return(err).Times(1)
+		if err == nil {
+			m.EXPECT().InsertAuditLog(gomock.Any(), model.AuditLogEntry{
+				Action: model.AuditActionPhoneNumberChange,
+				UserID: 2,
+				Details: map[string]string{
+					"from_phone_number": "+62 0812-1111-2222",
+					"to_phone_number":   "+6281211112222",
+					"reason":            "e164_normalization",
+				},
+			}).Return(model.AuditLogEntry{ID: 1}, nil).Times(1)
+		}
+	}
+
+	tests := []struct {
+		name           string
+		batchSize      int
+		dryRun         bool
+		mockRepository func(controller *gomock.Controller) *repository.MockRepositoryInterface
+		wantMigration  model.PhoneNumberMigration
+		wantErr        bool
+	}{
+		{
+			name:      "success-validate-constraint",
+			batchSize: 2,
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+
+				m.EXPECT().GetUsers(gomock.Any(), model.UserFilter{Limit: 2}).Return(users[:2], nil).Times(1)
+				m.EXPECT().GetUsers(gomock.Any(), model.UserFilter{Limit: 2, Offset: 2}).Return(nil, nil).Times(1)
+				mockMigratePhoneNumber(ctrl, m, nil)
+				m.EXPECT().ValidatePhoneNumberConstraint(gomock.Any()).Return(nil).Times(1)
+
+				return m
+			},
+			wantMigration: model.PhoneNumberMigration{
+				Users:               2,
+				Normalized:          []model.PhoneNumberMigrationEntry{normalized},
+				ConstraintValidated: true,
+			},
+		},
+		{
+			name:   "success-dry-run",
+			dryRun: true,
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				m.EXPECT().GetUsers(gomock.Any(), model.UserFilter{Limit: phoneNumberMigrationBatchSize}).Return(users, nil).Times(1)
+				return m
+			},
+			wantMigration: model.PhoneNumberMigration{
+				Users:      3,
+				Normalized: []model.PhoneNumberMigrationEntry{normalized},
+				Invalid:    []model.PhoneNumberMigrationEntry{invalid},
+			},
+		},
+		{
+			name: "success-invalid-and-conflict-not-validated",
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+
+				m.EXPECT().GetUsers(gomock.Any(), model.UserFilter{Limit: phoneNumberMigrationBatchSize}).Return(users, nil).Times(1)
+				mockMigratePhoneNumber(ctrl, m, &pq.Error{Code: "23505"})
+
+				return m
+			},
+			wantMigration: model.PhoneNumberMigration{
+				Users: 3,
+				Invalid: []model.PhoneNumberMigrationEntry{
+					invalid,
+				},
+				Conflicts: []model.PhoneNumberMigrationEntry{
+					{UserID: 2, From: "+62 0812-1111-2222", To: "+6281211112222", Reason: "phone number is already registered to another user"},
+				},
+			},
+		},
+		{
+			name: "fail-validate-constraint",
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+
+				m.EXPECT().GetUsers(gomock.Any(), gomock.Any()).Return(users[:1], nil).Times(1)
+				m.EXPECT().ValidatePhoneNumberConstraint(gomock.Any()).Return(&pq.Error{Code: "23514"}).Times(1)
+
+				return m
+			},
+			wantMigration: model.PhoneNumberMigration{Users: 1},
+			wantErr:       true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			usecase := &Usecase{Repository: test.mockRepository(controller)}
+
+			gotMigration, gotErr := usecase.MigratePhoneNumbers(context.Background(), test.batchSize, test.dryRun)
+			if !reflect.DeepEqual(gotMigration, test.wantMigration) {
+				t.Errorf("usecase.MigratePhoneNumbers() gotMigration = %+v, wantMigration %+v", gotMigration, test.wantMigration)
+			}
+			if (gotErr != nil) != test.wantErr {
+				t.Errorf("usecase.MigratePhoneNumbers() gotErr = %v, wantErr %v", gotErr, test.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,273 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/WalletService/model"
+	"github.com/WalletService/repository"
+	"github.com/WalletService/tracing"
+	"github.com/WalletService/utils"
+)
+
+const (
+	// maxPockets is the number of Pockets a User can have at once
+	maxPockets = 10
+)
+
+var (
+	ErrPocketLimitExceeded = fmt.Errorf("cannot have more than %d pockets", maxPockets)
+	ErrPocketNotEmpty      = errors.New("pocket balance must be zero to delete it")
+)
+
+// CreatePocket creates an empty Pocket for User to set money aside in with PocketTransfer Transactions
+func (uc *Usecase) CreatePocket(ctx context.Context, pocket model.Pocket) (newPocket model.Pocket, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.CreatePocket", tracing.Int64("user_id", pocket.UserID))
+	defer func() { span.End(err) }()
+
+	if pocket.TargetAmount < 0 {
+		return model.Pocket{}, errors.New("invalid target amount")
+	}
+
+	var pocketID int64
+	if err = utils.WithDbTx(ctx, uc.Repository, func(ctx context.Context) error {
+		// 1. Lock User, so concurrent creations are counted one after another
+		if _, err := uc.lockUsers(ctx, pocket.UserID); err != nil {
+			return err
+		}
+
+		// 2. Validate User has room for another Pocket
+		pockets, err := uc.Repository.GetPockets(ctx, model.PocketFilter{UserID: pocket.UserID})
+		if err != nil {
+			return err
+		}
+		if len(pockets) >= maxPockets {
+			return ErrPocketLimitExceeded
+		}
+
+		// 3. Insert the Pocket, violates the unique constraint if the name is already used, see utils.IsUniqueConstraintViolation
+		pocketID, err = uc.Repository.InsertPocket(ctx, pocket)
+		return err
+	}); err != nil {
+		return model.Pocket{}, err
+	}
+
+	return uc.getPocket(ctx, pocket.UserID, pocketID)
+}
+
+// UpdatePocket replaces the name and target of User's Pocket, its balance is only changed by PocketTransfer Transactions
+func (uc *Usecase) UpdatePocket(ctx context.Context, pocket model.Pocket) (updatedPocket model.Pocket, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.UpdatePocket", tracing.Int64("user_id", pocket.UserID))
+	defer func() { span.End(err) }()
+
+	if pocket.TargetAmount < 0 {
+		return model.Pocket{}, errors.New("invalid target amount")
+	}
+
+	if err = uc.Repository.UpdatePocket(ctx, pocket); err != nil {
+		return model.Pocket{}, err
+	}
+
+	return uc.getPocket(ctx, pocket.UserID, pocket.ID)
+}
+
+// DeletePocket deletes User's Pocket once it is emptied, its PocketTransfer Transactions are kept
+func (uc *Usecase) DeletePocket(ctx context.Context, userID, pocketID int64) (err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.DeletePocket", tracing.Int64("user_id", userID))
+	defer func() { span.End(err) }()
+
+	return utils.WithDbTx(ctx, uc.Repository, func(ctx context.Context) error {
+		// 1. Lock User, Pocket balances only change under this lock
+		if _, err := uc.lockUsers(ctx, userID); err != nil {
+			return err
+		}
+
+		// 2. Validate the Pocket is empty
+		pocket, err := uc.getPocket(ctx, userID, pocketID)
+		if err != nil {
+			return err
+		}
+		if pocket.Balance > 0 {
+			return ErrPocketNotEmpty
+		}
+
+		// 3. Delete the Pocket
+		return uc.Repository.DeletePocket(ctx, userID, pocketID)
+	})
+}
+
+// performPocketTransfer moves money between User's main balance and its Pockets, or between two Pockets. Money in a Pocket is not part of
+// User's main balance, so it can not be spent until moved back. It is free, and is not a TransferOut, i.e. it is not counted towards the
+// free TransferOut of the month.
+func (uc *Usecase) performPocketTransfer(ctx context.Context, user model.User, transaction model.Transaction) (newTransaction model.Transaction, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.performPocketTransfer")
+	defer func() { span.End(err) }()
+
+	// Validate requested password matches with User's password
+	if err := uc.verifyTransactionPassword(user, transaction.Password); err != nil {
+		return model.Transaction{}, err
+	}
+
+	// Validate money is moved in or out of a Pocket, zero being the main balance
+	if transaction.SourcePocketID == transaction.PocketID {
+		return model.Transaction{}, errors.New("must specify different source_pocket_id and pocket_id for PocketTransfer")
+	}
+
+	// PocketTransfer moves money between User's own balances
+	transaction.RecipientID = user.ID
+	transaction.Password = ""
+
+	// Perform the following in a single DB transaction to ensure atomicity of all PocketTransfer operations
+	if err = utils.WithDbTxRetry(ctx, uc.Repository, func(ctx context.Context) error {
+		// 1. Lock User data to prevent race condition, Pocket balances only change under this lock
+		lockedUsers, err := uc.lockUsers(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+
+		// 2. Validate User can send like a TransferOut, it may have been frozen or closed since retrieved
+		if err := canSend(lockedUsers[user.ID]); err != nil {
+			return err
+		}
+
+		// 3. Validate the Pockets belong to User, and the source has balance to cover the transaction now that it can not change
+		pockets, err := uc.Repository.GetPockets(ctx, model.PocketFilter{UserID: user.ID})
+		if err != nil {
+			return err
+		}
+		balance := lockedUsers[user.ID].Balance
+		if transaction.SourcePocketID != 0 {
+			source, ok := findPocket(pockets, transaction.SourcePocketID)
+			if !ok {
+				return repository.ErrPocketNotFound
+			}
+			balance = source.Balance
+		}
+		if _, ok := findPocket(pockets, transaction.PocketID); !ok && transaction.PocketID != 0 {
+			return repository.ErrPocketNotFound
+		}
+		if balance < transaction.Amount {
+			return errors.New("balance not enough")
+		}
+
+		// 4. Subtract the source balance
+		if err := uc.updateMainOrPocketBalance(ctx, user.ID, transaction.SourcePocketID, model.UpdateBalanceRequest{
+			Amount: transaction.Amount,
+			Type:   model.UpdateBalanceDecrement,
+		}); err != nil {
+			return err
+		}
+
+		// 5. Increment the target balance
+		if err := uc.updateMainOrPocketBalance(ctx, user.ID, transaction.PocketID, model.UpdateBalanceRequest{
+			Amount: transaction.Amount,
+			Type:   model.UpdateBalanceIncrement,
+		}); err != nil {
+			return err
+		}
+
+		// 6. Insert a Successful Transaction record
+		transaction.Status = model.TransactionStatusSuccessful
+		if transaction.ID, err = uc.Repository.InsertTransaction(ctx, transaction); err != nil {
+			return err
+		}
+		return nil
+	}); err != nil {
+		// Insert a Failed transaction record if failed, even if the request is cancelled
+		transaction.Status = model.TransactionStatusFailed
+
+		uc.Repository.InsertTransaction(context.WithoutCancel(ctx), transaction)
+
+		return model.Transaction{}, err
+	}
+
+	return transaction, err
+}
+
+// emptyClosingUserPockets moves the balance of every Pocket of a User being closed back to its main balance, as PocketTransfer Transactions
+// made by the admin, so that it is swept with it. User is expected to be locked by lockUsers and is returned with its updated main balance.
+// Without a sweep account, closing fails if any Pocket has balance.
+func (uc *Usecase) emptyClosingUserPockets(ctx context.Context, user model.User, sweep bool, request model.UserStatusChange) (updatedUser model.User, transfers []model.Transaction, err error) {
+	pockets, err := uc.Repository.GetPockets(ctx, model.PocketFilter{UserID: user.ID})
+	if err != nil {
+		return model.User{}, nil, err
+	}
+
+	for _, pocket := range pockets {
+		if pocket.Balance <= 0 {
+			continue
+		}
+		if !sweep {
+			return model.User{}, nil, ErrClosureBalanceNotZero
+		}
+
+		transaction := model.Transaction{
+			UserID:         user.ID,
+			Amount:         pocket.Balance,
+			Type:           model.TransactionTypePocketTransfer,
+			RecipientID:    user.ID,
+			Status:         model.TransactionStatusSuccessful,
+			Description:    fmt.Sprintf("Pocket emptied on account closure: %s", request.Reason),
+			Currency:       model.CurrencyIDR,
+			SourcePocketID: pocket.ID,
+			ActorID:        request.ActorID,
+		}
+
+		if err := uc.Repository.UpdatePocketBalance(ctx, user.ID, pocket.ID, model.UpdateBalanceRequest{
+			Amount: pocket.Balance,
+			Type:   model.UpdateBalanceDecrement,
+		}); err != nil {
+			return model.User{}, nil, err
+		}
+
+		if err := uc.Repository.UpdateUser(ctx, model.UpdateUserRequest{
+			UserID: user.ID,
+			Balance: model.UpdateBalanceRequest{
+				Amount: pocket.Balance,
+				Type:   model.UpdateBalanceIncrement,
+			},
+		}); err != nil {
+			return model.User{}, nil, err
+		}
+
+		if transaction.ID, err = uc.Repository.InsertTransaction(ctx, transaction); err != nil {
+			return model.User{}, nil, err
+		}
+		user.Balance += pocket.Balance
+		transfers = append(transfers, transaction)
+	}
+
+	return user, transfers, nil
+}
+
+// updateMainOrPocketBalance updates User's main IDR balance if pocketID is zero, otherwise the balance of User's Pocket
+func (uc *Usecase) updateMainOrPocketBalance(ctx context.Context, userID, pocketID int64, balance model.UpdateBalanceRequest) error {
+	if pocketID == 0 {
+		return uc.Repository.UpdateUser(ctx, model.UpdateUserRequest{UserID: userID, Balance: balance})
+	}
+	return uc.Repository.UpdatePocketBalance(ctx, userID, pocketID, balance)
+}
+
+// getPocket returns User's Pocket, repository.ErrPocketNotFound if User has no such Pocket
+func (uc *Usecase) getPocket(ctx context.Context, userID, pocketID int64) (model.Pocket, error) {
+	pockets, err := uc.Repository.GetPockets(ctx, model.PocketFilter{PocketID: pocketID, UserID: userID})
+	if err != nil {
+		return model.Pocket{}, err
+	}
+	if len(pockets) == 0 {
+		return model.Pocket{}, repository.ErrPocketNotFound
+	}
+
+	return pockets[0], nil
+}
+
+func findPocket(pockets []model.Pocket, pocketID int64) (model.Pocket, bool) {
+	for _, pocket := range pockets {
+		if pocket.ID == pocketID {
+			return pocket, true
+		}
+	}
+
+	return model.Pocket{}, false
+}
@@ -0,0 +1,320 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/WalletService/model"
+	"github.com/WalletService/repository"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+)
+
+func TestCreatePocket(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          model.Pocket
+		mockRepository func(controller *gomock.Controller) *repository.MockRepositoryInterface
+		wantPocket     model.Pocket
+		wantErr        error
+	}{
+		{
+			name:  "success",
+			input: model.Pocket{UserID: 1234, Name: "Holiday", TargetAmount: 5000000},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockProfileDbTx(ctrl, m, true)
+
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234}).Return([]model.User{{ID: 1234}}, nil).Times(1)
+				m.EXPECT().GetPockets(gomock.Any(), model.PocketFilter{UserID: 1234}).Return([]model.Pocket{{ID: 1, UserID: 1234, Name: "Emergency"}}, nil).Times(1)
+				m.EXPECT().InsertPocket(gomock.Any(), model.Pocket{UserID: 1234, Name: "Holiday", TargetAmount: 5000000}).Return(int64(2), nil).Times(1)
+				m.EXPECT().GetPockets(gomock.Any(), model.PocketFilter{PocketID: 2, UserID: 1234}).Return([]model.Pocket{
+					{ID: 2, UserID: 1234, Name: "Holiday", TargetAmount: 5000000},
+				}, nil).Times(1)
+
+				return m
+			},
+			wantPocket: model.Pocket{ID: 2, UserID: 1234, Name: "Holiday", TargetAmount: 5000000},
+		},
+		{
+			name:  "failed-limit-exceeded",
+			input: model.Pocket{UserID: 1234, Name: "Holiday"},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockProfileDbTx(ctrl, m, false)
+
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234}).Return([]model.User{{ID: 1234}}, nil).Times(1)
+				m.EXPECT().GetPockets(gomock.Any(), model.PocketFilter{UserID: 1234}).Return(make([]model.Pocket, maxPockets), nil).Times(1)
+
+				return m
+			},
+			wantErr: ErrPocketLimitExceeded,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			usecase := &Usecase{
+				Repository: test.mockRepository(controller),
+			}
+
+			gotPocket, gotErr := usecase.CreatePocket(context.Background(), test.input)
+			if !errors.Is(gotErr, test.wantErr) {
+				t.Errorf("usecase.CreatePocket() gotErr = %v, wantErr %v", gotErr, test.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotPocket, test.wantPocket) {
+				t.Errorf("usecase.CreatePocket() gotPocket = %v, wantPocket %v", gotPocket, test.wantPocket)
+			}
+		})
+	}
+}
+
+func TestDeletePocket(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockRepository func(controller *gomock.Controller) *repository.MockRepositoryInterface
+		wantErr        error
+	}{
+		{
+			name: "success",
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockProfileDbTx(ctrl, m, true)
+
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234}).Return([]model.User{{ID: 1234}}, nil).Times(1)
+				m.EXPECT().GetPockets(gomock.Any(), model.PocketFilter{PocketID: 2, UserID: 1234}).Return([]model.Pocket{{ID: 2, UserID: 1234}}, nil).Times(1)
+				m.EXPECT().DeletePocket(gomock.Any(), int64(1234), int64(2)).Return(nil).Times(1)
+
+				return m
+			},
+		},
+		{
+			name: "failed-not-empty",
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockProfileDbTx(ctrl, m, false)
+
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234}).Return([]model.User{{ID: 1234}}, nil).Times(1)
+				m.EXPECT().GetPockets(gomock.Any(), model.PocketFilter{PocketID: 2, UserID: 1234}).Return([]model.Pocket{{ID: 2, UserID: 1234, Balance: 100}}, nil).Times(1)
+
+				return m
+			},
+			wantErr: ErrPocketNotEmpty,
+		},
+		{
+			name: "failed-not-found",
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockProfileDbTx(ctrl, m, false)
+
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234}).Return([]model.User{{ID: 1234}}, nil).Times(1)
+				m.EXPECT().GetPockets(gomock.Any(), model.PocketFilter{PocketID: 2, UserID: 1234}).Return(nil, nil).Times(1)
+
+				return m
+			},
+			wantErr: repository.ErrPocketNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			usecase := &Usecase{
+				Repository: test.mockRepository(controller),
+			}
+
+			gotErr := usecase.DeletePocket(context.Background(), 1234, 2)
+			if !errors.Is(gotErr, test.wantErr) {
+				t.Errorf("usecase.DeletePocket() gotErr = %v, wantErr %v", gotErr, test.wantErr)
+			}
+		})
+	}
+}
+
+func Test_performPocketTransfer(t *testing.T) {
+	var (
+		transactionID = convertToUUID("3d6e668f-ad02-40ff-8540-90c1528a7c88")
+
+		user = model.User{
+			ID:          1234,
+			FullName:    "User",
+			PhoneNumber: "+628123456789",
+			Password:    "$2a$12$35ELZtgOq3iFR6awq.jsDuV5Dr.0XU5k7iUQuShfeLTWRHGFr//fq",
+			Balance:     1000000,
+		}
+		pockets = []model.Pocket{
+			{ID: 2, UserID: 1234, Name: "Holiday", Balance: 50000},
+			{ID: 3, UserID: 1234, Name: "Emergency"},
+		}
+	)
+
+	tests := []struct {
+		name              string
+		inputTransaction  model.Transaction
+		mockRepository    func(controller *gomock.Controller) *repository.MockRepositoryInterface
+		wantTransactionID uuid.UUID
+		wantErr           error
+	}{
+		{
+			name: "success-main-to-pocket",
+			inputTransaction: model.Transaction{
+				UserID:   1234,
+				Amount:   250000,
+				Type:     model.TransactionTypePocketTransfer,
+				PocketID: 2,
+				Password: "Admin1234!",
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockProfileDbTx(ctrl, m, true)
+
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234}).Return([]model.User{{ID: 1234, Balance: 1000000}}, nil).Times(1)
+				m.EXPECT().GetPockets(gomock.Any(), model.PocketFilter{UserID: 1234}).Return(pockets, nil).Times(1)
+				m.EXPECT().UpdateUser(gomock.Any(), model.UpdateUserRequest{
+					UserID:  1234,
+					Balance: model.UpdateBalanceRequest{Amount: 250000, Type: model.UpdateBalanceDecrement},
+				}).Return(nil).Times(1)
+				m.EXPECT().UpdatePocketBalance(gomock.Any(), int64(1234), int64(2), model.UpdateBalanceRequest{
+					Amount: 250000, Type: model.UpdateBalanceIncrement,
+				}).Return(nil).Times(1)
+				m.EXPECT().InsertTransaction(gomock.Any(), model.Transaction{
+					UserID:      1234,
+					Amount:      250000,
+					RecipientID: 1234,
+					Type:        model.TransactionTypePocketTransfer,
+					Status:      model.TransactionStatusSuccessful,
+					PocketID:    2,
+				}).Return(transactionID, nil).Times(1)
+
+				return m
+			},
+			wantTransactionID: transactionID,
+		},
+		{
+			name: "success-pocket-to-pocket",
+			inputTransaction: model.Transaction{
+				UserID:         1234,
+				Amount:         50000,
+				Type:           model.TransactionTypePocketTransfer,
+				SourcePocketID: 2,
+				PocketID:       3,
+				Password:       "Admin1234!",
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockProfileDbTx(ctrl, m, true)
+
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234}).Return([]model.User{{ID: 1234, Balance: 1000000}}, nil).Times(1)
+				m.EXPECT().GetPockets(gomock.Any(), model.PocketFilter{UserID: 1234}).Return(pockets, nil).Times(1)
+				m.EXPECT().UpdatePocketBalance(gomock.Any(), int64(1234), int64(2), model.UpdateBalanceRequest{
+					Amount: 50000, Type: model.UpdateBalanceDecrement,
+				}).Return(nil).Times(1)
+				m.EXPECT().UpdatePocketBalance(gomock.Any(), int64(1234), int64(3), model.UpdateBalanceRequest{
+					Amount: 50000, Type: model.UpdateBalanceIncrement,
+				}).Return(nil).Times(1)
+				m.EXPECT().InsertTransaction(gomock.Any(), model.Transaction{
+					UserID:         1234,
+					Amount:         50000,
+					RecipientID:    1234,
+					Type:           model.TransactionTypePocketTransfer,
+					Status:         model.TransactionStatusSuccessful,
+					SourcePocketID: 2,
+					PocketID:       3,
+				}).Return(transactionID, nil).Times(1)
+
+				return m
+			},
+			wantTransactionID: transactionID,
+		},
+		{
+			name: "failed-pocket-balance-not-enough",
+			inputTransaction: model.Transaction{
+				UserID:         1234,
+				Amount:         50001,
+				Type:           model.TransactionTypePocketTransfer,
+				SourcePocketID: 2,
+				Password:       "Admin1234!",
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockProfileDbTx(ctrl, m, false)
+
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234}).Return([]model.User{{ID: 1234, Balance: 1000000}}, nil).Times(1)
+				m.EXPECT().GetPockets(gomock.Any(), model.PocketFilter{UserID: 1234}).Return(pockets, nil).Times(1)
+				m.EXPECT().InsertTransaction(gomock.Any(), model.Transaction{
+					UserID:         1234,
+					Amount:         50001,
+					RecipientID:    1234,
+					Type:           model.TransactionTypePocketTransfer,
+					Status:         model.TransactionStatusFailed,
+					SourcePocketID: 2,
+				}).Return(uuid.Nil, nil).Times(1)
+
+				return m
+			},
+			wantTransactionID: uuid.Nil,
+			wantErr:           errors.New("balance not enough"),
+		},
+		{
+			name: "failed-pocket-of-other-user",
+			inputTransaction: model.Transaction{
+				UserID:   1234,
+				Amount:   1000,
+				Type:     model.TransactionTypePocketTransfer,
+				PocketID: 9,
+				Password: "Admin1234!",
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockProfileDbTx(ctrl, m, false)
+
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234}).Return([]model.User{{ID: 1234, Balance: 1000000}}, nil).Times(1)
+				m.EXPECT().GetPockets(gomock.Any(), model.PocketFilter{UserID: 1234}).Return(pockets, nil).Times(1)
+				m.EXPECT().InsertTransaction(gomock.Any(), gomock.Any()).Return(uuid.Nil, nil).Times(1)
+
+				return m
+			},
+			wantTransactionID: uuid.Nil,
+			wantErr:           repository.ErrPocketNotFound,
+		},
+		{
+			name: "failed-same-source-and-target",
+			inputTransaction: model.Transaction{
+				UserID:   1234,
+				Amount:   1000,
+				Type:     model.TransactionTypePocketTransfer,
+				Password: "Admin1234!",
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				return repository.NewMockRepositoryInterface(ctrl)
+			},
+			wantTransactionID: uuid.Nil,
+			wantErr:           errors.New("must specify different source_pocket_id and pocket_id for PocketTransfer"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			usecase := &Usecase{
+				Repository: test.mockRepository(controller),
+			}
+
+			gotTransaction, gotErr := usecase.performPocketTransfer(context.Background(), user, test.inputTransaction)
+			if gotTransaction.ID != test.wantTransactionID {
+				t.Errorf("usecase.performPocketTransfer() gotTransactionID = %v, wantTransactionID %v", gotTransaction.ID, test.wantTransactionID)
+				return
+			}
+			if (gotErr == nil) != (test.wantErr == nil) || (gotErr != nil && gotErr.Error() != test.wantErr.Error()) {
+				t.Errorf("usecase.performPocketTransfer() gotErr = %v, wantErr %v", gotErr, test.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,302 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"time"
+
+	"github.com/WalletService/logging"
+	"github.com/WalletService/model"
+	"github.com/WalletService/repository"
+	"github.com/WalletService/tracing"
+	"github.com/WalletService/utils"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// otpDigits is the length of OTPs, sent as a zero-padded number
+	otpDigits = 6
+
+	defaultOTPExpiry        = 5 * time.Minute
+	defaultOTPMaxAttempts   = 5
+	defaultOTPRequestLimit  = 5
+	defaultOTPRequestWindow = time.Hour
+)
+
+var (
+	ErrPhoneNumberUnchanged    = errors.New("phone number is already used by this account")
+	ErrPhoneNumberTaken        = errors.New("phone number is already registered to an existing user")
+	ErrOTPInvalid              = errors.New("invalid OTP")
+	ErrOTPExpired              = errors.New("OTP has expired, request a new one")
+	ErrOTPAttemptsExceeded     = errors.New("too many invalid OTPs, request a new one")
+	ErrOTPRequestLimitExceeded = errors.New("too many OTPs requested, try again later")
+)
+
+// UpdateUserProfile updates User's profile fields, other than the phone number which must be verified first, see RequestPhoneNumberChange.
+func (uc *Usecase) UpdateUserProfile(ctx context.Context, request model.UpdateUserRequest) (user model.User, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.UpdateUserProfile", tracing.Int64("user_id", request.UserID))
+	defer func() { span.End(err) }()
+
+	if request.Balance != (model.UpdateBalanceRequest{}) || request.Status != "" || request.PhoneNumber != "" {
+		return model.User{}, errors.New("only full name can be updated")
+	}
+	if request.FullName == "" {
+		return model.User{}, errors.New("nothing to update")
+	}
+
+	if err = utils.WithDbTx(ctx, uc.Repository, func(ctx context.Context) error {
+		// 1. Update User's profile
+		if err := uc.Repository.UpdateUser(ctx, request); err != nil {
+			return err
+		}
+
+		// 2. Record the change in the audit log
+		_, err := uc.Repository.InsertAuditLog(ctx, model.AuditLogEntry{
+			Action:  model.AuditActionProfileUpdate,
+			UserID:  request.UserID,
+			Details: map[string]string{"full_name": request.FullName},
+		})
+		return err
+	}); err != nil {
+		return model.User{}, err
+	}
+
+	return uc.GetUser(ctx, request.UserID)
+}
+
+// RequestPhoneNumberChange sends an OTP to the new phone number of User, the phone number is changed once the OTP is confirmed with ConfirmPhoneNumberChange.
+// The phone number is User's login, so the current password is required. Requesting another change replaces the pending one, keeping its invalid
+// OTP attempts, and requests are limited per User so that OTPs can not be guessed or sent indefinitely.
+func (uc *Usecase) RequestPhoneNumberChange(ctx context.Context, userID int64, phoneNumber, password string) (change model.PhoneNumberChange, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.RequestPhoneNumberChange", tracing.Int64("user_id", userID))
+	defer func() { span.End(err) }()
+
+	if uc.SMS == nil {
+		return model.PhoneNumberChange{}, errors.New("phone number change is not available")
+	}
+
+	user, err := uc.Repository.GetUser(ctx, userID)
+	if err != nil {
+		return model.PhoneNumberChange{}, err
+	}
+	if user.Status == model.UserStatusClosed {
+		return model.PhoneNumberChange{}, ErrUserClosed
+	}
+	if bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)) != nil {
+		return model.PhoneNumberChange{}, ErrInvalidPassword
+	}
+	if user.PhoneNumber == phoneNumber {
+		return model.PhoneNumberChange{}, ErrPhoneNumberUnchanged
+	}
+
+	// Checked again when confirmed, the phone number may be registered by another User meanwhile
+	users, err := uc.Repository.GetUsers(ctx, model.UserFilter{PhoneNumber: phoneNumber})
+	if err != nil {
+		return model.PhoneNumberChange{}, err
+	}
+	if len(users) > 0 {
+		return model.PhoneNumberChange{}, ErrPhoneNumberTaken
+	}
+
+	otp, err := generateOTP()
+	if err != nil {
+		return model.PhoneNumberChange{}, err
+	}
+
+	change = model.PhoneNumberChange{
+		ID:          uuid.New(),
+		UserID:      userID,
+		PhoneNumber: phoneNumber,
+		ExpiryTime:  time.Now().Add(uc.otpExpiry()),
+	}
+	change.OTPHash = hashOTP(change.ID, otp)
+
+	if err = utils.WithDbTx(ctx, uc.Repository, func(ctx context.Context) error {
+		// 1. Lock User, so concurrent requests are counted one after another
+		if _, err := uc.lockUsers(ctx, userID); err != nil {
+			return err
+		}
+
+		// 2. Validate User has OTP requests left within the window
+		count, err := uc.Repository.CountPhoneNumberChanges(ctx, userID, time.Now().Add(-uc.otpRequestWindow()))
+		if err != nil {
+			return err
+		}
+		if count >= uc.otpRequestLimit() {
+			return ErrOTPRequestLimitExceeded
+		}
+
+		// 3. Keep the invalid attempts of the pending change being replaced, a new OTP does not allow more guesses
+		pending, err := uc.Repository.LockPhoneNumberChange(ctx, userID)
+		if err != nil && !errors.Is(err, repository.ErrPhoneNumberChangeNotFound) {
+			return err
+		}
+		if err == nil && pending.VerifiedTime == nil && time.Now().Before(pending.ExpiryTime) {
+			change.Attempts = pending.Attempts
+		}
+
+		// 4. Record the change, replacing the pending one
+		return uc.Repository.InsertPhoneNumberChange(ctx, change)
+	}); err != nil {
+		return model.PhoneNumberChange{}, err
+	}
+
+	// Proves User owns the new phone number
+	message := fmt.Sprintf("Your wallet verification code is %s. It expires in %d minutes, never share it with anyone.", otp, int(uc.otpExpiry().Minutes()))
+	if err := uc.SMS.Send(ctx, phoneNumber, message); err != nil {
+		return model.PhoneNumberChange{}, fmt.Errorf("failed to send OTP: %w", err)
+	}
+
+	change.OTPHash = ""
+	return change, nil
+}
+
+// ConfirmPhoneNumberChange changes User's phone number to the one of the pending PhoneNumberChange if the OTP sent to it matches.
+// The previous phone number is notified of the change.
+func (uc *Usecase) ConfirmPhoneNumberChange(ctx context.Context, userID int64, otp string) (user model.User, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.ConfirmPhoneNumberChange", tracing.Int64("user_id", userID))
+	defer func() { span.End(err) }()
+
+	// Previous phone number is notified once changed
+	user, err = uc.GetUser(ctx, userID)
+	if err != nil {
+		return model.User{}, err
+	}
+
+	var (
+		change  model.PhoneNumberChange
+		invalid bool
+	)
+	if err = utils.WithDbTxRetry(ctx, uc.Repository, func(ctx context.Context) error {
+		invalid = false
+
+		// 1. Lock the pending change, so concurrent attempts are counted one after another
+		change, err = uc.Repository.LockPhoneNumberChange(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		// 2. Validate the change is still pending and the OTP can be attempted
+		if change.VerifiedTime != nil {
+			return repository.ErrPhoneNumberChangeNotFound
+		}
+		if time.Now().After(change.ExpiryTime) {
+			return ErrOTPExpired
+		}
+		if change.Attempts >= uc.otpMaxAttempts() {
+			return ErrOTPAttemptsExceeded
+		}
+
+		// 3. Count an invalid OTP, committed so that OTPs can not be guessed indefinitely
+		if subtle.ConstantTimeCompare([]byte(hashOTP(change.ID, otp)), []byte(change.OTPHash)) != 1 {
+			invalid = true
+			return uc.Repository.UpdatePhoneNumberChange(ctx, model.UpdatePhoneNumberChangeRequest{
+				ID:       change.ID,
+				Attempts: change.Attempts + 1,
+			})
+		}
+
+		// 4. Change User's phone number, violates the unique constraint if registered by another User since requested
+		if err := uc.Repository.UpdateUser(ctx, model.UpdateUserRequest{
+			UserID:      userID,
+			PhoneNumber: change.PhoneNumber,
+		}); err != nil {
+			return err
+		}
+
+		// 5. Mark the change verified, so the OTP can not be used again
+		if err := uc.Repository.UpdatePhoneNumberChange(ctx, model.UpdatePhoneNumberChangeRequest{
+			ID:           change.ID,
+			Attempts:     change.Attempts,
+			VerifiedTime: time.Now(),
+		}); err != nil {
+			return err
+		}
+
+		// 6. Record the change in the audit log
+		_, err := uc.Repository.InsertAuditLog(ctx, model.AuditLogEntry{
+			Action: model.AuditActionPhoneNumberChange,
+			UserID: userID,
+			Details: map[string]string{
+				"phone_number_change_id": change.ID.String(),
+				"from_phone_number":      user.PhoneNumber,
+				"to_phone_number":        change.PhoneNumber,
+			},
+		})
+		return err
+	}); err != nil {
+		return model.User{}, err
+	}
+	if invalid {
+		return model.User{}, ErrOTPInvalid
+	}
+
+	// Warns the previous owner of the phone number in case the account is taken over, the change is already made if it fails
+	message := fmt.Sprintf("The phone number of your wallet was changed to %s. If you did not request it, contact support immediately.", logging.MaskPhoneNumber(change.PhoneNumber))
+	if err := uc.SMS.Send(ctx, user.PhoneNumber, message); err != nil {
+		logging.FromContext(ctx).LogAttrs(ctx, slog.LevelWarn, "failed to notify previous phone number",
+			slog.Int64("user_id", userID),
+			slog.Any("error", err),
+		)
+	}
+
+	user.PhoneNumber = change.PhoneNumber
+	return user, nil
+}
+
+// generateOTP returns a random number of otpDigits digits, zero-padded
+func generateOTP() (string, error) {
+	max := big.NewInt(1)
+	for i := 0; i < otpDigits; i++ {
+		max.Mul(max, big.NewInt(10))
+	}
+
+	n, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%0*d", otpDigits, n), nil
+}
+
+// hashOTP returns the hash of the OTP stored with the PhoneNumberChange, salted by its ID
+func hashOTP(changeID uuid.UUID, otp string) string {
+	sum := sha256.Sum256([]byte(changeID.String() + ":" + otp))
+	return hex.EncodeToString(sum[:])
+}
+
+func (uc *Usecase) otpExpiry() time.Duration {
+	if uc.OTPExpiry <= 0 {
+		return defaultOTPExpiry
+	}
+	return uc.OTPExpiry
+}
+
+func (uc *Usecase) otpMaxAttempts() int {
+	if uc.OTPMaxAttempts <= 0 {
+		return defaultOTPMaxAttempts
+	}
+	return uc.OTPMaxAttempts
+}
+
+func (uc *Usecase) otpRequestLimit() int {
+	if uc.OTPRequestLimit <= 0 {
+		return defaultOTPRequestLimit
+	}
+	return uc.OTPRequestLimit
+}
+
+func (uc *Usecase) otpRequestWindow() time.Duration {
+	if uc.OTPRequestWindow <= 0 {
+		return defaultOTPRequestWindow
+	}
+	return uc.OTPRequestWindow
+}
@@ -0,0 +1,630 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/WalletService/model"
+	"github.com/WalletService/repository"
+	"github.com/WalletService/sms"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/lib/pq"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func mockProfileDbTx(ctrl *gomock.Controller, m *repository.MockRepositoryInterface, commit bool) {
+	sqlDb := repository.NewMockSqlDbInterface(ctrl)
+	sqlTx := repository.NewMockSqlTxInterface(ctrl)
+
+	m.EXPECT().GetSqlDb().Return(sqlDb, nil).Times(1)
+	sqlDb.EXPECT().BeginTx(gomock.Any(), gomock.Nil()).Return(sqlTx, nil).Times(1)
+	if commit {
+		sqlTx.EXPECT().Commit().Return(nil).Times(1)
+	} else {
+		sqlTx.EXPECT().Rollback().Return(nil).Times(1)
+	}
+}
+
+func TestUpdateUserProfile(t *testing.T) {
+	errorDb := errors.New("db error")
+
+	tests := []struct {
+		name           string
+		input          model.UpdateUserRequest
+		mockRepository func(controller *gomock.Controller) *repository.MockRepositoryInterface
+		wantUser       model.User
+		wantErr        bool
+	}{
+		{
+			name:  "success",
+			input: model.UpdateUserRequest{UserID: 1234, FullName: "Jane Doe"},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockProfileDbTx(ctrl, m, true)
+
+				m.EXPECT().UpdateUser(gomock.Any(), model.UpdateUserRequest{UserID: 1234, FullName: "Jane Doe"}).Return(nil).Times(1)
+				m.EXPECT().InsertAuditLog(gomock.Any(), model.AuditLogEntry{
+					Action:  model.AuditActionProfileUpdate,
+					UserID:  1234,
+					Details: map[string]string{"full_name": "Jane Doe"},
+				}).Return(model.AuditLogEntry{ID: 1}, nil).Times(1)
+				m.EXPECT().GetUser(gomock.Any(), int64(1234)).Return(model.User{ID: 1234, FullName: "Jane Doe", Balance: 100}, nil).Times(1)
+				m.EXPECT().GetUserBalances(gomock.Any(), int64(1234)).Return(nil, nil).Times(1)
+				m.EXPECT().GetPockets(gomock.Any(), model.PocketFilter{UserID: 1234}).Return(nil, nil).Times(1)
+				return m
+			},
+			wantUser: model.User{
+				ID:       1234,
+				FullName: "Jane Doe",
+				Balance:  100,
+				Balances: []model.Balance{{Currency: model.CurrencyIDR, Amount: 100}},
+			},
+		},
+		{
+			name:  "fail-nothing-to-update",
+			input: model.UpdateUserRequest{UserID: 1234},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				return repository.NewMockRepositoryInterface(ctrl)
+			},
+			wantErr: true,
+		},
+		{
+			name:  "fail-phone-number-without-otp",
+			input: model.UpdateUserRequest{UserID: 1234, PhoneNumber: "+6281234567890"},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				return repository.NewMockRepositoryInterface(ctrl)
+			},
+			wantErr: true,
+		},
+		{
+			name:  "fail-update-user-should-rollback",
+			input: model.UpdateUserRequest{UserID: 1234, FullName: "Jane Doe"},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockProfileDbTx(ctrl, m, false)
+
+				m.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Return(errorDb).Times(1)
+
+				return m
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			usecase := &Usecase{Repository: test.mockRepository(controller)}
+
+			gotUser, gotErr := usecase.UpdateUserProfile(context.Background(), test.input)
+			if !reflect.DeepEqual(gotUser, test.wantUser) {
+				t.Errorf("usecase.UpdateUserProfile() gotUser = %v, wantUser %v", gotUser, test.wantUser)
+			}
+			if (gotErr != nil) != test.wantErr {
+				t.Errorf("usecase.UpdateUserProfile() gotErr = %v, wantErr %v", gotErr, test.wantErr)
+			}
+		})
+	}
+}
+
+// mockPhoneNumberChangeTx mocks the transaction of RequestPhoneNumberChange up to inserting the change, count is the number requested within the window
+func mockPhoneNumberChangeTx(ctrl *gomock.Controller, m *repository.MockRepositoryInterface, count int, pending model.PhoneNumberChange, pendingErr error) {
+	commit := count < defaultOTPRequestLimit
+	mockProfileDbTx(ctrl, m, commit)
+
+	m.EXPECT().LockUsers(gomock.Any(), []int64{1234}).Return([]model.User{{ID: 1234, Status: model.UserStatusActive}}, nil).Times(1)
+	m.EXPECT().CountPhoneNumberChanges(gomock.Any(), int64(1234), gomock.Any()).Return(count, nil).Times(1)
+	if !commit {
+		return
+	}
+	m.EXPECT().LockPhoneNumberChange(gomock.Any(), int64(1234)).Return(pending, pendingErr).Times(1)
+}
+
+func TestRequestPhoneNumberChange(t *testing.T) {
+	passwordHash, _ := bcrypt.GenerateFromPassword([]byte("Secret123!"), bcrypt.MinCost)
+
+	var (
+		user        = model.User{ID: 1234, PhoneNumber: "+6281111111111", Password: string(passwordHash), Status: model.UserStatusActive}
+		phoneNumber = "+6282222222222"
+		errorSMS    = errors.New("sms gateway unavailable")
+	)
+
+	tests := []struct {
+		name           string
+		noSMS          bool
+		inputPassword  string
+		mockRepository func(controller *gomock.Controller) *repository.MockRepositoryInterface
+		mockSMS        func(controller *gomock.Controller) *sms.MockSender
+		wantErr        error
+	}{
+		{
+			name:          "success",
+			inputPassword: "Secret123!",
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+
+				m.EXPECT().GetUser(gomock.Any(), int64(1234)).Return(user, nil).Times(1)
+				m.EXPECT().GetUsers(gomock.Any(), model.UserFilter{PhoneNumber: phoneNumber}).Return(nil, nil).Times(1)
+				mockPhoneNumberChangeTx(ctrl, m, defaultOTPRequestLimit-1, model.PhoneNumberChange{}, repository.ErrPhoneNumberChangeNotFound)
+				m.EXPECT().InsertPhoneNumberChange(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+				return m
+			},
+			mockSMS: func(ctrl *gomock.Controller) *sms.MockSender {
+				m := sms.NewMockSender(ctrl)
+				m.EXPECT().Send(gomock.Any(), phoneNumber, gomock.Any()).Return(nil).Times(1)
+				return m
+			},
+		},
+		{
+			name:          "fail-invalid-password",
+			inputPassword: "Wrong123!",
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				m.EXPECT().GetUser(gomock.Any(), int64(1234)).Return(user, nil).Times(1)
+				return m
+			},
+			mockSMS: func(ctrl *gomock.Controller) *sms.MockSender {
+				return sms.NewMockSender(ctrl)
+			},
+			wantErr: ErrInvalidPassword,
+		},
+		{
+			name:          "fail-otp-request-limit-exceeded",
+			inputPassword: "Secret123!",
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				m.EXPECT().GetUser(gomock.Any(), int64(1234)).Return(user, nil).Times(1)
+				m.EXPECT().GetUsers(gomock.Any(), model.UserFilter{PhoneNumber: phoneNumber}).Return(nil, nil).Times(1)
+				mockPhoneNumberChangeTx(ctrl, m, defaultOTPRequestLimit, model.PhoneNumberChange{}, nil)
+				return m
+			},
+			mockSMS: func(ctrl *gomock.Controller) *sms.MockSender {
+				return sms.NewMockSender(ctrl)
+			},
+			wantErr: ErrOTPRequestLimitExceeded,
+		},
+		{
+			name:          "fail-unchanged",
+			inputPassword: "Secret123!",
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				m.EXPECT().GetUser(gomock.Any(), int64(1234)).Return(model.User{ID: 1234, PhoneNumber: phoneNumber, Password: string(passwordHash)}, nil).Times(1)
+				return m
+			},
+			mockSMS: func(ctrl *gomock.Controller) *sms.MockSender {
+				return sms.NewMockSender(ctrl)
+			},
+			wantErr: ErrPhoneNumberUnchanged,
+		},
+		{
+			name:          "fail-closed",
+			inputPassword: "Secret123!",
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				m.EXPECT().GetUser(gomock.Any(), int64(1234)).Return(model.User{ID: 1234, Status: model.UserStatusClosed}, nil).Times(1)
+				return m
+			},
+			mockSMS: func(ctrl *gomock.Controller) *sms.MockSender {
+				return sms.NewMockSender(ctrl)
+			},
+			wantErr: ErrUserClosed,
+		},
+		{
+			name:          "fail-taken",
+			inputPassword: "Secret123!",
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				m.EXPECT().GetUser(gomock.Any(), int64(1234)).Return(user, nil).Times(1)
+				m.EXPECT().GetUsers(gomock.Any(), model.UserFilter{PhoneNumber: phoneNumber}).Return([]model.User{{ID: 5678}}, nil).Times(1)
+				return m
+			},
+			mockSMS: func(ctrl *gomock.Controller) *sms.MockSender {
+				return sms.NewMockSender(ctrl)
+			},
+			wantErr: ErrPhoneNumberTaken,
+		},
+		{
+			name:          "fail-send",
+			inputPassword: "Secret123!",
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				m.EXPECT().GetUser(gomock.Any(), int64(1234)).Return(user, nil).Times(1)
+				m.EXPECT().GetUsers(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+				mockPhoneNumberChangeTx(ctrl, m, 0, model.PhoneNumberChange{}, repository.ErrPhoneNumberChangeNotFound)
+				m.EXPECT().InsertPhoneNumberChange(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				return m
+			},
+			mockSMS: func(ctrl *gomock.Controller) *sms.MockSender {
+				m := sms.NewMockSender(ctrl)
+				m.EXPECT().Send(gomock.Any(), phoneNumber, gomock.Any()).Return(errorSMS).Times(1)
+				return m
+			},
+			wantErr: errorSMS,
+		},
+		{
+			name:  "fail-no-sms-sender",
+			noSMS: true,
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				return repository.NewMockRepositoryInterface(ctrl)
+			},
+			mockSMS: func(ctrl *gomock.Controller) *sms.MockSender {
+				return sms.NewMockSender(ctrl)
+			},
+			wantErr: errors.New("phone number change is not available"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			usecase := &Usecase{
+				Repository: test.mockRepository(controller),
+				SMS:        test.mockSMS(controller),
+			}
+			if test.noSMS {
+				usecase.SMS = nil
+			}
+
+			gotChange, gotErr := usecase.RequestPhoneNumberChange(context.Background(), 1234, phoneNumber, test.inputPassword)
+			if test.wantErr != nil {
+				if gotErr == nil || !errors.Is(gotErr, test.wantErr) && gotErr.Error() != test.wantErr.Error() {
+					t.Errorf("usecase.RequestPhoneNumberChange() gotErr = %v, wantErr %v", gotErr, test.wantErr)
+				}
+				return
+			}
+			if gotErr != nil {
+				t.Fatalf("usecase.RequestPhoneNumberChange() gotErr = %v, wantErr nil", gotErr)
+			}
+			if gotChange.UserID != 1234 || gotChange.PhoneNumber != phoneNumber || gotChange.OTPHash != "" {
+				t.Errorf("usecase.RequestPhoneNumberChange() gotChange = %v", gotChange)
+			}
+			if wantExpiry := time.Now().Add(defaultOTPExpiry); gotChange.ExpiryTime.After(wantExpiry) || gotChange.ExpiryTime.Before(wantExpiry.Add(-time.Minute)) {
+				t.Errorf("usecase.RequestPhoneNumberChange() gotChange.ExpiryTime = %v, want about %v", gotChange.ExpiryTime, wantExpiry)
+			}
+		})
+	}
+}
+
+// TestRequestPhoneNumberChange_Attempts verifies the invalid OTP attempts of the pending change are kept when it is replaced
+func TestRequestPhoneNumberChange_Attempts(t *testing.T) {
+	passwordHash, _ := bcrypt.GenerateFromPassword([]byte("Secret123!"), bcrypt.MinCost)
+	verifiedTime := time.Now().Add(-time.Minute)
+
+	tests := []struct {
+		name         string
+		pending      model.PhoneNumberChange
+		pendingErr   error
+		wantAttempts int
+	}{
+		{
+			name:         "no-previous-change",
+			pendingErr:   repository.ErrPhoneNumberChangeNotFound,
+			wantAttempts: 0,
+		},
+		{
+			name:         "pending-change-replaced",
+			pending:      model.PhoneNumberChange{Attempts: 3, ExpiryTime: time.Now().Add(time.Minute)},
+			wantAttempts: 3,
+		},
+		{
+			name:         "pending-change-attempts-exceeded",
+			pending:      model.PhoneNumberChange{Attempts: defaultOTPMaxAttempts, ExpiryTime: time.Now().Add(time.Minute)},
+			wantAttempts: defaultOTPMaxAttempts,
+		},
+		{
+			name:         "previous-change-expired",
+			pending:      model.PhoneNumberChange{Attempts: 3, ExpiryTime: time.Now().Add(-time.Minute)},
+			wantAttempts: 0,
+		},
+		{
+			name:         "previous-change-verified",
+			pending:      model.PhoneNumberChange{Attempts: 3, ExpiryTime: time.Now().Add(time.Minute), VerifiedTime: &verifiedTime},
+			wantAttempts: 0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			var inserted model.PhoneNumberChange
+			repo := repository.NewMockRepositoryInterface(controller)
+			repo.EXPECT().GetUser(gomock.Any(), int64(1234)).Return(model.User{ID: 1234, PhoneNumber: "+6281111111111", Password: string(passwordHash)}, nil).Times(1)
+			repo.EXPECT().GetUsers(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+			mockPhoneNumberChangeTx(controller, repo, 0, test.pending, test.pendingErr)
+			repo.EXPECT().InsertPhoneNumberChange(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, change model.PhoneNumberChange) error {
+				inserted = change
+				return nil
+			}).Times(1)
+			sender := sms.NewMockSender(controller)
+			sender.EXPECT().Send(gomock.Any(), "+6282222222222", gomock.Any()).Return(nil).Times(1)
+
+			usecase := &Usecase{Repository: repo, SMS: sender}
+			if _, err := usecase.RequestPhoneNumberChange(context.Background(), 1234, "+6282222222222", "Secret123!"); err != nil {
+				t.Fatalf("usecase.RequestPhoneNumberChange() gotErr = %v, wantErr nil", err)
+			}
+			if inserted.Attempts != test.wantAttempts {
+				t.Errorf("usecase.RequestPhoneNumberChange() stored Attempts = %v, want %v", inserted.Attempts, test.wantAttempts)
+			}
+		})
+	}
+}
+
+// TestRequestPhoneNumberChange_OTP verifies the OTP sent to the new phone number is the one stored, only as its hash
+func TestRequestPhoneNumberChange_OTP(t *testing.T) {
+	controller := gomock.NewController(t)
+	passwordHash, _ := bcrypt.GenerateFromPassword([]byte("Secret123!"), bcrypt.MinCost)
+
+	var (
+		inserted model.PhoneNumberChange
+		message  string
+	)
+	repo := repository.NewMockRepositoryInterface(controller)
+	repo.EXPECT().GetUser(gomock.Any(), int64(1234)).Return(model.User{ID: 1234, PhoneNumber: "+6281111111111", Password: string(passwordHash)}, nil).Times(1)
+	repo.EXPECT().GetUsers(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+	mockPhoneNumberChangeTx(controller, repo, 0, model.PhoneNumberChange{}, repository.ErrPhoneNumberChangeNotFound)
+	repo.EXPECT().InsertPhoneNumberChange(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, change model.PhoneNumberChange) error {
+		inserted = change
+		return nil
+	}).Times(1)
+	sender := sms.NewMockSender(controller)
+	sender.EXPECT().Send(gomock.Any(), "+6282222222222", gomock.Any()).DoAndReturn(func(_ context.Context, _ string, m string) error {
+		message = m
+		return nil
+	}).Times(1)
+
+	usecase := &Usecase{Repository: repo, SMS: sender}
+	if _, err := usecase.RequestPhoneNumberChange(context.Background(), 1234, "+6282222222222", "Secret123!"); err != nil {
+		t.Fatalf("usecase.RequestPhoneNumberChange() gotErr = %v, wantErr nil", err)
+	}
+
+	otp := regexp.MustCompile(`\d{6}`).FindString(message)
+	if otp == "" {
+		t.Fatalf("usecase.RequestPhoneNumberChange() sent %q, want an OTP", message)
+	}
+	if inserted.OTPHash != hashOTP(inserted.ID, otp) {
+		t.Errorf("usecase.RequestPhoneNumberChange() stored OTPHash = %v, want hash of %v", inserted.OTPHash, otp)
+	}
+}
+
+func TestConfirmPhoneNumberChange(t *testing.T) {
+	var (
+		changeID = convertToUUID("6a0c3a4f-2f39-4d4c-9d3c-1f0e6f7b1c22")
+		user     = model.User{ID: 1234, PhoneNumber: "+6281111111111", Balance: 100}
+		change   = model.PhoneNumberChange{
+			ID:          changeID,
+			UserID:      1234,
+			PhoneNumber: "+6282222222222",
+			OTPHash:     hashOTP(changeID, "123456"),
+			Attempts:    1,
+			ExpiryTime:  time.Now().Add(time.Minute),
+		}
+		verifiedTime = time.Now()
+		errorSMS     = errors.New("sms gateway unavailable")
+		errorTaken   = &pq.Error{Code: "23505", Constraint: "user_phone_number_key"}
+	)
+
+	mockGetUser := func(m *repository.MockRepositoryInterface) {
+		m.EXPECT().GetUser(gomock.Any(), int64(1234)).Return(user, nil).Times(1)
+		m.EXPECT().GetUserBalances(gomock.Any(), int64(1234)).Return(nil, nil).Times(1)
+		m.EXPECT().GetPockets(gomock.Any(), model.PocketFilter{UserID: 1234}).Return(nil, nil).Times(1)
+	}
+	withChange := func(update func(*model.PhoneNumberChange)) model.PhoneNumberChange {
+		c := change
+		update(&c)
+		return c
+	}
+
+	tests := []struct {
+		name           string
+		otp            string
+		mockRepository func(controller *gomock.Controller) *repository.MockRepositoryInterface
+		mockSMS        func(controller *gomock.Controller) *sms.MockSender
+		wantUser       model.User
+		wantErr        error
+	}{
+		{
+			name: "success",
+			otp:  "123456",
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockGetUser(m)
+				mockProfileDbTx(ctrl, m, true)
+
+				m.EXPECT().LockPhoneNumberChange(gomock.Any(), int64(1234)).Return(change, nil).Times(1)
+				m.EXPECT().UpdateUser(gomock.Any(), model.UpdateUserRequest{UserID: 1234, PhoneNumber: "+6282222222222"}).Return(nil).Times(1)
+				m.EXPECT().UpdatePhoneNumberChange(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request model.UpdatePhoneNumberChangeRequest) error {
+					if request.ID != changeID || request.Attempts != 1 || request.VerifiedTime.IsZero() {
+						t.Errorf("UpdatePhoneNumberChange() request = %v, want verified", request)
+					}
+					return nil
+				}).Times(1)
+				m.EXPECT().InsertAuditLog(gomock.Any(), model.AuditLogEntry{
+					Action: model.AuditActionPhoneNumberChange,
+					UserID: 1234,
+					Details: map[string]string{
+						"phone_number_change_id": changeID.String(),
+						"from_phone_number":      "+6281111111111",
+						"to_phone_number":        "+6282222222222",
+					},
+				}).Return(model.AuditLogEntry{ID: 1}, nil).Times(1)
+
+				return m
+			},
+			mockSMS: func(ctrl *gomock.Controller) *sms.MockSender {
+				m := sms.NewMockSender(ctrl)
+				m.EXPECT().Send(gomock.Any(), "+6281111111111", gomock.Any()).Return(nil).Times(1)
+				return m
+			},
+			wantUser: model.User{
+				ID:          1234,
+				PhoneNumber: "+6282222222222",
+				Balance:     100,
+				Balances:    []model.Balance{{Currency: model.CurrencyIDR, Amount: 100}},
+			},
+		},
+		{
+			name: "success-notification-fails",
+			otp:  "123456",
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockGetUser(m)
+				mockProfileDbTx(ctrl, m, true)
+
+				m.EXPECT().LockPhoneNumberChange(gomock.Any(), int64(1234)).Return(change, nil).Times(1)
+				m.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				m.EXPECT().UpdatePhoneNumberChange(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				m.EXPECT().InsertAuditLog(gomock.Any(), gomock.Any()).Return(model.AuditLogEntry{ID: 1}, nil).Times(1)
+
+				return m
+			},
+			mockSMS: func(ctrl *gomock.Controller) *sms.MockSender {
+				m := sms.NewMockSender(ctrl)
+				m.EXPECT().Send(gomock.Any(), "+6281111111111", gomock.Any()).Return(errorSMS).Times(1)
+				return m
+			},
+			wantUser: model.User{
+				ID:          1234,
+				PhoneNumber: "+6282222222222",
+				Balance:     100,
+				Balances:    []model.Balance{{Currency: model.CurrencyIDR, Amount: 100}},
+			},
+		},
+		{
+			name: "fail-invalid-otp-should-count-attempt",
+			otp:  "654321",
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockGetUser(m)
+				mockProfileDbTx(ctrl, m, true)
+
+				m.EXPECT().LockPhoneNumberChange(gomock.Any(), int64(1234)).Return(change, nil).Times(1)
+				m.EXPECT().UpdatePhoneNumberChange(gomock.Any(), model.UpdatePhoneNumberChangeRequest{ID: changeID, Attempts: 2}).Return(nil).Times(1)
+
+				return m
+			},
+			mockSMS: func(ctrl *gomock.Controller) *sms.MockSender {
+				return sms.NewMockSender(ctrl)
+			},
+			wantErr: ErrOTPInvalid,
+		},
+		{
+			name: "fail-expired",
+			otp:  "123456",
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockGetUser(m)
+				mockProfileDbTx(ctrl, m, false)
+
+				m.EXPECT().LockPhoneNumberChange(gomock.Any(), int64(1234)).Return(withChange(func(c *model.PhoneNumberChange) {
+					c.ExpiryTime = time.Now().Add(-time.Second)
+				}), nil).Times(1)
+
+				return m
+			},
+			mockSMS: func(ctrl *gomock.Controller) *sms.MockSender {
+				return sms.NewMockSender(ctrl)
+			},
+			wantErr: ErrOTPExpired,
+		},
+		{
+			name: "fail-attempts-exceeded",
+			otp:  "123456",
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockGetUser(m)
+				mockProfileDbTx(ctrl, m, false)
+
+				m.EXPECT().LockPhoneNumberChange(gomock.Any(), int64(1234)).Return(withChange(func(c *model.PhoneNumberChange) {
+					c.Attempts = defaultOTPMaxAttempts
+				}), nil).Times(1)
+
+				return m
+			},
+			mockSMS: func(ctrl *gomock.Controller) *sms.MockSender {
+				return sms.NewMockSender(ctrl)
+			},
+			wantErr: ErrOTPAttemptsExceeded,
+		},
+		{
+			name: "fail-already-verified",
+			otp:  "123456",
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockGetUser(m)
+				mockProfileDbTx(ctrl, m, false)
+
+				m.EXPECT().LockPhoneNumberChange(gomock.Any(), int64(1234)).Return(withChange(func(c *model.PhoneNumberChange) {
+					c.VerifiedTime = &verifiedTime
+				}), nil).Times(1)
+
+				return m
+			},
+			mockSMS: func(ctrl *gomock.Controller) *sms.MockSender {
+				return sms.NewMockSender(ctrl)
+			},
+			wantErr: repository.ErrPhoneNumberChangeNotFound,
+		},
+		{
+			name: "fail-not-requested",
+			otp:  "123456",
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockGetUser(m)
+				mockProfileDbTx(ctrl, m, false)
+
+				m.EXPECT().LockPhoneNumberChange(gomock.Any(), int64(1234)).Return(model.PhoneNumberChange{}, repository.ErrPhoneNumberChangeNotFound).Times(1)
+
+				return m
+			},
+			mockSMS: func(ctrl *gomock.Controller) *sms.MockSender {
+				return sms.NewMockSender(ctrl)
+			},
+			wantErr: repository.ErrPhoneNumberChangeNotFound,
+		},
+		{
+			name: "fail-registered-since-requested-should-rollback",
+			otp:  "123456",
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockGetUser(m)
+				mockProfileDbTx(ctrl, m, false)
+
+				m.EXPECT().LockPhoneNumberChange(gomock.Any(), int64(1234)).Return(change, nil).Times(1)
+				m.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Return(errorTaken).Times(1)
+
+				return m
+			},
+			mockSMS: func(ctrl *gomock.Controller) *sms.MockSender {
+				return sms.NewMockSender(ctrl)
+			},
+			wantErr: errorTaken,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			usecase := &Usecase{
+				Repository: test.mockRepository(controller),
+				SMS:        test.mockSMS(controller),
+			}
+
+			gotUser, gotErr := usecase.ConfirmPhoneNumberChange(context.Background(), 1234, test.otp)
+			if !reflect.DeepEqual(gotUser, test.wantUser) {
+				t.Errorf("usecase.ConfirmPhoneNumberChange() gotUser = %v, wantUser %v", gotUser, test.wantUser)
+			}
+			if gotErr != test.wantErr {
+				t.Errorf("usecase.ConfirmPhoneNumberChange() gotErr = %v, wantErr %v", gotErr, test.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/WalletService/model"
+	"github.com/WalletService/qr"
+	"github.com/WalletService/tracing"
+	"github.com/google/uuid"
+)
+
+const (
+	// Merchant account information used to identify QR payloads issued by this wallet
+	qrMerchantAccountID            = "26"
+	qrGloballyUniqueID             = "ID.CO.WALLETSERVICE.WWW"
+	qrPANPrefix                    = "93600999" // 9360 is the national prefix, followed by the issuer code
+	qrMerchantCriteria             = "UMI"      // Usaha Mikro, the default criteria for personal wallets
+	qrMerchantCity                 = "JAKARTA"
+	qrTransactionCurrencyIDR       = "360"
+	qrPersonalMerchantCategoryCode = "4829" // Money transfer
+	qrMaxMerchantNameLength        = 25
+)
+
+var ErrQRMerchantNotVerified = errors.New("only verified merchants can generate QR with a merchant category code other than " + qrPersonalMerchantCategoryCode)
+
+// GenerateUserQR generates a QR payload that can be scanned by other Users to pay into the User's wallet.
+func (uc *Usecase) GenerateUserQR(ctx context.Context, request model.QRRequest) (payload string, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.GenerateUserQR")
+	defer func() { span.End(err) }()
+
+	if request.Amount < 0 {
+		return "", errors.New("invalid amount")
+	}
+
+	user, err := uc.Repository.GetUser(ctx, request.UserID)
+	if err != nil {
+		return "", err
+	}
+
+	merchantName := request.MerchantName
+	if merchantName == "" {
+		merchantName = user.FullName
+	}
+	if runes := []rune(merchantName); len(runes) > qrMaxMerchantNameLength {
+		merchantName = string(runes[:qrMaxMerchantNameLength])
+	}
+
+	// Merchant category code only describes the QR, payers are charged by the account of the recipient, see CreateUserQRPayment
+	merchantCategoryCode := request.MerchantCategoryCode
+	if merchantCategoryCode == "" {
+		merchantCategoryCode = user.MerchantCategoryCode
+	}
+	if merchantCategoryCode == "" {
+		merchantCategoryCode = qrPersonalMerchantCategoryCode
+	}
+	if merchantCategoryCode != qrPersonalMerchantCategoryCode && user.MerchantCategoryCode == "" {
+		return "", ErrQRMerchantNotVerified
+	}
+
+	p := qr.Payload{
+		PointOfInitiation: qr.PointOfInitiationStatic,
+		MerchantAccounts: []qr.MerchantAccount{
+			{
+				ID:               qrMerchantAccountID,
+				GloballyUniqueID: qrGloballyUniqueID,
+				PAN:              fmt.Sprintf("%s%011d", qrPANPrefix, user.ID),
+				MerchantID:       strconv.FormatInt(user.ID, 10),
+				Criteria:         qrMerchantCriteria,
+			},
+		},
+		MerchantCategoryCode: merchantCategoryCode,
+		TransactionCurrency:  qrTransactionCurrencyIDR,
+		MerchantName:         merchantName,
+		MerchantCity:         qrMerchantCity,
+	}
+
+	// Dynamic QR is only valid for a single amount, so we tag it with a reference for reconciliation
+	if request.Amount > 0 {
+		p.PointOfInitiation = qr.PointOfInitiationDynamic
+		p.TransactionAmount = request.Amount
+		p.AdditionalData = qr.AdditionalData{
+			ReferenceLabel: uuid.New().String()[:8],
+			Purpose:        request.Description,
+		}
+	}
+
+	return qr.Encode(p)
+}
+
+// CreateUserQRPayment pays a scanned QR payload by performing a Transaction to the User that issued the QR.
+func (uc *Usecase) CreateUserQRPayment(ctx context.Context, payment model.QRPayment) (newTransaction model.Transaction, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.CreateUserQRPayment")
+	defer func() { span.End(err) }()
+
+	p, err := qr.Parse(payment.Payload)
+	if err != nil {
+		return model.Transaction{}, err
+	}
+
+	// Validate QR is issued by this wallet
+	account, ok := p.MerchantAccount(qrGloballyUniqueID)
+	if !ok {
+		return model.Transaction{}, errors.New("QR is not issued by this wallet")
+	}
+
+	recipientID, err := strconv.ParseInt(account.MerchantID, 10, 64)
+	if err != nil {
+		return model.Transaction{}, errors.New("QR has invalid merchant ID")
+	}
+
+	if p.TransactionCurrency != qrTransactionCurrencyIDR {
+		return model.Transaction{}, errors.New("QR currency is not supported")
+	}
+
+	// Dynamic QR dictates the amount, while static QR requires the payer to enter the amount
+	amount := payment.Amount
+	if p.IsDynamic() {
+		if amount != 0 && amount != p.TransactionAmount {
+			return model.Transaction{}, errors.New("amount does not match QR amount")
+		}
+		amount = p.TransactionAmount
+	} else if amount <= 0 {
+		return model.Transaction{}, errors.New("amount is required for static QR")
+	}
+
+	// Payment to a verified merchant, the merchant category code of the payload is chosen by whoever generated or re-encoded it
+	recipient, err := uc.Repository.GetUser(ctx, recipientID)
+	if err != nil {
+		return model.Transaction{}, err
+	}
+	transactionType := model.TransactionTypeTransferOut
+	if recipient.MerchantCategoryCode != "" {
+		transactionType = model.TransactionTypePayment
+	}
+
+	description := payment.Description
+	if description == "" {
+		description = p.AdditionalData.Purpose
+	}
+
+	return uc.CreateUserTransaction(ctx, model.Transaction{
+		UserID:      payment.UserID,
+		Amount:      amount,
+		Type:        transactionType,
+		RecipientID: recipientID,
+		Description: description,
+		Password:    payment.Password,
+	})
+}
@@ -0,0 +1,330 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/WalletService/model"
+	"github.com/WalletService/qr"
+	"github.com/WalletService/repository"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+)
+
+func TestGenerateUserQR(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          model.QRRequest
+		mockRepository func(controller *gomock.Controller) *repository.MockRepositoryInterface
+		wantPayload    string
+		wantErr        bool
+	}{
+		{
+			name: "success-static",
+			input: model.QRRequest{
+				UserID: 1,
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				m.EXPECT().GetUser(gomock.Any(), int64(1)).Return(model.User{
+					ID:       1,
+					FullName: "name1",
+				}, nil).Times(1)
+				return m
+			},
+			wantPayload: "00020101021126620023ID.CO.WALLETSERVICE.WWW01199360099900000000001020110303UMI5204482953033605802ID5905name16007JAKARTA63047E85",
+			wantErr:     false,
+		},
+		{
+			name: "success-merchant-default-category",
+			input: model.QRRequest{
+				UserID: 1,
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				m.EXPECT().GetUser(gomock.Any(), int64(1)).Return(model.User{
+					ID:                   1,
+					FullName:             "name1",
+					MerchantCategoryCode: "5812",
+				}, nil).Times(1)
+				return m
+			},
+			wantPayload: "00020101021126620023ID.CO.WALLETSERVICE.WWW01199360099900000000001020110303UMI5204581253033605802ID5905name16007JAKARTA6304C428",
+			wantErr:     false,
+		},
+		{
+			name: "fail-merchant-category-not-verified",
+			input: model.QRRequest{
+				UserID:               1,
+				MerchantCategoryCode: "5812",
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				m.EXPECT().GetUser(gomock.Any(), int64(1)).Return(model.User{
+					ID:       1,
+					FullName: "name1",
+				}, nil).Times(1)
+				return m
+			},
+			wantPayload: "",
+			wantErr:     true,
+		},
+		{
+			name: "fail-get-user",
+			input: model.QRRequest{
+				UserID: 1,
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				m.EXPECT().GetUser(gomock.Any(), int64(1)).Return(model.User{}, errors.New("user not found")).Times(1)
+				return m
+			},
+			wantPayload: "",
+			wantErr:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			usecase := &Usecase{
+				Repository: test.mockRepository(controller),
+			}
+
+			gotPayload, gotErr := usecase.GenerateUserQR(context.Background(), test.input)
+			if gotPayload != test.wantPayload {
+				t.Errorf("usecase.GenerateUserQR() gotPayload = %v, wantPayload %v", gotPayload, test.wantPayload)
+				return
+			}
+			if (gotErr != nil) != test.wantErr {
+				t.Errorf("usecase.GenerateUserQR() gotErr = %v, wantErr %v", gotErr, test.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestGenerateUserQR_Dynamic(t *testing.T) {
+	controller := gomock.NewController(t)
+
+	m := repository.NewMockRepositoryInterface(controller)
+	m.EXPECT().GetUser(gomock.Any(), int64(1)).Return(model.User{
+		ID:                   1,
+		FullName:             "name1",
+		MerchantCategoryCode: "5411",
+	}, nil).Times(1)
+
+	usecase := &Usecase{
+		Repository: m,
+	}
+
+	payload, err := usecase.GenerateUserQR(context.Background(), model.QRRequest{
+		UserID:               1,
+		Amount:               250000,
+		MerchantName:         "Warung Makan",
+		MerchantCategoryCode: "5812",
+		Description:          "Traktir Makan",
+	})
+	if err != nil {
+		t.Fatalf("usecase.GenerateUserQR() err = %v", err)
+	}
+
+	got, err := qr.Parse(payload)
+	if err != nil {
+		t.Fatalf("qr.Parse() err = %v", err)
+	}
+
+	if !got.IsDynamic() || got.TransactionAmount != 250000 || got.MerchantName != "Warung Makan" || got.MerchantCategoryCode != "5812" || got.AdditionalData.Purpose != "Traktir Makan" {
+		t.Errorf("usecase.GenerateUserQR() payload = %+v", got)
+	}
+}
+
+func TestCreateUserQRPayment(t *testing.T) {
+	const (
+		staticPayload  = "00020101021126620023ID.CO.WALLETSERVICE.WWW01199360099900000000001020110303UMI5204482953033605802ID5905name16007JAKARTA63047E85"
+		dynamicPayload = "00020101021226620023ID.CO.WALLETSERVICE.WWW01199360099900000000001020110303UMI52045812530336054062500005802ID5912Warung Makan6007JAKARTA61051219062280507INV-0010813Traktir Makan63041EC8"
+		foreignPayload = "00020101021229300012D156000000000510A93FO3230Q31280012D15600000001030812345678520441115802CN5914BEST TRANSPORT6007BEIJING64200002ZH0104最佳运输0202北京540523.7253031565502016233030412340603***0708A60086670902ME91320016A0112233449988770708123456786304A13A"
+	)
+
+	tests := []struct {
+		name              string
+		input             model.QRPayment
+		mockRepository    func(controller *gomock.Controller) *repository.MockRepositoryInterface
+		wantTransactionID uuid.UUID
+		wantErr           bool
+	}{
+		{
+			name: "fail-invalid-checksum",
+			input: model.QRPayment{
+				UserID:  2,
+				Payload: staticPayload[:len(staticPayload)-4] + "0000",
+				Amount:  1000,
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				return repository.NewMockRepositoryInterface(ctrl)
+			},
+			wantTransactionID: uuid.Nil,
+			wantErr:           true,
+		},
+		{
+			name: "fail-qr-not-issued-by-wallet",
+			input: model.QRPayment{
+				UserID:  2,
+				Payload: foreignPayload,
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				return repository.NewMockRepositoryInterface(ctrl)
+			},
+			wantTransactionID: uuid.Nil,
+			wantErr:           true,
+		},
+		{
+			name: "fail-static-qr-missing-amount",
+			input: model.QRPayment{
+				UserID:  2,
+				Payload: staticPayload,
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				return repository.NewMockRepositoryInterface(ctrl)
+			},
+			wantTransactionID: uuid.Nil,
+			wantErr:           true,
+		},
+		{
+			name: "fail-dynamic-qr-amount-mismatch",
+			input: model.QRPayment{
+				UserID:  2,
+				Payload: dynamicPayload,
+				Amount:  1000,
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				return repository.NewMockRepositoryInterface(ctrl)
+			},
+			wantTransactionID: uuid.Nil,
+			wantErr:           true,
+		},
+		{
+			name: "fail-dynamic-qr-pays-merchant-with-qr-amount",
+			input: model.QRPayment{
+				UserID:   2,
+				Payload:  dynamicPayload,
+				Password: "Admin1234!",
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+
+				// Payer does not have enough balance to cover the QR amount
+				m.EXPECT().GetUser(gomock.Any(), int64(1)).Return(model.User{ID: 1, MerchantCategoryCode: "5812"}, nil).Times(1)
+				m.EXPECT().GetUser(gomock.Any(), int64(2)).Return(model.User{
+					ID:       2,
+					Password: "$2a$12$35ELZtgOq3iFR6awq.jsDuV5Dr.0XU5k7iUQuShfeLTWRHGFr//fq",
+					Balance:  100000,
+				}, nil).Times(1)
+
+				return m
+			},
+			wantTransactionID: uuid.Nil,
+			wantErr:           true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			usecase := &Usecase{
+				Repository: test.mockRepository(controller),
+			}
+
+			gotTransaction, gotErr := usecase.CreateUserQRPayment(context.Background(), test.input)
+			if gotTransaction.ID != test.wantTransactionID {
+				t.Errorf("usecase.CreateUserQRPayment() gotTransactionID = %v, wantTransactionID %v", gotTransaction.ID, test.wantTransactionID)
+				return
+			}
+			if (gotErr != nil) != test.wantErr {
+				t.Errorf("usecase.CreateUserQRPayment() gotErr = %v, wantErr %v", gotErr, test.wantErr)
+				return
+			}
+		})
+	}
+}
+
+// TestCreateUserQRPayment_TransactionType verifies the fee is charged by the account of the recipient, whatever merchant category code the payload has
+func TestCreateUserQRPayment_TransactionType(t *testing.T) {
+	const (
+		staticPayload  = "00020101021126620023ID.CO.WALLETSERVICE.WWW01199360099900000000001020110303UMI5204482953033605802ID5905name16007JAKARTA63047E85"
+		dynamicPayload = "00020101021226620023ID.CO.WALLETSERVICE.WWW01199360099900000000001020110303UMI52045812530336054062500005802ID5912Warung Makan6007JAKARTA61051219062280507INV-0010813Traktir Makan63041EC8"
+	)
+	errorStop := errors.New("stop after the fee is calculated")
+
+	tests := []struct {
+		name           string
+		input          model.QRPayment
+		mockRepository func(controller *gomock.Controller) *repository.MockRepositoryInterface
+		wantErr        error
+	}{
+		{
+			name:  "merchant-recipient-pays-merchant-fee-with-personal-category",
+			input: model.QRPayment{UserID: 2, Payload: staticPayload, Amount: 100000, Password: "Admin1234!"},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+
+				m.EXPECT().GetUser(gomock.Any(), int64(1)).Return(model.User{ID: 1, MerchantCategoryCode: "5812"}, nil).Times(1)
+				// Payment fee of 700 is not covered, TransferOut would have been free
+				m.EXPECT().GetUser(gomock.Any(), int64(2)).Return(model.User{
+					ID:       2,
+					Password: "$2a$12$35ELZtgOq3iFR6awq.jsDuV5Dr.0XU5k7iUQuShfeLTWRHGFr//fq",
+					Balance:  100500,
+				}, nil).Times(1)
+
+				return m
+			},
+			wantErr: errors.New("balance not enough"),
+		},
+		{
+			name:  "personal-recipient-transfers-with-merchant-category",
+			input: model.QRPayment{UserID: 2, Payload: dynamicPayload, Password: "Admin1234!"},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+
+				gomock.InOrder(
+					m.EXPECT().GetUser(gomock.Any(), int64(1)).Return(model.User{ID: 1}, nil).Times(1),
+					m.EXPECT().GetUser(gomock.Any(), int64(2)).Return(model.User{
+						ID:       2,
+						Password: "$2a$12$35ELZtgOq3iFR6awq.jsDuV5Dr.0XU5k7iUQuShfeLTWRHGFr//fq",
+						Balance:  250000,
+					}, nil).Times(1),
+					// Only TransferOut counts the monthly free transfers
+					m.EXPECT().CountTransactions(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, filter model.TransactionFilter) (int, error) {
+						if filter.Type != model.TransactionTypeTransferOut {
+							t.Errorf("CountTransactions() filter.Type = %v, want %v", filter.Type, model.TransactionTypeTransferOut)
+						}
+						return 0, nil
+					}).Times(1),
+					m.EXPECT().GetUser(gomock.Any(), int64(1)).Return(model.User{}, errorStop).Times(1),
+				)
+
+				return m
+			},
+			wantErr: errorStop,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			usecase := &Usecase{
+				Repository: test.mockRepository(controller),
+				Fees:       &testFeeSchedule,
+			}
+
+			_, gotErr := usecase.CreateUserQRPayment(context.Background(), test.input)
+			if gotErr == nil || !errors.Is(gotErr, test.wantErr) && gotErr.Error() != test.wantErr.Error() {
+				t.Errorf("usecase.CreateUserQRPayment() gotErr = %v, wantErr %v", gotErr, test.wantErr)
+			}
+		})
+	}
+}
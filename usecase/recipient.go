@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/WalletService/model"
+	"github.com/WalletService/repository"
+	"github.com/WalletService/tracing"
+	"github.com/WalletService/utils"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultRecipientLookupLimit  = 10
+	defaultRecipientLookupWindow = time.Hour
+	defaultRecipientTokenExpiry  = 5 * time.Minute
+)
+
+var (
+	ErrRecipientNotFound            = errors.New("recipient not found")
+	ErrRecipientLookupLimitExceeded = errors.New("too many recipient lookups, try again later")
+	ErrRecipientTokenInvalid        = errors.New("invalid recipient token")
+	ErrRecipientTokenExpired        = errors.New("recipient token has expired, look up the recipient again")
+)
+
+// LookupRecipient finds the User registered with phoneNumber, for User to confirm the masked name of the Recipient before transferring with the
+// returned lookup ID as token. Lookups are limited per User, unknown phone numbers included, so that accounts can not be enumerated.
+func (uc *Usecase) LookupRecipient(ctx context.Context, userID int64, phoneNumber string) (lookup model.RecipientLookup, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.LookupRecipient", tracing.Int64("user_id", userID))
+	defer func() { span.End(err) }()
+
+	lookup = model.RecipientLookup{
+		ID:          uuid.New(),
+		UserID:      userID,
+		PhoneNumber: phoneNumber,
+		ExpiryTime:  time.Now().Add(uc.recipientTokenExpiry()),
+	}
+
+	var recipient model.User
+	if err = utils.WithDbTx(ctx, uc.Repository, func(ctx context.Context) error {
+		// 1. Lock User, so concurrent lookups are counted one after another
+		lockedUsers, err := uc.lockUsers(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		// 2. Validate User can send, looking up a Recipient is only needed to transfer
+		if err := canSend(lockedUsers[userID]); err != nil {
+			return err
+		}
+
+		// 3. Validate User has lookups left within the window
+		count, err := uc.Repository.CountRecipientLookups(ctx, userID, time.Now().Add(-uc.recipientLookupWindow()))
+		if err != nil {
+			return err
+		}
+		if count >= uc.recipientLookupLimit() {
+			return ErrRecipientLookupLimitExceeded
+		}
+
+		// 4. Find the Recipient, Closed accounts can never receive so they are not found
+		users, err := uc.Repository.GetUsers(ctx, model.UserFilter{PhoneNumber: phoneNumber})
+		if err != nil {
+			return err
+		}
+		if len(users) > 0 && users[0].Status != model.UserStatusClosed {
+			recipient = users[0]
+			lookup.RecipientID = recipient.ID
+		}
+
+		// 5. Record the lookup, an unknown phone number counts towards the limit too
+		return uc.Repository.InsertRecipientLookup(ctx, lookup)
+	}); err != nil {
+		return model.RecipientLookup{}, err
+	}
+
+	if lookup.RecipientID == 0 {
+		return model.RecipientLookup{}, ErrRecipientNotFound
+	}
+
+	lookup.RecipientName = maskName(recipient.FullName)
+	return lookup, nil
+}
+
+// resolveRecipient returns the ID of the Recipient of a transfer, which may be requested with the token of a lookup or the phone number of the
+// Recipient instead. A phone number is looked up like LookupRecipient does, so transfers can not be used to enumerate accounts either.
+func (uc *Usecase) resolveRecipient(ctx context.Context, transaction model.Transaction) (recipientID int64, err error) {
+	switch {
+	case transaction.RecipientID != 0:
+		return transaction.RecipientID, nil
+	case transaction.RecipientToken != uuid.Nil:
+		return uc.recipientOfToken(ctx, transaction.UserID, transaction.RecipientToken)
+	case transaction.RecipientPhoneNumber != "":
+		lookup, err := uc.LookupRecipient(ctx, transaction.UserID, transaction.RecipientPhoneNumber)
+		if err != nil {
+			return 0, err
+		}
+		return lookup.RecipientID, nil
+	default:
+		return 0, nil
+	}
+}
+
+// recipientOfToken returns the ID of the Recipient looked up by User with the token, see LookupRecipient
+func (uc *Usecase) recipientOfToken(ctx context.Context, userID int64, token uuid.UUID) (recipientID int64, err error) {
+	lookup, err := uc.Repository.GetRecipientLookup(ctx, token)
+	if errors.Is(err, repository.ErrRecipientLookupNotFound) {
+		return 0, ErrRecipientTokenInvalid
+	} else if err != nil {
+		return 0, err
+	}
+
+	// Token is only valid for the User who looked up the Recipient
+	if lookup.UserID != userID || lookup.RecipientID == 0 {
+		return 0, ErrRecipientTokenInvalid
+	}
+	if time.Now().After(lookup.ExpiryTime) {
+		return 0, ErrRecipientTokenExpired
+	}
+	return lookup.RecipientID, nil
+}
+
+// maskName masks each word of a name but its first 2 characters, i.e. "John Doe" into "Jo** Do*".
+// Words of 2 characters only keep their first one.
+func maskName(name string) string {
+	words := strings.Fields(name)
+	for i, word := range words {
+		runes := []rune(word)
+
+		keep := 2
+		if len(runes) <= keep {
+			keep = 1
+		}
+		words[i] = string(runes[:keep]) + strings.Repeat("*", len(runes)-keep)
+	}
+
+	return strings.Join(words, " ")
+}
+
+func (uc *Usecase) recipientLookupLimit() int {
+	if uc.RecipientLookupLimit <= 0 {
+		return defaultRecipientLookupLimit
+	}
+	return uc.RecipientLookupLimit
+}
+
+func (uc *Usecase) recipientLookupWindow() time.Duration {
+	if uc.RecipientLookupWindow <= 0 {
+		return defaultRecipientLookupWindow
+	}
+	return uc.RecipientLookupWindow
+}
+
+func (uc *Usecase) recipientTokenExpiry() time.Duration {
+	if uc.RecipientTokenExpiry <= 0 {
+		return defaultRecipientTokenExpiry
+	}
+	return uc.RecipientTokenExpiry
+}
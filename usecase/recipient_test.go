@@ -0,0 +1,273 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/WalletService/model"
+	"github.com/WalletService/repository"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+)
+
+func TestLookupRecipient(t *testing.T) {
+	errorDb := errors.New("db error")
+
+	mockLookup := func(ctrl *gomock.Controller, m *repository.MockRepositoryInterface, commit bool, sender model.User, count int) {
+		mockProfileDbTx(ctrl, m, commit)
+
+		m.EXPECT().LockUsers(gomock.Any(), []int64{1234}).Return([]model.User{sender}, nil).Times(1)
+		if sender.Status != model.UserStatusActive {
+			return
+		}
+		m.EXPECT().CountRecipientLookups(gomock.Any(), int64(1234), gomock.Any()).Return(count, nil).Times(1)
+	}
+	// Lookup ID and expiry are generated, only the rest is compared
+	insertLookup := func(t *testing.T, m *repository.MockRepositoryInterface, recipientID int64) {
+		m.EXPECT().InsertRecipientLookup(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, lookup model.RecipientLookup) error {
+			if lookup.ID == uuid.Nil || lookup.UserID != 1234 || lookup.RecipientID != recipientID ||
+				lookup.PhoneNumber != "+628987654321" || !lookup.ExpiryTime.After(time.Now()) {
+				t.Errorf("InsertRecipientLookup() lookup = %+v, want recipientID %v", lookup, recipientID)
+			}
+			return nil
+		}).Times(1)
+	}
+
+	tests := []struct {
+		name           string
+		mockRepository func(t *testing.T, controller *gomock.Controller) *repository.MockRepositoryInterface
+		wantRecipient  int64
+		wantName       string
+		wantErr        error
+	}{
+		{
+			name: "success",
+			mockRepository: func(t *testing.T, ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+
+				mockLookup(ctrl, m, true, model.User{ID: 1234, Status: model.UserStatusActive}, 9)
+				m.EXPECT().GetUsers(gomock.Any(), model.UserFilter{PhoneNumber: "+628987654321"}).Return([]model.User{
+					{ID: 6789, FullName: "John Doe", Status: model.UserStatusActive},
+				}, nil).Times(1)
+				insertLookup(t, m, 6789)
+
+				return m
+			},
+			wantRecipient: 6789,
+			wantName:      "Jo** Do*",
+		},
+		{
+			name: "failed-not-found-is-recorded",
+			mockRepository: func(t *testing.T, ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+
+				mockLookup(ctrl, m, true, model.User{ID: 1234, Status: model.UserStatusActive}, 0)
+				m.EXPECT().GetUsers(gomock.Any(), model.UserFilter{PhoneNumber: "+628987654321"}).Return(nil, nil).Times(1)
+				insertLookup(t, m, 0)
+
+				return m
+			},
+			wantErr: ErrRecipientNotFound,
+		},
+		{
+			name: "failed-closed-recipient-not-found",
+			mockRepository: func(t *testing.T, ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+
+				mockLookup(ctrl, m, true, model.User{ID: 1234, Status: model.UserStatusActive}, 0)
+				m.EXPECT().GetUsers(gomock.Any(), model.UserFilter{PhoneNumber: "+628987654321"}).Return([]model.User{
+					{ID: 6789, FullName: "John Doe", Status: model.UserStatusClosed},
+				}, nil).Times(1)
+				insertLookup(t, m, 0)
+
+				return m
+			},
+			wantErr: ErrRecipientNotFound,
+		},
+		{
+			name: "failed-limit-exceeded",
+			mockRepository: func(t *testing.T, ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockLookup(ctrl, m, false, model.User{ID: 1234, Status: model.UserStatusActive}, defaultRecipientLookupLimit)
+				return m
+			},
+			wantErr: ErrRecipientLookupLimitExceeded,
+		},
+		{
+			name: "failed-user-frozen",
+			mockRepository: func(t *testing.T, ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockLookup(ctrl, m, false, model.User{ID: 1234, Status: model.UserStatusFrozen}, 0)
+				return m
+			},
+			wantErr: ErrUserFrozen,
+		},
+		{
+			name: "failed-repo-call",
+			mockRepository: func(t *testing.T, ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+
+				mockLookup(ctrl, m, false, model.User{ID: 1234, Status: model.UserStatusActive}, 0)
+				m.EXPECT().GetUsers(gomock.Any(), gomock.Any()).Return(nil, errorDb).Times(1)
+
+				return m
+			},
+			wantErr: errorDb,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			usecase := &Usecase{Repository: test.mockRepository(t, controller)}
+
+			gotLookup, gotErr := usecase.LookupRecipient(context.Background(), 1234, "+628987654321")
+			if gotLookup.RecipientID != test.wantRecipient || gotLookup.RecipientName != test.wantName {
+				t.Errorf("usecase.LookupRecipient() gotLookup = %+v, wantRecipient %v, wantName %v", gotLookup, test.wantRecipient, test.wantName)
+			}
+			if !errors.Is(gotErr, test.wantErr) {
+				t.Errorf("usecase.LookupRecipient() gotErr = %v, wantErr %v", gotErr, test.wantErr)
+			}
+		})
+	}
+}
+
+func Test_resolveRecipient(t *testing.T) {
+	token := uuid.New()
+
+	tests := []struct {
+		name           string
+		input          model.Transaction
+		mockRepository func(controller *gomock.Controller) *repository.MockRepositoryInterface
+		wantRecipient  int64
+		wantErr        error
+	}{
+		{
+			name:  "success-recipient-id",
+			input: model.Transaction{UserID: 1234, RecipientID: 6789, RecipientToken: token},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				return repository.NewMockRepositoryInterface(ctrl)
+			},
+			wantRecipient: 6789,
+		},
+		{
+			name:  "success-token",
+			input: model.Transaction{UserID: 1234, RecipientToken: token},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				m.EXPECT().GetRecipientLookup(gomock.Any(), token).Return(model.RecipientLookup{
+					ID: token, UserID: 1234, RecipientID: 6789, ExpiryTime: time.Now().Add(time.Minute),
+				}, nil).Times(1)
+				return m
+			},
+			wantRecipient: 6789,
+		},
+		{
+			name:  "success-phone-number",
+			input: model.Transaction{UserID: 1234, RecipientPhoneNumber: "+628987654321"},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+
+				mockProfileDbTx(ctrl, m, true)
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234}).Return([]model.User{{ID: 1234, Status: model.UserStatusActive}}, nil).Times(1)
+				m.EXPECT().CountRecipientLookups(gomock.Any(), int64(1234), gomock.Any()).Return(0, nil).Times(1)
+				m.EXPECT().GetUsers(gomock.Any(), model.UserFilter{PhoneNumber: "+628987654321"}).Return([]model.User{{ID: 6789}}, nil).Times(1)
+				m.EXPECT().InsertRecipientLookup(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+				return m
+			},
+			wantRecipient: 6789,
+		},
+		{
+			name:  "success-no-recipient",
+			input: model.Transaction{UserID: 1234},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				return repository.NewMockRepositoryInterface(ctrl)
+			},
+		},
+		{
+			name:  "failed-token-not-found",
+			input: model.Transaction{UserID: 1234, RecipientToken: token},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				m.EXPECT().GetRecipientLookup(gomock.Any(), token).Return(model.RecipientLookup{}, repository.ErrRecipientLookupNotFound).Times(1)
+				return m
+			},
+			wantErr: ErrRecipientTokenInvalid,
+		},
+		{
+			name:  "failed-token-of-another-user",
+			input: model.Transaction{UserID: 1234, RecipientToken: token},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				m.EXPECT().GetRecipientLookup(gomock.Any(), token).Return(model.RecipientLookup{
+					ID: token, UserID: 4321, RecipientID: 6789, ExpiryTime: time.Now().Add(time.Minute),
+				}, nil).Times(1)
+				return m
+			},
+			wantErr: ErrRecipientTokenInvalid,
+		},
+		{
+			name:  "failed-token-of-unknown-phone-number",
+			input: model.Transaction{UserID: 1234, RecipientToken: token},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				m.EXPECT().GetRecipientLookup(gomock.Any(), token).Return(model.RecipientLookup{
+					ID: token, UserID: 1234, ExpiryTime: time.Now().Add(time.Minute),
+				}, nil).Times(1)
+				return m
+			},
+			wantErr: ErrRecipientTokenInvalid,
+		},
+		{
+			name:  "failed-token-expired",
+			input: model.Transaction{UserID: 1234, RecipientToken: token},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				m.EXPECT().GetRecipientLookup(gomock.Any(), token).Return(model.RecipientLookup{
+					ID: token, UserID: 1234, RecipientID: 6789, ExpiryTime: time.Now().Add(-time.Minute),
+				}, nil).Times(1)
+				return m
+			},
+			wantErr: ErrRecipientTokenExpired,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			usecase := &Usecase{Repository: test.mockRepository(controller)}
+
+			gotRecipient, gotErr := usecase.resolveRecipient(context.Background(), test.input)
+			if gotRecipient != test.wantRecipient {
+				t.Errorf("usecase.resolveRecipient() gotRecipient = %v, wantRecipient %v", gotRecipient, test.wantRecipient)
+			}
+			if !errors.Is(gotErr, test.wantErr) {
+				t.Errorf("usecase.resolveRecipient() gotErr = %v, wantErr %v", gotErr, test.wantErr)
+			}
+		})
+	}
+}
+
+func Test_maskName(t *testing.T) {
+	tests := map[string]string{
+		"John Doe":         "Jo** Do*",
+		"  John   Doe  ":   "Jo** Do*",
+		"Al Bo":            "A* B*",
+		"A":                "A",
+		"Siti Nurhaliza":   "Si** Nu*******",
+		"Ünal Çelik":       "Ün** Çe***",
+		"":                 "",
+		"Budi Santoso Jr.": "Bu** Sa***** Jr*",
+	}
+
+	for input, want := range tests {
+		if got := maskName(input); got != want {
+			t.Errorf("maskName(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
@@ -0,0 +1,535 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/WalletService/model"
+	"github.com/WalletService/repository"
+	"github.com/WalletService/tracing"
+	"github.com/WalletService/utils"
+)
+
+var (
+	ErrSharedWalletRoleNotAllowed           = errors.New("shared wallet role does not allow this")
+	ErrSharedWalletOwnerMember              = errors.New("owner of the shared wallet can not be changed or removed")
+	ErrSharedWalletTransactionLimitExceeded = errors.New("amount exceeds the transaction limit of the shared wallet member")
+	ErrSharedWalletMonthlyLimitExceeded     = errors.New("amount exceeds the monthly limit of the shared wallet member")
+	ErrClosureSharedWalletNotEmpty          = errors.New("shared wallets owned by user must be emptied before closing")
+)
+
+// CreateSharedWallet creates an empty SharedWallet owned by User, who is its only member until adding others with AddSharedWalletMember
+func (uc *Usecase) CreateSharedWallet(ctx context.Context, wallet model.SharedWallet) (newWallet model.SharedWallet, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.CreateSharedWallet", tracing.Int64("user_id", wallet.OwnerID))
+	defer func() { span.End(err) }()
+
+	if err = utils.WithDbTx(ctx, uc.Repository, func(ctx context.Context) error {
+		// 1. Insert the SharedWallet
+		if wallet.ID, err = uc.Repository.InsertSharedWallet(ctx, wallet); err != nil {
+			return err
+		}
+
+		// 2. Add User as its owner
+		return uc.Repository.InsertSharedWalletMember(ctx, model.SharedWalletMember{
+			SharedWalletID: wallet.ID,
+			UserID:         wallet.OwnerID,
+			Role:           model.SharedWalletRoleOwner,
+		})
+	}); err != nil {
+		return model.SharedWallet{}, err
+	}
+
+	return uc.getSharedWallet(ctx, wallet.OwnerID, wallet.ID)
+}
+
+// GetUserSharedWallets returns the SharedWallets User is a member of, with User's role, limits and amount spent this month
+func (uc *Usecase) GetUserSharedWallets(ctx context.Context, userID int64) (wallets []model.SharedWallet, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.GetUserSharedWallets", tracing.Int64("user_id", userID))
+	defer func() { span.End(err) }()
+
+	wallets, err = uc.Repository.GetSharedWallets(ctx, model.SharedWalletFilter{UserID: userID})
+	if err != nil {
+		return []model.SharedWallet{}, err
+	}
+
+	for i := range wallets {
+		if wallets[i].Member.MonthlySpent, err = uc.monthlySpent(ctx, wallets[i].ID, userID); err != nil {
+			return []model.SharedWallet{}, err
+		}
+	}
+
+	return wallets, nil
+}
+
+// GetSharedWalletMembers returns the members of the SharedWallet with their masked name and amount spent this month, only to its owner
+func (uc *Usecase) GetSharedWalletMembers(ctx context.Context, ownerID, sharedWalletID int64) (members []model.SharedWalletMember, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.GetSharedWalletMembers", tracing.Int64("user_id", ownerID))
+	defer func() { span.End(err) }()
+
+	if _, err = uc.getOwnedSharedWallet(ctx, ownerID, sharedWalletID); err != nil {
+		return []model.SharedWalletMember{}, err
+	}
+
+	members, err = uc.Repository.GetSharedWalletMembers(ctx, model.SharedWalletMemberFilter{SharedWalletID: sharedWalletID})
+	if err != nil {
+		return []model.SharedWalletMember{}, err
+	}
+
+	for i := range members {
+		members[i].FullName = maskName(members[i].FullName)
+		if members[i].MonthlySpent, err = uc.monthlySpent(ctx, sharedWalletID, members[i].UserID); err != nil {
+			return []model.SharedWalletMember{}, err
+		}
+	}
+
+	return members, nil
+}
+
+// AddSharedWalletMember adds a User to the SharedWallet on behalf of its owner. Like the Recipient of a TransferOut, the member is requested
+// with the token of a lookup or its phone number, which is looked up and so rate limited.
+func (uc *Usecase) AddSharedWalletMember(ctx context.Context, request model.SharedWalletMemberRequest) (member model.SharedWalletMember, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.AddSharedWalletMember", tracing.Int64("user_id", request.OwnerID))
+	defer func() { span.End(err) }()
+
+	member = model.SharedWalletMember{
+		SharedWalletID:   request.SharedWalletID,
+		Role:             request.Role,
+		TransactionLimit: request.TransactionLimit,
+		MonthlyLimit:     request.MonthlyLimit,
+	}
+	if err := validateSharedWalletMember(member); err != nil {
+		return model.SharedWalletMember{}, err
+	}
+
+	// Only the owner can look up members, so that the SharedWallet can not be used to enumerate accounts
+	if _, err = uc.getOwnedSharedWallet(ctx, request.OwnerID, request.SharedWalletID); err != nil {
+		return model.SharedWalletMember{}, err
+	}
+
+	if member.UserID, err = uc.resolveRecipient(ctx, model.Transaction{
+		UserID:               request.OwnerID,
+		RecipientPhoneNumber: request.PhoneNumber,
+		RecipientToken:       request.Token,
+	}); err != nil {
+		return model.SharedWalletMember{}, err
+	}
+	if member.UserID == 0 {
+		return model.SharedWalletMember{}, errors.New("member is required")
+	}
+	if member.UserID == request.OwnerID {
+		return model.SharedWalletMember{}, ErrSharedWalletOwnerMember
+	}
+
+	// Closed accounts can never act on the SharedWallet
+	user, err := uc.Repository.GetUser(ctx, member.UserID)
+	if errors.Is(err, repository.ErrUserNotFound) {
+		return model.SharedWalletMember{}, ErrRecipientNotFound
+	} else if err != nil {
+		return model.SharedWalletMember{}, err
+	}
+	if user.Status == model.UserStatusClosed {
+		return model.SharedWalletMember{}, ErrRecipientNotFound
+	}
+
+	if err = uc.withOwnedSharedWallet(ctx, request.OwnerID, request.SharedWalletID, func(ctx context.Context) error {
+		// Violates the unique constraint if User is already a member, see utils.IsUniqueConstraintViolation
+		return uc.Repository.InsertSharedWalletMember(ctx, member)
+	}); err != nil {
+		return model.SharedWalletMember{}, err
+	}
+
+	return uc.getSharedWalletMember(ctx, request.SharedWalletID, member.UserID)
+}
+
+// UpdateSharedWalletMember replaces the role and limits of a member on behalf of the owner of the SharedWallet
+func (uc *Usecase) UpdateSharedWalletMember(ctx context.Context, ownerID int64, member model.SharedWalletMember) (updatedMember model.SharedWalletMember, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.UpdateSharedWalletMember", tracing.Int64("user_id", ownerID))
+	defer func() { span.End(err) }()
+
+	if err := validateSharedWalletMember(member); err != nil {
+		return model.SharedWalletMember{}, err
+	}
+	if member.UserID == ownerID {
+		return model.SharedWalletMember{}, ErrSharedWalletOwnerMember
+	}
+
+	if err = uc.withOwnedSharedWallet(ctx, ownerID, member.SharedWalletID, func(ctx context.Context) error {
+		return uc.Repository.UpdateSharedWalletMember(ctx, member)
+	}); err != nil {
+		return model.SharedWalletMember{}, err
+	}
+
+	return uc.getSharedWalletMember(ctx, member.SharedWalletID, member.UserID)
+}
+
+// RemoveSharedWalletMember removes a member on behalf of the owner of the SharedWallet, its Transactions are kept in the activity
+func (uc *Usecase) RemoveSharedWalletMember(ctx context.Context, ownerID, sharedWalletID, userID int64) (err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.RemoveSharedWalletMember", tracing.Int64("user_id", ownerID))
+	defer func() { span.End(err) }()
+
+	if userID == ownerID {
+		return ErrSharedWalletOwnerMember
+	}
+
+	return uc.withOwnedSharedWallet(ctx, ownerID, sharedWalletID, func(ctx context.Context) error {
+		return uc.Repository.DeleteSharedWalletMember(ctx, sharedWalletID, userID)
+	})
+}
+
+// GetSharedWalletTransactions lists the activity of the SharedWallet, most recent first. Owner and Viewers see every Transaction of the
+// SharedWallet, Spenders only their own.
+func (uc *Usecase) GetSharedWalletTransactions(ctx context.Context, userID int64, request model.TransactionFilter) (transactions []model.Transaction, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.GetSharedWalletTransactions", tracing.Int64("user_id", userID))
+	defer func() { span.End(err) }()
+
+	wallet, err := uc.getSharedWallet(ctx, userID, request.SharedWalletID)
+	if err != nil {
+		return nil, err
+	}
+
+	request.UserID = 0
+	if wallet.Member.Role == model.SharedWalletRoleSpender {
+		request.UserID = userID
+	}
+
+	return uc.Repository.GetTransactions(ctx, request)
+}
+
+// performSharedWalletTransferOut transfers out of a SharedWallet on behalf of one of its members, recorded as a TransferOut of the member
+// with the SharedWallet as its source. Owner and Spenders can transfer, Spenders within their limits, including to themselves.
+func (uc *Usecase) performSharedWalletTransferOut(ctx context.Context, user model.User, transaction model.Transaction) (newTransaction model.Transaction, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.performSharedWalletTransferOut")
+	defer func() { span.End(err) }()
+
+	// Validate requested password matches with User's password
+	if err := uc.verifyTransactionPassword(user, transaction.Password); err != nil {
+		return model.Transaction{}, err
+	}
+
+	// SharedWallet only holds IDR
+	if transaction.Currency != model.CurrencyIDR {
+		return model.Transaction{}, errors.New("shared wallet only supports IDR")
+	}
+
+	// Validate User is a member allowed to transfer the amount, before looking up the Recipient
+	wallet, err := uc.getSharedWallet(ctx, user.ID, transaction.SharedWalletID)
+	if err != nil {
+		return model.Transaction{}, err
+	}
+	if err := canSpend(wallet.Member, transaction.Amount); err != nil {
+		return model.Transaction{}, err
+	}
+
+	// Validate SharedWallet has balance to cover transaction and its fee
+	if transaction.Fee, err = uc.calculateFee(ctx, transaction); err != nil {
+		return model.Transaction{}, err
+	}
+	if wallet.Balance < transaction.Amount+transaction.Fee {
+		return model.Transaction{}, errors.New("balance not enough")
+	}
+
+	// Recipient may be requested with the token of a lookup or its phone number instead of its ID
+	if transaction.RecipientID, err = uc.resolveRecipient(ctx, transaction); err != nil {
+		return model.Transaction{}, err
+	}
+	if transaction.RecipientID == 0 {
+		return model.Transaction{}, errors.New("must specify recipient for TransferOut")
+	}
+
+	// Validate that Recipient exists, it may be the member itself
+	recipient, err := uc.Repository.GetUser(ctx, transaction.RecipientID)
+	if err != nil {
+		return model.Transaction{}, err
+	}
+
+	// Perform the following in a single DB transaction to ensure atomicity of all TransferOut operations
+	if err = utils.WithDbTxRetry(ctx, uc.Repository, func(ctx context.Context) error {
+		// 1. Lock member, Recipient and owner data in ID order, then the SharedWallet, like every transfer of the SharedWallet
+		lockedUsers, err := uc.lockUsers(ctx, user.ID, recipient.ID, wallet.OwnerID)
+		if err != nil {
+			return err
+		}
+		lockedWallet, err := uc.Repository.LockSharedWallet(ctx, wallet.ID)
+		if err != nil {
+			return err
+		}
+
+		// 2. Validate member can send and Recipient can receive, the SharedWallet is frozen or closed along with its owner
+		if err := canSend(lockedUsers[user.ID]); err != nil {
+			return err
+		}
+		if err := canSend(lockedUsers[wallet.OwnerID]); err != nil {
+			return err
+		}
+		if err := uc.canReceive(lockedUsers[recipient.ID]); err != nil {
+			return err
+		}
+
+		// 3. Validate member's role and limits again now that the owner can not change them, including the amount spent this month
+		member, err := uc.getSharedWalletMember(ctx, wallet.ID, user.ID)
+		if err != nil {
+			return err
+		}
+		if err := canSpend(member, transaction.Amount); err != nil {
+			return err
+		}
+		if member.Role == model.SharedWalletRoleSpender && member.MonthlyLimit > 0 && member.MonthlySpent+transaction.Amount > member.MonthlyLimit {
+			return ErrSharedWalletMonthlyLimitExceeded
+		}
+
+		// 4. Validate SharedWallet's balance again now that it can not change, along with the fee recalculated under member's lock
+		if transaction.Fee, err = uc.calculateFee(ctx, transaction); err != nil {
+			return err
+		}
+		if lockedWallet.Balance < transaction.Amount+transaction.Fee {
+			return errors.New("balance not enough")
+		}
+
+		// 5. Subtract SharedWallet's balance, including the fee
+		if err := uc.Repository.UpdateSharedWalletBalance(ctx, wallet.ID, user.ID, model.UpdateBalanceRequest{
+			Amount: transaction.Amount + transaction.Fee,
+			Type:   model.UpdateBalanceDecrement,
+		}); err != nil {
+			return err
+		}
+
+		// 6. Increment Recipient's balance
+		if err := uc.Repository.UpdateUser(ctx, model.UpdateUserRequest{
+			UserID: recipient.ID,
+			Balance: model.UpdateBalanceRequest{
+				Amount: transaction.Amount,
+				Type:   model.UpdateBalanceIncrement,
+			},
+		}); err != nil {
+			return err
+		}
+
+		// 7. Post the fee to house revenue account
+		if err := uc.collectFee(ctx, transaction, model.UpdateBalanceIncrement); err != nil {
+			return err
+		}
+
+		// 8. Insert a Successful Transaction record
+		transaction.Status = model.TransactionStatusSuccessful
+		transaction.Password = ""
+		if transaction.ID, err = uc.Repository.InsertTransaction(ctx, transaction); err != nil {
+			return err
+		}
+		return nil
+	}); err != nil {
+		// Insert a Failed transaction record if failed, even if the request is cancelled
+		transaction.Status = model.TransactionStatusFailed
+		transaction.Password = ""
+
+		uc.Repository.InsertTransaction(context.WithoutCancel(ctx), transaction)
+
+		return model.Transaction{}, err
+	}
+
+	return transaction, err
+}
+
+// performSharedWalletDeposit moves money from User's balance into a SharedWallet User is a member of, whatever its role. It is free.
+func (uc *Usecase) performSharedWalletDeposit(ctx context.Context, user model.User, transaction model.Transaction) (newTransaction model.Transaction, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.performSharedWalletDeposit")
+	defer func() { span.End(err) }()
+
+	// Validate requested password matches with User's password
+	if err := uc.verifyTransactionPassword(user, transaction.Password); err != nil {
+		return model.Transaction{}, err
+	}
+
+	// Validate User is a member of the SharedWallet
+	wallet, err := uc.getSharedWallet(ctx, user.ID, transaction.SharedWalletID)
+	if err != nil {
+		return model.Transaction{}, err
+	}
+
+	// Money stays with User, see performWithdrawal
+	transaction.RecipientID = user.ID
+	transaction.Password = ""
+
+	// Perform the following in a single DB transaction to ensure atomicity of all SharedWalletDeposit operations
+	if err = utils.WithDbTxRetry(ctx, uc.Repository, func(ctx context.Context) error {
+		// 1. Lock member and owner data in ID order, then the SharedWallet, like every transfer of the SharedWallet
+		lockedUsers, err := uc.lockUsers(ctx, user.ID, wallet.OwnerID)
+		if err != nil {
+			return err
+		}
+		if _, err := uc.Repository.LockSharedWallet(ctx, wallet.ID); err != nil {
+			return err
+		}
+
+		// 2. Validate member can send, and the SharedWallet is not frozen or closed along with its owner
+		if err := canSend(lockedUsers[user.ID]); err != nil {
+			return err
+		}
+		if err := canSend(lockedUsers[wallet.OwnerID]); err != nil {
+			return err
+		}
+
+		// 3. Validate User is still a member and has balance to cover the transaction now that neither can change
+		if _, err := uc.getSharedWalletMember(ctx, wallet.ID, user.ID); err != nil {
+			return err
+		}
+		if lockedUsers[user.ID].Balance < transaction.Amount {
+			return errors.New("balance not enough")
+		}
+
+		// 4. Subtract User's balance
+		if err := uc.Repository.UpdateUser(ctx, model.UpdateUserRequest{
+			UserID: user.ID,
+			Balance: model.UpdateBalanceRequest{
+				Amount: transaction.Amount,
+				Type:   model.UpdateBalanceDecrement,
+			},
+		}); err != nil {
+			return err
+		}
+
+		// 5. Increment SharedWallet's balance
+		if err := uc.Repository.UpdateSharedWalletBalance(ctx, wallet.ID, user.ID, model.UpdateBalanceRequest{
+			Amount: transaction.Amount,
+			Type:   model.UpdateBalanceIncrement,
+		}); err != nil {
+			return err
+		}
+
+		// 6. Insert a Successful Transaction record
+		transaction.Status = model.TransactionStatusSuccessful
+		if transaction.ID, err = uc.Repository.InsertTransaction(ctx, transaction); err != nil {
+			return err
+		}
+		return nil
+	}); err != nil {
+		// Insert a Failed transaction record if failed, even if the request is cancelled
+		transaction.Status = model.TransactionStatusFailed
+
+		uc.Repository.InsertTransaction(context.WithoutCancel(ctx), transaction)
+
+		return model.Transaction{}, err
+	}
+
+	return transaction, err
+}
+
+// checkClosingUserSharedWallets validates the SharedWallets owned by a User being closed are empty, they are not swept as their money
+// is shared with their members
+func (uc *Usecase) checkClosingUserSharedWallets(ctx context.Context, user model.User) error {
+	wallets, err := uc.Repository.GetSharedWallets(ctx, model.SharedWalletFilter{UserID: user.ID})
+	if err != nil {
+		return err
+	}
+
+	for _, wallet := range wallets {
+		if wallet.OwnerID == user.ID && wallet.Balance > 0 {
+			return ErrClosureSharedWalletNotEmpty
+		}
+	}
+
+	return nil
+}
+
+// withOwnedSharedWallet runs fn in a DB transaction holding the lock of the SharedWallet, once validated User owns it.
+// Members are changed under the lock, so that transfers validate the role and limits in effect when they are made.
+func (uc *Usecase) withOwnedSharedWallet(ctx context.Context, ownerID, sharedWalletID int64, fn func(ctx context.Context) error) error {
+	return utils.WithDbTx(ctx, uc.Repository, func(ctx context.Context) error {
+		// 1. Lock the SharedWallet
+		if _, err := uc.Repository.LockSharedWallet(ctx, sharedWalletID); err != nil {
+			return err
+		}
+
+		// 2. Validate User owns it
+		if _, err := uc.getOwnedSharedWallet(ctx, ownerID, sharedWalletID); err != nil {
+			return err
+		}
+
+		// 3. Change its members
+		return fn(ctx)
+	})
+}
+
+// getSharedWallet returns the SharedWallet with User's membership, repository.ErrSharedWalletNotFound if User is not a member
+func (uc *Usecase) getSharedWallet(ctx context.Context, userID, sharedWalletID int64) (model.SharedWallet, error) {
+	wallets, err := uc.Repository.GetSharedWallets(ctx, model.SharedWalletFilter{SharedWalletID: sharedWalletID, UserID: userID})
+	if err != nil {
+		return model.SharedWallet{}, err
+	}
+	if len(wallets) == 0 {
+		return model.SharedWallet{}, repository.ErrSharedWalletNotFound
+	}
+
+	return wallets[0], nil
+}
+
+// getOwnedSharedWallet returns the SharedWallet, ErrSharedWalletRoleNotAllowed if User is a member but not its owner
+func (uc *Usecase) getOwnedSharedWallet(ctx context.Context, ownerID, sharedWalletID int64) (model.SharedWallet, error) {
+	wallet, err := uc.getSharedWallet(ctx, ownerID, sharedWalletID)
+	if err != nil {
+		return model.SharedWallet{}, err
+	}
+	if wallet.Member.Role != model.SharedWalletRoleOwner {
+		return model.SharedWallet{}, ErrSharedWalletRoleNotAllowed
+	}
+
+	return wallet, nil
+}
+
+// getSharedWalletMember returns the member with its masked name and amount spent this month,
+// repository.ErrSharedWalletMemberNotFound if User is not a member
+func (uc *Usecase) getSharedWalletMember(ctx context.Context, sharedWalletID, userID int64) (model.SharedWalletMember, error) {
+	members, err := uc.Repository.GetSharedWalletMembers(ctx, model.SharedWalletMemberFilter{SharedWalletID: sharedWalletID, UserID: userID})
+	if err != nil {
+		return model.SharedWalletMember{}, err
+	}
+	if len(members) == 0 {
+		return model.SharedWalletMember{}, repository.ErrSharedWalletMemberNotFound
+	}
+
+	member := members[0]
+	member.FullName = maskName(member.FullName)
+	if member.MonthlySpent, err = uc.monthlySpent(ctx, sharedWalletID, userID); err != nil {
+		return model.SharedWalletMember{}, err
+	}
+	return member, nil
+}
+
+// monthlySpent returns the amount of successful TransferOut of the member out of the SharedWallet this month, fees excluded
+func (uc *Usecase) monthlySpent(ctx context.Context, sharedWalletID, userID int64) (float32, error) {
+	now := time.Now()
+	return uc.Repository.SumTransactions(ctx, model.TransactionFilter{
+		UserID:          userID,
+		SharedWalletID:  sharedWalletID,
+		Type:            model.TransactionTypeTransferOut,
+		Status:          model.TransactionStatusSuccessful,
+		CreatedTimeFrom: time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location()),
+	})
+}
+
+// canSpend validates the member's role allows transferring the amount out of the SharedWallet, the monthly limit is validated under lock
+func canSpend(member model.SharedWalletMember, amount float32) error {
+	switch member.Role {
+	case model.SharedWalletRoleOwner:
+		return nil
+	case model.SharedWalletRoleSpender:
+		if member.TransactionLimit > 0 && amount > member.TransactionLimit {
+			return ErrSharedWalletTransactionLimitExceeded
+		}
+		return nil
+	default:
+		return ErrSharedWalletRoleNotAllowed
+	}
+}
+
+// validateSharedWalletMember validates the role and limits the owner gives to a member, there is only one owner
+func validateSharedWalletMember(member model.SharedWalletMember) error {
+	if member.Role != model.SharedWalletRoleSpender && member.Role != model.SharedWalletRoleViewer {
+		return errors.New("role should be Spender or Viewer")
+	}
+	if member.TransactionLimit < 0 || member.MonthlyLimit < 0 {
+		return errors.New("invalid limit")
+	}
+
+	return nil
+}
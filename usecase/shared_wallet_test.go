@@ -0,0 +1,322 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/WalletService/model"
+	"github.com/WalletService/repository"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+)
+
+func Test_performSharedWalletTransferOut(t *testing.T) {
+	var (
+		transactionID = convertToUUID("3d6e668f-ad02-40ff-8540-90c1528a7c88")
+
+		user = model.User{
+			ID:          1234,
+			FullName:    "User",
+			PhoneNumber: "+628123456789",
+			Password:    "$2a$12$35ELZtgOq3iFR6awq.jsDuV5Dr.0XU5k7iUQuShfeLTWRHGFr//fq",
+		}
+		transaction = model.Transaction{
+			UserID:         1234,
+			Amount:         50000,
+			RecipientID:    6789,
+			Type:           model.TransactionTypeTransferOut,
+			Currency:       model.CurrencyIDR,
+			SharedWalletID: 5,
+			Password:       "Admin1234!",
+		}
+		spender = model.SharedWalletMember{
+			SharedWalletID: 5, UserID: 1234, Role: model.SharedWalletRoleSpender, TransactionLimit: 100000, MonthlyLimit: 300000,
+		}
+
+		getSharedWallet = func(m *repository.MockRepositoryInterface, member model.SharedWalletMember) {
+			m.EXPECT().GetSharedWallets(gomock.Any(), model.SharedWalletFilter{SharedWalletID: 5, UserID: 1234}).Return([]model.SharedWallet{
+				{ID: 5, OwnerID: 99, Name: "Family", Balance: 1000000, Member: member},
+			}, nil).Times(1)
+		}
+		lockSharedWallet = func(m *repository.MockRepositoryInterface, member model.SharedWalletMember, spent float32) {
+			m.EXPECT().GetUser(gomock.Any(), int64(6789)).Return(model.User{ID: 6789}, nil).Times(1)
+			m.EXPECT().LockUsers(gomock.Any(), []int64{1234, 6789, 99}).Return([]model.User{{ID: 99}, {ID: 1234}, {ID: 6789}}, nil).Times(1)
+			m.EXPECT().LockSharedWallet(gomock.Any(), int64(5)).Return(model.SharedWallet{ID: 5, OwnerID: 99, Balance: 1000000}, nil).Times(1)
+			m.EXPECT().GetSharedWalletMembers(gomock.Any(), model.SharedWalletMemberFilter{SharedWalletID: 5, UserID: 1234}).Return([]model.SharedWalletMember{member}, nil).Times(1)
+			m.EXPECT().SumTransactions(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, filter model.TransactionFilter) (float32, error) {
+				if filter.UserID != 1234 || filter.SharedWalletID != 5 || filter.Type != model.TransactionTypeTransferOut || filter.CreatedTimeFrom.Day() != 1 {
+					t.Errorf("SumTransactions() filter = %+v, want TransferOut of member 1234 out of shared wallet 5 this month", filter)
+				}
+				return spent, nil
+			}).Times(1)
+		}
+	)
+
+	tests := []struct {
+		name              string
+		inputTransaction  model.Transaction
+		mockRepository    func(controller *gomock.Controller) *repository.MockRepositoryInterface
+		wantTransactionID uuid.UUID
+		wantErr           error
+	}{
+		{
+			name:             "success-spender-within-limits",
+			inputTransaction: transaction,
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockProfileDbTx(ctrl, m, true)
+
+				getSharedWallet(m, spender)
+				lockSharedWallet(m, spender, 250000)
+				m.EXPECT().UpdateSharedWalletBalance(gomock.Any(), int64(5), int64(1234), model.UpdateBalanceRequest{
+					Amount: 50000, Type: model.UpdateBalanceDecrement,
+				}).Return(nil).Times(1)
+				m.EXPECT().UpdateUser(gomock.Any(), model.UpdateUserRequest{
+					UserID:  6789,
+					Balance: model.UpdateBalanceRequest{Amount: 50000, Type: model.UpdateBalanceIncrement},
+				}).Return(nil).Times(1)
+				m.EXPECT().InsertTransaction(gomock.Any(), model.Transaction{
+					UserID:         1234,
+					Amount:         50000,
+					RecipientID:    6789,
+					Type:           model.TransactionTypeTransferOut,
+					Status:         model.TransactionStatusSuccessful,
+					Currency:       model.CurrencyIDR,
+					SharedWalletID: 5,
+				}).Return(transactionID, nil).Times(1)
+
+				return m
+			},
+			wantTransactionID: transactionID,
+		},
+		{
+			name:             "failed-monthly-limit-exceeded-should-rollback",
+			inputTransaction: transaction,
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockProfileDbTx(ctrl, m, false)
+
+				getSharedWallet(m, spender)
+				lockSharedWallet(m, spender, 250001)
+				m.EXPECT().InsertTransaction(gomock.Any(), model.Transaction{
+					UserID:         1234,
+					Amount:         50000,
+					RecipientID:    6789,
+					Type:           model.TransactionTypeTransferOut,
+					Status:         model.TransactionStatusFailed,
+					Currency:       model.CurrencyIDR,
+					SharedWalletID: 5,
+				}).Return(uuid.Nil, nil).Times(1)
+
+				return m
+			},
+			wantTransactionID: uuid.Nil,
+			wantErr:           ErrSharedWalletMonthlyLimitExceeded,
+		},
+		{
+			name:             "failed-transaction-limit-exceeded",
+			inputTransaction: transaction,
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				member := spender
+				member.TransactionLimit = 49999
+				getSharedWallet(m, member)
+				return m
+			},
+			wantTransactionID: uuid.Nil,
+			wantErr:           ErrSharedWalletTransactionLimitExceeded,
+		},
+		{
+			name:             "failed-viewer",
+			inputTransaction: transaction,
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				getSharedWallet(m, model.SharedWalletMember{SharedWalletID: 5, UserID: 1234, Role: model.SharedWalletRoleViewer})
+				return m
+			},
+			wantTransactionID: uuid.Nil,
+			wantErr:           ErrSharedWalletRoleNotAllowed,
+		},
+		{
+			name:             "failed-not-member",
+			inputTransaction: transaction,
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				m.EXPECT().GetSharedWallets(gomock.Any(), model.SharedWalletFilter{SharedWalletID: 5, UserID: 1234}).Return(nil, nil).Times(1)
+				return m
+			},
+			wantTransactionID: uuid.Nil,
+			wantErr:           repository.ErrSharedWalletNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			usecase := &Usecase{
+				Repository: test.mockRepository(controller),
+			}
+
+			gotTransaction, gotErr := usecase.performSharedWalletTransferOut(context.Background(), user, test.inputTransaction)
+			if gotTransaction.ID != test.wantTransactionID {
+				t.Errorf("usecase.performSharedWalletTransferOut() gotTransactionID = %v, wantTransactionID %v", gotTransaction.ID, test.wantTransactionID)
+				return
+			}
+			if !errors.Is(gotErr, test.wantErr) {
+				t.Errorf("usecase.performSharedWalletTransferOut() gotErr = %v, wantErr %v", gotErr, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestAddSharedWalletMember(t *testing.T) {
+	var (
+		token       = uuid.New()
+		createdTime = time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+		request     = model.SharedWalletMemberRequest{
+			SharedWalletID: 5, OwnerID: 99, Token: token, Role: model.SharedWalletRoleSpender, TransactionLimit: 100000,
+		}
+
+		getSharedWallet = func(m *repository.MockRepositoryInterface, role model.SharedWalletRole) {
+			m.EXPECT().GetSharedWallets(gomock.Any(), model.SharedWalletFilter{SharedWalletID: 5, UserID: 99}).Return([]model.SharedWallet{
+				{ID: 5, OwnerID: 99, Member: model.SharedWalletMember{SharedWalletID: 5, UserID: 99, Role: role}},
+			}, nil).Times(1)
+		}
+		lookup = func(m *repository.MockRepositoryInterface, recipientID int64) {
+			m.EXPECT().GetRecipientLookup(gomock.Any(), token).Return(model.RecipientLookup{
+				ID: token, UserID: 99, RecipientID: recipientID, ExpiryTime: time.Now().Add(time.Minute),
+			}, nil).Times(1)
+		}
+	)
+
+	tests := []struct {
+		name           string
+		input          model.SharedWalletMemberRequest
+		mockRepository func(controller *gomock.Controller) *repository.MockRepositoryInterface
+		wantMember     model.SharedWalletMember
+		wantErr        error
+	}{
+		{
+			name:  "success",
+			input: request,
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				getSharedWallet(m, model.SharedWalletRoleOwner)
+				lookup(m, 1234)
+				m.EXPECT().GetUser(gomock.Any(), int64(1234)).Return(model.User{ID: 1234, Status: model.UserStatusActive}, nil).Times(1)
+
+				mockProfileDbTx(ctrl, m, true)
+				m.EXPECT().LockSharedWallet(gomock.Any(), int64(5)).Return(model.SharedWallet{ID: 5, OwnerID: 99}, nil).Times(1)
+				getSharedWallet(m, model.SharedWalletRoleOwner)
+				m.EXPECT().InsertSharedWalletMember(gomock.Any(), model.SharedWalletMember{
+					SharedWalletID: 5, UserID: 1234, Role: model.SharedWalletRoleSpender, TransactionLimit: 100000,
+				}).Return(nil).Times(1)
+
+				m.EXPECT().GetSharedWalletMembers(gomock.Any(), model.SharedWalletMemberFilter{SharedWalletID: 5, UserID: 1234}).Return([]model.SharedWalletMember{
+					{SharedWalletID: 5, UserID: 1234, Role: model.SharedWalletRoleSpender, TransactionLimit: 100000, FullName: "John Doe", CreatedTime: createdTime},
+				}, nil).Times(1)
+				m.EXPECT().SumTransactions(gomock.Any(), gomock.Any()).Return(float32(0), nil).Times(1)
+
+				return m
+			},
+			wantMember: model.SharedWalletMember{
+				SharedWalletID: 5, UserID: 1234, Role: model.SharedWalletRoleSpender, TransactionLimit: 100000, FullName: "Jo** Do*", CreatedTime: createdTime,
+			},
+		},
+		{
+			name:  "failed-not-owner",
+			input: request,
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				getSharedWallet(m, model.SharedWalletRoleSpender)
+				return m
+			},
+			wantErr: ErrSharedWalletRoleNotAllowed,
+		},
+		{
+			name:  "failed-owner-role",
+			input: model.SharedWalletMemberRequest{SharedWalletID: 5, OwnerID: 99, Token: token, Role: model.SharedWalletRoleOwner},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				return repository.NewMockRepositoryInterface(ctrl)
+			},
+			wantErr: errors.New("role should be Spender or Viewer"),
+		},
+		{
+			name:  "failed-owner-self",
+			input: request,
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				getSharedWallet(m, model.SharedWalletRoleOwner)
+				lookup(m, 99)
+				return m
+			},
+			wantErr: ErrSharedWalletOwnerMember,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			usecase := &Usecase{
+				Repository: test.mockRepository(controller),
+			}
+
+			gotMember, gotErr := usecase.AddSharedWalletMember(context.Background(), test.input)
+			if (gotErr == nil) != (test.wantErr == nil) || (gotErr != nil && gotErr.Error() != test.wantErr.Error()) {
+				t.Errorf("usecase.AddSharedWalletMember() gotErr = %v, wantErr %v", gotErr, test.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotMember, test.wantMember) {
+				t.Errorf("usecase.AddSharedWalletMember() gotMember = %v, wantMember %v", gotMember, test.wantMember)
+			}
+		})
+	}
+}
+
+func TestGetSharedWalletTransactions(t *testing.T) {
+	tests := []struct {
+		name       string
+		role       model.SharedWalletRole
+		wantFilter model.TransactionFilter
+	}{
+		{
+			name:       "owner-sees-every-member",
+			role:       model.SharedWalletRoleOwner,
+			wantFilter: model.TransactionFilter{SharedWalletID: 5, Limit: 20},
+		},
+		{
+			name:       "viewer-sees-every-member",
+			role:       model.SharedWalletRoleViewer,
+			wantFilter: model.TransactionFilter{SharedWalletID: 5, Limit: 20},
+		},
+		{
+			name:       "spender-sees-its-own",
+			role:       model.SharedWalletRoleSpender,
+			wantFilter: model.TransactionFilter{UserID: 1234, SharedWalletID: 5, Limit: 20},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			m := repository.NewMockRepositoryInterface(controller)
+			m.EXPECT().GetSharedWallets(gomock.Any(), model.SharedWalletFilter{SharedWalletID: 5, UserID: 1234}).Return([]model.SharedWallet{
+				{ID: 5, OwnerID: 99, Member: model.SharedWalletMember{SharedWalletID: 5, UserID: 1234, Role: test.role}},
+			}, nil).Times(1)
+			m.EXPECT().GetTransactions(gomock.Any(), test.wantFilter).Return(nil, nil).Times(1)
+
+			usecase := &Usecase{
+				Repository: m,
+			}
+
+			if _, err := usecase.GetSharedWalletTransactions(context.Background(), 1234, model.TransactionFilter{UserID: 1234, SharedWalletID: 5, Limit: 20}); err != nil {
+				t.Errorf("usecase.GetSharedWalletTransactions() error = %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,240 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/WalletService/model"
+	"github.com/WalletService/tracing"
+	"github.com/WalletService/utils"
+)
+
+var (
+	ErrUserFrozen               = errors.New("account is frozen")
+	ErrUserClosed               = errors.New("account is closed")
+	ErrRecipientCannotReceive   = errors.New("recipient account can not receive")
+	ErrUserStatusUnchanged      = errors.New("account already has this status")
+	ErrUserStatusOwn            = errors.New("cannot change own account status")
+	ErrUserStatusReasonRequired = errors.New("reason is required to change account status")
+	ErrClosureBalanceNotZero    = errors.New("account balance must be zero to close it")
+	ErrClosurePendingWithdrawal = errors.New("account has a pending withdrawal")
+	ErrClosurePendingTopUp      = errors.New("account has a pending top-up")
+)
+
+// canSend validates User can send money out of its balance, User is expected to be locked by lockUsers.
+// Users created before account status are Active.
+func canSend(user model.User) error {
+	switch user.Status {
+	case model.UserStatusFrozen:
+		return ErrUserFrozen
+	case model.UserStatusClosed:
+		return ErrUserClosed
+	default:
+		return nil
+	}
+}
+
+// canReceive validates User can receive money from other Users, Frozen Users only can if FrozenCanReceive
+func (uc *Usecase) canReceive(user model.User) error {
+	if user.Status == model.UserStatusClosed || user.Status == model.UserStatusFrozen && !uc.FrozenCanReceive {
+		return ErrRecipientCannotReceive
+	}
+	return nil
+}
+
+// ChangeUserStatus freezes, unfreezes or closes User's account on behalf of an admin, recording the transition with its reason.
+// Closing is final. The account must have no balance left, unless ClosureSweepUserID is set to receive it.
+func (uc *Usecase) ChangeUserStatus(ctx context.Context, request model.UserStatusChange) (change model.UserStatusChange, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.ChangeUserStatus",
+		tracing.Int64("user_id", request.UserID),
+		tracing.Int64("actor_id", request.ActorID),
+		tracing.String("user.status", string(request.ToStatus)),
+	)
+	defer func() { span.End(err) }()
+
+	switch request.ToStatus {
+	case model.UserStatusActive, model.UserStatusFrozen, model.UserStatusClosed:
+	default:
+		return model.UserStatusChange{}, errors.New("unknown account status")
+	}
+	if request.Reason == "" {
+		return model.UserStatusChange{}, ErrUserStatusReasonRequired
+	}
+	if request.UserID == request.ActorID {
+		return model.UserStatusChange{}, ErrUserStatusOwn
+	}
+
+	// Remaining balance is swept to the designated account, if any, when closing
+	userIDs := []int64{request.UserID}
+	sweep := request.ToStatus == model.UserStatusClosed && uc.ClosureSweepUserID != 0 && uc.ClosureSweepUserID != request.UserID
+	if sweep {
+		userIDs = append(userIDs, uc.ClosureSweepUserID)
+	}
+
+	var sweeps []model.Transaction
+	if err = utils.WithDbTxRetry(ctx, uc.Repository, func(ctx context.Context) error {
+		change, sweeps = request, nil
+
+		// 1. Lock User, and the sweep account when closing, to prevent race condition with Transactions
+		lockedUsers, err := uc.lockUsers(ctx, userIDs...)
+		if err != nil {
+			return err
+		}
+		user := lockedUsers[request.UserID]
+
+		// 2. Validate the transition, a Closed account is never reopened
+		change.FromStatus = user.Status
+		if change.FromStatus == "" {
+			change.FromStatus = model.UserStatusActive
+		}
+		if change.FromStatus == model.UserStatusClosed {
+			return ErrUserClosed
+		}
+		if change.FromStatus == change.ToStatus {
+			return ErrUserStatusUnchanged
+		}
+
+		// 3. Empty the account when closing, or refuse to close it
+		if change.ToStatus == model.UserStatusClosed {
+			if sweeps, err = uc.sweepClosingUser(ctx, user, lockedUsers[uc.ClosureSweepUserID], sweep, request); err != nil {
+				return err
+			}
+		}
+
+		// 4. Update User's status
+		if err := uc.Repository.UpdateUser(ctx, model.UpdateUserRequest{
+			UserID: user.ID,
+			Status: change.ToStatus,
+		}); err != nil {
+			return err
+		}
+
+		// 5. Record the transition in the audit trail
+		if change.ID, err = uc.Repository.InsertUserStatusChange(ctx, change); err != nil {
+			return err
+		}
+
+		// 6. Record the admin action in the audit log
+		_, err = uc.Repository.InsertAuditLog(ctx, model.AuditLogEntry{
+			Action:  model.AuditActionUserStatusChange,
+			UserID:  change.UserID,
+			ActorID: change.ActorID,
+			Details: map[string]string{
+				"status_change_id": strconv.FormatInt(change.ID, 10),
+				"from_status":      string(change.FromStatus),
+				"to_status":        string(change.ToStatus),
+				"reason":           change.Reason,
+			},
+		})
+		return err
+	}); err != nil {
+		return model.UserStatusChange{}, err
+	}
+
+	for _, transaction := range sweeps {
+		recordTransaction(transaction, transaction.Status)
+		auditTransaction(ctx, transaction, transaction.Status, nil)
+	}
+	auditUserStatusChange(ctx, change)
+
+	return change, nil
+}
+
+// sweepClosingUser moves every remaining balance of a User being closed to the sweep account, as TransferOut Transactions made by the admin,
+// Pockets included.
+// Both Users are expected to be locked by lockUsers. Without a sweep account, closing fails if any balance remains, and it always fails
+// while a SharedWallet owned by User has balance.
+func (uc *Usecase) sweepClosingUser(ctx context.Context, user, sweepUser model.User, sweep bool, request model.UserStatusChange) (sweeps []model.Transaction, err error) {
+	// Refund of a failed withdrawal would credit the account after it is closed
+	pending, err := uc.Repository.CountTransactions(ctx, model.TransactionFilter{
+		UserID: user.ID,
+		Type:   model.TransactionTypeWithdrawal,
+		Status: model.TransactionStatusPending,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if pending > 0 {
+		return nil, ErrClosurePendingWithdrawal
+	}
+
+	// Virtual account issued for a top-up can still be paid, the payment could not be credited once closed
+	pending, err = uc.Repository.CountPendingTopUpIntents(ctx, user.ID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if pending > 0 {
+		return nil, ErrClosurePendingTopUp
+	}
+
+	// Money of shared wallets belongs to their members too, so it is never swept
+	if err := uc.checkClosingUserSharedWallets(ctx, user); err != nil {
+		return nil, err
+	}
+
+	// Money set aside in Pockets is moved back to the main balance first, so that it is swept with it
+	user, sweeps, err = uc.emptyClosingUserPockets(ctx, user, sweep, request)
+	if err != nil {
+		return nil, err
+	}
+
+	balances, err := uc.getUserBalances(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, balance := range balances {
+		if balance.Amount <= 0 {
+			continue
+		}
+		if !sweep {
+			return nil, ErrClosureBalanceNotZero
+		}
+		if err := uc.canReceive(sweepUser); err != nil {
+			return nil, err
+		}
+
+		transaction := model.Transaction{
+			UserID:      user.ID,
+			Amount:      balance.Amount,
+			Type:        model.TransactionTypeTransferOut,
+			RecipientID: sweepUser.ID,
+			Status:      model.TransactionStatusSuccessful,
+			Description: fmt.Sprintf("Balance swept on account closure: %s", request.Reason),
+			Currency:    balance.Currency,
+			ActorID:     request.ActorID,
+		}
+
+		if err := uc.Repository.UpdateUser(ctx, model.UpdateUserRequest{
+			UserID: user.ID,
+			Balance: model.UpdateBalanceRequest{
+				Amount:   balance.Amount,
+				Type:     model.UpdateBalanceDecrement,
+				Currency: balance.Currency,
+			},
+		}); err != nil {
+			return nil, err
+		}
+
+		if err := uc.Repository.UpdateUser(ctx, model.UpdateUserRequest{
+			UserID: sweepUser.ID,
+			Balance: model.UpdateBalanceRequest{
+				Amount:   balance.Amount,
+				Type:     model.UpdateBalanceIncrement,
+				Currency: balance.Currency,
+			},
+		}); err != nil {
+			return nil, err
+		}
+
+		if transaction.ID, err = uc.Repository.InsertTransaction(ctx, transaction); err != nil {
+			return nil, err
+		}
+		sweeps = append(sweeps, transaction)
+	}
+
+	return sweeps, nil
+}
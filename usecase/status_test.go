@@ -0,0 +1,365 @@
+package usecase
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/WalletService/model"
+	"github.com/WalletService/repository"
+	gomock "github.com/golang/mock/gomock"
+)
+
+func TestChangeUserStatus(t *testing.T) {
+	transactionID := convertToUUID("3d6e668f-ad02-40ff-8540-90c1528a7c88")
+
+	mockDbTx := func(ctrl *gomock.Controller, m *repository.MockRepositoryInterface, commit bool) {
+		sqlDb := repository.NewMockSqlDbInterface(ctrl)
+		sqlTx := repository.NewMockSqlTxInterface(ctrl)
+
+		m.EXPECT().GetSqlDb().Return(sqlDb, nil).Times(1)
+		sqlDb.EXPECT().BeginTx(gomock.Any(), gomock.Nil()).Return(sqlTx, nil).Times(1)
+		if commit {
+			sqlTx.EXPECT().Commit().Return(nil).Times(1)
+		} else {
+			sqlTx.EXPECT().Rollback().Return(nil).Times(1)
+		}
+	}
+	pendingWithdrawals := model.TransactionFilter{
+		UserID: 1234,
+		Type:   model.TransactionTypeWithdrawal,
+		Status: model.TransactionStatusPending,
+	}
+
+	tests := []struct {
+		name               string
+		closureSweepUserID int64
+		input              model.UserStatusChange
+		mockRepository     func(controller *gomock.Controller) *repository.MockRepositoryInterface
+		wantChange         model.UserStatusChange
+		wantErr            error
+	}{
+		{
+			name:  "success-freeze",
+			input: model.UserStatusChange{UserID: 1234, ActorID: 1, ToStatus: model.UserStatusFrozen, Reason: "Reported stolen phone"},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockDbTx(ctrl, m, true)
+
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234}).Return([]model.User{{ID: 1234, Balance: 5000, Status: model.UserStatusActive}}, nil).Times(1)
+				m.EXPECT().UpdateUser(gomock.Any(), model.UpdateUserRequest{UserID: 1234, Status: model.UserStatusFrozen}).Return(nil).Times(1)
+				m.EXPECT().InsertUserStatusChange(gomock.Any(), model.UserStatusChange{
+					UserID:     1234,
+					ActorID:    1,
+					FromStatus: model.UserStatusActive,
+					ToStatus:   model.UserStatusFrozen,
+					Reason:     "Reported stolen phone",
+				}).Return(int64(7), nil).Times(1)
+				m.EXPECT().InsertAuditLog(gomock.Any(), model.AuditLogEntry{
+					Action:  model.AuditActionUserStatusChange,
+					UserID:  1234,
+					ActorID: 1,
+					Details: map[string]string{
+						"status_change_id": "7",
+						"from_status":      "Active",
+						"to_status":        "Frozen",
+						"reason":           "Reported stolen phone",
+					},
+				}).Return(model.AuditLogEntry{ID: 1}, nil).Times(1)
+
+				return m
+			},
+			wantChange: model.UserStatusChange{
+				ID:         7,
+				UserID:     1234,
+				ActorID:    1,
+				FromStatus: model.UserStatusActive,
+				ToStatus:   model.UserStatusFrozen,
+				Reason:     "Reported stolen phone",
+			},
+		},
+		{
+			name:               "success-close-sweep-balance",
+			closureSweepUserID: 99,
+			input:              model.UserStatusChange{UserID: 1234, ActorID: 1, ToStatus: model.UserStatusClosed, Reason: "Requested by user"},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockDbTx(ctrl, m, true)
+
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234, 99}).Return([]model.User{
+					{ID: 99},
+					{ID: 1234, Balance: 5000, Status: model.UserStatusFrozen},
+				}, nil).Times(1)
+				m.EXPECT().CountTransactions(gomock.Any(), pendingWithdrawals).Return(0, nil).Times(1)
+				m.EXPECT().CountPendingTopUpIntents(gomock.Any(), int64(1234), gomock.Any()).Return(0, nil).Times(1)
+				m.EXPECT().GetSharedWallets(gomock.Any(), model.SharedWalletFilter{UserID: 1234}).Return(nil, nil).Times(1)
+				m.EXPECT().GetPockets(gomock.Any(), model.PocketFilter{UserID: 1234}).Return([]model.Pocket{{ID: 3, UserID: 1234}}, nil).Times(1)
+				m.EXPECT().GetUserBalances(gomock.Any(), int64(1234)).Return(nil, nil).Times(1)
+				m.EXPECT().UpdateUser(gomock.Any(), model.UpdateUserRequest{
+					UserID:  1234,
+					Balance: model.UpdateBalanceRequest{Amount: 5000, Type: model.UpdateBalanceDecrement, Currency: model.CurrencyIDR},
+				}).Return(nil).Times(1)
+				m.EXPECT().UpdateUser(gomock.Any(), model.UpdateUserRequest{
+					UserID:  99,
+					Balance: model.UpdateBalanceRequest{Amount: 5000, Type: model.UpdateBalanceIncrement, Currency: model.CurrencyIDR},
+				}).Return(nil).Times(1)
+				m.EXPECT().InsertTransaction(gomock.Any(), model.Transaction{
+					UserID:      1234,
+					Amount:      5000,
+					Type:        model.TransactionTypeTransferOut,
+					RecipientID: 99,
+					Status:      model.TransactionStatusSuccessful,
+					Description: "Balance swept on account closure: Requested by user",
+					Currency:    model.CurrencyIDR,
+					ActorID:     1,
+				}).Return(transactionID, nil).Times(1)
+				m.EXPECT().UpdateUser(gomock.Any(), model.UpdateUserRequest{UserID: 1234, Status: model.UserStatusClosed}).Return(nil).Times(1)
+				m.EXPECT().InsertUserStatusChange(gomock.Any(), gomock.Any()).Return(int64(8), nil).Times(1)
+				m.EXPECT().InsertAuditLog(gomock.Any(), gomock.Any()).Return(model.AuditLogEntry{ID: 2}, nil).Times(1)
+
+				return m
+			},
+			wantChange: model.UserStatusChange{
+				ID:         8,
+				UserID:     1234,
+				ActorID:    1,
+				FromStatus: model.UserStatusFrozen,
+				ToStatus:   model.UserStatusClosed,
+				Reason:     "Requested by user",
+			},
+		},
+		{
+			name:               "success-close-sweep-pockets",
+			closureSweepUserID: 99,
+			input:              model.UserStatusChange{UserID: 1234, ActorID: 1, ToStatus: model.UserStatusClosed, Reason: "Requested by user"},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockDbTx(ctrl, m, true)
+
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234, 99}).Return([]model.User{
+					{ID: 99},
+					{ID: 1234, Balance: 5000},
+				}, nil).Times(1)
+				m.EXPECT().CountTransactions(gomock.Any(), pendingWithdrawals).Return(0, nil).Times(1)
+				m.EXPECT().CountPendingTopUpIntents(gomock.Any(), int64(1234), gomock.Any()).Return(0, nil).Times(1)
+				m.EXPECT().GetSharedWallets(gomock.Any(), model.SharedWalletFilter{UserID: 1234}).Return(nil, nil).Times(1)
+				m.EXPECT().GetPockets(gomock.Any(), model.PocketFilter{UserID: 1234}).Return([]model.Pocket{
+					{ID: 3, UserID: 1234, Balance: 2000},
+				}, nil).Times(1)
+				m.EXPECT().UpdatePocketBalance(gomock.Any(), int64(1234), int64(3), model.UpdateBalanceRequest{
+					Amount: 2000, Type: model.UpdateBalanceDecrement,
+				}).Return(nil).Times(1)
+				m.EXPECT().UpdateUser(gomock.Any(), model.UpdateUserRequest{
+					UserID:  1234,
+					Balance: model.UpdateBalanceRequest{Amount: 2000, Type: model.UpdateBalanceIncrement},
+				}).Return(nil).Times(1)
+				m.EXPECT().InsertTransaction(gomock.Any(), model.Transaction{
+					UserID:         1234,
+					Amount:         2000,
+					Type:           model.TransactionTypePocketTransfer,
+					RecipientID:    1234,
+					Status:         model.TransactionStatusSuccessful,
+					Description:    "Pocket emptied on account closure: Requested by user",
+					Currency:       model.CurrencyIDR,
+					SourcePocketID: 3,
+					ActorID:        1,
+				}).Return(transactionID, nil).Times(1)
+				// The main balance is swept with the money of the Pocket
+				m.EXPECT().GetUserBalances(gomock.Any(), int64(1234)).Return(nil, nil).Times(1)
+				m.EXPECT().UpdateUser(gomock.Any(), model.UpdateUserRequest{
+					UserID:  1234,
+					Balance: model.UpdateBalanceRequest{Amount: 7000, Type: model.UpdateBalanceDecrement, Currency: model.CurrencyIDR},
+				}).Return(nil).Times(1)
+				m.EXPECT().UpdateUser(gomock.Any(), model.UpdateUserRequest{
+					UserID:  99,
+					Balance: model.UpdateBalanceRequest{Amount: 7000, Type: model.UpdateBalanceIncrement, Currency: model.CurrencyIDR},
+				}).Return(nil).Times(1)
+				m.EXPECT().InsertTransaction(gomock.Any(), gomock.Any()).Return(transactionID, nil).Times(1)
+				m.EXPECT().UpdateUser(gomock.Any(), model.UpdateUserRequest{UserID: 1234, Status: model.UserStatusClosed}).Return(nil).Times(1)
+				m.EXPECT().InsertUserStatusChange(gomock.Any(), gomock.Any()).Return(int64(8), nil).Times(1)
+				m.EXPECT().InsertAuditLog(gomock.Any(), gomock.Any()).Return(model.AuditLogEntry{ID: 2}, nil).Times(1)
+
+				return m
+			},
+			wantChange: model.UserStatusChange{
+				ID:         8,
+				UserID:     1234,
+				ActorID:    1,
+				FromStatus: model.UserStatusActive,
+				ToStatus:   model.UserStatusClosed,
+				Reason:     "Requested by user",
+			},
+		},
+		{
+			name:  "fail-close-pocket-not-empty",
+			input: model.UserStatusChange{UserID: 1234, ActorID: 1, ToStatus: model.UserStatusClosed, Reason: "Requested by user"},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockDbTx(ctrl, m, false)
+
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234}).Return([]model.User{{ID: 1234}}, nil).Times(1)
+				m.EXPECT().CountTransactions(gomock.Any(), pendingWithdrawals).Return(0, nil).Times(1)
+				m.EXPECT().CountPendingTopUpIntents(gomock.Any(), int64(1234), gomock.Any()).Return(0, nil).Times(1)
+				m.EXPECT().GetSharedWallets(gomock.Any(), model.SharedWalletFilter{UserID: 1234}).Return(nil, nil).Times(1)
+				m.EXPECT().GetPockets(gomock.Any(), model.PocketFilter{UserID: 1234}).Return([]model.Pocket{{ID: 3, UserID: 1234, Balance: 1}}, nil).Times(1)
+
+				return m
+			},
+			wantErr: ErrClosureBalanceNotZero,
+		},
+		{
+			name:               "fail-close-shared-wallet-not-empty",
+			closureSweepUserID: 99,
+			input:              model.UserStatusChange{UserID: 1234, ActorID: 1, ToStatus: model.UserStatusClosed, Reason: "Requested by user"},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockDbTx(ctrl, m, false)
+
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234, 99}).Return([]model.User{{ID: 99}, {ID: 1234}}, nil).Times(1)
+				m.EXPECT().CountTransactions(gomock.Any(), pendingWithdrawals).Return(0, nil).Times(1)
+				m.EXPECT().CountPendingTopUpIntents(gomock.Any(), int64(1234), gomock.Any()).Return(0, nil).Times(1)
+				// Shared wallets User is only a member of do not prevent closing
+				m.EXPECT().GetSharedWallets(gomock.Any(), model.SharedWalletFilter{UserID: 1234}).Return([]model.SharedWallet{
+					{ID: 5, OwnerID: 6789, Balance: 1000},
+					{ID: 7, OwnerID: 1234, Balance: 500},
+				}, nil).Times(1)
+
+				return m
+			},
+			wantErr: ErrClosureSharedWalletNotEmpty,
+		},
+		{
+			name:  "fail-close-balance-not-zero",
+			input: model.UserStatusChange{UserID: 1234, ActorID: 1, ToStatus: model.UserStatusClosed, Reason: "Requested by user"},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockDbTx(ctrl, m, false)
+
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234}).Return([]model.User{{ID: 1234}}, nil).Times(1)
+				m.EXPECT().CountTransactions(gomock.Any(), pendingWithdrawals).Return(0, nil).Times(1)
+				m.EXPECT().CountPendingTopUpIntents(gomock.Any(), int64(1234), gomock.Any()).Return(0, nil).Times(1)
+				m.EXPECT().GetSharedWallets(gomock.Any(), model.SharedWalletFilter{UserID: 1234}).Return(nil, nil).Times(1)
+				m.EXPECT().GetPockets(gomock.Any(), model.PocketFilter{UserID: 1234}).Return([]model.Pocket{{ID: 3, UserID: 1234}}, nil).Times(1)
+				m.EXPECT().GetUserBalances(gomock.Any(), int64(1234)).Return([]model.Balance{{Currency: model.CurrencyUSD, Amount: 10}}, nil).Times(1)
+
+				return m
+			},
+			wantErr: ErrClosureBalanceNotZero,
+		},
+		{
+			name:  "fail-close-pending-withdrawal",
+			input: model.UserStatusChange{UserID: 1234, ActorID: 1, ToStatus: model.UserStatusClosed, Reason: "Requested by user"},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockDbTx(ctrl, m, false)
+
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234}).Return([]model.User{{ID: 1234}}, nil).Times(1)
+				m.EXPECT().CountTransactions(gomock.Any(), pendingWithdrawals).Return(1, nil).Times(1)
+
+				return m
+			},
+			wantErr: ErrClosurePendingWithdrawal,
+		},
+		{
+			name:  "fail-close-pending-topup",
+			input: model.UserStatusChange{UserID: 1234, ActorID: 1, ToStatus: model.UserStatusClosed, Reason: "Requested by user"},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockDbTx(ctrl, m, false)
+
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234}).Return([]model.User{{ID: 1234}}, nil).Times(1)
+				m.EXPECT().CountTransactions(gomock.Any(), pendingWithdrawals).Return(0, nil).Times(1)
+				m.EXPECT().CountPendingTopUpIntents(gomock.Any(), int64(1234), gomock.Any()).Return(1, nil).Times(1)
+
+				return m
+			},
+			wantErr: ErrClosurePendingTopUp,
+		},
+		{
+			name:  "fail-already-closed",
+			input: model.UserStatusChange{UserID: 1234, ActorID: 1, ToStatus: model.UserStatusActive, Reason: "Reopen"},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockDbTx(ctrl, m, false)
+
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234}).Return([]model.User{{ID: 1234, Status: model.UserStatusClosed}}, nil).Times(1)
+
+				return m
+			},
+			wantErr: ErrUserClosed,
+		},
+		{
+			name:  "fail-status-unchanged",
+			input: model.UserStatusChange{UserID: 1234, ActorID: 1, ToStatus: model.UserStatusActive, Reason: "Unfreeze"},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockDbTx(ctrl, m, false)
+
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234}).Return([]model.User{{ID: 1234}}, nil).Times(1)
+
+				return m
+			},
+			wantErr: ErrUserStatusUnchanged,
+		},
+		{
+			name:  "fail-own-status",
+			input: model.UserStatusChange{UserID: 1, ActorID: 1, ToStatus: model.UserStatusFrozen, Reason: "Reported stolen phone"},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				return repository.NewMockRepositoryInterface(ctrl)
+			},
+			wantErr: ErrUserStatusOwn,
+		},
+		{
+			name:  "fail-reason-required",
+			input: model.UserStatusChange{UserID: 1234, ActorID: 1, ToStatus: model.UserStatusFrozen},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				return repository.NewMockRepositoryInterface(ctrl)
+			},
+			wantErr: ErrUserStatusReasonRequired,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			usecase := &Usecase{
+				Repository:         test.mockRepository(controller),
+				ClosureSweepUserID: test.closureSweepUserID,
+			}
+
+			gotChange, gotErr := usecase.ChangeUserStatus(context.Background(), test.input)
+			if !reflect.DeepEqual(gotChange, test.wantChange) {
+				t.Errorf("usecase.ChangeUserStatus() gotChange = %v, wantChange %v", gotChange, test.wantChange)
+			}
+			if gotErr != test.wantErr {
+				t.Errorf("usecase.ChangeUserStatus() gotErr = %v, wantErr %v", gotErr, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestCanReceive(t *testing.T) {
+	tests := []struct {
+		name             string
+		frozenCanReceive bool
+		status           model.UserStatus
+		wantErr          error
+	}{
+		{name: "active", status: model.UserStatusActive},
+		{name: "created-before-status", status: ""},
+		{name: "frozen", status: model.UserStatusFrozen, wantErr: ErrRecipientCannotReceive},
+		{name: "frozen-can-receive", frozenCanReceive: true, status: model.UserStatusFrozen},
+		{name: "closed", frozenCanReceive: true, status: model.UserStatusClosed, wantErr: ErrRecipientCannotReceive},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			usecase := &Usecase{FrozenCanReceive: test.frozenCanReceive}
+
+			if gotErr := usecase.canReceive(model.User{Status: test.status}); gotErr != test.wantErr {
+				t.Errorf("usecase.canReceive() gotErr = %v, wantErr %v", gotErr, test.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/WalletService/model"
+	"github.com/WalletService/payment"
+	"github.com/WalletService/repository"
+	"github.com/WalletService/tracing"
+	"github.com/WalletService/utils"
+	"github.com/google/uuid"
+)
+
+const (
+	topUpIntentExpiry = 24 * time.Hour
+	topUpMinAmount    = 10000 // Smallest amount banks accept into a virtual account
+)
+
+var (
+	ErrTopUpNotFound       = errors.New("top-up not found")
+	ErrTopUpAmountMismatch = errors.New("amount does not match top-up amount")
+	ErrTopUpExpired        = errors.New("top-up is expired")
+	ErrTopUpAlreadyPaid    = errors.New("top-up is already paid by another payment")
+)
+
+// CreateUserTopUpIntent asks the payment gateway for a virtual account that User pays into to top-up the wallet.
+// User's balance is credited only once the gateway reports the payment, see CompleteTopUp.
+func (uc *Usecase) CreateUserTopUpIntent(ctx context.Context, intent model.TopUpIntent) (newIntent model.TopUpIntent, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.CreateUserTopUpIntent")
+	defer func() { span.End(err) }()
+
+	if uc.Payment == nil {
+		return model.TopUpIntent{}, errors.New("top-up is not available")
+	}
+
+	if intent.Amount < topUpMinAmount {
+		return model.TopUpIntent{}, fmt.Errorf("minimum top-up amount is %d", topUpMinAmount)
+	}
+
+	// Validate User exists and can receive, the payment would be rejected by CompleteTopUp otherwise
+	user, err := uc.Repository.GetUser(ctx, intent.UserID)
+	if err != nil {
+		return model.TopUpIntent{}, err
+	}
+	if err := uc.canReceive(user); err != nil {
+		return model.TopUpIntent{}, err
+	}
+
+	intent.ID = uuid.New()
+	intent.Status = model.TopUpIntentStatusPending
+	intent.ExpiryTime = time.Now().Add(topUpIntentExpiry)
+
+	virtualAccount, err := uc.Payment.CreateVirtualAccount(ctx, payment.VirtualAccountRequest{
+		ExternalID: intent.ID,
+		Name:       user.FullName,
+		Amount:     intent.Amount,
+		ExpiryTime: intent.ExpiryTime,
+	})
+	if err != nil {
+		return model.TopUpIntent{}, err
+	}
+
+	intent.BankCode = virtualAccount.BankCode
+	intent.VirtualAccountNumber = virtualAccount.Number
+
+	if err := uc.Repository.InsertTopUpIntent(ctx, intent); err != nil {
+		return model.TopUpIntent{}, err
+	}
+
+	return intent, nil
+}
+
+func (uc *Usecase) GetUserTopUpIntent(ctx context.Context, userID int64, intentID uuid.UUID) (intent model.TopUpIntent, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.GetUserTopUpIntent")
+	defer func() { span.End(err) }()
+
+	intents, err := uc.Repository.GetTopUpIntents(ctx, model.TopUpIntentFilter{
+		TopUpIntentID: intentID,
+		UserID:        userID,
+	})
+	if err != nil {
+		return model.TopUpIntent{}, err
+	}
+	if len(intents) == 0 {
+		return model.TopUpIntent{}, ErrTopUpNotFound
+	}
+
+	intent = intents[0]
+	if intent.Status == model.TopUpIntentStatusPending && time.Now().After(intent.ExpiryTime) {
+		intent.Status = model.TopUpIntentStatusExpired
+	}
+
+	return intent, nil
+}
+
+// CompleteTopUp credits User's balance for a payment into a top-up virtual account reported by the payment gateway.
+// Gateways may deliver the same payment more than once, so a payment that is already credited is not credited again.
+// A payment to a User who can no longer receive is rejected like an amount mismatch.
+func (uc *Usecase) CompleteTopUp(ctx context.Context, topUpPayment model.TopUpPayment) (newTransaction model.Transaction, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.CompleteTopUp")
+	defer func() { span.End(err) }()
+
+	paidTime := topUpPayment.PaidTime
+	if paidTime.IsZero() {
+		paidTime = time.Now()
+	}
+
+	// Duplicate deliveries of the same payment must only be counted once
+	credited := false
+
+	err = utils.WithDbTxRetry(ctx, uc.Repository, func(ctx context.Context) error {
+		credited = false
+
+		// 1. Lock TopUpIntent to prevent concurrent deliveries of the same payment
+		intent, err := uc.Repository.LockTopUpIntent(ctx, topUpPayment.VirtualAccountNumber)
+		if errors.Is(err, repository.ErrTopUpIntentNotFound) {
+			return ErrTopUpNotFound
+		} else if err != nil {
+			return err
+		}
+
+		newTransaction = model.Transaction{
+			ID:          intent.TransactionID,
+			UserID:      intent.UserID,
+			Amount:      intent.Amount,
+			Type:        model.TransactionTypeTopUp,
+			RecipientID: intent.UserID,
+			Status:      model.TransactionStatusSuccessful,
+			Description: fmt.Sprintf("Top-up via %s virtual account %s", intent.BankCode, intent.VirtualAccountNumber),
+		}
+
+		if intent.Status == model.TopUpIntentStatusPaid {
+			if intent.PaymentReference != topUpPayment.PaymentReference {
+				return ErrTopUpAlreadyPaid
+			}
+			return nil
+		}
+
+		if paidTime.After(intent.ExpiryTime) {
+			return ErrTopUpExpired
+		}
+
+		// Virtual account is issued for an exact amount, anything else has to be reconciled manually
+		if topUpPayment.Amount != intent.Amount {
+			return ErrTopUpAmountMismatch
+		}
+
+		// 2. Lock User data to prevent race condition
+		lockedUsers, err := uc.lockUsers(ctx, intent.UserID)
+		if err != nil {
+			return err
+		}
+
+		// User frozen or closed since the intent was created can not be credited, the payment has to be reconciled manually
+		if err := uc.canReceive(lockedUsers[intent.UserID]); err != nil {
+			return err
+		}
+
+		// 3. Increment User's balance
+		if err := uc.Repository.UpdateUser(ctx, model.UpdateUserRequest{
+			UserID: intent.UserID,
+			Balance: model.UpdateBalanceRequest{
+				Amount: intent.Amount,
+				Type:   model.UpdateBalanceIncrement,
+			},
+		}); err != nil {
+			return err
+		}
+
+		// 4. Insert a Successful Transaction record
+		if newTransaction.ID, err = uc.Repository.InsertTransaction(ctx, newTransaction); err != nil {
+			return err
+		}
+
+		// 5. Mark TopUpIntent as Paid so the payment is never credited twice
+		credited = true
+		return uc.Repository.UpdateTopUpIntent(ctx, model.UpdateTopUpIntentRequest{
+			ID:               intent.ID,
+			Status:           model.TopUpIntentStatusPaid,
+			PaymentReference: topUpPayment.PaymentReference,
+			TransactionID:    newTransaction.ID,
+		})
+	})
+	if err != nil {
+		return model.Transaction{}, err
+	}
+
+	if credited {
+		recordTransaction(newTransaction, newTransaction.Status)
+		auditTransaction(ctx, newTransaction, newTransaction.Status, nil)
+	}
+
+	return newTransaction, nil
+}
@@ -0,0 +1,416 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/WalletService/model"
+	"github.com/WalletService/payment"
+	"github.com/WalletService/repository"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+)
+
+func TestCreateUserTopUpIntent(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          model.TopUpIntent
+		mockRepository func(controller *gomock.Controller) *repository.MockRepositoryInterface
+		mockPayment    func(controller *gomock.Controller) *payment.MockGateway
+		wantVA         string
+		wantErr        bool
+	}{
+		{
+			name: "success",
+			input: model.TopUpIntent{
+				UserID: 1234,
+				Amount: 100000,
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+
+				m.EXPECT().GetUser(gomock.Any(), int64(1234)).Return(model.User{ID: 1234, FullName: "User"}, nil).Times(1)
+				m.EXPECT().InsertTopUpIntent(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, intent model.TopUpIntent) error {
+					if intent.UserID != 1234 || intent.Amount != 100000 || intent.Status != model.TopUpIntentStatusPending ||
+						intent.BankCode != "BCA" || intent.VirtualAccountNumber != "8808000000001234" || intent.ID == uuid.Nil {
+						return errors.New("unexpected top-up intent")
+					}
+					return nil
+				}).Times(1)
+
+				return m
+			},
+			mockPayment: func(ctrl *gomock.Controller) *payment.MockGateway {
+				m := payment.NewMockGateway(ctrl)
+
+				m.EXPECT().CreateVirtualAccount(gomock.Any(), gomock.Any()).Return(payment.VirtualAccount{
+					BankCode: "BCA",
+					Number:   "8808000000001234",
+				}, nil).Times(1)
+
+				return m
+			},
+			wantVA:  "8808000000001234",
+			wantErr: false,
+		},
+		{
+			name: "fail-below-minimum-amount",
+			input: model.TopUpIntent{
+				UserID: 1234,
+				Amount: 5000,
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				return repository.NewMockRepositoryInterface(ctrl)
+			},
+			mockPayment: func(ctrl *gomock.Controller) *payment.MockGateway {
+				return payment.NewMockGateway(ctrl)
+			},
+			wantVA:  "",
+			wantErr: true,
+		},
+		{
+			name: "fail-user-frozen",
+			input: model.TopUpIntent{
+				UserID: 1234,
+				Amount: 100000,
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+
+				m.EXPECT().GetUser(gomock.Any(), int64(1234)).Return(model.User{ID: 1234, FullName: "User", Status: model.UserStatusFrozen}, nil).Times(1)
+
+				return m
+			},
+			mockPayment: func(ctrl *gomock.Controller) *payment.MockGateway {
+				return payment.NewMockGateway(ctrl)
+			},
+			wantVA:  "",
+			wantErr: true,
+		},
+		{
+			name: "fail-user-closed",
+			input: model.TopUpIntent{
+				UserID: 1234,
+				Amount: 100000,
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+
+				m.EXPECT().GetUser(gomock.Any(), int64(1234)).Return(model.User{ID: 1234, FullName: "User", Status: model.UserStatusClosed}, nil).Times(1)
+
+				return m
+			},
+			mockPayment: func(ctrl *gomock.Controller) *payment.MockGateway {
+				return payment.NewMockGateway(ctrl)
+			},
+			wantVA:  "",
+			wantErr: true,
+		},
+		{
+			name: "fail-create-virtual-account",
+			input: model.TopUpIntent{
+				UserID: 1234,
+				Amount: 100000,
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+
+				m.EXPECT().GetUser(gomock.Any(), int64(1234)).Return(model.User{ID: 1234, FullName: "User"}, nil).Times(1)
+
+				return m
+			},
+			mockPayment: func(ctrl *gomock.Controller) *payment.MockGateway {
+				m := payment.NewMockGateway(ctrl)
+
+				m.EXPECT().CreateVirtualAccount(gomock.Any(), gomock.Any()).Return(payment.VirtualAccount{}, errors.New("gateway unavailable")).Times(1)
+
+				return m
+			},
+			wantVA:  "",
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			usecase := &Usecase{
+				Repository: test.mockRepository(controller),
+				Payment:    test.mockPayment(controller),
+			}
+
+			gotIntent, gotErr := usecase.CreateUserTopUpIntent(context.Background(), test.input)
+			if gotIntent.VirtualAccountNumber != test.wantVA {
+				t.Errorf("usecase.CreateUserTopUpIntent() gotVA = %v, wantVA %v", gotIntent.VirtualAccountNumber, test.wantVA)
+				return
+			}
+			if (gotErr != nil) != test.wantErr {
+				t.Errorf("usecase.CreateUserTopUpIntent() gotErr = %v, wantErr %v", gotErr, test.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestCompleteTopUp(t *testing.T) {
+	var (
+		intentID      = convertToUUID("9b1f4a4e-0c43-4f5e-9d6f-1d2b8f1f6c11")
+		transactionID = convertToUUID("3d6e668f-ad02-40ff-8540-90c1528a7c88")
+
+		pendingIntent = model.TopUpIntent{
+			ID:                   intentID,
+			UserID:               1234,
+			Amount:               100000,
+			BankCode:             "BCA",
+			VirtualAccountNumber: "8808000000001234",
+			Status:               model.TopUpIntentStatusPending,
+			ExpiryTime:           time.Now().Add(time.Hour),
+		}
+
+		paidIntent = model.TopUpIntent{
+			ID:                   intentID,
+			UserID:               1234,
+			Amount:               100000,
+			BankCode:             "BCA",
+			VirtualAccountNumber: "8808000000001234",
+			Status:               model.TopUpIntentStatusPaid,
+			ExpiryTime:           time.Now().Add(time.Hour),
+			PaymentReference:     "PAY-1",
+			TransactionID:        transactionID,
+		}
+	)
+
+	mockDbTx := func(ctrl *gomock.Controller, m *repository.MockRepositoryInterface, commit bool) {
+		sqlDb := repository.NewMockSqlDbInterface(ctrl)
+		sqlTx := repository.NewMockSqlTxInterface(ctrl)
+
+		m.EXPECT().GetSqlDb().Return(sqlDb, nil).Times(1)
+		sqlDb.EXPECT().BeginTx(gomock.Any(), gomock.Nil()).Return(sqlTx, nil).Times(1)
+		if commit {
+			sqlTx.EXPECT().Commit().Return(nil).Times(1)
+		} else {
+			sqlTx.EXPECT().Rollback().Return(nil).Times(1)
+		}
+	}
+
+	tests := []struct {
+		name              string
+		input             model.TopUpPayment
+		mockRepository    func(controller *gomock.Controller) *repository.MockRepositoryInterface
+		wantTransactionID uuid.UUID
+		wantErr           error
+	}{
+		{
+			name: "success",
+			input: model.TopUpPayment{
+				VirtualAccountNumber: "8808000000001234",
+				Amount:               100000,
+				PaymentReference:     "PAY-1",
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockDbTx(ctrl, m, true)
+
+				m.EXPECT().LockTopUpIntent(gomock.Any(), "8808000000001234").Return(pendingIntent, nil).Times(1)
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234}).Return([]model.User{{ID: 1234, Status: model.UserStatusActive}}, nil).Times(1)
+				m.EXPECT().UpdateUser(gomock.Any(), model.UpdateUserRequest{
+					UserID: 1234,
+					Balance: model.UpdateBalanceRequest{
+						Amount: 100000,
+						Type:   model.UpdateBalanceIncrement,
+					},
+				}).Return(nil).Times(1)
+				m.EXPECT().InsertTransaction(gomock.Any(), model.Transaction{
+					UserID:      1234,
+					Amount:      100000,
+					Type:        model.TransactionTypeTopUp,
+					RecipientID: 1234,
+					Status:      model.TransactionStatusSuccessful,
+					Description: "Top-up via BCA virtual account 8808000000001234",
+				}).Return(transactionID, nil).Times(1)
+				m.EXPECT().UpdateTopUpIntent(gomock.Any(), model.UpdateTopUpIntentRequest{
+					ID:               intentID,
+					Status:           model.TopUpIntentStatusPaid,
+					PaymentReference: "PAY-1",
+					TransactionID:    transactionID,
+				}).Return(nil).Times(1)
+
+				return m
+			},
+			wantTransactionID: transactionID,
+			wantErr:           nil,
+		},
+		{
+			name: "success-duplicate-callback-is-not-credited-again",
+			input: model.TopUpPayment{
+				VirtualAccountNumber: "8808000000001234",
+				Amount:               100000,
+				PaymentReference:     "PAY-1",
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockDbTx(ctrl, m, true)
+
+				m.EXPECT().LockTopUpIntent(gomock.Any(), "8808000000001234").Return(paidIntent, nil).Times(1)
+
+				return m
+			},
+			wantTransactionID: transactionID,
+			wantErr:           nil,
+		},
+		{
+			name: "fail-already-paid-by-another-payment",
+			input: model.TopUpPayment{
+				VirtualAccountNumber: "8808000000001234",
+				Amount:               100000,
+				PaymentReference:     "PAY-2",
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockDbTx(ctrl, m, false)
+
+				m.EXPECT().LockTopUpIntent(gomock.Any(), "8808000000001234").Return(paidIntent, nil).Times(1)
+
+				return m
+			},
+			wantTransactionID: uuid.Nil,
+			wantErr:           ErrTopUpAlreadyPaid,
+		},
+		{
+			name: "fail-amount-mismatch",
+			input: model.TopUpPayment{
+				VirtualAccountNumber: "8808000000001234",
+				Amount:               1000000,
+				PaymentReference:     "PAY-1",
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockDbTx(ctrl, m, false)
+
+				m.EXPECT().LockTopUpIntent(gomock.Any(), "8808000000001234").Return(pendingIntent, nil).Times(1)
+
+				return m
+			},
+			wantTransactionID: uuid.Nil,
+			wantErr:           ErrTopUpAmountMismatch,
+		},
+		{
+			name: "fail-paid-after-expiry",
+			input: model.TopUpPayment{
+				VirtualAccountNumber: "8808000000001234",
+				Amount:               100000,
+				PaymentReference:     "PAY-1",
+				PaidTime:             time.Now().Add(2 * time.Hour),
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockDbTx(ctrl, m, false)
+
+				m.EXPECT().LockTopUpIntent(gomock.Any(), "8808000000001234").Return(pendingIntent, nil).Times(1)
+
+				return m
+			},
+			wantTransactionID: uuid.Nil,
+			wantErr:           ErrTopUpExpired,
+		},
+		{
+			name: "fail-paid-after-freeze",
+			input: model.TopUpPayment{
+				VirtualAccountNumber: "8808000000001234",
+				Amount:               100000,
+				PaymentReference:     "PAY-1",
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockDbTx(ctrl, m, false)
+
+				m.EXPECT().LockTopUpIntent(gomock.Any(), "8808000000001234").Return(pendingIntent, nil).Times(1)
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234}).Return([]model.User{{ID: 1234, Status: model.UserStatusFrozen}}, nil).Times(1)
+
+				return m
+			},
+			wantTransactionID: uuid.Nil,
+			wantErr:           ErrRecipientCannotReceive,
+		},
+		{
+			name: "fail-paid-after-closure",
+			input: model.TopUpPayment{
+				VirtualAccountNumber: "8808000000001234",
+				Amount:               100000,
+				PaymentReference:     "PAY-1",
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockDbTx(ctrl, m, false)
+
+				m.EXPECT().LockTopUpIntent(gomock.Any(), "8808000000001234").Return(pendingIntent, nil).Times(1)
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234}).Return([]model.User{{ID: 1234, Status: model.UserStatusClosed}}, nil).Times(1)
+
+				return m
+			},
+			wantTransactionID: uuid.Nil,
+			wantErr:           ErrRecipientCannotReceive,
+		},
+		{
+			name: "fail-unknown-virtual-account",
+			input: model.TopUpPayment{
+				VirtualAccountNumber: "8808999999999999",
+				Amount:               100000,
+				PaymentReference:     "PAY-1",
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockDbTx(ctrl, m, false)
+
+				m.EXPECT().LockTopUpIntent(gomock.Any(), "8808999999999999").Return(model.TopUpIntent{}, repository.ErrTopUpIntentNotFound).Times(1)
+
+				return m
+			},
+			wantTransactionID: uuid.Nil,
+			wantErr:           ErrTopUpNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			usecase := &Usecase{
+				Repository: test.mockRepository(controller),
+			}
+
+			gotTransaction, gotErr := usecase.CompleteTopUp(context.Background(), test.input)
+			if gotTransaction.ID != test.wantTransactionID {
+				t.Errorf("usecase.CompleteTopUp() gotTransactionID = %v, wantTransactionID %v", gotTransaction.ID, test.wantTransactionID)
+				return
+			}
+			if !errors.Is(gotErr, test.wantErr) {
+				t.Errorf("usecase.CompleteTopUp() gotErr = %v, wantErr %v", gotErr, test.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestCreateUserTransaction_DirectTopUpDisabled(t *testing.T) {
+	controller := gomock.NewController(t)
+
+	m := repository.NewMockRepositoryInterface(controller)
+	m.EXPECT().GetUser(gomock.Any(), int64(1234)).Return(model.User{ID: 1234}, nil).Times(1)
+
+	usecase := &Usecase{
+		Repository: m,
+	}
+
+	_, err := usecase.CreateUserTransaction(context.Background(), model.Transaction{
+		UserID: 1234,
+		Amount: 100000,
+		Type:   model.TransactionTypeTopUp,
+	})
+	if err == nil {
+		t.Errorf("usecase.CreateUserTransaction() should reject direct TopUp when EnableDirectTopUp is false")
+	}
+}
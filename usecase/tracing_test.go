@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/WalletService/model"
+	"github.com/WalletService/repository"
+	"github.com/WalletService/tracing"
+	gomock "github.com/golang/mock/gomock"
+)
+
+func TestCreateUserTransaction_Spans(t *testing.T) {
+	exporter := tracing.NewInMemoryExporter()
+	defaultTracer := tracing.Default()
+	tracing.SetDefault(tracing.NewTracer(exporter))
+	defer tracing.SetDefault(defaultTracer)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := repository.NewMockRepositoryInterface(ctrl)
+	sqlDb := repository.NewMockSqlDbInterface(ctrl)
+	sqlTx := repository.NewMockSqlTxInterface(ctrl)
+
+	m.EXPECT().GetUser(gomock.Any(), int64(1234)).Return(model.User{
+		ID:       1234,
+		Password: "$2a$12$35ELZtgOq3iFR6awq.jsDuV5Dr.0XU5k7iUQuShfeLTWRHGFr//fq",
+		Balance:  1000000,
+	}, nil)
+	m.EXPECT().GetUser(gomock.Any(), int64(6789)).Return(model.User{ID: 6789}, nil)
+	m.EXPECT().GetSqlDb().Return(sqlDb, nil)
+	sqlDb.EXPECT().BeginTx(gomock.Any(), gomock.Nil()).Return(sqlTx, nil)
+	m.EXPECT().LockUsers(gomock.Any(), []int64{1234, 6789}).Return([]model.User{{ID: 1234, Balance: 1000000}, {ID: 6789}}, nil)
+	m.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	m.EXPECT().InsertTransaction(gomock.Any(), gomock.Any()).Return(convertToUUID("3d6e668f-ad02-40ff-8540-90c1528a7c88"), nil)
+	sqlTx.EXPECT().Commit().Return(nil)
+
+	ctx, request := tracing.Default().Start(context.Background(), "CreateUserTransaction", tracing.SpanKindServer)
+
+	uc := &Usecase{Repository: m}
+	if _, err := uc.CreateUserTransaction(ctx, model.Transaction{
+		UserID:      1234,
+		Amount:      250000,
+		RecipientID: 6789,
+		Type:        model.TransactionTypeTransferOut,
+		Password:    "Admin1234!",
+	}); err != nil {
+		t.Fatalf("CreateUserTransaction() error = %v", err)
+	}
+	request.End(nil)
+
+	// Span tree as "parent > child", every span is in the trace of the request
+	spans := exporter.Spans()
+	names := map[tracing.SpanID]string{}
+	for _, span := range spans {
+		names[span.SpanContext.SpanID] = span.Name
+	}
+
+	got := map[string]bool{}
+	for _, span := range spans {
+		if span.SpanContext.TraceID != request.SpanContext().TraceID {
+			t.Errorf("span %s is not in the trace of the request", span.Name)
+		}
+		if span.StatusCode == tracing.StatusError {
+			t.Errorf("span %s failed: %s", span.Name, span.StatusMessage)
+		}
+		if span.ParentSpanID.IsValid() {
+			got[names[span.ParentSpanID]+" > "+span.Name] = true
+		}
+	}
+
+	for _, want := range []string{
+		"CreateUserTransaction > Usecase.CreateUserTransaction",
+		"Usecase.CreateUserTransaction > Usecase.performTransferOut",
+		"Usecase.performTransferOut > db.transaction",
+	} {
+		if !got[want] {
+			t.Errorf("span tree %v does not contain %s", got, want)
+		}
+	}
+}
@@ -3,120 +3,227 @@ package usecase
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/WalletService/model"
+	"github.com/WalletService/tracing"
 	"github.com/WalletService/utils"
-	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 )
 
-func (uc *Usecase) CreateUserTransaction(ctx context.Context, transaction model.Transaction) (newTransactionID uuid.UUID, err error) {
+var ErrInvalidPassword = errors.New("invalid password")
+
+func (uc *Usecase) CreateUserTransaction(ctx context.Context, transaction model.Transaction) (newTransaction model.Transaction, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.CreateUserTransaction",
+		tracing.Int64("user_id", transaction.UserID),
+		tracing.String("transaction.type", string(transaction.Type)),
+	)
+	defer func() { span.End(err) }()
+
 	if transaction.Amount <= 0 {
-		return uuid.Nil, errors.New("invalid amount")
+		return model.Transaction{}, errors.New("invalid amount")
+	}
+
+	// Transaction is in IDR unless requested otherwise
+	if transaction.Currency == "" {
+		transaction.Currency = model.CurrencyIDR
+	}
+	if !isSupportedCurrency(transaction.Currency) {
+		return model.Transaction{}, errors.New("unsupported currency")
+	}
+
+	// Money only enters and leaves the wallet in IDR, i.e. through virtual account, bank account and QRIS
+	if transaction.Currency != model.CurrencyIDR && transaction.Type != model.TransactionTypeTransferOut && transaction.Type != model.TransactionTypeConvert {
+		return model.Transaction{}, fmt.Errorf("%s Transaction only supports %s", transaction.Type, model.CurrencyIDR)
 	}
 
 	// Validate User exists
 	user, err := uc.Repository.GetUser(ctx, transaction.UserID)
 	if err != nil {
-		return uuid.Nil, err
+		return model.Transaction{}, err
+	}
+
+	// Balances of other currencies are only needed to validate non-IDR Transaction
+	if transaction.Currency != model.CurrencyIDR {
+		if user.Balances, err = uc.getUserBalances(ctx, user); err != nil {
+			return model.Transaction{}, err
+		}
+	}
+
+	// Only TransferOut is made out of and SharedWalletDeposit into a SharedWallet
+	if transaction.SharedWalletID != 0 && transaction.Type != model.TransactionTypeTransferOut && transaction.Type != model.TransactionTypeSharedWalletDeposit {
+		return model.Transaction{}, fmt.Errorf("%s Transaction does not support shared_wallet_id", transaction.Type)
 	}
 
 	// Perform Transaction based on its Type
 	switch transaction.Type {
-	case model.TransactionTypeTransferOut:
-		return uc.performTransferOut(ctx, user, transaction)
+	case model.TransactionTypeTransferOut, model.TransactionTypePayment:
+		if transaction.SharedWalletID != 0 {
+			newTransaction, err = uc.performSharedWalletTransferOut(ctx, user, transaction)
+			break
+		}
+		newTransaction, err = uc.performTransferOut(ctx, user, transaction)
+	case model.TransactionTypeConvert:
+		newTransaction, err = uc.performConvert(ctx, user, transaction)
+	case model.TransactionTypePocketTransfer:
+		newTransaction, err = uc.performPocketTransfer(ctx, user, transaction)
+	case model.TransactionTypeSharedWalletDeposit:
+		newTransaction, err = uc.performSharedWalletDeposit(ctx, user, transaction)
 	case model.TransactionTypeTopUp:
-		return uc.performTopUp(ctx, user, transaction)
+		// Direct TopUp credits whatever amount is requested, real top-up goes through CreateUserTopUpIntent
+		if !uc.EnableDirectTopUp {
+			return model.Transaction{}, errors.New("direct TopUp is disabled, create a top-up to get a virtual account instead")
+		}
+		newTransaction, err = uc.performTopUp(ctx, user, transaction)
+	case model.TransactionTypeWithdrawal:
+		newTransaction, err = uc.performWithdrawal(ctx, user, transaction)
 	default:
-		return uuid.Nil, errors.New("unknown transaction type")
+		return model.Transaction{}, errors.New("unknown transaction type")
+	}
+
+	if err != nil {
+		recordTransaction(transaction, model.TransactionStatusFailed)
+		auditTransaction(ctx, transaction, model.TransactionStatusFailed, err)
+		return model.Transaction{}, err
 	}
+	recordTransaction(newTransaction, newTransaction.Status)
+	auditTransaction(ctx, newTransaction, newTransaction.Status, nil)
+
+	return newTransaction, nil
 }
 
-func (uc *Usecase) performTransferOut(ctx context.Context, user model.User, transaction model.Transaction) (newTransactionID uuid.UUID, err error) {
-	// Validate requested password matches with User's password
-	inputPassword, errorList := utils.ValidatePassword(&transaction.Password)
+// verifyTransactionPassword validates requested password matches with User's password
+func (uc *Usecase) verifyTransactionPassword(user model.User, password string) error {
+	inputPassword, errorList := utils.ValidatePassword(&password, uc.validationLimits())
 	if len(errorList) > 0 {
-		return uuid.Nil, errors.New(errorList[0])
+		return errors.New(errorList[0])
 	} else if bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(inputPassword)) != nil {
-		return uuid.Nil, errors.New("invalid password")
+		return ErrInvalidPassword
 	}
 
-	// Validate User has balance to cover transaction
-	if user.Balance < transaction.Amount {
-		return uuid.Nil, errors.New("balance not enough")
+	return nil
+}
+
+func (uc *Usecase) performTransferOut(ctx context.Context, user model.User, transaction model.Transaction) (newTransaction model.Transaction, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.performTransferOut")
+	defer func() { span.End(err) }()
+
+	// Validate requested password matches with User's password
+	if err := uc.verifyTransactionPassword(user, transaction.Password); err != nil {
+		return model.Transaction{}, err
+	}
+
+	// Validate User has balance to cover transaction and its fee, Recipient receives the amount in the same currency
+	if transaction.Fee, err = uc.calculateFee(ctx, transaction); err != nil {
+		return model.Transaction{}, err
+	}
+	if balanceOf(user, transaction.Currency) < transaction.Amount+transaction.Fee {
+		return model.Transaction{}, errors.New("balance not enough")
+	}
+
+	// Recipient may be requested with the token of a lookup or its phone number instead of its ID
+	if transaction.RecipientID, err = uc.resolveRecipient(ctx, transaction); err != nil {
+		return model.Transaction{}, err
 	}
 
 	// Validate requested Recipient's ID should not be 0 for TransferOut Transaction
 	if transaction.RecipientID == 0 {
-		return uuid.Nil, errors.New("must specify recipient for TransferOut")
+		return model.Transaction{}, errors.New("must specify recipient for TransferOut")
 	}
 
 	// Validate should not perform TransferOut to oneself
 	if transaction.RecipientID == user.ID {
-		return uuid.Nil, errors.New("cannot perform TransferOut to self")
+		return model.Transaction{}, errors.New("cannot perform TransferOut to self")
 	}
 
 	// Validate that Recipient exists
 	recipient, err := uc.Repository.GetUser(ctx, transaction.RecipientID)
 	if err != nil {
-		return uuid.Nil, err
+		return model.Transaction{}, err
 	}
 
 	// Perform the following in a single DB transaction to ensure atomicity of all TransferOut operations
-	if err = utils.WithDbTx(context.Background(), uc.Repository, func(ctx context.Context) error {
-		// 1. Lock User data to prevent race condition
-		if err := uc.Repository.LockUser(ctx, user.ID); err != nil {
+	if err = utils.WithDbTxRetry(ctx, uc.Repository, func(ctx context.Context) error {
+		// 1. Lock User and Recipient data in ID order to prevent race condition, and deadlock with a transfer in the opposite direction
+		lockedUsers, err := uc.lockUsers(ctx, user.ID, recipient.ID)
+		if err != nil {
 			return err
 		}
 
-		// 2. Subtract User's balance
+		// 2. Validate User can send and Recipient can receive, either may have been frozen or closed since retrieved
+		if err := canSend(lockedUsers[user.ID]); err != nil {
+			return err
+		}
+		if err := uc.canReceive(lockedUsers[recipient.ID]); err != nil {
+			return err
+		}
+
+		// 3. Validate User's balance again now that it can not change, it may have changed since User was retrieved.
+		// The fee is recalculated too, User's Transactions this month can not change either
+		if transaction.Fee, err = uc.calculateFee(ctx, transaction); err != nil {
+			return err
+		}
+		balance, err := uc.lockedBalanceOf(ctx, lockedUsers[user.ID], transaction.Currency)
+		if err != nil {
+			return err
+		}
+		if balance < transaction.Amount+transaction.Fee {
+			return errors.New("balance not enough")
+		}
+
+		// 4. Subtract User's balance, including the fee
 		if err := uc.Repository.UpdateUser(ctx, model.UpdateUserRequest{
 			UserID: user.ID,
 			Balance: model.UpdateBalanceRequest{
-				Amount: transaction.Amount,
-				Type:   model.UpdateBalanceDecrement,
+				Amount:   transaction.Amount + transaction.Fee,
+				Type:     model.UpdateBalanceDecrement,
+				Currency: transaction.Currency,
 			},
 		}); err != nil {
 			return err
 		}
 
-		// 3. Lock Recipient data to prevent race condition
-		if err := uc.Repository.LockUser(ctx, recipient.ID); err != nil {
-			return err
-		}
-
-		// 4. Increment Recipient's balance
+		// 5. Increment Recipient's balance
 		if err := uc.Repository.UpdateUser(ctx, model.UpdateUserRequest{
 			UserID: recipient.ID,
 			Balance: model.UpdateBalanceRequest{
-				Amount: transaction.Amount,
-				Type:   model.UpdateBalanceIncrement,
+				Amount:   transaction.Amount,
+				Type:     model.UpdateBalanceIncrement,
+				Currency: transaction.Currency,
 			},
 		}); err != nil {
 			return err
 		}
 
-		// 5. Insert a Successful Transaction record
+		// 6. Post the fee to house revenue account
+		if err := uc.collectFee(ctx, transaction, model.UpdateBalanceIncrement); err != nil {
+			return err
+		}
+
+		// 7. Insert a Successful Transaction record
 		transaction.Status = model.TransactionStatusSuccessful
 		transaction.Password = ""
-		if newTransactionID, err = uc.Repository.InsertTransaction(ctx, transaction); err != nil {
+		if transaction.ID, err = uc.Repository.InsertTransaction(ctx, transaction); err != nil {
 			return err
 		}
 		return nil
 	}); err != nil {
-		// Insert a Failed transaction record if failed
+		// Insert a Failed transaction record if failed, even if the request is cancelled
 		transaction.Status = model.TransactionStatusFailed
 		transaction.Password = ""
 
-		uc.Repository.InsertTransaction(ctx, transaction)
+		uc.Repository.InsertTransaction(context.WithoutCancel(ctx), transaction)
 
-		return uuid.Nil, err
+		return model.Transaction{}, err
 	}
 
-	return newTransactionID, err
+	return transaction, err
 }
 
-func (uc *Usecase) performTopUp(ctx context.Context, user model.User, transaction model.Transaction) (newTransactionID uuid.UUID, err error) {
+func (uc *Usecase) performTopUp(ctx context.Context, user model.User, transaction model.Transaction) (newTransaction model.Transaction, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.performTopUp")
+	defer func() { span.End(err) }()
+
 	// Increment User's balance
 	user.Balance = user.Balance + transaction.Amount
 
@@ -124,7 +231,7 @@ func (uc *Usecase) performTopUp(ctx context.Context, user model.User, transactio
 	transaction.RecipientID = user.ID
 
 	// Perform the following in a single DB transaction to ensure atomicity of all TopUp operations
-	if err = utils.WithDbTx(context.Background(), uc.Repository, func(ctx context.Context) error {
+	if err = utils.WithDbTxRetry(ctx, uc.Repository, func(ctx context.Context) error {
 		// 1. Lock User data to prevent race condition
 		if err := uc.Repository.LockUser(ctx, user.ID); err != nil {
 			return err
@@ -146,19 +253,19 @@ func (uc *Usecase) performTopUp(ctx context.Context, user model.User, transactio
 		transaction.Password = ""
 		transaction.RecipientID = transaction.UserID
 
-		if newTransactionID, err = uc.Repository.InsertTransaction(ctx, transaction); err != nil {
+		if transaction.ID, err = uc.Repository.InsertTransaction(ctx, transaction); err != nil {
 			return err
 		}
 		return nil
 	}); err != nil {
-		// Insert a Failed transaction record if failed
+		// Insert a Failed transaction record if failed, even if the request is cancelled
 		transaction.Status = model.TransactionStatusFailed
 		transaction.Password = ""
 
-		uc.Repository.InsertTransaction(ctx, transaction)
+		uc.Repository.InsertTransaction(context.WithoutCancel(ctx), transaction)
 
-		return uuid.Nil, err
+		return model.Transaction{}, err
 	}
 
-	return newTransactionID, err
+	return transaction, err
 }
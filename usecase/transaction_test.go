@@ -53,15 +53,16 @@ func Test_performTransferOut(t *testing.T) {
 				// sql_txn operations
 				m.EXPECT().GetSqlDb().Return(sqlDb, nil).Times(1)
 				sqlDb.EXPECT().BeginTx(gomock.Any(), gomock.Nil()).Return(sqlTx, nil).Times(1)
-				m.EXPECT().SetExecutor(sqlTx).Times(1)
 				sqlTx.EXPECT().Commit().Return(nil).Times(1)
-				m.EXPECT().SetExecutor(sqlDb).Times(1)
 
 				m.EXPECT().GetUser(gomock.Any(), int64(6789)).Return(model.User{
 					ID: 6789,
 				}, nil).Times(1)
 
-				m.EXPECT().LockUser(gomock.Any(), int64(1234)).Return(nil).Times(1)
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234, 6789}).Return([]model.User{
+					{ID: 1234, Balance: 1000000},
+					{ID: 6789},
+				}, nil).Times(1)
 
 				m.EXPECT().UpdateUser(gomock.Any(), model.UpdateUserRequest{
 					UserID: 1234,
@@ -71,8 +72,6 @@ func Test_performTransferOut(t *testing.T) {
 					},
 				}).Return(nil).Times(1)
 
-				m.EXPECT().LockUser(gomock.Any(), int64(6789)).Return(nil).Times(1)
-
 				m.EXPECT().UpdateUser(gomock.Any(), model.UpdateUserRequest{
 					UserID: 6789,
 					Balance: model.UpdateBalanceRequest{
@@ -95,6 +94,57 @@ func Test_performTransferOut(t *testing.T) {
 			wantTransactionID: convertToUUID("3d6e668f-ad02-40ff-8540-90c1528a7c88"),
 			wantErr:           false,
 		},
+		{
+			name: "failed-recipient-frozen-should-rollback",
+			inputUser: model.User{
+				ID:          1234,
+				FullName:    "User",
+				PhoneNumber: "+628123456789",
+				Password:    "$2a$12$35ELZtgOq3iFR6awq.jsDuV5Dr.0XU5k7iUQuShfeLTWRHGFr//fq",
+				Balance:     1000000,
+			},
+			inputTransaction: model.Transaction{
+				UserID:      1234,
+				Amount:      250000,
+				RecipientID: 6789,
+				Type:        model.TransactionTypeTransferOut,
+				Description: "Traktir Makan",
+				Password:    "Admin1234!",
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+
+				sqlDb := repository.NewMockSqlDbInterface(ctrl)
+				sqlTx := repository.NewMockSqlTxInterface(ctrl)
+
+				// sql_txn operations
+				m.EXPECT().GetSqlDb().Return(sqlDb, nil).Times(1)
+				sqlDb.EXPECT().BeginTx(gomock.Any(), gomock.Nil()).Return(sqlTx, nil).Times(1)
+				sqlTx.EXPECT().Rollback().Return(nil).Times(1)
+
+				m.EXPECT().GetUser(gomock.Any(), int64(6789)).Return(model.User{
+					ID: 6789,
+				}, nil).Times(1)
+
+				// Recipient is frozen after it was read, status is checked again under lock
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234, 6789}).Return([]model.User{
+					{ID: 1234, Balance: 1000000, Status: model.UserStatusActive},
+					{ID: 6789, Status: model.UserStatusFrozen},
+				}, nil).Times(1)
+
+				m.EXPECT().InsertTransaction(gomock.Any(), model.Transaction{
+					UserID:      1234,
+					Amount:      250000,
+					RecipientID: 6789,
+					Type:        model.TransactionTypeTransferOut,
+					Status:      model.TransactionStatusFailed,
+					Description: "Traktir Makan",
+				}).Return(convertToUUID("3d6e668f-ad02-40ff-8540-90c1528a7c88"), nil).Times(1)
+
+				return m
+			},
+			wantErr: true,
+		},
 		{
 			name: "failed-repo-call-should-rollback",
 			inputUser: model.User{
@@ -121,15 +171,16 @@ func Test_performTransferOut(t *testing.T) {
 				// sql_txn operations
 				m.EXPECT().GetSqlDb().Return(sqlDb, nil).Times(1)
 				sqlDb.EXPECT().BeginTx(gomock.Any(), gomock.Nil()).Return(sqlTx, nil).Times(1)
-				m.EXPECT().SetExecutor(sqlTx).Times(1)
 				sqlTx.EXPECT().Rollback().Return(nil).Times(1)
-				m.EXPECT().SetExecutor(sqlDb).Times(1)
 
 				m.EXPECT().GetUser(gomock.Any(), int64(6789)).Return(model.User{
 					ID: 6789,
 				}, nil).Times(1)
 
-				m.EXPECT().LockUser(gomock.Any(), int64(1234)).Return(nil).Times(1)
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234, 6789}).Return([]model.User{
+					{ID: 1234, Balance: 1000000},
+					{ID: 6789},
+				}, nil).Times(1)
 
 				m.EXPECT().UpdateUser(gomock.Any(), model.UpdateUserRequest{
 					UserID: 1234,
@@ -153,6 +204,58 @@ func Test_performTransferOut(t *testing.T) {
 			wantTransactionID: uuid.Nil,
 			wantErr:           true,
 		},
+		{
+			name: "failed-balance-spent-before-lock-should-rollback",
+			inputUser: model.User{
+				ID:          1234,
+				FullName:    "User",
+				PhoneNumber: "+628123456789",
+				Password:    "$2a$12$35ELZtgOq3iFR6awq.jsDuV5Dr.0XU5k7iUQuShfeLTWRHGFr//fq",
+				Balance:     1000000,
+			},
+			inputTransaction: model.Transaction{
+				UserID:      1234,
+				Amount:      250000,
+				RecipientID: 6789,
+				Type:        model.TransactionTypeTransferOut,
+				Description: "Traktir Makan",
+				Password:    "Admin1234!",
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+
+				sqlDb := repository.NewMockSqlDbInterface(ctrl)
+				sqlTx := repository.NewMockSqlTxInterface(ctrl)
+
+				// sql_txn operations
+				m.EXPECT().GetSqlDb().Return(sqlDb, nil).Times(1)
+				sqlDb.EXPECT().BeginTx(gomock.Any(), gomock.Nil()).Return(sqlTx, nil).Times(1)
+				sqlTx.EXPECT().Rollback().Return(nil).Times(1)
+
+				m.EXPECT().GetUser(gomock.Any(), int64(6789)).Return(model.User{
+					ID: 6789,
+				}, nil).Times(1)
+
+				// A concurrent Transaction spent the balance after User was retrieved
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234, 6789}).Return([]model.User{
+					{ID: 1234, Balance: 100000},
+					{ID: 6789},
+				}, nil).Times(1)
+
+				m.EXPECT().InsertTransaction(gomock.Any(), model.Transaction{
+					UserID:      1234,
+					Amount:      250000,
+					RecipientID: 6789,
+					Type:        model.TransactionTypeTransferOut,
+					Status:      model.TransactionStatusFailed,
+					Description: "Traktir Makan",
+				}).Return(convertToUUID("3d6e668f-ad02-40ff-8540-90c1528a7c88"), nil).Times(1)
+
+				return m
+			},
+			wantTransactionID: uuid.Nil,
+			wantErr:           true,
+		},
 	}
 
 	for _, test := range tests {
@@ -163,9 +266,9 @@ func Test_performTransferOut(t *testing.T) {
 				Repository: test.mockRepository(controller),
 			}
 
-			gotTransactionID, gotErr := usecase.performTransferOut(context.Background(), test.inputUser, test.inputTransaction)
-			if gotTransactionID != test.wantTransactionID {
-				t.Errorf("usecase.performTransferOut() gotTransactionID = %v, wantTransactionID %v", gotTransactionID, test.wantTransactionID)
+			gotTransaction, gotErr := usecase.performTransferOut(context.Background(), test.inputUser, test.inputTransaction)
+			if gotTransaction.ID != test.wantTransactionID {
+				t.Errorf("usecase.performTransferOut() gotTransactionID = %v, wantTransactionID %v", gotTransaction.ID, test.wantTransactionID)
 				return
 			}
 			if (gotErr != nil) != test.wantErr {
@@ -210,9 +313,7 @@ func Test_performTopUp(t *testing.T) {
 				// sql_txn operations
 				m.EXPECT().GetSqlDb().Return(sqlDb, nil).Times(1)
 				sqlDb.EXPECT().BeginTx(gomock.Any(), gomock.Nil()).Return(sqlTx, nil).Times(1)
-				m.EXPECT().SetExecutor(sqlTx).Times(1)
 				sqlTx.EXPECT().Commit().Return(nil).Times(1)
-				m.EXPECT().SetExecutor(sqlDb).Times(1)
 
 				m.EXPECT().LockUser(gomock.Any(), int64(1234)).Return(nil).Times(1)
 
@@ -263,9 +364,7 @@ func Test_performTopUp(t *testing.T) {
 				// sql_txn operations
 				m.EXPECT().GetSqlDb().Return(sqlDb, nil).Times(1)
 				sqlDb.EXPECT().BeginTx(gomock.Any(), gomock.Nil()).Return(sqlTx, nil).Times(1)
-				m.EXPECT().SetExecutor(sqlTx).Times(1)
 				sqlTx.EXPECT().Rollback().Return(nil).Times(1)
-				m.EXPECT().SetExecutor(sqlDb).Times(1)
 
 				m.EXPECT().LockUser(gomock.Any(), int64(1234)).Return(nil).Times(1)
 
@@ -301,9 +400,9 @@ func Test_performTopUp(t *testing.T) {
 				Repository: test.mockRepository(controller),
 			}
 
-			gotTransactionID, gotErr := usecase.performTopUp(context.Background(), test.inputUser, test.inputTransaction)
-			if gotTransactionID != test.wantTransactionID {
-				t.Errorf("usecase.performTransferOut() gotTransactionID = %v, wantTransactionID %v", gotTransactionID, test.wantTransactionID)
+			gotTransaction, gotErr := usecase.performTopUp(context.Background(), test.inputUser, test.inputTransaction)
+			if gotTransaction.ID != test.wantTransactionID {
+				t.Errorf("usecase.performTransferOut() gotTransactionID = %v, wantTransactionID %v", gotTransaction.ID, test.wantTransactionID)
 				return
 			}
 			if (gotErr != nil) != test.wantErr {
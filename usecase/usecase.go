@@ -2,15 +2,99 @@
 package usecase
 
 import (
+	"sync"
+	"time"
+
+	"github.com/WalletService/disbursement"
+	"github.com/WalletService/fees"
+	"github.com/WalletService/fx"
+	"github.com/WalletService/payment"
 	"github.com/WalletService/repository"
+	"github.com/WalletService/sms"
+	"github.com/WalletService/utils"
 )
 
 type Usecase struct {
-	Repository repository.RepositoryInterface
+	Repository   repository.RepositoryInterface
+	Disbursement disbursement.Gateway
+	Payment      payment.Gateway
+	SMS          sms.Sender // Phone number can not be changed if nil
+	FXQuoter     *fx.Quoter
+	Fees         *fees.Schedule // Transactions are free if nil
+
+	ValidationLimits *utils.ValidationLimits // utils.DefaultValidationLimits if nil
+
+	// EnableDirectTopUp allows TopUp Transaction to credit any amount without payment, only for sandbox/dev
+	EnableDirectTopUp bool
+
+	// FrozenCanReceive lets Frozen Users receive transfers, they can never send
+	FrozenCanReceive bool
+
+	// ClosureSweepUserID receives the remaining balance of closed accounts, only empty accounts can be closed if zero
+	ClosureSweepUserID int64
+
+	// OTPExpiry and OTPMaxAttempts bound OTPs, and OTPRequestLimit is the number of OTPs a User can request within OTPRequestWindow,
+	// defaultOTPExpiry, defaultOTPMaxAttempts, defaultOTPRequestLimit and defaultOTPRequestWindow if not set
+	OTPExpiry        time.Duration
+	OTPMaxAttempts   int
+	OTPRequestLimit  int
+	OTPRequestWindow time.Duration
+
+	// RecipientLookupLimit is the number of recipient lookups of a User within RecipientLookupWindow, and RecipientTokenExpiry
+	// bounds how long a lookup can be transferred with, defaultRecipientLookupLimit, defaultRecipientLookupWindow and defaultRecipientTokenExpiry if not set
+	RecipientLookupLimit  int
+	RecipientLookupWindow time.Duration
+	RecipientTokenExpiry  time.Duration
+
+	// disbursements tracks the disbursement requests still being sent to the gateway, see WaitForDisbursements
+	disbursements sync.WaitGroup
+}
+
+type NewUsecaseOptions struct {
+	Repository            repository.RepositoryInterface
+	Disbursement          disbursement.Gateway
+	Payment               payment.Gateway
+	SMS                   sms.Sender
+	FXQuoter              *fx.Quoter
+	Fees                  *fees.Schedule
+	ValidationLimits      *utils.ValidationLimits
+	EnableDirectTopUp     bool
+	FrozenCanReceive      bool
+	ClosureSweepUserID    int64
+	OTPExpiry             time.Duration
+	OTPMaxAttempts        int
+	OTPRequestLimit       int
+	OTPRequestWindow      time.Duration
+	RecipientLookupLimit  int
+	RecipientLookupWindow time.Duration
+	RecipientTokenExpiry  time.Duration
 }
 
-func NewUsecase(repo repository.RepositoryInterface) *Usecase {
+func NewUsecase(opts NewUsecaseOptions) *Usecase {
 	return &Usecase{
-		Repository: repo,
+		Repository:            opts.Repository,
+		Disbursement:          opts.Disbursement,
+		Payment:               opts.Payment,
+		SMS:                   opts.SMS,
+		FXQuoter:              opts.FXQuoter,
+		Fees:                  opts.Fees,
+		ValidationLimits:      opts.ValidationLimits,
+		EnableDirectTopUp:     opts.EnableDirectTopUp,
+		FrozenCanReceive:      opts.FrozenCanReceive,
+		ClosureSweepUserID:    opts.ClosureSweepUserID,
+		OTPExpiry:             opts.OTPExpiry,
+		OTPMaxAttempts:        opts.OTPMaxAttempts,
+		OTPRequestLimit:       opts.OTPRequestLimit,
+		OTPRequestWindow:      opts.OTPRequestWindow,
+		RecipientLookupLimit:  opts.RecipientLookupLimit,
+		RecipientLookupWindow: opts.RecipientLookupWindow,
+		RecipientTokenExpiry:  opts.RecipientTokenExpiry,
+	}
+}
+
+func (uc *Usecase) validationLimits() utils.ValidationLimits {
+	if uc.ValidationLimits == nil {
+		return utils.DefaultValidationLimits
 	}
+	return *uc.ValidationLimits
 }
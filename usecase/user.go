@@ -5,22 +5,129 @@ import (
 	"errors"
 
 	"github.com/WalletService/model"
+	"github.com/WalletService/repository"
+	"github.com/WalletService/tracing"
 	"golang.org/x/crypto/bcrypt"
 )
 
 func (uc *Usecase) RegisterUser(ctx context.Context, user model.User) (userID int64, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.RegisterUser")
+	defer func() { span.End(err) }()
+
 	return uc.Repository.InsertUser(ctx, user)
 }
 
+// GetUser retrieves User along with User's balance in every currency User holds, and User's Pockets.
 func (uc *Usecase) GetUser(ctx context.Context, userID int64) (user model.User, err error) {
-	return uc.Repository.GetUser(ctx, userID)
+	ctx, span := tracing.Start(ctx, "Usecase.GetUser")
+	defer func() { span.End(err) }()
+
+	user, err = uc.Repository.GetUser(ctx, userID)
+	if err != nil {
+		return model.User{}, err
+	}
+
+	if user.Balances, err = uc.getUserBalances(ctx, user); err != nil {
+		return model.User{}, err
+	}
+
+	if user.Pockets, err = uc.Repository.GetPockets(ctx, model.PocketFilter{UserID: userID}); err != nil {
+		return model.User{}, err
+	}
+
+	return user, nil
+}
+
+// getUserBalances combines IDR balance stored in User with the balances of other currencies.
+func (uc *Usecase) getUserBalances(ctx context.Context, user model.User) (balances []model.Balance, err error) {
+	otherBalances, err := uc.Repository.GetUserBalances(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	balances = append(balances, model.Balance{Currency: model.CurrencyIDR, Amount: user.Balance})
+	return append(balances, otherBalances...), nil
+}
+
+// lockUsers locks Users in ID order within the DB txn carried by ctx and returns them by ID.
+// Returned Users only have their IDR balance, which is read under the lock and can not change until the txn ends.
+func (uc *Usecase) lockUsers(ctx context.Context, userIDs ...int64) (users map[int64]model.User, err error) {
+	lockedUsers, err := uc.Repository.LockUsers(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	users = make(map[int64]model.User, len(lockedUsers))
+	for _, user := range lockedUsers {
+		users[user.ID] = user
+	}
+
+	for _, userID := range userIDs {
+		if _, ok := users[userID]; !ok {
+			return nil, repository.ErrUserNotFound
+		}
+	}
+
+	return users, nil
+}
+
+// lockedBalanceOf returns the balance of a User locked by lockUsers, balances of other currencies are read under the same lock.
+func (uc *Usecase) lockedBalanceOf(ctx context.Context, user model.User, currency model.Currency) (balance float32, err error) {
+	if currency != "" && currency != model.CurrencyIDR {
+		if user.Balances, err = uc.getUserBalances(ctx, user); err != nil {
+			return 0, err
+		}
+	}
+
+	return balanceOf(user, currency), nil
+}
+
+// balanceOf returns User's balance in the currency, User is expected to be retrieved with its balances for non-IDR currency.
+func balanceOf(user model.User, currency model.Currency) float32 {
+	if currency == "" || currency == model.CurrencyIDR {
+		return user.Balance
+	}
+
+	for _, balance := range user.Balances {
+		if balance.Currency == currency {
+			return balance.Amount
+		}
+	}
+
+	return 0
+}
+
+func isSupportedCurrency(currency model.Currency) bool {
+	for _, supported := range model.SupportedCurrencies {
+		if currency == supported {
+			return true
+		}
+	}
+
+	return false
 }
 
 func (uc *Usecase) GetUsers(ctx context.Context, request model.UserFilter) (users []model.User, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.GetUsers")
+	defer func() { span.End(err) }()
+
 	return uc.Repository.GetUsers(ctx, request)
 }
 
 func (uc *Usecase) UserLogin(ctx context.Context, phoneNumber, password string) (userID int64, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.UserLogin")
+	defer func() { span.End(err) }()
+
+	defer func() { recordLogin(err) }()
+
+	// Every attempt is recorded in the audit log, a successful login is rejected if it can not be recorded
+	var user model.User
+	defer func() {
+		if auditErr := uc.auditLogin(ctx, user.ID, err); auditErr != nil && err == nil {
+			userID, err = 0, auditErr
+		}
+	}()
+
 	// Get User data
 	users, err := uc.Repository.GetUsers(ctx, model.UserFilter{PhoneNumber: phoneNumber})
 	if err != nil {
@@ -31,12 +138,17 @@ func (uc *Usecase) UserLogin(ctx context.Context, phoneNumber, password string)
 	}
 
 	// Phone number is unique, so expecting only at most 1 user to be retrieved
-	user := users[0]
+	user = users[0]
 
 	// Validate input password (plain) matches user's password (hashed and salted)
 	if bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)) != nil {
 		return 0, errors.New("invalid password")
 	}
 
+	// Frozen User can still log in to read its account, only checked once the password proves the account is User's
+	if user.Status == model.UserStatusClosed {
+		return 0, ErrUserClosed
+	}
+
 	return user.ID, nil
 }
@@ -0,0 +1,273 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/WalletService/disbursement"
+	"github.com/WalletService/model"
+	"github.com/WalletService/tracing"
+	"github.com/WalletService/utils"
+)
+
+func (uc *Usecase) RegisterUserBankAccount(ctx context.Context, bankAccount model.BankAccount) (bankAccountID int64, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.RegisterUserBankAccount")
+	defer func() { span.End(err) }()
+
+	return uc.Repository.InsertBankAccount(ctx, bankAccount)
+}
+
+func (uc *Usecase) GetUserBankAccounts(ctx context.Context, userID int64) (bankAccounts []model.BankAccount, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.GetUserBankAccounts")
+	defer func() { span.End(err) }()
+
+	return uc.Repository.GetBankAccounts(ctx, model.BankAccountFilter{UserID: userID})
+}
+
+// performWithdrawal places a hold on User's balance and asks the disbursement gateway to transfer the money to User's bank account.
+// The Withdrawal stays Pending until the gateway reports the result, see CompleteWithdrawal.
+func (uc *Usecase) performWithdrawal(ctx context.Context, user model.User, transaction model.Transaction) (newTransaction model.Transaction, err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.performWithdrawal")
+	defer func() { span.End(err) }()
+
+	if uc.Disbursement == nil {
+		return model.Transaction{}, errors.New("withdrawal is not available")
+	}
+
+	// Validate requested password matches with User's password
+	if err := uc.verifyTransactionPassword(user, transaction.Password); err != nil {
+		return model.Transaction{}, err
+	}
+
+	// Validate User has balance to cover transaction and its fee
+	if transaction.Fee, err = uc.calculateFee(ctx, transaction); err != nil {
+		return model.Transaction{}, err
+	}
+	if user.Balance < transaction.Amount+transaction.Fee {
+		return model.Transaction{}, errors.New("balance not enough")
+	}
+
+	// Validate requested bank account belongs to User
+	if transaction.BankAccountID == 0 {
+		return model.Transaction{}, errors.New("must specify bank account for Withdrawal")
+	}
+	bankAccounts, err := uc.Repository.GetBankAccounts(ctx, model.BankAccountFilter{
+		BankAccountID: transaction.BankAccountID,
+		UserID:        user.ID,
+	})
+	if err != nil {
+		return model.Transaction{}, err
+	}
+	if len(bankAccounts) == 0 {
+		return model.Transaction{}, errors.New("bank account not found")
+	}
+	bankAccount := bankAccounts[0]
+
+	// Money leaves the wallet, so Withdrawal has no Recipient within the wallet
+	transaction.RecipientID = user.ID
+	transaction.Password = ""
+
+	// Perform the following in a single DB transaction to ensure atomicity of all Withdrawal operations
+	if err = utils.WithDbTxRetry(ctx, uc.Repository, func(ctx context.Context) error {
+		// 1. Lock User data to prevent race condition
+		lockedUsers, err := uc.lockUsers(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+
+		// 2. Validate User can send and has the balance, both may have changed since User was retrieved, along with the fee
+		if err := canSend(lockedUsers[user.ID]); err != nil {
+			return err
+		}
+		if transaction.Fee, err = uc.calculateFee(ctx, transaction); err != nil {
+			return err
+		}
+		if lockedUsers[user.ID].Balance < transaction.Amount+transaction.Fee {
+			return errors.New("balance not enough")
+		}
+
+		// 3. Hold User's balance and fee by subtracting it, both are refunded if the disbursement fails
+		if err := uc.Repository.UpdateUser(ctx, model.UpdateUserRequest{
+			UserID: user.ID,
+			Balance: model.UpdateBalanceRequest{
+				Amount: transaction.Amount + transaction.Fee,
+				Type:   model.UpdateBalanceDecrement,
+			},
+		}); err != nil {
+			return err
+		}
+
+		// 4. Post the fee to house revenue account
+		if err := uc.collectFee(ctx, transaction, model.UpdateBalanceIncrement); err != nil {
+			return err
+		}
+
+		// 5. Insert a Pending Transaction record
+		transaction.Status = model.TransactionStatusPending
+		if transaction.ID, err = uc.Repository.InsertTransaction(ctx, transaction); err != nil {
+			return err
+		}
+		return nil
+	}); err != nil {
+		// Insert a Failed transaction record if failed, even if the request is cancelled
+		transaction.Status = model.TransactionStatusFailed
+
+		uc.Repository.InsertTransaction(context.WithoutCancel(ctx), transaction)
+
+		return model.Transaction{}, err
+	}
+
+	// Call the gateway only after the hold is committed, so a result can never arrive for an unknown Transaction.
+	// Disbursement outlives the request, so it must not be cancelled with the request context.
+	uc.disbursements.Add(1)
+	go func() {
+		defer uc.disbursements.Done()
+		uc.disburse(context.WithoutCancel(ctx), transaction, bankAccount)
+	}()
+
+	return transaction, nil
+}
+
+// WaitForDisbursements blocks until every disbursement request is sent to the gateway, or ctx is done.
+// It is used on shutdown so a Withdrawal is not left Pending without a request, nor refunded after the DB is closed.
+func (uc *Usecase) WaitForDisbursements(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		uc.disbursements.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// disburse sends the disbursement request to the gateway.
+// If the gateway rejects the request right away, the Withdrawal is failed and refunded immediately.
+func (uc *Usecase) disburse(ctx context.Context, transaction model.Transaction, bankAccount model.BankAccount) {
+	ctx, span := tracing.Default().Start(ctx, "Disbursement.Disburse", tracing.SpanKindClient,
+		tracing.String("transaction.id", transaction.ID.String()),
+	)
+
+	reference, err := uc.Disbursement.Disburse(ctx, disbursement.Request{
+		TransactionID: transaction.ID,
+		BankCode:      bankAccount.BankCode,
+		AccountNumber: bankAccount.AccountNumber,
+		AccountName:   bankAccount.AccountName,
+		Amount:        transaction.Amount,
+	})
+	span.End(err)
+	if err != nil {
+		uc.CompleteWithdrawal(ctx, model.DisbursementResult{
+			TransactionID: transaction.ID,
+			Status:        model.DisbursementStatusFailed,
+			FailureReason: err.Error(),
+		})
+		return
+	}
+
+	uc.Repository.UpdateTransaction(ctx, model.UpdateTransactionRequest{
+		ID:                    transaction.ID,
+		DisbursementReference: reference,
+	})
+}
+
+// CompleteWithdrawal finalizes a Pending Withdrawal based on the result reported by the disbursement gateway.
+// A failed disbursement refunds the held balance back to User.
+// Gateways may deliver the same result more than once, so completing an already completed Withdrawal is a no-op.
+func (uc *Usecase) CompleteWithdrawal(ctx context.Context, result model.DisbursementResult) (err error) {
+	ctx, span := tracing.Start(ctx, "Usecase.CompleteWithdrawal")
+	defer func() { span.End(err) }()
+
+	var newStatus model.TransactionStatus
+	switch result.Status {
+	case model.DisbursementStatusSuccessful:
+		newStatus = model.TransactionStatusSuccessful
+	case model.DisbursementStatusFailed:
+		newStatus = model.TransactionStatusFailed
+	default:
+		return errors.New("unknown disbursement status")
+	}
+
+	// Duplicate deliveries of the same result must only be counted once
+	var completed *model.Transaction
+
+	if err := utils.WithDbTxRetry(ctx, uc.Repository, func(ctx context.Context) error {
+		completed = nil
+
+		// 1. Lock Transaction to prevent concurrent deliveries of the same result
+		transaction, err := uc.Repository.LockTransaction(ctx, result.TransactionID)
+		if err != nil {
+			return err
+		}
+
+		if transaction.Type != model.TransactionTypeWithdrawal {
+			return errors.New("transaction is not a Withdrawal")
+		}
+
+		if transaction.Status != model.TransactionStatusPending {
+			if transaction.Status != newStatus {
+				return fmt.Errorf("withdrawal is already %s", transaction.Status)
+			}
+			return nil
+		}
+
+		// 2. Refund User's held balance and fee if disbursement failed
+		if newStatus == model.TransactionStatusFailed {
+			if err := uc.Repository.LockUser(ctx, transaction.UserID); err != nil {
+				return err
+			}
+
+			if err := uc.Repository.UpdateUser(ctx, model.UpdateUserRequest{
+				UserID: transaction.UserID,
+				Balance: model.UpdateBalanceRequest{
+					Amount:   transaction.Amount + transaction.Fee,
+					Type:     model.UpdateBalanceIncrement,
+					Currency: transaction.Currency,
+				},
+			}); err != nil {
+				return err
+			}
+
+			if err := uc.collectFee(ctx, transaction, model.UpdateBalanceDecrement); err != nil {
+				return err
+			}
+		}
+
+		// 3. Update the Transaction to its final status
+		update := model.UpdateTransactionRequest{
+			ID:                    transaction.ID,
+			Status:                newStatus,
+			DisbursementReference: result.Reference,
+		}
+		if result.FailureReason != "" {
+			update.Description = fmt.Sprintf("%s (failed: %s)", transaction.Description, result.FailureReason)
+		}
+
+		if err := uc.Repository.UpdateTransaction(ctx, update); err != nil {
+			return err
+		}
+
+		transaction.DisbursementReference = result.Reference
+		completed = &transaction
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if completed != nil {
+		recordTransaction(*completed, newStatus)
+
+		var disbursementErr error
+		if newStatus == model.TransactionStatusFailed {
+			disbursementErr = fmt.Errorf("disbursement failed: %s", result.FailureReason)
+		}
+		auditTransaction(ctx, *completed, newStatus, disbursementErr)
+	}
+
+	return nil
+}
@@ -0,0 +1,425 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/WalletService/disbursement"
+	"github.com/WalletService/model"
+	"github.com/WalletService/repository"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+)
+
+func Test_performWithdrawal(t *testing.T) {
+	tests := []struct {
+		name              string
+		inputUser         model.User
+		inputTransaction  model.Transaction
+		mockRepository    func(controller *gomock.Controller, disbursed chan struct{}) *repository.MockRepositoryInterface
+		mockDisbursement  func(controller *gomock.Controller, disbursed chan struct{}) *disbursement.MockGateway
+		wantTransaction   model.Transaction
+		wantErr           bool
+		wantDisbursedCall bool
+	}{
+		{
+			name: "success-hold-balance-and-disburse",
+			inputUser: model.User{
+				ID:       1234,
+				Password: "$2a$12$35ELZtgOq3iFR6awq.jsDuV5Dr.0XU5k7iUQuShfeLTWRHGFr//fq",
+				Balance:  1000000,
+			},
+			inputTransaction: model.Transaction{
+				UserID:        1234,
+				Amount:        250000,
+				Type:          model.TransactionTypeWithdrawal,
+				BankAccountID: 7,
+				Description:   "Tarik Tunai",
+				Password:      "Admin1234!",
+			},
+			mockRepository: func(ctrl *gomock.Controller, disbursed chan struct{}) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+
+				sqlDb := repository.NewMockSqlDbInterface(ctrl)
+				sqlTx := repository.NewMockSqlTxInterface(ctrl)
+
+				m.EXPECT().GetBankAccounts(gomock.Any(), model.BankAccountFilter{BankAccountID: 7, UserID: 1234}).Return([]model.BankAccount{
+					{ID: 7, UserID: 1234, BankCode: "014", AccountNumber: "1234567890", AccountName: "User"},
+				}, nil).Times(1)
+
+				// sql_txn operations
+				m.EXPECT().GetSqlDb().Return(sqlDb, nil).Times(1)
+				sqlDb.EXPECT().BeginTx(gomock.Any(), gomock.Nil()).Return(sqlTx, nil).Times(1)
+				sqlTx.EXPECT().Commit().Return(nil).Times(1)
+
+				m.EXPECT().LockUsers(gomock.Any(), []int64{1234}).Return([]model.User{{ID: 1234, Balance: 1000000}}, nil).Times(1)
+
+				m.EXPECT().UpdateUser(gomock.Any(), model.UpdateUserRequest{
+					UserID: 1234,
+					Balance: model.UpdateBalanceRequest{
+						Amount: 250000,
+						Type:   model.UpdateBalanceDecrement,
+					},
+				}).Return(nil).Times(1)
+
+				m.EXPECT().InsertTransaction(gomock.Any(), model.Transaction{
+					UserID:        1234,
+					Amount:        250000,
+					Type:          model.TransactionTypeWithdrawal,
+					RecipientID:   1234,
+					BankAccountID: 7,
+					Status:        model.TransactionStatusPending,
+					Description:   "Tarik Tunai",
+				}).Return(convertToUUID("3d6e668f-ad02-40ff-8540-90c1528a7c88"), nil).Times(1)
+
+				m.EXPECT().UpdateTransaction(gomock.Any(), model.UpdateTransactionRequest{
+					ID:                    convertToUUID("3d6e668f-ad02-40ff-8540-90c1528a7c88"),
+					DisbursementReference: "REF-1",
+				}).Do(func(context.Context, model.UpdateTransactionRequest) {
+					// Recording the disbursement reference is the last step of the async flow
+					close(disbursed)
+				}).Return(nil).Times(1)
+
+				return m
+			},
+			mockDisbursement: func(ctrl *gomock.Controller, disbursed chan struct{}) *disbursement.MockGateway {
+				m := disbursement.NewMockGateway(ctrl)
+
+				m.EXPECT().Disburse(gomock.Any(), disbursement.Request{
+					TransactionID: convertToUUID("3d6e668f-ad02-40ff-8540-90c1528a7c88"),
+					BankCode:      "014",
+					AccountNumber: "1234567890",
+					AccountName:   "User",
+					Amount:        250000,
+				}).Return("REF-1", nil).Times(1)
+
+				return m
+			},
+			wantTransaction: model.Transaction{
+				ID:            convertToUUID("3d6e668f-ad02-40ff-8540-90c1528a7c88"),
+				UserID:        1234,
+				Amount:        250000,
+				Type:          model.TransactionTypeWithdrawal,
+				RecipientID:   1234,
+				BankAccountID: 7,
+				Status:        model.TransactionStatusPending,
+				Description:   "Tarik Tunai",
+			},
+			wantErr:           false,
+			wantDisbursedCall: true,
+		},
+		{
+			name: "fail-bank-account-not-owned-by-user",
+			inputUser: model.User{
+				ID:       1234,
+				Password: "$2a$12$35ELZtgOq3iFR6awq.jsDuV5Dr.0XU5k7iUQuShfeLTWRHGFr//fq",
+				Balance:  1000000,
+			},
+			inputTransaction: model.Transaction{
+				UserID:        1234,
+				Amount:        250000,
+				Type:          model.TransactionTypeWithdrawal,
+				BankAccountID: 8,
+				Password:      "Admin1234!",
+			},
+			mockRepository: func(ctrl *gomock.Controller, disbursed chan struct{}) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+
+				m.EXPECT().GetBankAccounts(gomock.Any(), model.BankAccountFilter{BankAccountID: 8, UserID: 1234}).Return(nil, nil).Times(1)
+
+				return m
+			},
+			mockDisbursement: func(ctrl *gomock.Controller, disbursed chan struct{}) *disbursement.MockGateway {
+				return disbursement.NewMockGateway(ctrl)
+			},
+			wantTransaction: model.Transaction{},
+			wantErr:         true,
+		},
+		{
+			name: "fail-balance-not-enough",
+			inputUser: model.User{
+				ID:       1234,
+				Password: "$2a$12$35ELZtgOq3iFR6awq.jsDuV5Dr.0XU5k7iUQuShfeLTWRHGFr//fq",
+				Balance:  1000,
+			},
+			inputTransaction: model.Transaction{
+				UserID:        1234,
+				Amount:        250000,
+				Type:          model.TransactionTypeWithdrawal,
+				BankAccountID: 7,
+				Password:      "Admin1234!",
+			},
+			mockRepository: func(ctrl *gomock.Controller, disbursed chan struct{}) *repository.MockRepositoryInterface {
+				return repository.NewMockRepositoryInterface(ctrl)
+			},
+			mockDisbursement: func(ctrl *gomock.Controller, disbursed chan struct{}) *disbursement.MockGateway {
+				return disbursement.NewMockGateway(ctrl)
+			},
+			wantTransaction: model.Transaction{},
+			wantErr:         true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			disbursed := make(chan struct{})
+
+			usecase := &Usecase{
+				Repository:   test.mockRepository(controller, disbursed),
+				Disbursement: test.mockDisbursement(controller, disbursed),
+			}
+
+			gotTransaction, gotErr := usecase.performWithdrawal(context.Background(), test.inputUser, test.inputTransaction)
+			if gotTransaction != test.wantTransaction {
+				t.Errorf("usecase.performWithdrawal() gotTransaction = %v, wantTransaction %v", gotTransaction, test.wantTransaction)
+				return
+			}
+			if (gotErr != nil) != test.wantErr {
+				t.Errorf("usecase.performWithdrawal() gotErr = %v, wantErr %v", gotErr, test.wantErr)
+				return
+			}
+
+			if test.wantDisbursedCall {
+				select {
+				case <-disbursed:
+				case <-time.After(time.Second):
+					t.Errorf("usecase.performWithdrawal() did not call disbursement gateway")
+				}
+			}
+		})
+	}
+}
+
+func TestCompleteWithdrawal(t *testing.T) {
+	transactionID := convertToUUID("3d6e668f-ad02-40ff-8540-90c1528a7c88")
+
+	pendingWithdrawal := model.Transaction{
+		ID:          transactionID,
+		UserID:      1234,
+		Amount:      250000,
+		Type:        model.TransactionTypeWithdrawal,
+		Status:      model.TransactionStatusPending,
+		Description: "Tarik Tunai",
+	}
+
+	mockDbTx := func(ctrl *gomock.Controller, m *repository.MockRepositoryInterface, commit bool) {
+		sqlDb := repository.NewMockSqlDbInterface(ctrl)
+		sqlTx := repository.NewMockSqlTxInterface(ctrl)
+
+		m.EXPECT().GetSqlDb().Return(sqlDb, nil).Times(1)
+		sqlDb.EXPECT().BeginTx(gomock.Any(), gomock.Nil()).Return(sqlTx, nil).Times(1)
+		if commit {
+			sqlTx.EXPECT().Commit().Return(nil).Times(1)
+		} else {
+			sqlTx.EXPECT().Rollback().Return(nil).Times(1)
+		}
+	}
+
+	tests := []struct {
+		name           string
+		input          model.DisbursementResult
+		mockRepository func(controller *gomock.Controller) *repository.MockRepositoryInterface
+		wantErr        bool
+	}{
+		{
+			name: "success",
+			input: model.DisbursementResult{
+				TransactionID: transactionID,
+				Reference:     "REF-1",
+				Status:        model.DisbursementStatusSuccessful,
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockDbTx(ctrl, m, true)
+
+				m.EXPECT().LockTransaction(gomock.Any(), transactionID).Return(pendingWithdrawal, nil).Times(1)
+				m.EXPECT().UpdateTransaction(gomock.Any(), model.UpdateTransactionRequest{
+					ID:                    transactionID,
+					Status:                model.TransactionStatusSuccessful,
+					DisbursementReference: "REF-1",
+				}).Return(nil).Times(1)
+
+				return m
+			},
+			wantErr: false,
+		},
+		{
+			name: "success-failed-disbursement-refunds-balance",
+			input: model.DisbursementResult{
+				TransactionID: transactionID,
+				Reference:     "REF-1",
+				Status:        model.DisbursementStatusFailed,
+				FailureReason: "account closed",
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockDbTx(ctrl, m, true)
+
+				m.EXPECT().LockTransaction(gomock.Any(), transactionID).Return(pendingWithdrawal, nil).Times(1)
+				m.EXPECT().LockUser(gomock.Any(), int64(1234)).Return(nil).Times(1)
+				m.EXPECT().UpdateUser(gomock.Any(), model.UpdateUserRequest{
+					UserID: 1234,
+					Balance: model.UpdateBalanceRequest{
+						Amount: 250000,
+						Type:   model.UpdateBalanceIncrement,
+					},
+				}).Return(nil).Times(1)
+				m.EXPECT().UpdateTransaction(gomock.Any(), model.UpdateTransactionRequest{
+					ID:                    transactionID,
+					Status:                model.TransactionStatusFailed,
+					DisbursementReference: "REF-1",
+					Description:           "Tarik Tunai (failed: account closed)",
+				}).Return(nil).Times(1)
+
+				return m
+			},
+			wantErr: false,
+		},
+		{
+			name: "success-duplicate-callback-is-noop",
+			input: model.DisbursementResult{
+				TransactionID: transactionID,
+				Status:        model.DisbursementStatusFailed,
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockDbTx(ctrl, m, true)
+
+				failedWithdrawal := pendingWithdrawal
+				failedWithdrawal.Status = model.TransactionStatusFailed
+				m.EXPECT().LockTransaction(gomock.Any(), transactionID).Return(failedWithdrawal, nil).Times(1)
+
+				return m
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail-conflicting-callback",
+			input: model.DisbursementResult{
+				TransactionID: transactionID,
+				Status:        model.DisbursementStatusFailed,
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockDbTx(ctrl, m, false)
+
+				successfulWithdrawal := pendingWithdrawal
+				successfulWithdrawal.Status = model.TransactionStatusSuccessful
+				m.EXPECT().LockTransaction(gomock.Any(), transactionID).Return(successfulWithdrawal, nil).Times(1)
+
+				return m
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail-refund-should-rollback",
+			input: model.DisbursementResult{
+				TransactionID: transactionID,
+				Status:        model.DisbursementStatusFailed,
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				m := repository.NewMockRepositoryInterface(ctrl)
+				mockDbTx(ctrl, m, false)
+
+				m.EXPECT().LockTransaction(gomock.Any(), transactionID).Return(pendingWithdrawal, nil).Times(1)
+				m.EXPECT().LockUser(gomock.Any(), int64(1234)).Return(nil).Times(1)
+				m.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Return(errors.New("error-update-user")).Times(1)
+
+				return m
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail-unknown-status",
+			input: model.DisbursementResult{
+				TransactionID: uuid.New(),
+				Status:        "Unknown",
+			},
+			mockRepository: func(ctrl *gomock.Controller) *repository.MockRepositoryInterface {
+				return repository.NewMockRepositoryInterface(ctrl)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			usecase := &Usecase{
+				Repository: test.mockRepository(controller),
+			}
+
+			gotErr := usecase.CompleteWithdrawal(context.Background(), test.input)
+			if (gotErr != nil) != test.wantErr {
+				t.Errorf("usecase.CompleteWithdrawal() gotErr = %v, wantErr %v", gotErr, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestWaitForDisbursements(t *testing.T) {
+	controller := gomock.NewController(t)
+	transactionID := convertToUUID("3d6e668f-ad02-40ff-8540-90c1528a7c88")
+
+	mockRepository := repository.NewMockRepositoryInterface(controller)
+	sqlDb := repository.NewMockSqlDbInterface(controller)
+	sqlTx := repository.NewMockSqlTxInterface(controller)
+	mockRepository.EXPECT().GetBankAccounts(gomock.Any(), gomock.Any()).Return([]model.BankAccount{{ID: 7, UserID: 1234}}, nil).Times(1)
+	mockRepository.EXPECT().GetSqlDb().Return(sqlDb, nil).Times(1)
+	sqlDb.EXPECT().BeginTx(gomock.Any(), gomock.Nil()).Return(sqlTx, nil).Times(1)
+	sqlTx.EXPECT().Commit().Return(nil).Times(1)
+	mockRepository.EXPECT().LockUsers(gomock.Any(), []int64{1234}).Return([]model.User{{ID: 1234, Balance: 1000000}}, nil).Times(1)
+	mockRepository.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	mockRepository.EXPECT().InsertTransaction(gomock.Any(), gomock.Any()).Return(transactionID, nil).Times(1)
+
+	// Gateway is slow to accept the request, the reference is recorded once it does
+	release := make(chan struct{})
+	recorded := false
+	mockDisbursement := disbursement.NewMockGateway(controller)
+	mockDisbursement.EXPECT().Disburse(gomock.Any(), gomock.Any()).DoAndReturn(func(context.Context, disbursement.Request) (string, error) {
+		<-release
+		return "REF-1", nil
+	}).Times(1)
+	mockRepository.EXPECT().UpdateTransaction(gomock.Any(), model.UpdateTransactionRequest{ID: transactionID, DisbursementReference: "REF-1"}).Do(func(context.Context, model.UpdateTransactionRequest) {
+		recorded = true
+	}).Return(nil).Times(1)
+
+	usecase := &Usecase{
+		Repository:   mockRepository,
+		Disbursement: mockDisbursement,
+	}
+
+	_, err := usecase.performWithdrawal(context.Background(), model.User{
+		ID:       1234,
+		Password: "$2a$12$35ELZtgOq3iFR6awq.jsDuV5Dr.0XU5k7iUQuShfeLTWRHGFr//fq",
+		Balance:  1000000,
+	}, model.Transaction{
+		UserID:        1234,
+		Amount:        250000,
+		Type:          model.TransactionTypeWithdrawal,
+		BankAccountID: 7,
+		Password:      "Admin1234!",
+	})
+	if err != nil {
+		t.Fatalf("usecase.performWithdrawal() gotErr = %v", err)
+	}
+
+	// Gives up when ctx is done before the request is sent
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := usecase.WaitForDisbursements(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("usecase.WaitForDisbursements() gotErr = %v, wantErr %v", err, context.DeadlineExceeded)
+	}
+
+	close(release)
+	if err := usecase.WaitForDisbursements(context.Background()); err != nil {
+		t.Errorf("usecase.WaitForDisbursements() gotErr = %v", err)
+	}
+	if !recorded {
+		t.Errorf("usecase.WaitForDisbursements() returned before the disbursement reference is recorded")
+	}
+}
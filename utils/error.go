@@ -1,6 +1,10 @@
 package utils
 
-import "github.com/lib/pq"
+import (
+	"errors"
+
+	"github.com/lib/pq"
+)
 
 // IsUniqueConstraintViolation determines if an error is a Postgres UNIQUE constraint error.
 func IsUniqueConstraintViolation(err error) bool {
@@ -14,3 +18,17 @@ func IsUniqueConstraintViolation(err error) bool {
 
 	return false
 }
+
+// IsRetryableTxError determines if an error is a Postgres deadlock (40P01) or serialization failure (40001).
+// Postgres aborts the txn on these errors, so the whole txn can be retried.
+func IsRetryableTxError(err error) bool {
+	var e *pq.Error
+	if errors.As(err, &e) {
+		switch e.Code {
+		case "40P01", "40001":
+			return true
+		}
+	}
+
+	return false
+}
@@ -10,6 +10,14 @@ type JWTPermission string
 const (
 	JWTPermissionGetUser            JWTPermission = "get_profile"
 	JWTPermissionPerformTransaction JWTPermission = "perform_transaction"
+
+	// Granted by the roles of the User, see migrations/sql/0006_create_role.up.sql
+	JWTPermissionReadAnyUser        JWTPermission = "user:read_any"
+	JWTPermissionFreezeUser         JWTPermission = "user:freeze"
+	JWTPermissionReverseTransaction JWTPermission = "transaction:reverse"
+	JWTPermissionReviewKYC          JWTPermission = "kyc:review"
+	JWTPermissionAdjustBalance      JWTPermission = "balance:adjust"
+	JWTPermissionReadAuditLog       JWTPermission = "audit:read" // See migrations/sql/0008_create_audit_log.up.sql
 )
 
 type JWTClaimKey string
@@ -2,20 +2,44 @@ package utils
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"log/slog"
+	"math/rand"
+	"time"
 
+	"github.com/WalletService/logging"
+	"github.com/WalletService/metrics"
 	"github.com/WalletService/repository"
+	"github.com/WalletService/tracing"
+)
+
+var (
+	// DbTxMaxAttempts is the number of times WithDbTxRetry runs a txn aborted by Postgres
+	DbTxMaxAttempts = 3
+	// DbTxRetryBackoff is the backoff before the first retry, it doubles on every retry
+	DbTxRetryBackoff = 20 * time.Millisecond
 )
 
 // WithDbTx executes the provided function in a single DB txn.
 // fn is expected to contain all the operations that need to be performed within a single DB txn.
-func WithDbTx(ctx context.Context, dbTxnRepo repository.DbTxnRepoInterface, fn func(ctx context.Context) error) error {
-	// Revert back to the original *sql.DB Executor after the txn commit/rollback
+// The txn is carried by the ctx passed to fn, so repository calls must use that ctx to run within the txn.
+// The repository itself is never modified, which keeps concurrent requests isolated from each other's txn.
+func WithDbTx(ctx context.Context, dbTxnRepo repository.DbTxnRepoInterface, fn func(ctx context.Context) error) (err error) {
+	// Join the txn already carried by ctx, it is committed or rolled back by whoever started it
+	if _, ok := repository.TxFromContext(ctx); ok {
+		return fn(ctx)
+	}
+
 	db, err := dbTxnRepo.GetSqlDb()
 	if err != nil {
 		return err
 	}
-	defer dbTxnRepo.SetExecutor(db)
+
+	// Queries of the tx are children of its span
+	ctx, span := tracing.Start(ctx, "db.transaction")
+	defer func() { span.End(err) }()
 
 	// Start a new DB tx
 	tx, err := db.BeginTx(ctx, nil)
@@ -23,22 +47,58 @@ func WithDbTx(ctx context.Context, dbTxnRepo repository.DbTxnRepoInterface, fn f
 		return err
 	}
 
-	// Set repository to use the DB tx as the executor
-	dbTxnRepo.SetExecutor(tx)
-
 	// Run all operations in a single tx
-	err = fn(ctx)
+	err = fn(repository.ContextWithTx(ctx, tx))
 	if err != nil {
-		if rbErr := tx.Rollback(); rbErr != nil {
-			return fmt.Errorf("transaction failed: %v, rollback failed: %v", err, rbErr)
+		// database/sql already rolled back the tx if ctx is cancelled or its deadline passed
+		metrics.DBTransactions.Inc(metrics.DBTransactionRollback)
+		span.SetAttributes(tracing.String("db.transaction.result", metrics.DBTransactionRollback))
+		if rbErr := tx.Rollback(); rbErr != nil && !errors.Is(rbErr, sql.ErrTxDone) {
+			err = fmt.Errorf("transaction failed: %w, rollback failed: %v", err, rbErr)
 		}
 		return err
 	}
 
-	// Commit the tx
-	if err := tx.Commit(); err != nil {
+	// Commit the tx, a failed commit is rolled back by Postgres
+	if err = tx.Commit(); err != nil {
+		metrics.DBTransactions.Inc(metrics.DBTransactionRollback)
+		span.SetAttributes(tracing.String("db.transaction.result", metrics.DBTransactionRollback))
 		return err
 	}
+	metrics.DBTransactions.Inc(metrics.DBTransactionCommit)
+	span.SetAttributes(tracing.String("db.transaction.result", metrics.DBTransactionCommit))
 
 	return nil
 }
+
+// WithDbTxRetry executes the provided function in a single DB txn like WithDbTx,
+// and runs the whole txn again if Postgres aborted it due to deadlock or serialization failure.
+// fn may run more than once, so it must not have side effects outside of the DB txn.
+func WithDbTxRetry(ctx context.Context, dbTxnRepo repository.DbTxnRepoInterface, fn func(ctx context.Context) error) error {
+	// Only the outermost txn can be retried, the aborted txn is rolled back by whoever started it
+	if _, ok := repository.TxFromContext(ctx); ok {
+		return WithDbTx(ctx, dbTxnRepo, fn)
+	}
+
+	backoff := DbTxRetryBackoff
+	for attempt := 1; ; attempt++ {
+		err := WithDbTx(ctx, dbTxnRepo, fn)
+		if err == nil || attempt >= DbTxMaxAttempts || !IsRetryableTxError(err) {
+			return err
+		}
+
+		// Jitter the backoff so the conflicting txns do not retry at the same time again
+		wait := backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+		logging.FromContext(ctx).LogAttrs(ctx, slog.LevelWarn, "retrying aborted db transaction",
+			slog.Int("attempt", attempt),
+			slog.Duration("backoff", wait),
+			slog.Any("error", err),
+		)
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return err
+		}
+		backoff *= 2
+	}
+}
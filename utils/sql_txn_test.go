@@ -0,0 +1,103 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/WalletService/repository"
+	"github.com/golang/mock/gomock"
+	"github.com/lib/pq"
+)
+
+func TestWithDbTxRetry(t *testing.T) {
+	DbTxRetryBackoff = time.Millisecond
+
+	var (
+		deadlock = &pq.Error{Code: "40P01"}
+	)
+
+	tests := []struct {
+		name         string
+		fnErrors     []error // Error returned by each attempt
+		wantAttempts int
+		wantCommits  int
+		wantErr      error
+	}{
+		{
+			name:         "success-first-attempt",
+			fnErrors:     []error{nil},
+			wantAttempts: 1,
+			wantCommits:  1,
+		},
+		{
+			name:         "success-after-deadlock",
+			fnErrors:     []error{deadlock, &pq.Error{Code: "40001"}, nil},
+			wantAttempts: 3,
+			wantCommits:  1,
+		},
+		{
+			name:         "fail-deadlock-on-every-attempt",
+			fnErrors:     []error{deadlock, deadlock, deadlock},
+			wantAttempts: 3,
+			wantErr:      deadlock,
+		},
+		{
+			name:         "fail-not-retryable",
+			fnErrors:     []error{errors.New("balance not enough")},
+			wantAttempts: 1,
+			wantErr:      errors.New("balance not enough"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+
+			m := repository.NewMockRepositoryInterface(controller)
+			sqlDb := repository.NewMockSqlDbInterface(controller)
+			sqlTx := repository.NewMockSqlTxInterface(controller)
+
+			m.EXPECT().GetSqlDb().Return(sqlDb, nil).Times(test.wantAttempts)
+			sqlDb.EXPECT().BeginTx(gomock.Any(), gomock.Nil()).Return(sqlTx, nil).Times(test.wantAttempts)
+			sqlTx.EXPECT().Commit().Return(nil).Times(test.wantCommits)
+			sqlTx.EXPECT().Rollback().Return(nil).Times(test.wantAttempts - test.wantCommits)
+
+			attempts := 0
+			gotErr := WithDbTxRetry(context.Background(), m, func(ctx context.Context) error {
+				if tx, ok := repository.TxFromContext(ctx); !ok || tx != sqlTx {
+					t.Errorf("WithDbTxRetry() fn should run with the txn in its ctx")
+				}
+				attempts++
+				return test.fnErrors[attempts-1]
+			})
+
+			if attempts != test.wantAttempts {
+				t.Errorf("WithDbTxRetry() attempts = %v, wantAttempts %v", attempts, test.wantAttempts)
+			}
+			if (gotErr == nil) != (test.wantErr == nil) || (gotErr != nil && gotErr.Error() != test.wantErr.Error()) {
+				t.Errorf("WithDbTxRetry() gotErr = %v, wantErr %v", gotErr, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestWithDbTxRetry_JoinsOuterTxn(t *testing.T) {
+	controller := gomock.NewController(t)
+
+	// No new txn is started when ctx already carries one, and it is never retried on its own
+	m := repository.NewMockRepositoryInterface(controller)
+	sqlTx := repository.NewMockSqlTxInterface(controller)
+	ctx := repository.ContextWithTx(context.Background(), sqlTx)
+
+	attempts := 0
+	err := WithDbTxRetry(ctx, m, func(ctx context.Context) error {
+		attempts++
+		return &pq.Error{Code: "40P01"}
+	})
+
+	if err == nil || attempts != 1 {
+		t.Errorf("WithDbTxRetry() err = %v, attempts = %v, want deadlock error after 1 attempt", err, attempts)
+	}
+}
@@ -1,16 +1,46 @@
 package utils
 
-import "unicode"
+import (
+	"fmt"
+	"unicode"
 
-func ValidatePassword(input *string) (validPassword string, errorList []string) {
+	"github.com/WalletService/phone"
+)
+
+// ValidationLimits bounds User input
+type ValidationLimits struct {
+	PhoneNumberMinLength int // Of the E.164 phone number, "+" inclusive
+	PhoneNumberMaxLength int
+	PasswordMinLength    int
+	PasswordMaxLength    int
+	PhoneNumbers         *phone.Parser // phone.DefaultParser if nil
+}
+
+// DefaultValidationLimits is used when no ValidationLimits is configured
+var DefaultValidationLimits = ValidationLimits{
+	PhoneNumberMinLength: 10,
+	PhoneNumberMaxLength: 16,
+	PasswordMinLength:    6,
+	PasswordMaxLength:    64,
+}
+
+// PhoneNumberParser returns the Parser normalizing phone numbers into E.164
+func (l ValidationLimits) PhoneNumberParser() *phone.Parser {
+	if l.PhoneNumbers == nil {
+		return phone.DefaultParser
+	}
+	return l.PhoneNumbers
+}
+
+func ValidatePassword(input *string, limits ValidationLimits) (validPassword string, errorList []string) {
 	password := ""
 
 	if input != nil {
 		password = *input
 	}
 
-	if len(password) < 6 || len(password) > 64 {
-		errorList = append(errorList, "password should be 6 to 64 characters")
+	if len(password) < limits.PasswordMinLength || len(password) > limits.PasswordMaxLength {
+		errorList = append(errorList, fmt.Sprintf("password should be %d to %d characters", limits.PasswordMinLength, limits.PasswordMaxLength))
 	}
 
 	containsCapital, containsNumber, containsSpecialAlphaNumeric := false, false, false